@@ -71,6 +71,12 @@ type ComponentSpec struct {
 	// This references a Workflow CR and provides developer-configured schema values
 	// +optional
 	Workflow *WorkflowConfig `json:"workflow,omitempty"`
+
+	// ReleaseRetention controls which of the ComponentReleases of this component are kept.
+	// Fields that are not set default to the retention policy of the controller manager,
+	// which does not prune releases unless it is configured to.
+	// +optional
+	ReleaseRetention *ReleaseRetentionPolicy `json:"releaseRetention,omitempty"`
 }
 
 // ComponentTrait represents an trait instance attached to a component
@@ -109,6 +115,10 @@ type ComponentStatus struct {
 	// deployed to the first environment, if the autoDeploy flag is set to true
 	// +optional
 	LatestRelease *LatestRelease `json:"latestRelease,omitempty"`
+
+	// ReleaseRetention reports the ComponentReleases most recently pruned by the retention policy
+	// +optional
+	ReleaseRetention *ReleaseRetentionStatus `json:"releaseRetention,omitempty"`
 }
 
 // LatestRelease has name and generated hash of the latest ComponentRelease spec
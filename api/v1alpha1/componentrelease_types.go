@@ -79,6 +79,38 @@ type ComponentReleaseList struct {
 	Items           []ComponentRelease `json:"items"`
 }
 
+// ReleaseRetentionPolicy controls which ComponentReleases of a component are pruned.
+// A ComponentRelease is never pruned while it is the latest release of its component, is bound to
+// an environment by a ReleaseBinding (including the releases of an ongoing rollout and the releases
+// in the binding history) or is requested by a pending promotion request. Any other release is pruned
+// once it is not among the KeepLast most recent releases of the component, or once it is older than
+// MaxAge. A policy that sets neither field prunes nothing.
+type ReleaseRetentionPolicy struct {
+	// KeepLast is the number of most recent ComponentReleases kept unless they are older than MaxAge.
+	// Not set means releases are not pruned by count.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	KeepLast *int32 `json:"keepLast,omitempty"`
+
+	// MaxAge is the age after which a ComponentRelease is pruned even if it is among the KeepLast
+	// most recent releases. Not set means releases are not pruned by age.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// ReleaseRetentionStatus reports the last pruning of the ComponentReleases of a component.
+type ReleaseRetentionStatus struct {
+	// LastPrunedAt is when ComponentReleases were last pruned
+	// +optional
+	LastPrunedAt *metav1.Time `json:"lastPrunedAt,omitempty"`
+
+	// LastPrunedReleases are the names of the ComponentReleases removed by the last pruning
+	// +optional
+	LastPrunedReleases []string `json:"lastPrunedReleases,omitempty"`
+}
+
 // ComponentReleaseOwner identifies the component this ComponentRelease belongs to
 type ComponentReleaseOwner struct {
 	// ProjectName is the name of the project that owns this component
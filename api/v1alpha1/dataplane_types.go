@@ -9,7 +9,9 @@ import (
 
 // ValueFrom defines a common pattern for referencing secrets or providing inline values
 type ValueFrom struct {
-	// SecretRef is a reference to a secret containing the value
+	// SecretRef is a reference to a secret containing the value, in the form "<name>" or "<name>/<key>".
+	// The secret is read from the namespace of the referencing resource. When the key is omitted,
+	// the well-known key of the field is used (ca.crt, tls.crt, tls.key, token or client-secret).
 	// +optional
 	SecretRef string `json:"secretRef,omitempty"`
 	// Value is the inline value (optional fallback)
@@ -41,6 +43,13 @@ type KubernetesAuth struct {
 	// BearerToken contains the bearer token authentication configuration
 	// +optional
 	BearerToken *ValueFrom `json:"bearerToken,omitempty"`
+	// OIDC obtains bearer tokens from an OIDC provider with the client credentials flow
+	// +optional
+	OIDC *OIDCAuth `json:"oidc,omitempty"`
+	// Exec obtains credentials from an external command, such as a cloud provider CLI.
+	// The command must be allowed by the exec authentication settings of the controller manager.
+	// +optional
+	Exec *ExecAuth `json:"exec,omitempty"`
 }
 
 // MTLSAuth defines certificate-based authentication (mTLS)
@@ -51,6 +60,49 @@ type MTLSAuth struct {
 	ClientKey ValueFrom `json:"clientKey"`
 }
 
+// OIDCAuth defines bearer token authentication with tokens issued by an OIDC provider
+type OIDCAuth struct {
+	// TokenURL is the token endpoint of the OIDC provider
+	// +kubebuilder:validation:MinLength=1
+	TokenURL string `json:"tokenURL"`
+	// ClientID is the client identifier registered with the provider
+	// +kubebuilder:validation:MinLength=1
+	ClientID string `json:"clientID"`
+	// ClientSecret contains the client secret configuration
+	ClientSecret ValueFrom `json:"clientSecret"`
+	// Scopes are the scopes requested for the token
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+	// Audience is the audience requested for the token, for providers that require it
+	// +optional
+	Audience string `json:"audience,omitempty"`
+}
+
+// ExecAuth defines authentication with a client-go credential plugin
+type ExecAuth struct {
+	// Command is the command to execute
+	// +kubebuilder:validation:MinLength=1
+	Command string `json:"command"`
+	// Args are the arguments passed to the command
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// Env defines additional environment variables for the command
+	// +optional
+	Env []ExecEnvVar `json:"env,omitempty"`
+	// APIVersion is the version of the ExecCredential returned by the command
+	// +kubebuilder:default=client.authentication.k8s.io/v1
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+// ExecEnvVar defines an environment variable for a credential plugin
+type ExecEnvVar struct {
+	// Name of the environment variable
+	Name string `json:"name"`
+	// Value of the environment variable
+	Value string `json:"value"`
+}
+
 // GatewaySpec defines the gateway configuration for the data plane
 type GatewaySpec struct {
 	// Public virtual host for the gateway
@@ -87,7 +139,71 @@ type SecretStoreRef struct {
 	Name string `json:"name"`
 }
 
+// GitOpsExportMode controls whether the releases of a data plane are applied in addition to being exported.
+// +kubebuilder:validation:Enum=ExportOnly;ExportAndApply
+type GitOpsExportMode string
+
+const (
+	// GitOpsExportModeExportOnly only writes the releases to the repository. The data plane is
+	// expected to be synced from the repository by a GitOps agent such as Argo CD or Flux.
+	GitOpsExportModeExportOnly GitOpsExportMode = "ExportOnly"
+	// GitOpsExportModeExportAndApply writes the releases to the repository and applies them to the data plane.
+	GitOpsExportModeExportAndApply GitOpsExportMode = "ExportAndApply"
+)
+
+// GitOpsExport configures exporting the rendered releases of a data plane to a Git repository.
+// The resources of each release are written as YAML files into the
+// <path>/<organization>/<project>/<component>/<environment> directory of the repository.
+// Secrets are not exported, as the repository would hold their data in plain text. Use SecretReferences,
+// which are exported as ExternalSecrets, to provide secrets to the data plane.
+type GitOpsExport struct {
+	// RepoURL is the HTTPS or SSH URL of the repository, e.g. https://github.com/org/gitops.git
+	// +kubebuilder:validation:MinLength=1
+	RepoURL string `json:"repoURL"`
+	// Branch to commit into. Defaults to the default branch of the repository.
+	// +optional
+	Branch string `json:"branch,omitempty"`
+	// Path is the directory of the repository the releases are written under. Defaults to the repository root.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// AuthSecretRef is the name of a Secret in the organization namespace that contains write credentials
+	// data["username"], data["password"] for HTTPS  **or**
+	// data["ssh-privatekey"] for SSH
+	// +optional
+	AuthSecretRef string `json:"authSecretRef,omitempty"`
+	// Author of the export commits
+	// +optional
+	Author GitCommitAuthor `json:"author,omitempty"`
+	// Mode controls whether the releases are also applied to the data plane
+	// +kubebuilder:default=ExportAndApply
+	// +optional
+	Mode GitOpsExportMode `json:"mode,omitempty"`
+}
+
+// DataPlaneAgent configures a data plane that is managed by an agent running inside it.
+// The agent connects to the control plane, applies the Releases of the environments that use the data plane
+// and reports their status back, so the control plane never connects to the data plane API server.
+type DataPlaneAgent struct {
+	// HeartbeatTimeout is how long after the last heartbeat the agent is reported as disconnected.
+	// Defaults to 2m if not specified.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"
+	// +optional
+	HeartbeatTimeout *metav1.Duration `json:"heartbeatTimeout,omitempty"`
+}
+
+// DataPlaneAgentStatus reports the agent connected to a data plane.
+type DataPlaneAgentStatus struct {
+	// LastHeartbeatTime is when the agent last reported that it is running
+	// +optional
+	LastHeartbeatTime *metav1.Time `json:"lastHeartbeatTime,omitempty"`
+	// Version is the version of the agent
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
 // DataPlaneSpec defines the desired state of a DataPlane.
+// +kubebuilder:validation:XValidation:rule="has(self.agent) ? !has(self.kubernetesCluster) : has(self.kubernetesCluster) || (has(self.gitOps) && has(self.gitOps.mode) && self.gitOps.mode == 'ExportOnly')",message="spec.kubernetesCluster and spec.agent must not both be set, and one of them is required unless spec.gitOps.mode is ExportOnly"
 type DataPlaneSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
@@ -101,8 +217,20 @@ type DataPlaneSpec struct {
 	// +optional
 	SecretStoreRef *SecretStoreRef `json:"secretStoreRef,omitempty"`
 
+	// GitOps exports the rendered releases of this data plane to a Git repository
+	// +optional
+	GitOps *GitOpsExport `json:"gitOps,omitempty"`
+
+	// Agent configures the data plane to be managed by an agent running inside it instead of
+	// the control plane connecting to the cluster with the KubernetesCluster credentials.
+	// +optional
+	Agent *DataPlaneAgent `json:"agent,omitempty"`
+
 	// KubernetesCluster defines the target Kubernetes cluster where workloads should be deployed.
-	KubernetesCluster KubernetesClusterSpec `json:"kubernetesCluster"`
+	// Must not be set for data planes managed by an agent, and may be omitted for ExportOnly GitOps data planes,
+	// which the control plane does not connect to.
+	// +optional
+	KubernetesCluster *KubernetesClusterSpec `json:"kubernetesCluster,omitempty"`
 	// Gateway specifies the configuration for the API gateway in this DataPlane.
 	Gateway GatewaySpec `json:"gateway"`
 	// Observer specifies the configuration for the Observer API integration.
@@ -116,6 +244,10 @@ type DataPlaneStatus struct {
 	// Important: Run "make" to regenerate code after modifying this file
 	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
 	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+
+	// Agent reports the agent managing the data plane, if spec.agent is set
+	// +optional
+	Agent *DataPlaneAgentStatus `json:"agent,omitempty"`
 }
 
 // +kubebuilder:object:root=true
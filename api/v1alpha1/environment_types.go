@@ -19,6 +19,11 @@ type EnvironmentSpec struct {
 	DataPlaneRef string        `json:"dataPlaneRef,omitempty"`
 	IsProduction bool          `json:"isProduction,omitempty"`
 	Gateway      GatewayConfig `json:"gateway,omitempty"`
+
+	// DriftMode defines how changes made to the resources of the environment outside of OpenChoreo
+	// are handled. Releases can override it. Defaults to Correct.
+	// +optional
+	DriftMode DriftMode `json:"driftMode,omitempty"`
 }
 
 // EnvironmentStatus defines the observed state of Environment.
@@ -29,6 +29,10 @@ type GitCommitRequestSpec struct {
 	AuthSecretRef string `json:"authSecretRef,omitempty"`
 	// Files to create or patch
 	Files []FileEdit `json:"files"`
+	// Directories to delete before the files are written, so that they only
+	// contain the files of this request afterwards
+	// +optional
+	PrunePaths []string `json:"prunePaths,omitempty"`
 }
 
 type GitCommitAuthor struct {
@@ -42,15 +46,27 @@ type FileEdit struct {
 	Patch   string `json:"patch,omitempty"`   // optional RFC-6902 JSON patch
 }
 
+// GitCommitRequestPhase is the lifecycle phase of a GitCommitRequest.
+type GitCommitRequestPhase string
+
+const (
+	// GitCommitRequestPhasePending means the commit is being prepared and pushed
+	GitCommitRequestPhasePending GitCommitRequestPhase = "Pending"
+	// GitCommitRequestPhaseSucceeded means the branch contains the requested edits
+	GitCommitRequestPhaseSucceeded GitCommitRequestPhase = "Succeeded"
+	// GitCommitRequestPhaseFailed means the last attempt failed; it is retried with backoff
+	GitCommitRequestPhaseFailed GitCommitRequestPhase = "Failed"
+)
+
 // GitCommitRequestStatus defines the observed state of GitCommitRequest.
 type GitCommitRequestStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
-	Phase          string `json:"phase,omitempty"`          // Pending|Succeeded|Failed
-	ObservedSHA    string `json:"observedSHA,omitempty"`    // last commit SHA
-	ObservedBranch string `json:"observedBranch,omitempty"` // branch we pushed
-	Message        string `json:"message,omitempty"`
+	Phase          GitCommitRequestPhase `json:"phase,omitempty"`          // Pending|Succeeded|Failed
+	ObservedSHA    string                `json:"observedSHA,omitempty"`    // last commit SHA
+	ObservedBranch string                `json:"observedBranch,omitempty"` // branch we pushed
+	Message        string                `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,126 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GuardrailMode defines what happens when a resource violates a guardrail rule
+// +kubebuilder:validation:Enum=Enforce;Warn
+type GuardrailMode string
+
+const (
+	// GuardrailModeEnforce fails the rendering of the component and reports the violation on the binding
+	GuardrailModeEnforce GuardrailMode = "Enforce"
+
+	// GuardrailModeWarn reports the violation as a rendering warning and keeps the resource
+	GuardrailModeWarn GuardrailMode = "Warn"
+)
+
+// GuardrailPolicySpec defines the desired state of GuardrailPolicy.
+//
+// Example:
+//
+//	rules:
+//	  - name: min-replicas-in-production
+//	    target:
+//	      group: apps
+//	      kind: Deployment
+//	    rule: ${environment.name != "production" || (has(resource.spec.replicas) && resource.spec.replicas >= 2)}
+//	    message: Deployments in production must run at least 2 replicas
+//	  - name: trusted-registry
+//	    target:
+//	      group: apps
+//	      kind: Deployment
+//	    rule: ${resource.spec.template.spec.containers.all(c, c.image.startsWith("registry.acme.io/"))}
+//	    message: Images must come from registry.acme.io
+//	    mode: Warn
+type GuardrailPolicySpec struct {
+	// Rules are evaluated against every resource rendered for the components of the organization
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Rules []GuardrailRule `json:"rules"`
+}
+
+// GuardrailRule is a CEL check over a rendered resource
+type GuardrailRule struct {
+	// Name identifies the rule in violation messages
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Target restricts the rule to resources of a group, version and kind
+	// All resources are checked when not set
+	// +optional
+	Target *GuardrailTarget `json:"target,omitempty"`
+
+	// Rule is a CEL expression that must evaluate to true for the resource to comply
+	// The expression sees the rendered resource as "resource" and the rendering context of the
+	// component as "environment", "component" and "metadata".
+	// Rules referencing fields that are missing from a resource are violated by it, so optional
+	// fields are guarded with has().
+	// Example: ${!has(resource.spec.replicas) || resource.spec.replicas <= 10}
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^\$\{.+\}$`
+	Rule string `json:"rule"`
+
+	// Message describes the violation
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Mode defines whether a violation fails the rendering or only produces a warning
+	// +optional
+	// +kubebuilder:default=Enforce
+	Mode GuardrailMode `json:"mode,omitempty"`
+}
+
+// GuardrailTarget selects the resources a guardrail rule applies to
+// Empty fields match any value
+type GuardrailTarget struct {
+	// Group is the API group of the resource (e.g., "apps", "batch")
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Version is the API version of the resource (e.g., "v1")
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Kind is the resource type (e.g., "Deployment")
+	// +optional
+	Kind string `json:"kind,omitempty"`
+}
+
+// GuardrailPolicyStatus defines the observed state of GuardrailPolicy.
+type GuardrailPolicyStatus struct {
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=gp;gps
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// GuardrailPolicy is the Schema for the guardrailpolicies API.
+// The rules of all GuardrailPolicies in an organization are checked against the rendered
+// resources of every component deployed in the organization.
+type GuardrailPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GuardrailPolicySpec   `json:"spec,omitempty"`
+	Status GuardrailPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GuardrailPolicyList contains a list of GuardrailPolicy.
+type GuardrailPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GuardrailPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GuardrailPolicy{}, &GuardrailPolicyList{})
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PromotionRequestSpec defines the desired state of PromotionRequest.
+type PromotionRequestSpec struct {
+	// Owner identifies the component and project being promoted
+	// +kubebuilder:validation:Required
+	Owner PromotionRequestOwner `json:"owner"`
+
+	// SourceEnvironment is the environment the release is promoted from
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	SourceEnvironment string `json:"sourceEnvironment"`
+
+	// TargetEnvironment is the environment the release is promoted to
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	TargetEnvironment string `json:"targetEnvironment"`
+
+	// ReleaseName is the ComponentRelease bound to the source environment when the promotion was requested.
+	// This is the release that gets bound to the target environment once the request is approved.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ReleaseName string `json:"releaseName"`
+
+	// RequestedBy is the identity of the user who requested the promotion
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+
+	// Reason is a free-form justification for the promotion
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// PromotionRequestOwner identifies the component a PromotionRequest belongs to
+type PromotionRequestOwner struct {
+	// ProjectName is the name of the project that owns this component
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ProjectName string `json:"projectName"`
+
+	// ComponentName is the name of the component
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ComponentName string `json:"componentName"`
+}
+
+// PromotionRequestPhase is the phase of a PromotionRequest
+type PromotionRequestPhase string
+
+const (
+	// PromotionRequestPhasePending indicates the request is waiting for a review.
+	PromotionRequestPhasePending PromotionRequestPhase = "Pending"
+	// PromotionRequestPhaseApproved indicates the request was approved but the release is not bound yet.
+	PromotionRequestPhaseApproved PromotionRequestPhase = "Approved"
+	// PromotionRequestPhaseRejected indicates the request was rejected.
+	PromotionRequestPhaseRejected PromotionRequestPhase = "Rejected"
+	// PromotionRequestPhasePromoted indicates the release was bound to the target environment.
+	PromotionRequestPhasePromoted PromotionRequestPhase = "Promoted"
+)
+
+// PromotionRequestStatus defines the observed state of PromotionRequest.
+type PromotionRequestStatus struct {
+	// Phase is the current phase of the request
+	// +optional
+	Phase PromotionRequestPhase `json:"phase,omitempty"`
+
+	// ReviewedBy is the identity of the user who approved or rejected the request
+	// +optional
+	ReviewedBy string `json:"reviewedBy,omitempty"`
+
+	// ReviewedAt is when the request was approved or rejected
+	// +optional
+	ReviewedAt *metav1.Time `json:"reviewedAt,omitempty"`
+
+	// Comment is the comment left by the reviewer
+	// +optional
+	Comment string `json:"comment,omitempty"`
+
+	// ReleaseBindingName is the ReleaseBinding created or updated for the target environment
+	// +optional
+	ReleaseBindingName string `json:"releaseBindingName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Component",type=string,JSONPath=`.spec.owner.componentName`
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.sourceEnvironment`
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.targetEnvironment`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// PromotionRequest is the Schema for the promotionrequests API.
+// It records a request to promote a component release to an environment that requires approval,
+// together with the identity of the requester and the reviewer.
+type PromotionRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PromotionRequestSpec   `json:"spec,omitempty"`
+	Status PromotionRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PromotionRequestList contains a list of PromotionRequest.
+type PromotionRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PromotionRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PromotionRequest{}, &PromotionRequestList{})
+}
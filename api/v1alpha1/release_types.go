@@ -39,17 +39,46 @@ type ReleaseSpec struct {
 	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"
 	// +optional
 	ProgressingInterval *metav1.Duration `json:"progressingInterval,omitempty"`
+
+	// DriftMode defines how changes made to the applied resources outside of the Release are handled.
+	// Defaults to the drift mode of the environment, or Correct if the environment does not set one.
+	// +optional
+	DriftMode DriftMode `json:"driftMode,omitempty"`
 }
 
+// DriftMode defines how the Release controller handles fields of applied resources that were
+// changed in the data plane by another field manager (e.g., a manual kubectl edit).
+// Fields owned by autoscalers, such as the replicas of a Deployment scaled by a
+// HorizontalPodAutoscaler, are not drift and are left to the autoscaler.
+// +kubebuilder:validation:Enum=Correct;DetectOnly
+type DriftMode string
+
+const (
+	// DriftModeCorrect reverts drifted fields to the values in the Release and reports the correction.
+	DriftModeCorrect DriftMode = "Correct"
+	// DriftModeDetectOnly reports drifted resources and stops applying to them until the drift is resolved.
+	DriftModeDetectOnly DriftMode = "DetectOnly"
+)
+
 // ReleaseStatus defines the observed state of Release.
 type ReleaseStatus struct {
 	// Resources contain the list of resources that have been successfully applied to the data plane
 	// +optional
 	Resources []ResourceStatus `json:"resources,omitempty"`
 
+	// Drift lists the applied resources with fields changed in the data plane that were not reverted.
+	// Only populated in the DetectOnly drift mode.
+	// +optional
+	Drift []ResourceDrift `json:"drift,omitempty"`
+
 	// Conditions represent the latest available observations of the Release's current state.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the generation of the Release the resources were last applied for.
+	// The resource statuses belong to an earlier spec while it is lower than metadata.generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -136,6 +165,52 @@ type ResourceStatus struct {
 	LastObservedTime *metav1.Time `json:"lastObservedTime,omitempty"`
 }
 
+// ResourceDrift records an applied resource whose fields were changed in the data plane
+// outside of the Release.
+type ResourceDrift struct {
+	// ID corresponds to the resource ID in spec.resources
+	// +kubebuilder:validation:MinLength=1
+	ID string `json:"id"`
+
+	// Group is the API group of the resource (e.g., "apps", "batch")
+	// Empty string for core resources
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Version is the API version of the resource (e.g., "v1", "v1beta1")
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+
+	// Kind is the type of the resource (e.g., "Deployment", "Service")
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+
+	// Name is the name of the resource in the data plane
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the resource in the data plane
+	// Empty for cluster-scoped resources
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Fields are the fields of the resource that differ from spec.resources
+	Fields []DriftedField `json:"fields"`
+
+	// DetectedAt is when the drift was first detected
+	DetectedAt metav1.Time `json:"detectedAt"`
+}
+
+// DriftedField is a field of an applied resource that was changed by another field manager.
+type DriftedField struct {
+	// Path is the path of the field (e.g., ".spec.replicas")
+	Path string `json:"path"`
+
+	// Manager is the field manager that changed the field (e.g., "kubectl-edit")
+	// +optional
+	Manager string `json:"manager,omitempty"`
+}
+
 // HealthStatus represents the health of a resource
 type HealthStatus string
 
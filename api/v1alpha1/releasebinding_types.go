@@ -4,6 +4,7 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -58,6 +59,94 @@ type ReleaseBindingSpec struct {
 	// +optional
 	// +kubebuilder:pruning:PreserveUnknownFields
 	WorkloadOverrides *WorkloadOverrideTemplateSpec `json:"workloadOverrides,omitempty"`
+
+	// Rollout configures progressive delivery when ReleaseName changes.
+	// When not set, the new release replaces the current one in a single step.
+	// +optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
+}
+
+// RolloutStrategyType is the type of progressive delivery strategy
+// +kubebuilder:validation:Enum=Canary;BlueGreen
+type RolloutStrategyType string
+
+const (
+	// RolloutStrategyCanary shifts traffic to the new release in weighted steps.
+	RolloutStrategyCanary RolloutStrategyType = "Canary"
+	// RolloutStrategyBlueGreen deploys the new release next to the current one without traffic,
+	// then switches all traffic at once after analysis succeeds.
+	RolloutStrategyBlueGreen RolloutStrategyType = "BlueGreen"
+)
+
+// RolloutStrategy defines how a ReleaseBinding moves from one release to the next.
+// The previous and the new release run side by side and traffic is split between them
+// using the weights of the Gateway API HTTPRoutes rendered by the component.
+// Each step is gated on the health of the new release and the optional analysis metrics;
+// the rollout is rolled back automatically if the new release becomes degraded or a metric fails.
+type RolloutStrategy struct {
+	// Type is the rollout strategy type
+	// +kubebuilder:validation:Required
+	Type RolloutStrategyType `json:"type"`
+
+	// Steps are the traffic weights the new release goes through for the Canary strategy.
+	// Defaults to a single step at weight 100. Ignored for BlueGreen, which always previews
+	// the new release at weight 0 and then switches to 100.
+	// +optional
+	Steps []RolloutStep `json:"steps,omitempty"`
+
+	// Analysis defines the checks that must pass before moving to the next step
+	// +optional
+	Analysis *RolloutAnalysis `json:"analysis,omitempty"`
+}
+
+// RolloutStep is a single traffic weight of a canary rollout.
+type RolloutStep struct {
+	// Weight is the percentage of traffic sent to the new release
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+
+	// Pause is how long the step is observed before moving on.
+	// Defaults to the analysis interval.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"
+	// +optional
+	Pause *metav1.Duration `json:"pause,omitempty"`
+}
+
+// RolloutAnalysis defines the checks evaluated at the end of each rollout step.
+type RolloutAnalysis struct {
+	// Interval is the default time each step is observed before the metrics are evaluated.
+	// Defaults to 1m if not specified.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Metrics are Prometheus queries whose result must stay within the given range
+	// +optional
+	Metrics []RolloutMetric `json:"metrics,omitempty"`
+}
+
+// RolloutMetric is a Prometheus instant query evaluated during a rollout.
+type RolloutMetric struct {
+	// Name identifies the metric in the rollout status
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Query is a PromQL query that must return a single value.
+	// It may reference the tracks of the rollout with ${canary.*} and ${stable.*} expressions,
+	// e.g. ${canary.name}, ${canary.namespace} and ${canary.componentName}.
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query"`
+
+	// Min is the lowest acceptable value (inclusive)
+	// +optional
+	Min *resource.Quantity `json:"min,omitempty"`
+
+	// Max is the highest acceptable value (inclusive)
+	// +optional
+	Max *resource.Quantity `json:"max,omitempty"`
 }
 
 // ReleaseBindingOwner identifies the component this ReleaseBinding belongs to
@@ -78,6 +167,83 @@ type ReleaseBindingStatus struct {
 	// Conditions represent the latest available observations of the ReleaseBinding's current state.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Rollout tracks the progressive delivery state when spec.rollout is set
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+
+	// History lists the ComponentReleases bound to this ReleaseBinding, most recent first.
+	// The first entry is the release currently bound. Older entries are dropped once the
+	// history reaches its maximum length.
+	// +optional
+	// +kubebuilder:validation:MaxItems=20
+	History []ReleaseHistoryEntry `json:"history,omitempty"`
+}
+
+// ReleaseHistoryEntry records a ComponentRelease that was bound to a ReleaseBinding.
+type ReleaseHistoryEntry struct {
+	// ReleaseName is the name of the bound ComponentRelease
+	ReleaseName string `json:"releaseName"`
+
+	// BoundAt is when the ReleaseBinding started to reference the release
+	BoundAt metav1.Time `json:"boundAt"`
+
+	// BoundBy is the user who bound the release, if it was bound through the OpenChoreo API
+	// +optional
+	BoundBy string `json:"boundBy,omitempty"`
+}
+
+// RolloutPhase is the phase of a progressive rollout
+type RolloutPhase string
+
+const (
+	// RolloutPhaseStable indicates no rollout is in progress and the stable release serves all traffic.
+	RolloutPhaseStable RolloutPhase = "Stable"
+	// RolloutPhaseProgressing indicates the new release is receiving traffic in steps.
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+	// RolloutPhasePromoting indicates all steps passed and the stable track is being updated to the new release.
+	RolloutPhasePromoting RolloutPhase = "Promoting"
+	// RolloutPhaseRolledBack indicates the new release failed and traffic was returned to the stable release.
+	RolloutPhaseRolledBack RolloutPhase = "RolledBack"
+)
+
+// RolloutStatus is the observed state of a progressive rollout.
+type RolloutStatus struct {
+	// Phase is the current phase of the rollout
+	Phase RolloutPhase `json:"phase"`
+
+	// StableRelease is the ComponentRelease currently serving production traffic
+	StableRelease string `json:"stableRelease"`
+
+	// StableTrackLabeled reports that the pods of the stable workloads carry the release track label.
+	// The stable Services are only narrowed to the release track once these pods are ready.
+	// +optional
+	StableTrackLabeled bool `json:"stableTrackLabeled,omitempty"`
+
+	// CanaryRelease is the ComponentRelease being rolled out
+	// +optional
+	CanaryRelease string `json:"canaryRelease,omitempty"`
+
+	// FailedRelease is the last ComponentRelease that was rolled back.
+	// It is not retried until spec.releaseName changes to a different release.
+	// +optional
+	FailedRelease string `json:"failedRelease,omitempty"`
+
+	// CurrentStep is the index of the current step
+	// +optional
+	CurrentStep int32 `json:"currentStep,omitempty"`
+
+	// CanaryWeight is the percentage of traffic currently sent to the canary release
+	// +optional
+	CanaryWeight int32 `json:"canaryWeight,omitempty"`
+
+	// StepStartedAt is when the current step or phase started
+	// +optional
+	StepStartedAt *metav1.Time `json:"stepStartedAt,omitempty"`
+
+	// Message is a human readable description of the rollout state
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -88,6 +88,14 @@ type SecretStoreReference struct {
 
 	// Kind of resource (ExternalSecret, ClusterExternalSecret)
 	Kind string `json:"kind"`
+
+	// DataPlane is the name of the DataPlane the ExternalSecret is deployed to
+	// +optional
+	DataPlane string `json:"dataPlane,omitempty"`
+
+	// ExternalSecretName is the name of the ExternalSecret consuming this reference
+	// +optional
+	ExternalSecretName string `json:"externalSecretName,omitempty"`
 }
 
 // SecretReferenceStatus defines the observed state of SecretReference.
@@ -129,6 +137,16 @@ type SecretReferenceList struct {
 	Items           []SecretReference `json:"items"`
 }
 
+// GetConditions returns the conditions from the status
+func (s *SecretReference) GetConditions() []metav1.Condition {
+	return s.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status
+func (s *SecretReference) SetConditions(conditions []metav1.Condition) {
+	s.Status.Conditions = conditions
+}
+
 func init() {
 	SchemeBuilder.Register(&SecretReference{}, &SecretReferenceList{})
 }
@@ -61,6 +61,10 @@ type WorkloadEndpoint struct {
 	// +kubebuilder:validation:Enum=HTTP;REST;gRPC;GraphQL;Websocket;TCP;UDP
 	Type EndpointType `json:"type"`
 
+	// BasePath is the base path of the API served by the endpoint, e.g. /api/v1.
+	// +optional
+	BasePath string `json:"basePath,omitempty"`
+
 	// Port number for the endpoint.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Minimum=1
@@ -71,6 +75,12 @@ type WorkloadEndpoint struct {
 	// This can be used to define the actual API definition of the endpoint that is exposed by the workload.
 	// +optional
 	Schema *Schema `json:"schema,omitempty"`
+
+	// Visibility is the widest scope from which other components can connect to the endpoint.
+	// Defaults to Project, which only allows components of the same project to connect.
+	// +kubebuilder:validation:Enum=Project;Organization;Public
+	// +optional
+	Visibility EndpointExposeLevel `json:"visibility,omitempty"`
 }
 
 // Schema defines the API definition for an endpoint.
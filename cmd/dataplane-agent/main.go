@@ -0,0 +1,174 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"flag"
+	"os"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/agent"
+	"github.com/openchoreo/openchoreo/internal/controller/release"
+	"github.com/openchoreo/openchoreo/internal/version"
+)
+
+// The dataplane agent runs inside a dataplane cluster and applies the Releases of the environments that use
+// its DataPlane. It connects to the control plane with the given kubeconfig, so the control plane never
+// needs credentials for the dataplane API server.
+
+var (
+	controlPlaneScheme = runtime.NewScheme()
+	dataPlaneScheme    = runtime.NewScheme()
+	setupLog           = ctrl.Log.WithName("setup")
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(controlPlaneScheme))
+	utilruntime.Must(openchoreov1alpha1.AddToScheme(controlPlaneScheme))
+
+	utilruntime.Must(clientgoscheme.AddToScheme(dataPlaneScheme))
+}
+
+func main() {
+	var controlPlaneKubeconfig string
+	var dataPlaneNamespace string
+	var dataPlaneName string
+	var metricsAddr string
+	var probeAddr string
+	var enableLeaderElection bool
+	var leaderElectionNamespace string
+	var heartbeatInterval time.Duration
+	var healthCheckConfig string
+	flag.StringVar(&controlPlaneKubeconfig, "control-plane-kubeconfig", "",
+		"Path to the kubeconfig used to connect to the control plane. "+
+			"The credentials need access to the Releases, Environments and DataPlanes of the organization.")
+	flag.StringVar(&dataPlaneNamespace, "dataplane-namespace", "",
+		"Namespace (organization) of the DataPlane managed by the agent.")
+	flag.StringVar(&dataPlaneName, "dataplane-name", "", "Name of the DataPlane managed by the agent.")
+	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
+		"Use :8080 for HTTP, or leave as 0 to disable the metrics service.")
+	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
+		"Enable leader election for the agent. The lease is held in the dataplane cluster.")
+	flag.StringVar(&leaderElectionNamespace, "leader-election-namespace", "",
+		"Namespace of the dataplane cluster the leader election lease is created in. "+
+			"Defaults to the namespace the agent runs in.")
+	flag.DurationVar(&heartbeatInterval, "heartbeat-interval", agent.DefaultHeartbeatInterval,
+		"How often the agent reports to the control plane that it is running.")
+	flag.StringVar(&healthCheckConfig, "health-check-config", "",
+		"Path to a YAML file with CEL health rules for resource kinds applied by Releases. "+
+			"Rules replace the built-in health checks for the same kinds.")
+	opts := zap.Options{
+		Development: true,
+	}
+	opts.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+
+	setupLog.Info("starting dataplane agent", version.GetLogKeyValues()...)
+
+	if controlPlaneKubeconfig == "" || dataPlaneNamespace == "" || dataPlaneName == "" {
+		setupLog.Error(nil, "--control-plane-kubeconfig, --dataplane-namespace and --dataplane-name are required")
+		os.Exit(1)
+	}
+	dataPlane := types.NamespacedName{Namespace: dataPlaneNamespace, Name: dataPlaneName}
+
+	controlPlaneConfig, err := clientcmd.BuildConfigFromFlags("", controlPlaneKubeconfig)
+	if err != nil {
+		setupLog.Error(err, "unable to load the control plane kubeconfig", "path", controlPlaneKubeconfig)
+		os.Exit(1)
+	}
+	// The agent runs in the dataplane, so the in-cluster config is the dataplane config
+	dataPlaneConfig := ctrl.GetConfigOrDie()
+
+	dpClient, err := client.New(dataPlaneConfig, client.Options{Scheme: dataPlaneScheme})
+	if err != nil {
+		setupLog.Error(err, "unable to create dataplane client")
+		os.Exit(1)
+	}
+
+	// The manager watches the control plane. Releases live in the organization namespace of the DataPlane,
+	// so the cache is limited to that namespace. The leader election lease is kept in the dataplane.
+	mgr, err := ctrl.NewManager(controlPlaneConfig, ctrl.Options{
+		Scheme:                  controlPlaneScheme,
+		Metrics:                 metricsserver.Options{BindAddress: metricsAddr},
+		HealthProbeBindAddress:  probeAddr,
+		LeaderElection:          enableLeaderElection,
+		LeaderElectionID:        "dataplane-agent." + dataPlaneName + ".openchoreo.dev",
+		LeaderElectionConfig:    dataPlaneConfig,
+		LeaderElectionNamespace: leaderElectionNamespace,
+		Cache: cache.Options{
+			DefaultNamespaces: map[string]cache.Config{dataPlaneNamespace: {}},
+		},
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to start manager")
+		os.Exit(1)
+	}
+
+	healthChecks := release.NewHealthCheckRegistry()
+	if healthCheckConfig != "" {
+		rules, err := release.LoadHealthRules(healthCheckConfig)
+		if err != nil {
+			setupLog.Error(err, "unable to load health check config", "path", healthCheckConfig)
+			os.Exit(1)
+		}
+		if err := healthChecks.RegisterRules(rules); err != nil {
+			setupLog.Error(err, "invalid health check config", "path", healthCheckConfig)
+			os.Exit(1)
+		}
+		setupLog.Info("loaded custom health rules", "count", len(rules))
+	}
+
+	if err = (&release.Reconciler{
+		Client:          mgr.GetClient(),
+		Scheme:          mgr.GetScheme(),
+		HealthChecks:    healthChecks,
+		DataPlaneClient: dpClient,
+		AgentDataPlane:  &dataPlane,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Release")
+		os.Exit(1)
+	}
+
+	if err := mgr.Add(&agent.Heartbeat{
+		Client:    mgr.GetClient(),
+		DataPlane: dataPlane,
+		Interval:  heartbeatInterval,
+		Version:   version.Get().Version,
+	}); err != nil {
+		setupLog.Error(err, "unable to set up heartbeat")
+		os.Exit(1)
+	}
+
+	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+		setupLog.Error(err, "unable to set up health check")
+		os.Exit(1)
+	}
+	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+		setupLog.Error(err, "unable to set up ready check")
+		os.Exit(1)
+	}
+
+	setupLog.Info("starting manager", "dataplane", dataPlane)
+	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+		setupLog.Error(err, "problem running manager")
+		os.Exit(1)
+	}
+}
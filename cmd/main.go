@@ -6,10 +6,15 @@ package main
 import (
 	"crypto/tls"
 	"flag"
+	"log/slog"
 	"os"
+	"strings"
+	"time"
 
 	// +kubebuilder:scaffold:imports
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -23,6 +28,7 @@ import (
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
 	"github.com/openchoreo/openchoreo/internal/controller/api"
 	"github.com/openchoreo/openchoreo/internal/controller/apibinding"
 	"github.com/openchoreo/openchoreo/internal/controller/apiclass"
@@ -63,12 +69,15 @@ import (
 	ciliumv2 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/cilium.io/v2"
 	esv1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/externalsecrets/v1"
 	csisecretv1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/secretstorecsi/v1"
+	observerconfig "github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/prometheus"
 	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
 	workflowpipeline "github.com/openchoreo/openchoreo/internal/pipeline/workflow"
 	"github.com/openchoreo/openchoreo/internal/version"
 	componentwebhook "github.com/openchoreo/openchoreo/internal/webhook/component"
 	componentreleasewebhook "github.com/openchoreo/openchoreo/internal/webhook/componentrelease"
 	componenttypewebhook "github.com/openchoreo/openchoreo/internal/webhook/componenttype"
+	guardrailpolicywebhook "github.com/openchoreo/openchoreo/internal/webhook/guardrailpolicy"
 	projectwebhook "github.com/openchoreo/openchoreo/internal/webhook/project"
 	releasebindingwebhook "github.com/openchoreo/openchoreo/internal/webhook/releasebinding"
 	traitwebhook "github.com/openchoreo/openchoreo/internal/webhook/trait"
@@ -100,6 +109,12 @@ func main() {
 	var secureMetrics bool
 	var enableHTTP2 bool
 	var enableLegacyCRDs bool
+	var healthCheckConfig string
+	var rolloutPrometheusAddress string
+	var releaseRetentionKeepLast int
+	var releaseRetentionMaxAge time.Duration
+	var enableExecAuth bool
+	var execAuthAllowedCommands string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -113,6 +128,23 @@ func main() {
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
 	flag.BoolVar(&enableLegacyCRDs, "enable-legacy-crds", false, // TODO <-- remove me
 		"If set, legacy CRDs will be enabled. This is only for the POC and will be removed in the future.")
+	flag.StringVar(&healthCheckConfig, "health-check-config", "",
+		"Path to a YAML file with CEL health rules for resource kinds applied by Releases. "+
+			"Rules replace the built-in health checks for the same kinds.")
+	flag.StringVar(&rolloutPrometheusAddress, "rollout-prometheus-address", "",
+		"Address of the Prometheus server used to evaluate the analysis metrics of ReleaseBinding rollouts. "+
+			"Rollouts with analysis metrics do not progress if this is not set.")
+	flag.IntVar(&releaseRetentionKeepLast, "release-retention-keep-last", 0,
+		"Number of most recent ComponentReleases kept per component unless the component sets spec.releaseRetention. "+
+			"0 disables pruning by count.")
+	flag.DurationVar(&releaseRetentionMaxAge, "release-retention-max-age", 0,
+		"Age after which ComponentReleases are pruned unless the component sets spec.releaseRetention. "+
+			"Releases bound to an environment are never pruned. 0 disables pruning by age.")
+	flag.BoolVar(&enableExecAuth, "enable-exec-auth", false,
+		"If set, DataPlanes and BuildPlanes may authenticate with the exec credential plugins "+
+			"listed in --exec-auth-allowed-commands. The plugins run inside the controller manager.")
+	flag.StringVar(&execAuthAllowedCommands, "exec-auth-allowed-commands", "",
+		"Comma-separated list of the credential plugin commands allowed for exec authentication.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -188,6 +220,24 @@ func main() {
 		os.Exit(1)
 	}
 
+	// The secrets holding cluster credentials are read directly from the API server, so that they
+	// are not kept in the cache of the manager
+	k8sClientMgr := kubernetesClient.SharedManager()
+	k8sClientMgr.SetSecretReader(mgr.GetAPIReader())
+	if enableExecAuth {
+		var commands []string
+		for _, command := range strings.Split(execAuthAllowedCommands, ",") {
+			if command = strings.TrimSpace(command); command != "" {
+				commands = append(commands, command)
+			}
+		}
+		if len(commands) == 0 {
+			setupLog.Error(nil, "--exec-auth-allowed-commands must list at least one command when exec auth is enabled")
+			os.Exit(1)
+		}
+		k8sClientMgr.EnableExecAuth(commands...)
+	}
+
 	// -----------------------------------------------------------------------------
 	// Setup controllers with the controller manager
 	// -----------------------------------------------------------------------------
@@ -312,19 +362,40 @@ func main() {
 	}
 
 	// ComponentRelease controller
+	var releaseRetention openchoreov1alpha1.ReleaseRetentionPolicy
+	if releaseRetentionKeepLast > 0 {
+		keepLast := int32(releaseRetentionKeepLast)
+		releaseRetention.KeepLast = &keepLast
+	}
+	if releaseRetentionMaxAge > 0 {
+		releaseRetention.MaxAge = &metav1.Duration{Duration: releaseRetentionMaxAge}
+	}
 	if err = (&componentrelease.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		APIReader:        mgr.GetAPIReader(),
+		DefaultRetention: releaseRetention,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ComponentRelease")
 		os.Exit(1)
 	}
 
 	// ReleaseBinding controller
+	var rolloutMetrics releasebinding.MetricsQuerier
+	if rolloutPrometheusAddress != "" {
+		logger := slog.New(logr.ToSlogHandler(ctrl.Log.WithName("rollout-analysis")))
+		promClient, err := prometheus.NewClient(&observerconfig.PrometheusConfig{Address: rolloutPrometheusAddress}, logger)
+		if err != nil {
+			setupLog.Error(err, "unable to create Prometheus client for rollout analysis")
+			os.Exit(1)
+		}
+		rolloutMetrics = prometheus.NewMetricsService(promClient, logger)
+	}
 	if err = (&releasebinding.Reconciler{
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
 		Pipeline: componentpipeline.NewPipeline(),
+		Metrics:  rolloutMetrics,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ReleaseBinding")
 		os.Exit(1)
@@ -430,9 +501,24 @@ func main() {
 		os.Exit(1)
 	}
 
+	healthChecks := release.NewHealthCheckRegistry()
+	if healthCheckConfig != "" {
+		rules, err := release.LoadHealthRules(healthCheckConfig)
+		if err != nil {
+			setupLog.Error(err, "unable to load health check config", "path", healthCheckConfig)
+			os.Exit(1)
+		}
+		if err := healthChecks.RegisterRules(rules); err != nil {
+			setupLog.Error(err, "invalid health check config", "path", healthCheckConfig)
+			os.Exit(1)
+		}
+		setupLog.Info("loaded custom health rules", "count", len(rules))
+	}
+
 	if err = (&release.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		HealthChecks: healthChecks,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Release")
 		os.Exit(1)
@@ -525,6 +611,13 @@ func main() {
 			os.Exit(1)
 		}
 	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := guardrailpolicywebhook.SetupGuardrailPolicyWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "GuardrailPolicy")
+			os.Exit(1)
+		}
+	}
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
@@ -15,8 +15,10 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/openchoreo/openchoreo/internal/observer/alerting"
 	"github.com/openchoreo/openchoreo/internal/observer/config"
 	"github.com/openchoreo/openchoreo/internal/observer/handlers"
+	"github.com/openchoreo/openchoreo/internal/observer/loki"
 	"github.com/openchoreo/openchoreo/internal/observer/mcp"
 	"github.com/openchoreo/openchoreo/internal/observer/middleware"
 	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
@@ -43,11 +45,25 @@ func main() {
 	logger := initLogger(cfg.LogLevel)
 	logger.Info("Configuration loaded successfully", "log_level", cfg.LogLevel)
 
-	// Initialize OpenSearch client
-	osClient, err := opensearch.NewClient(&cfg.OpenSearch, logger)
-	if err != nil {
-		log.Fatalf("Failed to initialize OpenSearch client: %v", err)
+	// Initialize log backend. Traces are stored in OpenSearch, so they are only available with that backend.
+	var logBackend service.LogBackend
+	var traceClient service.OpenSearchClient
+	switch cfg.Logging.Backend {
+	case config.LogBackendLoki:
+		lokiClient, err := loki.NewClient(&cfg.Loki, logger)
+		if err != nil {
+			log.Fatalf("Failed to initialize Loki client: %v", err)
+		}
+		logBackend = loki.NewLogBackend(lokiClient)
+	default:
+		osClient, err := opensearch.NewClient(&cfg.OpenSearch, logger)
+		if err != nil {
+			log.Fatalf("Failed to initialize OpenSearch client: %v", err)
+		}
+		logBackend = opensearch.NewLogBackend(osClient, cfg.OpenSearch.IndexPrefix)
+		traceClient = osClient
 	}
+	logger.Info("Log backend initialized", "backend", cfg.Logging.Backend)
 
 	// Initialize Prometheus client
 	promClient, err := prometheus.NewClient(&cfg.Prometheus, logger)
@@ -59,7 +75,11 @@ func main() {
 	metricsService := prometheus.NewMetricsService(promClient, logger)
 
 	// Initialize logging service
-	loggingService := service.NewLoggingService(osClient, metricsService, cfg, logger)
+	loggingService := service.NewLoggingService(logBackend, traceClient, metricsService, cfg, logger)
+
+	// Graceful shutdown using signal context
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	// Initialize HTTP server
 	mux := http.NewServeMux()
@@ -76,6 +96,12 @@ func main() {
 	mux.HandleFunc("POST /api/logs/gateway", handler.GetGatewayLogs)
 	mux.HandleFunc("POST /api/logs/org/{orgId}", handler.GetOrganizationLogs)
 
+	// API routes - Log streams (Server-Sent Events)
+	mux.HandleFunc("POST /api/logs/component/{componentId}/stream", handler.StreamComponentLogs)
+	mux.HandleFunc("POST /api/logs/project/{projectId}/stream", handler.StreamProjectLogs)
+	mux.HandleFunc("POST /api/logs/gateway/stream", handler.StreamGatewayLogs)
+	mux.HandleFunc("POST /api/logs/org/{orgId}/stream", handler.StreamOrganizationLogs)
+
 	// API routes - Traces
 	mux.HandleFunc("POST /api/traces/component", handler.GetComponentTraces)
 
@@ -83,6 +109,16 @@ func main() {
 	mux.HandleFunc("POST /api/metrics/component/http", handler.GetComponentHTTPMetrics)
 	mux.HandleFunc("POST /api/metrics/component/usage", handler.GetComponentResourceMetrics)
 
+	// Alert rule evaluation
+	if cfg.Alerting.Enabled {
+		evaluator := alerting.NewEvaluator(cfg.Alerting.RulesFile, cfg.Alerting.EvaluationInterval,
+			metricsService, loggingService, logger)
+		go evaluator.Run(ctx)
+
+		// API routes - Alerts
+		mux.HandleFunc("GET /api/alerts", handlers.NewAlertsHandler(evaluator, logger).GetAlerts)
+	}
+
 	// MCP endpoint
 	mux.Handle("/mcp", mcp.NewHTTPServer(&mcp.MCPHandler{Service: loggingService}))
 
@@ -109,10 +145,6 @@ func main() {
 		}
 	}()
 
-	// Graceful shutdown using signal context
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
-	defer stop()
-
 	// Wait for interrupt signal
 	<-ctx.Done()
 
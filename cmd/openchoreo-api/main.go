@@ -16,8 +16,11 @@ import (
 
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
 	k8s "github.com/openchoreo/openchoreo/internal/openchoreo-api/clients"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/config"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/handlers"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
 )
 
 var (
@@ -41,8 +44,47 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Start the cache of the shared informers the watches of the API are served from
+	k8sCache, err := k8s.NewK8sCache()
+	if err != nil {
+		baseLogger.Error("Failed to initialize Kubernetes cache", slog.Any("error", err))
+		os.Exit(1)
+	}
+	go func() {
+		if err := k8sCache.Start(ctx); err != nil {
+			baseLogger.Error("Kubernetes cache error", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}()
+
+	// Load the authorization policy. Without a policy every authenticated user can perform every action.
+	var authorizer *rbac.Authorizer
+	if policyFile := os.Getenv(config.EnvAuthzPolicyFile); policyFile != "" {
+		policy, err := rbac.LoadPolicy(policyFile)
+		if err != nil {
+			baseLogger.Error("Failed to load authorization policy", slog.String("file", policyFile), slog.Any("error", err))
+			os.Exit(1)
+		}
+		authorizer = rbac.NewAuthorizer(policy)
+		baseLogger.Info("Loaded authorization policy", slog.String("file", policyFile),
+			slog.Int("roles", len(policy.Roles)), slog.Int("bindings", len(policy.Bindings)))
+	} else {
+		baseLogger.Warn("No authorization policy configured, every authenticated user can perform every action")
+	}
+
+	// Create the auditor recording the mutating operations of the API and MCP tools
+	auditor, err := audit.NewFromConfig(audit.Config{
+		Sinks:      audit.ParseSinks(os.Getenv(config.EnvAuditSinks)),
+		FilePath:   os.Getenv(config.EnvAuditFile),
+		WebhookURL: os.Getenv(config.EnvAuditWebhookURL),
+	}, baseLogger.With("component", "audit"))
+	if err != nil {
+		baseLogger.Error("Failed to configure audit sinks", slog.Any("error", err))
+		os.Exit(1)
+	}
+
 	// Initialize services
-	services := services.NewServices(k8sClient, kubernetesClient.NewManager(), baseLogger)
+	services := services.NewServices(k8sClient, k8sCache, kubernetesClient.NewManager(), authorizer, auditor, baseLogger)
 
 	// Initialize HTTP handlers
 	handler := handlers.New(services, baseLogger.With("component", "handlers"))
@@ -75,5 +117,10 @@ func main() {
 		baseLogger.Error("Server shutdown error", slog.Any("error", err))
 	}
 
+	// Flush the audit events of the last requests
+	if err := auditor.Close(); err != nil {
+		baseLogger.Error("Failed to close audit sinks", slog.Any("error", err))
+	}
+
 	baseLogger.Info("Server stopped gracefully")
 }
@@ -0,0 +1,84 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// DefaultHeartbeatInterval is how often the agent reports that it is running
+const DefaultHeartbeatInterval = 30 * time.Second
+
+// Heartbeat periodically records in the status of the DataPlane that the agent managing it is running.
+// The DataPlane controller of the control plane reports the agent as disconnected when the heartbeats stop.
+type Heartbeat struct {
+	// Client is the client of the control plane
+	Client client.Client
+	// DataPlane is the DataPlane managed by the agent
+	DataPlane types.NamespacedName
+	// Interval between heartbeats. Defaults to DefaultHeartbeatInterval.
+	Interval time.Duration
+	// Version is the version of the agent reported with the heartbeats
+	Version string
+}
+
+// Start sends heartbeats until the context is cancelled. Failed heartbeats are logged and retried
+// with the next heartbeat, so that a temporarily unreachable control plane does not stop the agent.
+func (h *Heartbeat) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithValues("dataplane", h.DataPlane)
+
+	interval := h.Interval
+	if interval <= 0 {
+		interval = DefaultHeartbeatInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := h.beat(ctx, metav1.Now()); err != nil {
+			logger.Error(err, "Failed to send heartbeat")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection makes only the leading agent replica send heartbeats
+func (h *Heartbeat) NeedLeaderElection() bool {
+	return true
+}
+
+// beat records the heartbeat in the DataPlane status
+func (h *Heartbeat) beat(ctx context.Context, now metav1.Time) error {
+	dataPlane := &openchoreov1alpha1.DataPlane{}
+	if err := h.Client.Get(ctx, h.DataPlane, dataPlane); err != nil {
+		return fmt.Errorf("failed to get dataplane: %w", err)
+	}
+	if dataPlane.Spec.Agent == nil {
+		return fmt.Errorf("dataplane %s is not configured to be managed by an agent", h.DataPlane)
+	}
+
+	base := client.MergeFrom(dataPlane.DeepCopy())
+	dataPlane.Status.Agent = &openchoreov1alpha1.DataPlaneAgentStatus{
+		LastHeartbeatTime: &now,
+		Version:           h.Version,
+	}
+	if err := h.Client.Status().Patch(ctx, dataPlane, base); err != nil {
+		return fmt.Errorf("failed to update dataplane status: %w", err)
+	}
+	return nil
+}
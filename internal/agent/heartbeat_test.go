@@ -0,0 +1,60 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestHeartbeatBeat(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	agentManaged := &openchoreov1alpha1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "acme", Name: "on-prem"},
+		Spec:       openchoreov1alpha1.DataPlaneSpec{Agent: &openchoreov1alpha1.DataPlaneAgent{}},
+	}
+	direct := &openchoreov1alpha1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "acme", Name: "cloud"},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(agentManaged, direct).
+		WithStatusSubresource(&openchoreov1alpha1.DataPlane{}).
+		Build()
+	ctx := context.Background()
+	now := metav1.NewTime(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+
+	h := &Heartbeat{Client: c, DataPlane: types.NamespacedName{Namespace: "acme", Name: "on-prem"}, Version: "v0.4.0"}
+	if err := h.beat(ctx, now); err != nil {
+		t.Fatalf("beat() error = %v", err)
+	}
+
+	got := &openchoreov1alpha1.DataPlane{}
+	if err := c.Get(ctx, h.DataPlane, got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.Agent == nil || got.Status.Agent.LastHeartbeatTime == nil {
+		t.Fatalf("heartbeat not recorded: %+v", got.Status)
+	}
+	if !got.Status.Agent.LastHeartbeatTime.Equal(&now) || got.Status.Agent.Version != "v0.4.0" {
+		t.Errorf("status.agent = %+v, want heartbeat at %v from v0.4.0", got.Status.Agent, now)
+	}
+
+	h.DataPlane = types.NamespacedName{Namespace: "acme", Name: "cloud"}
+	if err := h.beat(ctx, now); err == nil {
+		t.Errorf("beat() for a dataplane without spec.agent succeeded, want error")
+	}
+}
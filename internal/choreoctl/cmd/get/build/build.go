@@ -32,6 +32,9 @@ func (i *GetBuildImpl) GetBuild(params api.GetBuildParams) error {
 		return err
 	}
 
+	if params.Watch {
+		return watchBuilds(params)
+	}
 	return getBuilds(params, i.config)
 }
 
@@ -0,0 +1,60 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/resources"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/resources/client"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/resources/kinds"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// watchBuilds prints the builds of a project or component and then every change to them until the command is
+// interrupted. The changes are streamed from the OpenChoreo API server.
+func watchBuilds(params api.GetBuildParams) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	format := resources.OutputFormatTable
+	if params.OutputFormat == constants.OutputFormatYAML {
+		format = resources.OutputFormatYAML
+	}
+	printer := resources.NewWatchPrinter(format, kinds.HeadersBuildWatch)
+
+	opts := &client.WatchOptions{Project: params.Project, Component: params.Component}
+	return apiClient.WatchResources(ctx, params.Organization, "builds", opts, func(event client.WatchEvent) error {
+		var build client.BuildResponse
+		if err := json.Unmarshal(event.Object, &build); err != nil {
+			return fmt.Errorf("failed to decode build: %w", err)
+		}
+		if params.Name != "" && build.Name != params.Name {
+			return nil
+		}
+		createdAt, _ := time.Parse(time.RFC3339, build.CreatedAt)
+		row := []string{
+			build.Name,
+			build.Status,
+			build.Commit,
+			resources.FormatAge(createdAt),
+			build.ComponentName,
+			build.ProjectName,
+			build.OrgName,
+		}
+		return printer.Print(event.Type, row, event.Object)
+	})
+}
@@ -32,6 +32,9 @@ func (i *GetCompImpl) GetComponent(params api.GetComponentParams) error {
 		return err
 	}
 
+	if params.Watch {
+		return watchComponents(params)
+	}
 	return getComponents(params, i.config)
 }
 
@@ -0,0 +1,56 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/resources"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/resources/client"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/resources/kinds"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// watchComponents prints the components of a project and then every change to them until the command is
+// interrupted. The changes are streamed from the OpenChoreo API server.
+func watchComponents(params api.GetComponentParams) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	format := resources.OutputFormatTable
+	if params.OutputFormat == constants.OutputFormatYAML {
+		format = resources.OutputFormatYAML
+	}
+	printer := resources.NewWatchPrinter(format, kinds.HeadersComponentWatch)
+
+	opts := &client.WatchOptions{Project: params.Project, Component: params.Name}
+	return apiClient.WatchResources(ctx, params.Organization, "components", opts, func(event client.WatchEvent) error {
+		var component client.ComponentResponse
+		if err := json.Unmarshal(event.Object, &component); err != nil {
+			return fmt.Errorf("failed to decode component: %w", err)
+		}
+		createdAt, _ := time.Parse(time.RFC3339, component.CreatedAt)
+		row := []string{
+			component.Name,
+			component.Type,
+			component.Status,
+			resources.FormatAge(createdAt),
+			component.ProjectName,
+			component.OrgName,
+		}
+		return printer.Print(event.Type, row, event.Object)
+	})
+}
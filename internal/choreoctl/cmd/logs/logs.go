@@ -52,6 +52,8 @@ func handleLogs(params api.LogParams) error {
 		return getBuildLogs(params)
 	case "deployment":
 		return getDeploymentLogs(params)
+	case "component":
+		return getComponentLogs(params)
 	default:
 		return fmt.Errorf("log type '%s' not supported", params.Type)
 	}
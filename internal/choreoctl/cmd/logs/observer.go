@@ -0,0 +1,272 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/resources"
+	apiclient "github.com/openchoreo/openchoreo/internal/choreoctl/resources/client"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+const (
+	// componentLogsWindow is how far back component logs are searched for the initial output
+	componentLogsWindow = 24 * time.Hour
+
+	// streamRetryInterval is how long to wait before reconnecting a dropped log stream
+	streamRetryInterval = 2 * time.Second
+)
+
+// observerLogEntry is a log entry returned by the observer
+type observerLogEntry struct {
+	Timestamp     time.Time `json:"timestamp"`
+	Log           string    `json:"log"`
+	PodID         string    `json:"podId"`
+	ContainerName string    `json:"containerName"`
+}
+
+// observerLogsRequest is the request body of the observer component log endpoints
+type observerLogsRequest struct {
+	StartTime     string `json:"startTime,omitempty"`
+	EndTime       string `json:"endTime,omitempty"`
+	EnvironmentID string `json:"environmentId"`
+	Limit         int    `json:"limit,omitempty"`
+	SortOrder     string `json:"sortOrder,omitempty"`
+}
+
+// observerClient calls the log endpoints of the observer
+type observerClient struct {
+	baseURL  string
+	username string
+	password string
+	http     *http.Client
+}
+
+// getComponentLogs prints the runtime logs of a component in an environment collected by the observer.
+// With --follow, new entries are printed as they arrive until the command is interrupted.
+func getComponentLogs(params api.LogParams) error {
+	if params.Organization == "" || params.Component == "" || params.Environment == "" {
+		return fmt.Errorf("organization, component and environment values are required for component logs")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	k8sClient, err := resources.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	component := &openchoreov1alpha1.Component{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: params.Organization, Name: params.Component}, component); err != nil {
+		return fmt.Errorf("failed to get component '%s': %w", params.Component, err)
+	}
+	if params.Project != "" && component.Spec.Owner.ProjectName != params.Project {
+		return fmt.Errorf("component '%s' not found in project '%s'", params.Component, params.Project)
+	}
+
+	env := &openchoreov1alpha1.Environment{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: params.Organization, Name: params.Environment}, env); err != nil {
+		return fmt.Errorf("failed to get environment '%s': %w", params.Environment, err)
+	}
+
+	observer, err := newObserverClient(ctx, k8sClient, params, env)
+	if err != nil {
+		return err
+	}
+
+	componentID := string(component.UID)
+	now := time.Now().UTC()
+	entries, err := observer.queryLogs(ctx, componentID, observerLogsRequest{
+		StartTime:     now.Add(-componentLogsWindow).Format(time.RFC3339),
+		EndTime:       now.Format(time.RFC3339),
+		EnvironmentID: string(env.UID),
+		Limit:         int(params.TailLines),
+		SortOrder:     "desc",
+	})
+	if err != nil {
+		return err
+	}
+
+	// The newest entries were requested, so print them in reverse to show the oldest first
+	for i := len(entries) - 1; i >= 0; i-- {
+		printLogEntry(entries[i])
+	}
+	if !params.Follow {
+		return nil
+	}
+
+	since := now
+	if len(entries) > 0 {
+		since = entries[0].Timestamp.Add(time.Millisecond)
+	}
+	return observer.followLogs(ctx, componentID, observerLogsRequest{
+		StartTime:     since.Format(time.RFC3339Nano),
+		EnvironmentID: string(env.UID),
+	})
+}
+
+// newObserverClient creates a client for the observer given by --observer-url, or for the observer
+// configured in the DataPlane of the environment
+func newObserverClient(ctx context.Context, k8sClient client.Client, params api.LogParams,
+	env *openchoreov1alpha1.Environment) (*observerClient, error) {
+	c := &observerClient{baseURL: params.ObserverURL, http: &http.Client{}}
+
+	dataPlane := &openchoreov1alpha1.DataPlane{}
+	err := k8sClient.Get(ctx, client.ObjectKey{Namespace: params.Organization, Name: env.Spec.DataPlaneRef}, dataPlane)
+	switch {
+	case err == nil:
+		if c.baseURL == "" {
+			c.baseURL = dataPlane.Spec.Observer.URL
+		}
+		c.username = dataPlane.Spec.Observer.Authentication.BasicAuth.Username
+		c.password = dataPlane.Spec.Observer.Authentication.BasicAuth.Password
+	case c.baseURL == "":
+		return nil, fmt.Errorf("failed to get dataplane '%s' of environment '%s': %w", env.Spec.DataPlaneRef, env.Name, err)
+	}
+
+	if c.baseURL == "" {
+		return nil, fmt.Errorf("no observer is configured for dataplane '%s'; use --observer-url to set one", dataPlane.Name)
+	}
+	c.baseURL = strings.TrimSuffix(c.baseURL, "/")
+	return c, nil
+}
+
+// newRequest creates a POST request with a JSON body to an observer endpoint
+func (c *observerClient) newRequest(ctx context.Context, path string, body any) (*http.Request, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	return req, nil
+}
+
+// queryLogs returns the component logs matching the request
+func (c *observerClient) queryLogs(ctx context.Context, componentID string, body observerLogsRequest) ([]observerLogEntry, error) {
+	req, err := c.newRequest(ctx, "/api/logs/component/"+url.PathEscape(componentID), body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the observer: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, observerError(resp)
+	}
+
+	var result struct {
+		Logs []observerLogEntry `json:"logs"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode the observer response: %w", err)
+	}
+	return result.Logs, nil
+}
+
+// followLogs prints the entries of the component log stream until the context is cancelled.
+// A dropped stream is reconnected and resumes after the last event received.
+func (c *observerClient) followLogs(ctx context.Context, componentID string, body observerLogsRequest) error {
+	lastEventID := ""
+	for {
+		err := c.streamLogs(ctx, componentID, body, &lastEventID)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "log stream interrupted: %v; reconnecting\n", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(streamRetryInterval):
+		}
+	}
+}
+
+// streamLogs reads the Server-Sent Events of one stream connection and prints the log events.
+// lastEventID is updated with the ID of every event received.
+func (c *observerClient) streamLogs(ctx context.Context, componentID string, body observerLogsRequest, lastEventID *string) error {
+	req, err := c.newRequest(ctx, "/api/logs/component/"+url.PathEscape(componentID)+"/stream", body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if *lastEventID != "" {
+		req.Header.Set("Last-Event-ID", *lastEventID)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to connect to the observer: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return observerError(resp)
+	}
+
+	err = apiclient.ReadEvents(resp.Body, func(id, event string, data []byte) error {
+		if id != "" {
+			*lastEventID = id
+		}
+		switch event {
+		case "log":
+			var entry observerLogEntry
+			if err := json.Unmarshal(data, &entry); err != nil {
+				return fmt.Errorf("failed to decode log event: %w", err)
+			}
+			printLogEntry(entry)
+		case "error":
+			return fmt.Errorf("observer reported an error: %s", data)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return errors.New("stream closed by the observer")
+}
+
+// observerError returns an error describing a failed observer response
+func observerError(resp *http.Response) error {
+	var errResp struct {
+		Message string `json:"message"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Message != "" {
+		return fmt.Errorf("observer returned %s: %s", resp.Status, errResp.Message)
+	}
+	return fmt.Errorf("observer returned %s", resp.Status)
+}
+
+// printLogEntry prints a log entry with its timestamp
+func printLogEntry(entry observerLogEntry) {
+	fmt.Printf("%s %s\n", entry.Timestamp.Local().Format(time.RFC3339), strings.TrimRight(entry.Log, "\n"))
+}
@@ -0,0 +1,178 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/resources"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/resources/client"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/validation"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+const (
+	outputFormatJSON = "json"
+
+	requestTimeout = 30 * time.Second
+)
+
+type PromotionImpl struct{}
+
+func NewPromotionImpl() *PromotionImpl {
+	return &PromotionImpl{}
+}
+
+// RequestPromotion creates a promotion request for the release currently bound to the source environment
+func (i *PromotionImpl) RequestPromotion(params api.RequestPromotionParams) error {
+	if err := validation.ValidateParams(validation.CmdPromotion, validation.ResourcePromotion, params); err != nil {
+		return err
+	}
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	pr, err := apiClient.RequestPromotion(ctx, params.Organization, params.Project, params.Component,
+		params.SourceEnvironment, params.TargetEnvironment, params.Reason)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Promotion request %s created: release %s from %s to %s is awaiting approval\n",
+		pr.Name, pr.ReleaseName, pr.SourceEnvironment, pr.TargetEnvironment)
+	return nil
+}
+
+// ListPromotionRequests prints the promotion requests of a component
+func (i *PromotionImpl) ListPromotionRequests(params api.ListPromotionRequestsParams) error {
+	if err := validation.ValidateParams(validation.CmdPromotion, validation.ResourcePromotion, params); err != nil {
+		return err
+	}
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	opts := &client.ListOptions{
+		Limit:         params.Limit,
+		LabelSelector: params.LabelSelector,
+		Filters: map[string]string{
+			"status":      params.Status,
+			"environment": params.TargetEnvironment,
+		},
+		SortBy: params.SortBy,
+		Order:  params.Order,
+	}
+	items, err := apiClient.ListPromotionRequests(ctx, params.Organization, params.Project, params.Component, opts)
+	if err != nil {
+		return err
+	}
+
+	switch params.OutputFormat {
+	case "":
+		return printPromotionRequestTable(items)
+	case constants.OutputFormatYAML:
+		out, err := yaml.Marshal(items)
+		if err != nil {
+			return fmt.Errorf("failed to marshal promotion requests: %w", err)
+		}
+		fmt.Print(string(out))
+		return nil
+	case outputFormatJSON:
+		out, err := json.MarshalIndent(items, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal promotion requests: %w", err)
+		}
+		fmt.Println(string(out))
+		return nil
+	default:
+		return fmt.Errorf(resources.ErrFormatUnsupported, params.OutputFormat)
+	}
+}
+
+// ApprovePromotionRequest approves a promotion request, which promotes the requested release
+func (i *PromotionImpl) ApprovePromotionRequest(params api.ReviewPromotionRequestParams) error {
+	if err := validation.ValidateParams(validation.CmdPromotion, validation.ResourcePromotion, params); err != nil {
+		return err
+	}
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	pr, err := apiClient.ApprovePromotionRequest(ctx, params.Organization, params.Project, params.Component, params.Name, params.Comment)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Promotion request %s approved: release %s promoted to %s\n", pr.Name, pr.ReleaseName, pr.TargetEnvironment)
+	return nil
+}
+
+// RejectPromotionRequest rejects a promotion request
+func (i *PromotionImpl) RejectPromotionRequest(params api.ReviewPromotionRequestParams) error {
+	if err := validation.ValidateParams(validation.CmdPromotion, validation.ResourcePromotion, params); err != nil {
+		return err
+	}
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	pr, err := apiClient.RejectPromotionRequest(ctx, params.Organization, params.Project, params.Component, params.Name, params.Comment)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Promotion request %s rejected\n", pr.Name)
+	return nil
+}
+
+func printPromotionRequestTable(items []client.PromotionRequestResponse) error {
+	if len(items) == 0 {
+		fmt.Println("No promotion requests found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tRELEASE\tSOURCE\tTARGET\tPHASE\tREQUESTED BY\tREVIEWED BY\tAGE")
+	for _, pr := range items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			pr.Name, pr.ReleaseName, pr.SourceEnvironment, pr.TargetEnvironment, pr.Phase,
+			valueOrDash(pr.RequestedBy), valueOrDash(pr.ReviewedBy), resources.FormatAge(pr.CreatedAt))
+	}
+	return w.Flush()
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
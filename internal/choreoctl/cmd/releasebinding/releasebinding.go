@@ -0,0 +1,119 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/choreoctl/resources"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/resources/client"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/validation"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+const (
+	outputFormatJSON = "json"
+
+	requestTimeout = 30 * time.Second
+)
+
+type ReleaseBindingImpl struct{}
+
+func NewReleaseBindingImpl() *ReleaseBindingImpl {
+	return &ReleaseBindingImpl{}
+}
+
+// GetReleaseBindingHistory prints the releases bound to a release binding, most recent first
+func (i *ReleaseBindingImpl) GetReleaseBindingHistory(params api.ReleaseBindingHistoryParams) error {
+	if err := validation.ValidateParams(validation.CmdReleaseBinding, validation.ResourceReleaseBinding, params); err != nil {
+		return err
+	}
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	history, err := apiClient.GetReleaseBindingHistory(ctx, params.Organization, params.Project, params.Component, params.Name)
+	if err != nil {
+		return err
+	}
+
+	switch params.OutputFormat {
+	case "":
+		return printHistoryTable(history)
+	case constants.OutputFormatYAML:
+		out, err := yaml.Marshal(history)
+		if err != nil {
+			return fmt.Errorf("failed to marshal release history: %w", err)
+		}
+		fmt.Print(string(out))
+		return nil
+	case outputFormatJSON:
+		out, err := json.MarshalIndent(history, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal release history: %w", err)
+		}
+		fmt.Println(string(out))
+		return nil
+	default:
+		return fmt.Errorf(resources.ErrFormatUnsupported, params.OutputFormat)
+	}
+}
+
+// RollbackReleaseBinding binds an earlier release from the history of a release binding
+func (i *ReleaseBindingImpl) RollbackReleaseBinding(params api.RollbackReleaseBindingParams) error {
+	if err := validation.ValidateParams(validation.CmdReleaseBinding, validation.ResourceReleaseBinding, params); err != nil {
+		return err
+	}
+
+	apiClient, err := client.NewAPIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	binding, err := apiClient.RollbackReleaseBinding(ctx, params.Organization, params.Project, params.Component, params.Name, params.Release)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Release binding %s rolled back: release %s is bound to %s\n", binding.Name, binding.ReleaseName, binding.Environment)
+	return nil
+}
+
+func printHistoryTable(history []client.ReleaseHistoryEntry) error {
+	if len(history) == 0 {
+		fmt.Println("No release history found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "RELEASE\tCURRENT\tBOUND BY\tAGE")
+	for _, entry := range history {
+		current := ""
+		if entry.Current {
+			current = "*"
+		}
+		boundBy := entry.BoundBy
+		if boundBy == "" {
+			boundBy = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", entry.ReleaseName, current, boundBy, resources.FormatAge(entry.BoundAt))
+	}
+	return w.Flush()
+}
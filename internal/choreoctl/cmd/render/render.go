@@ -0,0 +1,444 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/validation"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+const (
+	outputFormatJSON = "json"
+
+	// defaultNamespace is used for resources that do not declare a namespace,
+	// mirroring how kubectl treats namespaced resources without one.
+	defaultNamespace = "default"
+)
+
+type RenderImpl struct{}
+
+func NewRenderImpl() *RenderImpl {
+	return &RenderImpl{}
+}
+
+// Render loads the resources from the given path, runs the component rendering pipeline
+// and prints the rendered resources to stdout. Rendering warnings are printed to stderr.
+func (i *RenderImpl) Render(params api.RenderParams) error {
+	if err := validation.ValidateParams(validation.CmdRender, validation.ResourceRender, params); err != nil {
+		return err
+	}
+
+	outputFormat := params.OutputFormat
+	if outputFormat == "" {
+		outputFormat = constants.OutputFormatYAML
+	}
+	if outputFormat != constants.OutputFormatYAML && outputFormat != outputFormatJSON {
+		return fmt.Errorf("unsupported output format %q: expected one of [yaml, json]", outputFormat)
+	}
+
+	files, err := discoverResourceFiles(params.FilePath)
+	if err != nil {
+		return fmt.Errorf("failed to discover resources: %w", err)
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no YAML files found in: %s", params.FilePath)
+	}
+
+	set := newResourceSet()
+	for _, file := range files {
+		if err := set.loadFile(file); err != nil {
+			return err
+		}
+	}
+
+	input, err := set.buildRenderInput(params.Component, params.Environment)
+	if err != nil {
+		return err
+	}
+
+	output, err := componentpipeline.NewPipeline().Render(input)
+	if err != nil {
+		return fmt.Errorf("failed to render component %q: %w", input.Component.Name, err)
+	}
+
+	for _, warning := range output.Metadata.Warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+	}
+
+	return printResources(os.Stdout, output.Resources, outputFormat)
+}
+
+// resourceSet holds the OpenChoreo resources loaded from the input files.
+type resourceSet struct {
+	projects         map[string]*openchoreov1alpha1.Project
+	components       []*openchoreov1alpha1.Component
+	componentTypes   map[string]*openchoreov1alpha1.ComponentType
+	traits           map[string]*openchoreov1alpha1.Trait
+	workloads        []*openchoreov1alpha1.Workload
+	environments     map[string]*openchoreov1alpha1.Environment
+	dataPlanes       map[string]*openchoreov1alpha1.DataPlane
+	releaseBindings  []*openchoreov1alpha1.ReleaseBinding
+	secretReferences map[string]*openchoreov1alpha1.SecretReference
+}
+
+func newResourceSet() *resourceSet {
+	return &resourceSet{
+		projects:         make(map[string]*openchoreov1alpha1.Project),
+		componentTypes:   make(map[string]*openchoreov1alpha1.ComponentType),
+		traits:           make(map[string]*openchoreov1alpha1.Trait),
+		environments:     make(map[string]*openchoreov1alpha1.Environment),
+		dataPlanes:       make(map[string]*openchoreov1alpha1.DataPlane),
+		secretReferences: make(map[string]*openchoreov1alpha1.SecretReference),
+	}
+}
+
+// loadFile parses every YAML document in the file and adds the supported kinds to the set.
+// Documents of other kinds are skipped so that complete manifest directories can be rendered.
+func (s *resourceSet) loadFile(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading file %s: %w", path, err)
+	}
+
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(content)))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read YAML document in %s: %w", path, err)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		if err := s.add(doc); err != nil {
+			return fmt.Errorf("failed to parse resource in %s: %w", path, err)
+		}
+	}
+}
+
+func (s *resourceSet) add(doc []byte) error {
+	var typeMeta metav1.TypeMeta
+	if err := yaml.Unmarshal(doc, &typeMeta); err != nil {
+		return err
+	}
+
+	switch typeMeta.Kind {
+	case "Project":
+		obj := &openchoreov1alpha1.Project{}
+		if err := unmarshalResource(doc, obj, &obj.ObjectMeta); err != nil {
+			return err
+		}
+		s.projects[obj.Name] = obj
+	case "Component":
+		obj := &openchoreov1alpha1.Component{}
+		if err := unmarshalResource(doc, obj, &obj.ObjectMeta); err != nil {
+			return err
+		}
+		s.components = append(s.components, obj)
+	case "ComponentType":
+		obj := &openchoreov1alpha1.ComponentType{}
+		if err := unmarshalResource(doc, obj, &obj.ObjectMeta); err != nil {
+			return err
+		}
+		s.componentTypes[obj.Name] = obj
+	case "Trait":
+		obj := &openchoreov1alpha1.Trait{}
+		if err := unmarshalResource(doc, obj, &obj.ObjectMeta); err != nil {
+			return err
+		}
+		s.traits[obj.Name] = obj
+	case "Workload":
+		obj := &openchoreov1alpha1.Workload{}
+		if err := unmarshalResource(doc, obj, &obj.ObjectMeta); err != nil {
+			return err
+		}
+		s.workloads = append(s.workloads, obj)
+	case "Environment":
+		obj := &openchoreov1alpha1.Environment{}
+		if err := unmarshalResource(doc, obj, &obj.ObjectMeta); err != nil {
+			return err
+		}
+		s.environments[obj.Name] = obj
+	case "DataPlane":
+		obj := &openchoreov1alpha1.DataPlane{}
+		if err := unmarshalResource(doc, obj, &obj.ObjectMeta); err != nil {
+			return err
+		}
+		s.dataPlanes[obj.Name] = obj
+	case "ReleaseBinding":
+		obj := &openchoreov1alpha1.ReleaseBinding{}
+		if err := unmarshalResource(doc, obj, &obj.ObjectMeta); err != nil {
+			return err
+		}
+		s.releaseBindings = append(s.releaseBindings, obj)
+	case "SecretReference":
+		obj := &openchoreov1alpha1.SecretReference{}
+		if err := unmarshalResource(doc, obj, &obj.ObjectMeta); err != nil {
+			return err
+		}
+		s.secretReferences[obj.Name] = obj
+	}
+
+	return nil
+}
+
+// unmarshalResource decodes a YAML document into obj and defaults its namespace.
+func unmarshalResource(doc []byte, obj any, meta *metav1.ObjectMeta) error {
+	if err := yaml.Unmarshal(doc, obj); err != nil {
+		return err
+	}
+	if meta.Namespace == "" {
+		meta.Namespace = defaultNamespace
+	}
+	return nil
+}
+
+// buildRenderInput resolves the component to render and all the resources it depends on.
+func (s *resourceSet) buildRenderInput(componentName, environmentName string) (*componentpipeline.RenderInput, error) {
+	component, err := s.selectComponent(componentName)
+	if err != nil {
+		return nil, err
+	}
+
+	if component.Spec.ComponentType == "" {
+		return nil, fmt.Errorf("component %q does not specify spec.componentType", component.Name)
+	}
+	workloadType, componentTypeName, found := strings.Cut(component.Spec.ComponentType, "/")
+	if !found || workloadType == "" || componentTypeName == "" {
+		return nil, fmt.Errorf("invalid componentType format: expected {workloadType}/{name}, got %s",
+			component.Spec.ComponentType)
+	}
+	componentType, ok := s.componentTypes[componentTypeName]
+	if !ok {
+		return nil, fmt.Errorf("component type %q referenced by component %q not found in input files",
+			componentTypeName, component.Name)
+	}
+	if componentType.Spec.WorkloadType != workloadType {
+		return nil, fmt.Errorf("workloadType mismatch: component specifies %s but ComponentType has %s",
+			workloadType, componentType.Spec.WorkloadType)
+	}
+
+	traits := make([]openchoreov1alpha1.Trait, 0, len(component.Spec.Traits))
+	seenTraits := make(map[string]bool)
+	for _, instance := range component.Spec.Traits {
+		if seenTraits[instance.Name] {
+			continue
+		}
+		trait, ok := s.traits[instance.Name]
+		if !ok {
+			return nil, fmt.Errorf("trait %q referenced by component %q not found in input files",
+				instance.Name, component.Name)
+		}
+		traits = append(traits, *trait)
+		seenTraits[instance.Name] = true
+	}
+
+	workload, err := s.selectWorkload(component)
+	if err != nil {
+		return nil, err
+	}
+
+	environment, err := s.selectEnvironment(environmentName)
+	if err != nil {
+		return nil, err
+	}
+
+	if environment.Spec.DataPlaneRef == "" {
+		return nil, fmt.Errorf("environment %q has no dataPlaneRef configured", environment.Name)
+	}
+	dataPlane, ok := s.dataPlanes[environment.Spec.DataPlaneRef]
+	if !ok {
+		return nil, fmt.Errorf("data plane %q referenced by environment %q not found in input files",
+			environment.Spec.DataPlaneRef, environment.Name)
+	}
+
+	var releaseBinding *openchoreov1alpha1.ReleaseBinding
+	for _, rb := range s.releaseBindings {
+		if rb.Spec.Owner.ComponentName == component.Name && rb.Spec.Environment == environment.Name {
+			releaseBinding = rb
+			break
+		}
+	}
+
+	// The project is optional, as it only contributes its UID to the pod selectors
+	var projectUID string
+	if project, ok := s.projects[component.Spec.Owner.ProjectName]; ok {
+		projectUID = string(project.UID)
+	}
+
+	metadata := pipelinecontext.BuildMetadataContext(&pipelinecontext.MetadataContextInput{
+		OrganizationName: component.Namespace,
+		ProjectName:      component.Spec.Owner.ProjectName,
+		ProjectUID:       projectUID,
+		ComponentName:    component.Name,
+		ComponentUID:     string(component.UID),
+		EnvironmentName:  environment.Name,
+		EnvironmentUID:   string(environment.UID),
+		DataPlaneName:    dataPlane.Name,
+		DataPlaneUID:     string(dataPlane.UID),
+	})
+
+	return &componentpipeline.RenderInput{
+		ComponentType:    componentType,
+		Component:        component,
+		Traits:           traits,
+		Workload:         workload,
+		Environment:      environment,
+		ReleaseBinding:   releaseBinding,
+		DataPlane:        dataPlane,
+		SecretReferences: s.secretReferences,
+		Metadata:         metadata,
+	}, nil
+}
+
+func (s *resourceSet) selectComponent(name string) (*openchoreov1alpha1.Component, error) {
+	if name != "" {
+		for _, component := range s.components {
+			if component.Name == name {
+				return component, nil
+			}
+		}
+		return nil, fmt.Errorf("component %q not found in input files", name)
+	}
+
+	switch len(s.components) {
+	case 0:
+		return nil, fmt.Errorf("no component found in input files")
+	case 1:
+		return s.components[0], nil
+	default:
+		names := make([]string, 0, len(s.components))
+		for _, component := range s.components {
+			names = append(names, component.Name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("multiple components found in input files (%s)\n"+
+			"hint: use --component to select the component to render", strings.Join(names, ", "))
+	}
+}
+
+func (s *resourceSet) selectWorkload(component *openchoreov1alpha1.Component) (*openchoreov1alpha1.Workload, error) {
+	var matches []*openchoreov1alpha1.Workload
+	for _, workload := range s.workloads {
+		if workload.Spec.Owner.ComponentName == component.Name &&
+			workload.Spec.Owner.ProjectName == component.Spec.Owner.ProjectName {
+			matches = append(matches, workload)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("workload for component %q not found in input files", component.Name)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("multiple workloads found for component %q (expected exactly 1)", component.Name)
+	}
+}
+
+func (s *resourceSet) selectEnvironment(name string) (*openchoreov1alpha1.Environment, error) {
+	if name != "" {
+		environment, ok := s.environments[name]
+		if !ok {
+			return nil, fmt.Errorf("environment %q not found in input files\n"+
+				"hint: use --environment to select one of the environments defined in the input files", name)
+		}
+		return environment, nil
+	}
+
+	switch len(s.environments) {
+	case 0:
+		return nil, fmt.Errorf("no environment found in input files")
+	case 1:
+		for _, environment := range s.environments {
+			return environment, nil
+		}
+	}
+	return nil, fmt.Errorf("multiple environments found in input files\n" +
+		"hint: use --environment to select the environment to render for")
+}
+
+// printResources writes the rendered resources as a multi-document YAML stream or a JSON array.
+func printResources(w io.Writer, resources []map[string]any, outputFormat string) error {
+	if outputFormat == outputFormatJSON {
+		data, err := json.MarshalIndent(resources, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal rendered resources: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	}
+
+	for i, resource := range resources {
+		data, err := yaml.Marshal(resource)
+		if err != nil {
+			return fmt.Errorf("failed to marshal rendered resource #%d: %w", i, err)
+		}
+		if i > 0 {
+			if _, err := fmt.Fprintln(w, "---"); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// discoverResourceFiles returns the path itself for a file, or all YAML files under a directory.
+func discoverResourceFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("path %s does not exist", path)
+		}
+		return nil, fmt.Errorf("error accessing path %s: %w", path, err)
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var yamlFiles []string
+	err = filepath.Walk(path, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		ext := strings.ToLower(filepath.Ext(filePath))
+		if ext == ".yaml" || ext == ".yml" {
+			yamlFiles = append(yamlFiles, filePath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking directory %s: %w", path, err)
+	}
+
+	return yamlFiles, nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const testManifests = `
+apiVersion: openchoreo.dev/v1alpha1
+kind: Project
+metadata:
+  name: shop
+  namespace: acme
+  uid: project-uid-1
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Component
+metadata:
+  name: catalog
+  namespace: acme
+  uid: component-uid-1
+spec:
+  owner:
+    projectName: shop
+  componentType: deployment/web-service
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: ComponentType
+metadata:
+  name: web-service
+  namespace: acme
+spec:
+  workloadType: deployment
+  resources: []
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Workload
+metadata:
+  name: catalog
+  namespace: acme
+spec:
+  owner:
+    projectName: shop
+    componentName: catalog
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: Environment
+metadata:
+  name: development
+  namespace: acme
+  uid: env-uid-1
+spec:
+  dataPlaneRef: default
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: DataPlane
+metadata:
+  name: default
+  namespace: acme
+---
+apiVersion: openchoreo.dev/v1alpha1
+kind: ReleaseBinding
+metadata:
+  name: catalog-development
+  namespace: acme
+spec:
+  owner:
+    projectName: shop
+    componentName: catalog
+  environment: development
+  releaseName: catalog-abc123
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ignored
+`
+
+func TestBuildRenderInput(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "manifests.yaml")
+	if err := os.WriteFile(path, []byte(testManifests), 0o600); err != nil {
+		t.Fatalf("failed to write manifests: %v", err)
+	}
+
+	set := newResourceSet()
+	if err := set.loadFile(path); err != nil {
+		t.Fatalf("loadFile() error = %v", err)
+	}
+
+	input, err := set.buildRenderInput("", "")
+	if err != nil {
+		t.Fatalf("buildRenderInput() error = %v", err)
+	}
+
+	if input.Component.Name != "catalog" || input.Environment.Name != "development" || input.DataPlane.Name != "default" {
+		t.Errorf("unexpected resources selected: component %q, environment %q, data plane %q",
+			input.Component.Name, input.Environment.Name, input.DataPlane.Name)
+	}
+	if input.ReleaseBinding == nil || input.ReleaseBinding.Spec.ReleaseName != "catalog-abc123" {
+		t.Errorf("expected release binding catalog-development, got %+v", input.ReleaseBinding)
+	}
+	if input.Workload == nil || input.Workload.Name != "catalog" {
+		t.Errorf("expected workload catalog, got %+v", input.Workload)
+	}
+
+	if input.Metadata.ProjectUID != "project-uid-1" {
+		t.Errorf("expected project UID project-uid-1, got %q", input.Metadata.ProjectUID)
+	}
+	wantSelectors := map[string]string{
+		labels.LabelKeyComponentUID:   "component-uid-1",
+		labels.LabelKeyEnvironmentUID: "env-uid-1",
+		labels.LabelKeyProjectUID:     "project-uid-1",
+	}
+	for key, want := range wantSelectors {
+		if got := input.Metadata.PodSelectors[key]; got != want {
+			t.Errorf("pod selector %s = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestBuildRenderInput_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		component   string
+		environment string
+	}{
+		{name: "unknown component", component: "missing"},
+		{name: "unknown environment", environment: "production"},
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "manifests.yaml")
+	if err := os.WriteFile(path, []byte(testManifests), 0o600); err != nil {
+		t.Fatalf("failed to write manifests: %v", err)
+	}
+	set := newResourceSet()
+	if err := set.loadFile(path); err != nil {
+		t.Fatalf("loadFile() error = %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := set.buildRenderInput(tt.component, tt.environment); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -33,6 +33,9 @@ import (
 	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/login"
 	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/logout"
 	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/logs"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/promotion"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/releasebinding"
+	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/render"
 	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
 	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
 )
@@ -190,6 +193,47 @@ func (c *CommandImplementation) Apply(params api.ApplyParams) error {
 	return applyImpl.Apply(params)
 }
 
+// Render Operations
+
+func (c *CommandImplementation) Render(params api.RenderParams) error {
+	renderImpl := render.NewRenderImpl()
+	return renderImpl.Render(params)
+}
+
+// Promotion Operations
+
+func (c *CommandImplementation) RequestPromotion(params api.RequestPromotionParams) error {
+	promotionImpl := promotion.NewPromotionImpl()
+	return promotionImpl.RequestPromotion(params)
+}
+
+func (c *CommandImplementation) ListPromotionRequests(params api.ListPromotionRequestsParams) error {
+	promotionImpl := promotion.NewPromotionImpl()
+	return promotionImpl.ListPromotionRequests(params)
+}
+
+func (c *CommandImplementation) ApprovePromotionRequest(params api.ReviewPromotionRequestParams) error {
+	promotionImpl := promotion.NewPromotionImpl()
+	return promotionImpl.ApprovePromotionRequest(params)
+}
+
+func (c *CommandImplementation) RejectPromotionRequest(params api.ReviewPromotionRequestParams) error {
+	promotionImpl := promotion.NewPromotionImpl()
+	return promotionImpl.RejectPromotionRequest(params)
+}
+
+// Release Binding Operations
+
+func (c *CommandImplementation) GetReleaseBindingHistory(params api.ReleaseBindingHistoryParams) error {
+	releaseBindingImpl := releasebinding.NewReleaseBindingImpl()
+	return releaseBindingImpl.GetReleaseBindingHistory(params)
+}
+
+func (c *CommandImplementation) RollbackReleaseBinding(params api.RollbackReleaseBindingParams) error {
+	releaseBindingImpl := releasebinding.NewReleaseBindingImpl()
+	return releaseBindingImpl.RollbackReleaseBinding(params)
+}
+
 // Logs Operations
 
 func (c *CommandImplementation) GetLogs(params api.LogParams) error {
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/openchoreo/openchoreo/internal/choreoctl/cmd/config"
@@ -65,6 +67,48 @@ type ListResponse struct {
 	TotalCount int                    `json:"totalCount"`
 	Page       int                    `json:"page"`
 	PageSize   int                    `json:"pageSize"`
+	NextCursor string                 `json:"nextCursor,omitempty"`
+}
+
+// ListOptions represents the pagination, filtering and sorting query parameters of a list request
+type ListOptions struct {
+	Limit         int
+	Cursor        string
+	LabelSelector string
+	// Filters holds the resource specific filters of the list endpoint, such as type, status or environment
+	Filters map[string]string
+	SortBy  string
+	Order   string
+}
+
+// listPath appends the list options to the path of a list endpoint as query parameters
+func listPath(path string, opts *ListOptions) string {
+	if opts == nil {
+		return path
+	}
+	query := url.Values{}
+	if opts.Limit > 0 {
+		query.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	for name, value := range map[string]string{
+		"cursor":        opts.Cursor,
+		"labelSelector": opts.LabelSelector,
+		"sortBy":        opts.SortBy,
+		"order":         opts.Order,
+	} {
+		if value != "" {
+			query.Set(name, value)
+		}
+	}
+	for name, value := range opts.Filters {
+		if value != "" {
+			query.Set(name, value)
+		}
+	}
+	if len(query) == 0 {
+		return path
+	}
+	return path + "?" + query.Encode()
 }
 
 // ListOrganizationsResponse represents the response from listing organizations
@@ -94,6 +138,7 @@ type ListProjectsResponse struct {
 		TotalCount int               `json:"totalCount"`
 		Page       int               `json:"page"`
 		PageSize   int               `json:"pageSize"`
+		NextCursor string            `json:"nextCursor,omitempty"`
 	} `json:"data"`
 	Error string `json:"error,omitempty"`
 	Code  string `json:"code,omitempty"`
@@ -111,6 +156,18 @@ type ComponentResponse struct {
 	Status      string `json:"status,omitempty"`
 }
 
+// BuildResponse represents a build from the API
+type BuildResponse struct {
+	Name          string `json:"name"`
+	ComponentName string `json:"componentName"`
+	ProjectName   string `json:"projectName"`
+	OrgName       string `json:"orgName"`
+	Commit        string `json:"commit,omitempty"`
+	Status        string `json:"status,omitempty"`
+	CreatedAt     string `json:"createdAt"`
+	Image         string `json:"image,omitempty"`
+}
+
 // ListComponentsResponse represents the response from listing components
 type ListComponentsResponse struct {
 	Success bool `json:"success"`
@@ -119,6 +176,7 @@ type ListComponentsResponse struct {
 		TotalCount int                 `json:"totalCount"`
 		Page       int                 `json:"page"`
 		PageSize   int                 `json:"pageSize"`
+		NextCursor string              `json:"nextCursor,omitempty"`
 	} `json:"data"`
 	Error string `json:"error,omitempty"`
 	Code  string `json:"code,omitempty"`
@@ -202,9 +260,9 @@ func (c *APIClient) Delete(ctx context.Context, resource map[string]interface{})
 	return &deleteResp, nil
 }
 
-// ListOrganizations retrieves all organizations from the API
-func (c *APIClient) ListOrganizations(ctx context.Context) ([]OrganizationResponse, error) {
-	resp, err := c.get(ctx, "/api/v1/orgs")
+// ListOrganizations retrieves the organizations matching the list options from the API
+func (c *APIClient) ListOrganizations(ctx context.Context, opts *ListOptions) ([]OrganizationResponse, error) {
+	resp, err := c.get(ctx, listPath("/api/v1/orgs", opts))
 	if err != nil {
 		return nil, fmt.Errorf("failed to make list organizations request: %w", err)
 	}
@@ -227,10 +285,10 @@ func (c *APIClient) ListOrganizations(ctx context.Context) ([]OrganizationRespon
 	return listResp.Data.Items, nil
 }
 
-// ListProjects retrieves all projects for an organization from the API
-func (c *APIClient) ListProjects(ctx context.Context, orgName string) ([]ProjectResponse, error) {
+// ListProjects retrieves the projects of an organization matching the list options from the API
+func (c *APIClient) ListProjects(ctx context.Context, orgName string, opts *ListOptions) ([]ProjectResponse, error) {
 	path := fmt.Sprintf("/api/v1/orgs/%s/projects", orgName)
-	resp, err := c.get(ctx, path)
+	resp, err := c.get(ctx, listPath(path, opts))
 	if err != nil {
 		return nil, fmt.Errorf("failed to make list projects request: %w", err)
 	}
@@ -253,10 +311,10 @@ func (c *APIClient) ListProjects(ctx context.Context, orgName string) ([]Project
 	return listResp.Data.Items, nil
 }
 
-// ListComponents retrieves all components for an organization and project from the API
-func (c *APIClient) ListComponents(ctx context.Context, orgName, projectName string) ([]ComponentResponse, error) {
+// ListComponents retrieves the components of a project matching the list options from the API
+func (c *APIClient) ListComponents(ctx context.Context, orgName, projectName string, opts *ListOptions) ([]ComponentResponse, error) {
 	path := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/components", orgName, projectName)
-	resp, err := c.get(ctx, path)
+	resp, err := c.get(ctx, listPath(path, opts))
 	if err != nil {
 		return nil, fmt.Errorf("failed to make list components request: %w", err)
 	}
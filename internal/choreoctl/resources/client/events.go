@@ -0,0 +1,47 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+)
+
+// ReadEvents parses a Server-Sent Events stream and calls handle for every event.
+// It returns nil when the stream ends, and the error of handle if handle fails.
+func ReadEvents(r io.Reader, handle func(id, event string, data []byte) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var id, event string
+	var data [][]byte
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if len(data) > 0 {
+				if err := handle(id, event, bytes.Join(data, []byte("\n"))); err != nil {
+					return err
+				}
+			}
+			id, event, data = "", "", nil
+		case strings.HasPrefix(line, ":"):
+			// Comments are used as keep-alives
+		default:
+			field, value, _ := strings.Cut(line, ":")
+			value = strings.TrimPrefix(value, " ")
+			switch field {
+			case "id":
+				id = value
+			case "event":
+				event = value
+			case "data":
+				data = append(data, []byte(value))
+			}
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// PromotionRequestResponse represents a promotion request from the API
+type PromotionRequestResponse struct {
+	Name               string     `json:"name"`
+	ComponentName      string     `json:"componentName"`
+	ProjectName        string     `json:"projectName"`
+	OrgName            string     `json:"orgName"`
+	SourceEnvironment  string     `json:"sourceEnv"`
+	TargetEnvironment  string     `json:"targetEnv"`
+	ReleaseName        string     `json:"releaseName"`
+	RequestedBy        string     `json:"requestedBy,omitempty"`
+	Reason             string     `json:"reason,omitempty"`
+	Phase              string     `json:"phase"`
+	ReviewedBy         string     `json:"reviewedBy,omitempty"`
+	ReviewedAt         *time.Time `json:"reviewedAt,omitempty"`
+	Comment            string     `json:"comment,omitempty"`
+	ReleaseBindingName string     `json:"releaseBindingName,omitempty"`
+	CreatedAt          time.Time  `json:"createdAt"`
+}
+
+// promotionRequestAPIResponse represents the response for a single promotion request
+type promotionRequestAPIResponse struct {
+	Success bool                     `json:"success"`
+	Data    PromotionRequestResponse `json:"data"`
+	Error   string                   `json:"error,omitempty"`
+	Code    string                   `json:"code,omitempty"`
+}
+
+// listPromotionRequestsAPIResponse represents the response from listing promotion requests
+type listPromotionRequestsAPIResponse struct {
+	Success bool `json:"success"`
+	Data    struct {
+		Items      []PromotionRequestResponse `json:"items"`
+		TotalCount int                        `json:"totalCount"`
+		Page       int                        `json:"page"`
+		PageSize   int                        `json:"pageSize"`
+		NextCursor string                     `json:"nextCursor,omitempty"`
+	} `json:"data"`
+	Error string `json:"error,omitempty"`
+	Code  string `json:"code,omitempty"`
+}
+
+// RequestPromotion requests the promotion of a component to an environment that requires approval
+func (c *APIClient) RequestPromotion(ctx context.Context, orgName, projectName, componentName, sourceEnv, targetEnv, reason string) (*PromotionRequestResponse, error) {
+	body := map[string]string{
+		"sourceEnv": sourceEnv,
+		"targetEnv": targetEnv,
+		"reason":    reason,
+	}
+	resp, err := c.post(ctx, promotionRequestsPath(orgName, projectName, componentName), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make promotion request: %w", err)
+	}
+	return parsePromotionRequestResponse(resp, "request promotion")
+}
+
+// ListPromotionRequests retrieves the promotion requests of a component matching the list options
+func (c *APIClient) ListPromotionRequests(ctx context.Context, orgName, projectName, componentName string, opts *ListOptions) ([]PromotionRequestResponse, error) {
+	resp, err := c.get(ctx, listPath(promotionRequestsPath(orgName, projectName, componentName), opts))
+	if err != nil {
+		return nil, fmt.Errorf("failed to make list promotion requests request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var listResp listPromotionRequestsAPIResponse
+	if err := json.Unmarshal(body, &listResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !listResp.Success {
+		return nil, fmt.Errorf("list promotion requests failed: %s", listResp.Error)
+	}
+
+	return listResp.Data.Items, nil
+}
+
+// ApprovePromotionRequest approves a pending promotion request
+func (c *APIClient) ApprovePromotionRequest(ctx context.Context, orgName, projectName, componentName, name, comment string) (*PromotionRequestResponse, error) {
+	return c.reviewPromotionRequest(ctx, orgName, projectName, componentName, name, "approve", comment)
+}
+
+// RejectPromotionRequest rejects a pending promotion request
+func (c *APIClient) RejectPromotionRequest(ctx context.Context, orgName, projectName, componentName, name, comment string) (*PromotionRequestResponse, error) {
+	return c.reviewPromotionRequest(ctx, orgName, projectName, componentName, name, "reject", comment)
+}
+
+func (c *APIClient) reviewPromotionRequest(ctx context.Context, orgName, projectName, componentName, name, action, comment string) (*PromotionRequestResponse, error) {
+	path := fmt.Sprintf("%s/%s/%s", promotionRequestsPath(orgName, projectName, componentName), name, action)
+	resp, err := c.post(ctx, path, map[string]string{"comment": comment})
+	if err != nil {
+		return nil, fmt.Errorf("failed to make %s promotion request: %w", action, err)
+	}
+	return parsePromotionRequestResponse(resp, action+" promotion request")
+}
+
+func promotionRequestsPath(orgName, projectName, componentName string) string {
+	return fmt.Sprintf("/api/v1/orgs/%s/projects/%s/components/%s/promotion-requests", orgName, projectName, componentName)
+}
+
+func parsePromotionRequestResponse(resp *http.Response, operation string) (*PromotionRequestResponse, error) {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var prResp promotionRequestAPIResponse
+	if err := json.Unmarshal(body, &prResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !prResp.Success {
+		return nil, fmt.Errorf("%s failed: %s", operation, prResp.Error)
+	}
+
+	return &prResp.Data, nil
+}
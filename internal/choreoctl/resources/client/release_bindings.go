@@ -0,0 +1,105 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ReleaseHistoryEntry represents a release bound to a release binding from the API
+type ReleaseHistoryEntry struct {
+	ReleaseName string    `json:"releaseName"`
+	BoundAt     time.Time `json:"boundAt"`
+	BoundBy     string    `json:"boundBy,omitempty"`
+	Current     bool      `json:"current"`
+}
+
+// ReleaseBindingResponse represents a release binding from the API
+type ReleaseBindingResponse struct {
+	Name        string `json:"name"`
+	Environment string `json:"environment"`
+	ReleaseName string `json:"releaseName"`
+	Status      string `json:"status,omitempty"`
+}
+
+// releaseBindingAPIResponse represents the response for a single release binding
+type releaseBindingAPIResponse struct {
+	Success bool                   `json:"success"`
+	Data    ReleaseBindingResponse `json:"data"`
+	Error   string                 `json:"error,omitempty"`
+	Code    string                 `json:"code,omitempty"`
+}
+
+// releaseHistoryAPIResponse represents the response from getting the history of a release binding
+type releaseHistoryAPIResponse struct {
+	Success bool `json:"success"`
+	Data    struct {
+		Items      []ReleaseHistoryEntry `json:"items"`
+		TotalCount int                   `json:"totalCount"`
+		Page       int                   `json:"page"`
+		PageSize   int                   `json:"pageSize"`
+	} `json:"data"`
+	Error string `json:"error,omitempty"`
+	Code  string `json:"code,omitempty"`
+}
+
+// GetReleaseBindingHistory retrieves the releases bound to a release binding, most recent first
+func (c *APIClient) GetReleaseBindingHistory(ctx context.Context, orgName, projectName, componentName, bindingName string) ([]ReleaseHistoryEntry, error) {
+	resp, err := c.get(ctx, releaseBindingPath(orgName, projectName, componentName, bindingName)+"/history")
+	if err != nil {
+		return nil, fmt.Errorf("failed to make release binding history request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var historyResp releaseHistoryAPIResponse
+	if err := json.Unmarshal(body, &historyResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !historyResp.Success {
+		return nil, fmt.Errorf("get release binding history failed: %s", historyResp.Error)
+	}
+
+	return historyResp.Data.Items, nil
+}
+
+// RollbackReleaseBinding binds an earlier release from the history of a release binding.
+// An empty releaseName rolls back to the release bound before the current one.
+func (c *APIClient) RollbackReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName, releaseName string) (*ReleaseBindingResponse, error) {
+	path := releaseBindingPath(orgName, projectName, componentName, bindingName) + "/rollback"
+	resp, err := c.post(ctx, path, map[string]string{"releaseName": releaseName})
+	if err != nil {
+		return nil, fmt.Errorf("failed to make rollback request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var bindingResp releaseBindingAPIResponse
+	if err := json.Unmarshal(body, &bindingResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !bindingResp.Success {
+		return nil, fmt.Errorf("rollback release binding failed: %s", bindingResp.Error)
+	}
+
+	return &bindingResp.Data, nil
+}
+
+func releaseBindingPath(orgName, projectName, componentName, bindingName string) string {
+	return fmt.Sprintf("/api/v1/orgs/%s/projects/%s/components/%s/release-bindings/%s", orgName, projectName, componentName, bindingName)
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// watchRetryInterval is how long to wait before reconnecting a dropped watch
+const watchRetryInterval = 2 * time.Second
+
+// Types of watch events
+const (
+	WatchEventAdded    = "ADDED"
+	WatchEventModified = "MODIFIED"
+	WatchEventDeleted  = "DELETED"
+	WatchEventBookmark = "BOOKMARK"
+)
+
+// errWatchInterrupted marks watch failures that are recovered from by reconnecting
+var errWatchInterrupted = errors.New("watch interrupted")
+
+// WatchOptions represents the filters of a watch
+type WatchOptions struct {
+	Project   string
+	Component string
+}
+
+// WatchEvent represents a change of a watched resource from the API
+type WatchEvent struct {
+	Type            string `json:"type"`
+	Kind            string `json:"kind"`
+	ResourceVersion string `json:"resourceVersion"`
+	// Object is the API response of the resource, such as a ComponentResponse
+	Object json.RawMessage `json:"object,omitempty"`
+}
+
+// watchErrorEvent is the payload of the error event ending a watch
+type watchErrorEvent struct {
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// WatchResources calls handle for every change of the resources of a kind in an organization, such as
+// "components" or "builds", until the context is cancelled. The current resources are reported as added first.
+// A dropped watch is reconnected and resumes after the last event received.
+func (c *APIClient) WatchResources(ctx context.Context, orgName, kind string, opts *WatchOptions, handle func(WatchEvent) error) error {
+	resourceVersion := ""
+	for {
+		err := c.watchOnce(ctx, orgName, kind, opts, &resourceVersion, handle)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if !errors.Is(err, errWatchInterrupted) {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "%v; reconnecting\n", err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchRetryInterval):
+		}
+	}
+}
+
+// watchOnce reads the events of one watch connection. resourceVersion is updated with the ID of every event received.
+func (c *APIClient) watchOnce(ctx context.Context, orgName, kind string, opts *WatchOptions, resourceVersion *string,
+	handle func(WatchEvent) error) error {
+	query := url.Values{}
+	if opts != nil {
+		if opts.Project != "" {
+			query.Set("project", opts.Project)
+		}
+		if opts.Component != "" {
+			query.Set("component", opts.Component)
+		}
+	}
+	path := fmt.Sprintf("/api/v1/orgs/%s/watch/%s", orgName, kind)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if *resourceVersion != "" {
+		req.Header.Set("Last-Event-ID", *resourceVersion)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	// The request timeout of the API client would end the stream, so only the transport is shared
+	streamClient := &http.Client{Transport: c.httpClient.Transport}
+	resp, err := streamClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errWatchInterrupted, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp watchErrorEvent
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Error != "" {
+			return fmt.Errorf("watch %s failed: %s", kind, errResp.Error)
+		}
+		return fmt.Errorf("watch %s failed: API server returned %s", kind, resp.Status)
+	}
+
+	// Errors of the events end the watch, while read errors of a dropped connection are recovered from
+	var eventErr error
+	err = ReadEvents(resp.Body, func(id, event string, data []byte) error {
+		eventErr = handleWatchEvent(kind, id, event, data, resourceVersion, handle)
+		return eventErr
+	})
+	if eventErr != nil {
+		return eventErr
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %w", errWatchInterrupted, err)
+	}
+	return fmt.Errorf("%w: stream closed by the API server", errWatchInterrupted)
+}
+
+// handleWatchEvent decodes a Server-Sent Event of a watch and passes the change it carries to handle
+func handleWatchEvent(kind, id, event string, data []byte, resourceVersion *string, handle func(WatchEvent) error) error {
+	if event == "error" {
+		var errResp watchErrorEvent
+		if err := json.Unmarshal(data, &errResp); err != nil {
+			return fmt.Errorf("watch %s failed: %s", kind, data)
+		}
+		return fmt.Errorf("watch %s failed: %s", kind, errResp.Error)
+	}
+
+	var watchEvent WatchEvent
+	if err := json.Unmarshal(data, &watchEvent); err != nil {
+		return fmt.Errorf("failed to decode watch event: %w", err)
+	}
+	if id != "" {
+		*resourceVersion = id
+	}
+	if watchEvent.Type == WatchEventBookmark {
+		return nil
+	}
+	return handle(watchEvent)
+}
@@ -75,6 +75,7 @@ const (
 	HeaderDNSPrefix       = "DNS PREFIX"
 	HeaderCluster         = "CLUSTER"
 	HeaderAddress         = "ADDRESS"
+	HeaderEvent           = "EVENT"
 )
 
 // Resource-specific table headers defined as variables (not constants)
@@ -91,6 +92,10 @@ var (
 	// Build table headers
 	HeadersBuild = []string{HeaderName, HeaderStatus, HeaderRevision, HeaderDuration, HeaderAge, HeaderComponent, HeaderProject, HeaderOrganization}
 
+	// Table headers of component and build changes printed with --watch
+	HeadersComponentWatch = []string{HeaderEvent, HeaderName, HeaderType, HeaderStatus, HeaderAge, HeaderProject, HeaderOrganization}
+	HeadersBuildWatch     = []string{HeaderEvent, HeaderName, HeaderStatus, HeaderRevision, HeaderAge, HeaderComponent, HeaderProject, HeaderOrganization}
+
 	// DeployableArtifact table headers
 	HeadersDeployableArtifact = []string{HeaderName, HeaderSource, HeaderStatus, HeaderAge, HeaderComponent, HeaderProject, HeaderOrganization}
 
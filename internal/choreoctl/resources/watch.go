@@ -0,0 +1,67 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// WatchPrinter prints the changes of watched resources as they arrive
+type WatchPrinter struct {
+	format  OutputFormat
+	headers []string
+	widths  []int
+	started bool
+}
+
+// NewWatchPrinter creates a printer for the changes of a watch. The first table header is the event column.
+func NewWatchPrinter(format OutputFormat, headers []string) *WatchPrinter {
+	widths := make([]int, len(headers))
+	for i, header := range headers {
+		widths[i] = len(header)
+	}
+	return &WatchPrinter{format: format, headers: headers, widths: widths}
+}
+
+// Print prints the change of a resource. row holds the table columns after the event column, and
+// object the JSON of the resource printed in the YAML format.
+func (p *WatchPrinter) Print(eventType string, row []string, object []byte) error {
+	switch p.format {
+	case OutputFormatYAML:
+		data, err := yaml.JSONToYAML(object)
+		if err != nil {
+			return fmt.Errorf("failed to convert %s event to YAML: %w", eventType, err)
+		}
+		fmt.Printf("# %s\n%s---\n", eventType, data)
+		return nil
+	case OutputFormatTable:
+		if !p.started {
+			p.printRow(p.headers)
+			p.started = true
+		}
+		p.printRow(append([]string{eventType}, row...))
+		return nil
+	default:
+		return fmt.Errorf(ErrFormatUnsupported, p.format)
+	}
+}
+
+// printRow prints a row with its columns padded to the widest value printed so far in each column,
+// since the rows that follow are not known yet
+func (p *WatchPrinter) printRow(row []string) {
+	var line strings.Builder
+	for i, value := range row {
+		if i == len(row)-1 || i >= len(p.widths) {
+			line.WriteString(value)
+			continue
+		}
+		p.widths[i] = max(p.widths[i], len(value))
+		line.WriteString(value)
+		line.WriteString(strings.Repeat(" ", p.widths[i]-len(value)+3))
+	}
+	fmt.Println(line.String())
+}
@@ -36,6 +36,7 @@ type WorkloadDescriptorEndpoint struct {
 	Type       string `yaml:"type"`
 	SchemaFile string `yaml:"schemaFile,omitempty"`
 	Context    string `yaml:"context,omitempty"`
+	Visibility string `yaml:"visibility,omitempty"`
 }
 
 type WorkloadDescriptorConnection struct {
@@ -227,8 +228,10 @@ func addEndpointsFromDescriptor(workload *openchoreov1alpha1.Workload, descripto
 	workload.Spec.Endpoints = make(map[string]openchoreov1alpha1.WorkloadEndpoint)
 	for _, descriptorEndpoint := range descriptor.Endpoints {
 		endpoint := openchoreov1alpha1.WorkloadEndpoint{
-			Type: openchoreov1alpha1.EndpointType(descriptorEndpoint.Type),
-			Port: descriptorEndpoint.Port,
+			Type:       openchoreov1alpha1.EndpointType(descriptorEndpoint.Type),
+			Port:       descriptorEndpoint.Port,
+			BasePath:   descriptorEndpoint.Context,
+			Visibility: openchoreov1alpha1.EndpointExposeLevel(descriptorEndpoint.Visibility),
 		}
 
 		// Set schema if provided
@@ -12,11 +12,14 @@ import (
 type CommandType string
 
 const (
-	CmdCreate CommandType = "create"
-	CmdGet    CommandType = "get"
-	CmdLogs   CommandType = "logs"
-	CmdApply  CommandType = "apply"
-	CmdDelete CommandType = "delete"
+	CmdCreate         CommandType = "create"
+	CmdGet            CommandType = "get"
+	CmdLogs           CommandType = "logs"
+	CmdApply          CommandType = "apply"
+	CmdDelete         CommandType = "delete"
+	CmdRender         CommandType = "render"
+	CmdPromotion      CommandType = "promotion"
+	CmdReleaseBinding CommandType = "release-binding"
 )
 
 // ResourceType represents the resource being managed
@@ -39,6 +42,9 @@ const (
 	ResourceDeploymentPipeline ResourceType = "deploymentpipeline"
 	ResourceConfigurationGroup ResourceType = "configurationgroup"
 	ResourceWorkload           ResourceType = "workload"
+	ResourceRender             ResourceType = "render"
+	ResourcePromotion          ResourceType = "promotion"
+	ResourceReleaseBinding     ResourceType = "releasebinding"
 )
 
 // checkRequiredFields verifies if all required fields are populated
@@ -45,6 +45,12 @@ func ValidateParams(cmdType CommandType, resource ResourceType, params interface
 		return validateConfigurationGroupParams(cmdType, params)
 	case ResourceWorkload:
 		return validateWorkloadParams(cmdType, params)
+	case ResourceRender:
+		return validateRenderParams(cmdType, params)
+	case ResourcePromotion:
+		return validatePromotionParams(cmdType, params)
+	case ResourceReleaseBinding:
+		return validateReleaseBindingParams(cmdType, params)
 	default:
 		return fmt.Errorf("unknown resource type: %s", resource)
 	}
@@ -287,8 +293,17 @@ func validateLogParams(cmdType CommandType, params interface{}) error {
 					if !checkRequiredFields(deployFields) {
 						return generateHelpError(cmdType, ResourceLogs, deployFields)
 					}
+				case "component":
+					componentFields := map[string]string{
+						"organization": p.Organization,
+						"component":    p.Component,
+						"environment":  p.Environment,
+					}
+					if !checkRequiredFields(componentFields) {
+						return generateHelpError(cmdType, ResourceLogs, componentFields)
+					}
 				default:
-					return fmt.Errorf("log type '%s' not supported. Valid types are: build, deployment", p.Type)
+					return fmt.Errorf("log type '%s' not supported. Valid types are: build, deployment, component", p.Type)
 				}
 			}
 		}
@@ -384,6 +399,90 @@ func validateDeleteParams(cmdType CommandType, params interface{}) error {
 	return nil
 }
 
+// validateRenderParams validates parameters for render operations
+func validateRenderParams(cmdType CommandType, params interface{}) error {
+	if cmdType == CmdRender {
+		if p, ok := params.(api.RenderParams); ok {
+			fields := map[string]string{
+				"file": p.FilePath,
+			}
+			if !checkRequiredFields(fields) {
+				return generateHelpError(cmdType, "", fields)
+			}
+		}
+	}
+	return nil
+}
+
+// validatePromotionParams validates parameters for promotion request operations
+func validatePromotionParams(cmdType CommandType, params interface{}) error {
+	if cmdType != CmdPromotion {
+		return nil
+	}
+
+	switch p := params.(type) {
+	case api.RequestPromotionParams:
+		fields := map[string]string{
+			"organization": p.Organization,
+			"project":      p.Project,
+			"component":    p.Component,
+			"source-env":   p.SourceEnvironment,
+			"target-env":   p.TargetEnvironment,
+		}
+		if !checkRequiredFields(fields) {
+			return generateHelpError(cmdType, "request", fields)
+		}
+	case api.ListPromotionRequestsParams:
+		fields := map[string]string{
+			"organization": p.Organization,
+			"project":      p.Project,
+			"component":    p.Component,
+		}
+		if !checkRequiredFields(fields) {
+			return generateHelpError(cmdType, "list", fields)
+		}
+	case api.ReviewPromotionRequestParams:
+		fields := map[string]string{
+			"organization": p.Organization,
+			"project":      p.Project,
+			"component":    p.Component,
+		}
+		if !checkRequiredFields(fields) {
+			return generateHelpError(cmdType, "", fields)
+		}
+	}
+	return nil
+}
+
+// validateReleaseBindingParams validates parameters for release binding history and rollback operations
+func validateReleaseBindingParams(cmdType CommandType, params interface{}) error {
+	if cmdType != CmdReleaseBinding {
+		return nil
+	}
+
+	switch p := params.(type) {
+	case api.ReleaseBindingHistoryParams:
+		fields := map[string]string{
+			"organization": p.Organization,
+			"project":      p.Project,
+			"component":    p.Component,
+		}
+		if !checkRequiredFields(fields) {
+			return generateHelpError(cmdType, "history", fields)
+		}
+	case api.RollbackReleaseBindingParams:
+		fields := map[string]string{
+			"organization": p.Organization,
+			"project":      p.Project,
+			"component":    p.Component,
+		}
+		if !checkRequiredFields(fields) {
+			return generateHelpError(cmdType, "rollback", fields)
+		}
+	}
+	return nil
+}
+
 // Add validation function:
 func validateDeploymentPipelineParams(cmdType CommandType, params interface{}) error {
 	switch cmdType {
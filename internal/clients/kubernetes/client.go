@@ -4,13 +4,25 @@
 package kubernetes
 
 import (
+	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
 	"sync"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -20,19 +32,72 @@ import (
 	csisecretv1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/secretstorecsi/v1"
 )
 
+// Well-known keys read from a referenced secret when the secret reference does not name a key
+const (
+	secretKeyCA           = "ca.crt"
+	secretKeyClientCert   = "tls.crt"
+	secretKeyClientKey    = "tls.key"
+	secretKeyBearerToken  = "token"
+	secretKeyClientSecret = "client-secret"
+)
+
+// defaultExecAPIVersion is the ExecCredential version used when an exec configuration does not set one
+const defaultExecAPIVersion = "client.authentication.k8s.io/v1"
+
 // KubeMultiClientManager maintains a cache of Kubernetes clients keyed by a unique identifier.
+// Each cached client remembers a hash of the cluster configuration and credentials it was built
+// from, so that a client is rebuilt when the configuration or a referenced secret changes.
 type KubeMultiClientManager struct {
 	mu      sync.Mutex
-	clients map[string]client.Client
+	clients map[string]cachedClient
+
+	// execCommands are the credential plugin commands that cluster configurations may run.
+	// Exec authentication is disabled while it is empty.
+	execCommands map[string]bool
+	// secretReader reads the secrets referenced by cluster configurations instead of the reader
+	// passed to GetClient, if set
+	secretReader client.Reader
+}
+
+// cachedClient is a client together with the hash of the configuration it was built from
+type cachedClient struct {
+	hash   string
+	client client.Client
 }
 
 // NewManager initializes a new KubeMultiClientManager.
 func NewManager() *KubeMultiClientManager {
 	return &KubeMultiClientManager{
-		clients: make(map[string]client.Client),
+		clients: make(map[string]cachedClient),
 	}
 }
 
+// EnableExecAuth allows cluster configurations to authenticate with the given credential plugin
+// commands. Exec authentication runs the command inside the process of the manager, so it is
+// disabled unless the commands are allowed explicitly. It must be called before the manager is used.
+func (m *KubeMultiClientManager) EnableExecAuth(commands ...string) {
+	m.execCommands = make(map[string]bool, len(commands))
+	for _, command := range commands {
+		m.execCommands[command] = true
+	}
+}
+
+// SetSecretReader sets the reader used to read the secrets referenced by cluster configurations.
+// It should read from the API server directly, so that credential secrets are not kept in the cache
+// of the controllers. It must be called before the manager is used.
+func (m *KubeMultiClientManager) SetSecretReader(reader client.Reader) {
+	m.secretReader = reader
+}
+
+// sharedManager is the client cache shared by the controllers of a process, so that the clients of a
+// deleted DataPlane or BuildPlane can be evicted in one place.
+var sharedManager = NewManager()
+
+// SharedManager returns the KubeMultiClientManager shared by the controllers of the process.
+func SharedManager() *KubeMultiClientManager {
+	return sharedManager
+}
+
 func init() {
 	_ = scheme.AddToScheme(scheme.Scheme)
 	_ = openchoreov1alpha1.AddToScheme(scheme.Scheme)
@@ -43,18 +108,34 @@ func init() {
 	_ = argo.AddToScheme(scheme.Scheme)
 }
 
-// GetClient returns an existing Kubernetes client or creates one using the provided cluster configuration.
-func (m *KubeMultiClientManager) GetClient(key string, kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec) (client.Client, error) {
+// GetClient returns the cached Kubernetes client for the key, or creates one using the provided cluster
+// configuration. Secrets referenced by the configuration are read from the given namespace with the reader,
+// unless the manager has its own secret reader. A cached client is replaced when the configuration or the
+// data of a referenced secret has changed.
+func (m *KubeMultiClientManager) GetClient(ctx context.Context, reader client.Reader, key, namespace string,
+	kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec) (client.Client, error) {
+	if m.secretReader != nil {
+		reader = m.secretReader
+	}
+	secrets, err := readSecretValues(ctx, reader, namespace, kubernetesCluster)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := configHash(kubernetesCluster, secrets)
+	if err != nil {
+		return nil, err
+	}
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	// Return cached client if it exists
-	if cl, exists := m.clients[key]; exists {
-		return cl, nil
+	// Return cached client if it was built from the same configuration
+	if cached, exists := m.clients[key]; exists && cached.hash == hash {
+		return cached.client, nil
 	}
 
 	// Create REST config from the new structure
-	restCfg, err := buildRESTConfig(kubernetesCluster)
+	restCfg, err := buildRESTConfig(kubernetesCluster, secrets, m.execCommands)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build REST config: %w", err)
 	}
@@ -66,31 +147,164 @@ func (m *KubeMultiClientManager) GetClient(key string, kubernetesCluster opencho
 	}
 
 	// Cache and return the client
-	m.clients[key] = cl
+	m.clients[key] = cachedClient{hash: hash, client: cl}
 	return cl, nil
 }
 
-// buildRESTConfig constructs a REST config from the KubernetesClusterSpec
-func buildRESTConfig(kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec) (*rest.Config, error) {
+// RemoveClient evicts the client cached for the key, if any.
+func (m *KubeMultiClientManager) RemoveClient(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.clients, key)
+}
+
+// secretValues holds the data read from the secrets referenced by a KubernetesClusterSpec,
+// keyed by "<secret name>/<key>"
+type secretValues map[string][]byte
+
+// valueRef is a ValueFrom of a KubernetesClusterSpec together with the secret key used when
+// its secret reference does not name one
+type valueRef struct {
+	value      *openchoreov1alpha1.ValueFrom
+	defaultKey string
+}
+
+// valueRefs returns the ValueFroms used by the cluster configuration
+func valueRefs(kubernetesCluster *openchoreov1alpha1.KubernetesClusterSpec) []valueRef {
+	refs := []valueRef{{&kubernetesCluster.TLS.CA, secretKeyCA}}
+	auth := &kubernetesCluster.Auth
+	if auth.MTLS != nil {
+		refs = append(refs,
+			valueRef{&auth.MTLS.ClientCert, secretKeyClientCert},
+			valueRef{&auth.MTLS.ClientKey, secretKeyClientKey})
+	}
+	if auth.BearerToken != nil {
+		refs = append(refs, valueRef{auth.BearerToken, secretKeyBearerToken})
+	}
+	if auth.OIDC != nil {
+		refs = append(refs, valueRef{&auth.OIDC.ClientSecret, secretKeyClientSecret})
+	}
+	return refs
+}
+
+// parseSecretRef splits a secret reference of the form "<name>" or "<name>/<key>"
+func parseSecretRef(secretRef, defaultKey string) (name, key string) {
+	if name, key, found := strings.Cut(secretRef, "/"); found {
+		return name, key
+	}
+	return secretRef, defaultKey
+}
+
+// readSecretValues reads the secret data referenced by the cluster configuration
+func readSecretValues(ctx context.Context, reader client.Reader, namespace string,
+	kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec) (secretValues, error) {
+	values := make(secretValues)
+	secrets := make(map[string]*corev1.Secret)
+	for _, ref := range valueRefs(&kubernetesCluster) {
+		if ref.value.SecretRef == "" {
+			continue
+		}
+		name, key := parseSecretRef(ref.value.SecretRef, ref.defaultKey)
+		secret, ok := secrets[name]
+		if !ok {
+			if reader == nil {
+				return nil, fmt.Errorf("cannot read secret %q: no reader configured", name)
+			}
+			secret = &corev1.Secret{}
+			if err := reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret); err != nil {
+				return nil, fmt.Errorf("failed to get secret %q: %w", name, err)
+			}
+			secrets[name] = secret
+		}
+		data, ok := secret.Data[key]
+		if !ok {
+			return nil, fmt.Errorf("secret %q has no key %q", name, key)
+		}
+		values[name+"/"+key] = data
+	}
+	return values, nil
+}
+
+// configHash returns a hash of the cluster configuration and the secret data it references
+func configHash(kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec, secrets secretValues) (string, error) {
+	spec, err := json.Marshal(kubernetesCluster)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal cluster configuration: %w", err)
+	}
+
+	keys := make([]string, 0, len(secrets))
+	for key := range secrets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	h.Write(spec)
+	for _, key := range keys {
+		h.Write([]byte{0})
+		h.Write([]byte(key))
+		h.Write([]byte{0})
+		h.Write(secrets[key])
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// resolveValue returns the data of a ValueFrom. Secret data is used as is, while inline values are
+// base64-decoded when decodeInline is set.
+func resolveValue(value *openchoreov1alpha1.ValueFrom, defaultKey string, secrets secretValues,
+	decodeInline bool) ([]byte, error) {
+	if value.SecretRef != "" {
+		name, key := parseSecretRef(value.SecretRef, defaultKey)
+		data, ok := secrets[name+"/"+key]
+		if !ok {
+			return nil, fmt.Errorf("secret %q has no key %q", name, key)
+		}
+		return data, nil
+	}
+	if value.Value == "" || !decodeInline {
+		return []byte(value.Value), nil
+	}
+	data, err := base64.StdEncoding.DecodeString(value.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64 value: %w", err)
+	}
+	return data, nil
+}
+
+// buildRESTConfig constructs a REST config from the KubernetesClusterSpec. Exec authentication may
+// only run the given commands.
+func buildRESTConfig(kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec, secrets secretValues,
+	execCommands map[string]bool) (*rest.Config, error) {
 	restCfg := &rest.Config{
 		Host: kubernetesCluster.Server,
 	}
 
 	// Configure TLS
-	if err := configureTLS(restCfg, &kubernetesCluster.TLS); err != nil {
+	if err := configureTLS(restCfg, &kubernetesCluster.TLS, secrets); err != nil {
 		return nil, fmt.Errorf("failed to configure TLS: %w", err)
 	}
 
-	// Configure authentication with priority: mTLS > bearerToken > OIDC
-	if kubernetesCluster.Auth.MTLS != nil {
-		if err := configureMTLSAuth(restCfg, kubernetesCluster.Auth.MTLS); err != nil {
+	// Configure authentication with priority: mTLS > bearerToken > OIDC > exec
+	auth := &kubernetesCluster.Auth
+	switch {
+	case auth.MTLS != nil:
+		if err := configureMTLSAuth(restCfg, auth.MTLS, secrets); err != nil {
 			return nil, fmt.Errorf("failed to configure mTLS authentication: %w", err)
 		}
-	} else if kubernetesCluster.Auth.BearerToken != nil {
-		if err := configureBearerAuth(restCfg, kubernetesCluster.Auth.BearerToken); err != nil {
+	case auth.BearerToken != nil:
+		if err := configureBearerAuth(restCfg, auth.BearerToken, secrets); err != nil {
 			return nil, fmt.Errorf("failed to configure bearer token authentication: %w", err)
 		}
-	} else {
+	case auth.OIDC != nil:
+		if err := configureOIDCAuth(restCfg, auth.OIDC, secrets); err != nil {
+			return nil, fmt.Errorf("failed to configure OIDC authentication: %w", err)
+		}
+	case auth.Exec != nil:
+		if err := configureExecAuth(restCfg, auth.Exec, execCommands); err != nil {
+			return nil, fmt.Errorf("failed to configure exec authentication: %w", err)
+		}
+	default:
 		return nil, fmt.Errorf("no supported authentication method configured")
 	}
 
@@ -98,14 +312,15 @@ func buildRESTConfig(kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec)
 }
 
 // configureTLS sets up TLS configuration
-func configureTLS(restCfg *rest.Config, tls *openchoreov1alpha1.KubernetesTLS) error {
-	// Only handle inline CA data for now
-	if tls != nil && tls.CA.Value != "" {
-		// Decode base64 encoded CA certificate
-		caCert, err := base64.StdEncoding.DecodeString(tls.CA.Value)
-		if err != nil {
-			return fmt.Errorf("failed to decode base64 CA certificate: %w", err)
-		}
+func configureTLS(restCfg *rest.Config, tls *openchoreov1alpha1.KubernetesTLS, secrets secretValues) error {
+	if tls == nil {
+		return nil
+	}
+	caCert, err := resolveValue(&tls.CA, secretKeyCA, secrets, true)
+	if err != nil {
+		return fmt.Errorf("failed to resolve CA certificate: %w", err)
+	}
+	if len(caCert) > 0 {
 		restCfg.TLSClientConfig.CAData = caCert
 	}
 
@@ -113,27 +328,25 @@ func configureTLS(restCfg *rest.Config, tls *openchoreov1alpha1.KubernetesTLS) e
 }
 
 // configureMTLSAuth sets up mutual TLS authentication
-func configureMTLSAuth(restCfg *rest.Config, mtlsAuth *openchoreov1alpha1.MTLSAuth) error {
+func configureMTLSAuth(restCfg *rest.Config, mtlsAuth *openchoreov1alpha1.MTLSAuth, secrets secretValues) error {
 	if mtlsAuth == nil {
 		return fmt.Errorf("mTLS authentication config is nil")
 	}
 
-	// Get client certificate (only inline value for now)
-	if mtlsAuth.ClientCert.Value == "" {
-		return fmt.Errorf("client certificate value is required for mTLS authentication")
-	}
-	clientCertData, err := base64.StdEncoding.DecodeString(mtlsAuth.ClientCert.Value)
+	clientCertData, err := resolveValue(&mtlsAuth.ClientCert, secretKeyClientCert, secrets, true)
 	if err != nil {
-		return fmt.Errorf("failed to decode base64 client certificate: %w", err)
+		return fmt.Errorf("failed to resolve client certificate: %w", err)
 	}
-
-	// Get client key (only inline value for now)
-	if mtlsAuth.ClientKey.Value == "" {
-		return fmt.Errorf("client key value is required for mTLS authentication")
+	if len(clientCertData) == 0 {
+		return fmt.Errorf("client certificate is required for mTLS authentication")
 	}
-	clientKeyData, err := base64.StdEncoding.DecodeString(mtlsAuth.ClientKey.Value)
+
+	clientKeyData, err := resolveValue(&mtlsAuth.ClientKey, secretKeyClientKey, secrets, true)
 	if err != nil {
-		return fmt.Errorf("failed to decode base64 client key: %w", err)
+		return fmt.Errorf("failed to resolve client key: %w", err)
+	}
+	if len(clientKeyData) == 0 {
+		return fmt.Errorf("client key is required for mTLS authentication")
 	}
 
 	restCfg.TLSClientConfig.CertData = clientCertData
@@ -143,35 +356,114 @@ func configureMTLSAuth(restCfg *rest.Config, mtlsAuth *openchoreov1alpha1.MTLSAu
 }
 
 // configureBearerAuth sets up bearer token authentication
-func configureBearerAuth(restCfg *rest.Config, bearerAuth *openchoreov1alpha1.ValueFrom) error {
+func configureBearerAuth(restCfg *rest.Config, bearerAuth *openchoreov1alpha1.ValueFrom, secrets secretValues) error {
 	if bearerAuth == nil {
 		return fmt.Errorf("bearer token authentication config is nil")
 	}
 
-	// Only handle inline token value for now
-	if bearerAuth.Value == "" {
-		return fmt.Errorf("bearer token value is required for bearer token authentication")
+	// Inline tokens are not base64 encoded
+	token, err := resolveValue(bearerAuth, secretKeyBearerToken, secrets, false)
+	if err != nil {
+		return fmt.Errorf("failed to resolve bearer token: %w", err)
+	}
+	if len(token) == 0 {
+		return fmt.Errorf("bearer token is required for bearer token authentication")
+	}
+
+	restCfg.BearerToken = strings.TrimSpace(string(token))
+	return nil
+}
+
+// configureOIDCAuth sets up bearer token authentication with tokens obtained from an OIDC provider
+// using the client credentials flow. Tokens are cached and refreshed before they expire.
+func configureOIDCAuth(restCfg *rest.Config, oidcAuth *openchoreov1alpha1.OIDCAuth, secrets secretValues) error {
+	// Inline client secrets are not base64 encoded
+	clientSecret, err := resolveValue(&oidcAuth.ClientSecret, secretKeyClientSecret, secrets, false)
+	if err != nil {
+		return fmt.Errorf("failed to resolve client secret: %w", err)
+	}
+
+	cfg := clientcredentials.Config{
+		ClientID:     oidcAuth.ClientID,
+		ClientSecret: strings.TrimSpace(string(clientSecret)),
+		TokenURL:     oidcAuth.TokenURL,
+		Scopes:       oidcAuth.Scopes,
+	}
+	if oidcAuth.Audience != "" {
+		cfg.EndpointParams = url.Values{"audience": []string{oidcAuth.Audience}}
 	}
+	// The token source outlives the request that created the client, so it must not use its context
+	tokenSource := cfg.TokenSource(context.Background())
 
-	restCfg.BearerToken = bearerAuth.Value
+	restCfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &oauth2.Transport{Source: tokenSource, Base: rt}
+	})
 	return nil
 }
 
+// configureExecAuth sets up authentication with a client-go credential plugin, if the command of the
+// plugin is allowed
+func configureExecAuth(restCfg *rest.Config, execAuth *openchoreov1alpha1.ExecAuth, execCommands map[string]bool) error {
+	if len(execCommands) == 0 {
+		return fmt.Errorf("exec authentication is not enabled")
+	}
+	if !execCommands[execAuth.Command] {
+		return fmt.Errorf("command %q is not allowed for exec authentication", execAuth.Command)
+	}
+
+	apiVersion := execAuth.APIVersion
+	if apiVersion == "" {
+		apiVersion = defaultExecAPIVersion
+	}
+	env := make([]clientcmdapi.ExecEnvVar, 0, len(execAuth.Env))
+	for _, e := range execAuth.Env {
+		env = append(env, clientcmdapi.ExecEnvVar{Name: e.Name, Value: e.Value})
+	}
+
+	restCfg.ExecProvider = &clientcmdapi.ExecConfig{
+		Command:         execAuth.Command,
+		Args:            execAuth.Args,
+		Env:             env,
+		APIVersion:      apiVersion,
+		InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+	}
+	return nil
+}
+
+// PlaneKind is the kind of plane resource a cached client connects to. Planes of different kinds may
+// share a name, such as the default DataPlane and BuildPlane of an org, so the kind is part of the key.
+type PlaneKind string
+
+const (
+	PlaneKindDataPlane  PlaneKind = "dataplane"
+	PlaneKindBuildPlane PlaneKind = "buildplane"
+)
+
 // makeClientKey generates a unique key for the client cache.
-func makeClientKey(orgName, name string) string {
-	return fmt.Sprintf("%s/%s", orgName, name)
+func makeClientKey(kind PlaneKind, orgName, name string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, orgName, name)
 }
 
-// GetK8sClient retrieves a Kubernetes client for the specified org and cluster.
+// GetK8sClient retrieves a Kubernetes client for the specified plane of an org. Secrets referenced by the
+// cluster configuration are read from the org namespace with the reader.
 func GetK8sClient(
+	ctx context.Context,
 	clientMgr *KubeMultiClientManager,
+	reader client.Reader,
+	kind PlaneKind,
 	orgName, name string,
 	kubernetesCluster openchoreov1alpha1.KubernetesClusterSpec,
 ) (client.Client, error) {
-	key := makeClientKey(orgName, name)
-	cl, err := clientMgr.GetClient(key, kubernetesCluster)
+	key := makeClientKey(kind, orgName, name)
+	cl, err := clientMgr.GetClient(ctx, reader, key, orgName, kubernetesCluster)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get Kubernetes client: %w", err)
 	}
 	return cl, nil
 }
+
+// EvictK8sClient removes the cached client of the specified plane of an org, so that the next
+// GetK8sClient call builds a new one.
+func EvictK8sClient(clientMgr *KubeMultiClientManager, kind PlaneKind, orgName, name string) {
+	clientMgr.RemoveClient(makeClientKey(kind, orgName, name))
+}
@@ -0,0 +1,226 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestGetClientRebuildsOnCredentialChange(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "acme", Name: "dp-credentials"},
+		Data:       map[string][]byte{"token": []byte("token-1")},
+	}
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+	ctx := context.Background()
+
+	spec := openchoreov1alpha1.KubernetesClusterSpec{
+		Server: "https://dataplane.example.com:6443",
+		Auth: openchoreov1alpha1.KubernetesAuth{
+			BearerToken: &openchoreov1alpha1.ValueFrom{SecretRef: "dp-credentials"},
+		},
+	}
+
+	m := NewManager()
+	first, err := GetK8sClient(ctx, m, reader, PlaneKindDataPlane, "acme", "default", spec)
+	if err != nil {
+		t.Fatalf("GetK8sClient() error = %v", err)
+	}
+	cached, err := GetK8sClient(ctx, m, reader, PlaneKindDataPlane, "acme", "default", spec)
+	if err != nil {
+		t.Fatalf("GetK8sClient() error = %v", err)
+	}
+	if cached != first {
+		t.Errorf("GetK8sClient() built a new client for an unchanged configuration")
+	}
+
+	// Rotating the token in the secret must replace the client
+	secret.Data["token"] = []byte("token-2")
+	if err := reader.Update(ctx, secret); err != nil {
+		t.Fatal(err)
+	}
+	rotated, err := GetK8sClient(ctx, m, reader, PlaneKindDataPlane, "acme", "default", spec)
+	if err != nil {
+		t.Fatalf("GetK8sClient() error = %v", err)
+	}
+	if rotated == first {
+		t.Errorf("GetK8sClient() returned the cached client after the secret changed")
+	}
+
+	// Changing the spec must replace the client
+	spec.Server = "https://dataplane.example.com:7443"
+	moved, err := GetK8sClient(ctx, m, reader, PlaneKindDataPlane, "acme", "default", spec)
+	if err != nil {
+		t.Fatalf("GetK8sClient() error = %v", err)
+	}
+	if moved == rotated {
+		t.Errorf("GetK8sClient() returned the cached client after the spec changed")
+	}
+
+	EvictK8sClient(m, PlaneKindDataPlane, "acme", "default")
+	if _, exists := m.clients[makeClientKey(PlaneKindDataPlane, "acme", "default")]; exists {
+		t.Errorf("EvictK8sClient() kept the cached client")
+	}
+
+	// The secret reader of the manager is used instead of the reader of the caller
+	m.SetSecretReader(reader)
+	if _, err := GetK8sClient(ctx, m, nil, PlaneKindDataPlane, "acme", "default", spec); err != nil {
+		t.Errorf("GetK8sClient() with the secret reader of the manager error = %v", err)
+	}
+}
+
+func TestGetClientKeysByPlaneKind(t *testing.T) {
+	ctx := context.Background()
+	dataPlane := openchoreov1alpha1.KubernetesClusterSpec{Server: "https://dataplane.example.com:6443"}
+	buildPlane := openchoreov1alpha1.KubernetesClusterSpec{Server: "https://buildplane.example.com:6443"}
+
+	// The default DataPlane and BuildPlane of an org share their name
+	m := NewManager()
+	dpClient, err := GetK8sClient(ctx, m, nil, PlaneKindDataPlane, "acme", "default", dataPlane)
+	if err != nil {
+		t.Fatalf("GetK8sClient() error = %v", err)
+	}
+	if _, err := GetK8sClient(ctx, m, nil, PlaneKindBuildPlane, "acme", "default", buildPlane); err != nil {
+		t.Fatalf("GetK8sClient() error = %v", err)
+	}
+	cached, err := GetK8sClient(ctx, m, nil, PlaneKindDataPlane, "acme", "default", dataPlane)
+	if err != nil {
+		t.Fatalf("GetK8sClient() error = %v", err)
+	}
+	if cached != dpClient {
+		t.Errorf("GetK8sClient() rebuilt the DataPlane client after the BuildPlane client was created")
+	}
+
+	EvictK8sClient(m, PlaneKindBuildPlane, "acme", "default")
+	if _, exists := m.clients[makeClientKey(PlaneKindDataPlane, "acme", "default")]; !exists {
+		t.Errorf("EvictK8sClient() of the BuildPlane removed the DataPlane client")
+	}
+}
+
+func TestBuildRESTConfig(t *testing.T) {
+	secrets := secretValues{
+		"dp-tls/tls.crt":   []byte("cert"),
+		"dp-tls/tls.key":   []byte("key"),
+		"dp-ca/ca.crt":     []byte("ca"),
+		"dp-token/token":   []byte("secret-token\n"),
+		"oidc/credentials": []byte("client-secret"),
+	}
+	execCommands := map[string]bool{"aws": true, "kubelogin": true}
+
+	tests := []struct {
+		name    string
+		spec    openchoreov1alpha1.KubernetesClusterSpec
+		wantErr bool
+	}{
+		{
+			name: "mTLS from secret",
+			spec: openchoreov1alpha1.KubernetesClusterSpec{
+				TLS: openchoreov1alpha1.KubernetesTLS{CA: openchoreov1alpha1.ValueFrom{SecretRef: "dp-ca"}},
+				Auth: openchoreov1alpha1.KubernetesAuth{MTLS: &openchoreov1alpha1.MTLSAuth{
+					ClientCert: openchoreov1alpha1.ValueFrom{SecretRef: "dp-tls"},
+					ClientKey:  openchoreov1alpha1.ValueFrom{SecretRef: "dp-tls"},
+				}},
+			},
+		},
+		{
+			name: "Bearer token from secret",
+			spec: openchoreov1alpha1.KubernetesClusterSpec{
+				Auth: openchoreov1alpha1.KubernetesAuth{
+					BearerToken: &openchoreov1alpha1.ValueFrom{SecretRef: "dp-token"},
+				},
+			},
+		},
+		{
+			name: "OIDC with explicit secret key",
+			spec: openchoreov1alpha1.KubernetesClusterSpec{
+				Auth: openchoreov1alpha1.KubernetesAuth{OIDC: &openchoreov1alpha1.OIDCAuth{
+					TokenURL:     "https://idp.example.com/token",
+					ClientID:     "openchoreo",
+					ClientSecret: openchoreov1alpha1.ValueFrom{SecretRef: "oidc/credentials"},
+				}},
+			},
+		},
+		{
+			name: "Exec",
+			spec: openchoreov1alpha1.KubernetesClusterSpec{
+				Auth: openchoreov1alpha1.KubernetesAuth{Exec: &openchoreov1alpha1.ExecAuth{
+					Command: "aws",
+					Args:    []string{"eks", "get-token", "--cluster-name", "dataplane"},
+				}},
+			},
+		},
+		{
+			name: "Missing secret key",
+			spec: openchoreov1alpha1.KubernetesClusterSpec{
+				Auth: openchoreov1alpha1.KubernetesAuth{
+					BearerToken: &openchoreov1alpha1.ValueFrom{SecretRef: "dp-token/other"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Exec with command that is not allowed",
+			spec: openchoreov1alpha1.KubernetesClusterSpec{
+				Auth: openchoreov1alpha1.KubernetesAuth{Exec: &openchoreov1alpha1.ExecAuth{Command: "sh"}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "No authentication",
+			spec:    openchoreov1alpha1.KubernetesClusterSpec{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := buildRESTConfig(tt.spec, secrets, execCommands)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("buildRESTConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	cfg, err := buildRESTConfig(openchoreov1alpha1.KubernetesClusterSpec{
+		TLS: openchoreov1alpha1.KubernetesTLS{CA: openchoreov1alpha1.ValueFrom{SecretRef: "dp-ca"}},
+		Auth: openchoreov1alpha1.KubernetesAuth{
+			BearerToken: &openchoreov1alpha1.ValueFrom{SecretRef: "dp-token"},
+		},
+	}, secrets, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.BearerToken != "secret-token" || string(cfg.TLSClientConfig.CAData) != "ca" {
+		t.Errorf("buildRESTConfig() token = %q, CA = %q", cfg.BearerToken, cfg.TLSClientConfig.CAData)
+	}
+
+	cfg, err = buildRESTConfig(openchoreov1alpha1.KubernetesClusterSpec{
+		Auth: openchoreov1alpha1.KubernetesAuth{Exec: &openchoreov1alpha1.ExecAuth{Command: "kubelogin"}},
+	}, secrets, execCommands)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.ExecProvider == nil || cfg.ExecProvider.APIVersion != defaultExecAPIVersion {
+		t.Errorf("buildRESTConfig() exec provider = %+v, want API version %s", cfg.ExecProvider, defaultExecAPIVersion)
+	}
+
+	// Exec authentication is disabled unless commands are allowed
+	if _, err := buildRESTConfig(openchoreov1alpha1.KubernetesClusterSpec{
+		Auth: openchoreov1alpha1.KubernetesAuth{Exec: &openchoreov1alpha1.ExecAuth{Command: "kubelogin"}},
+	}, secrets, nil); err == nil {
+		t.Errorf("buildRESTConfig() allowed exec authentication without allowed commands")
+	}
+}
@@ -207,7 +207,7 @@ func (s *Builder) getBuildPlaneClient(ctx context.Context, build *openchoreov1al
 		return nil, fmt.Errorf("cannot retrieve the build plane: %w", err)
 	}
 
-	bpClient, err := kubernetesClient.GetK8sClient(s.k8sClientMgr, buildPlane.Namespace, buildPlane.Name, buildPlane.Spec.KubernetesCluster)
+	bpClient, err := kubernetesClient.GetK8sClient(ctx, s.k8sClientMgr, s.client, kubernetesClient.PlaneKindBuildPlane, buildPlane.Namespace, buildPlane.Name, buildPlane.Spec.KubernetesCluster)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get build plane client: %w", err)
 	}
@@ -217,7 +217,7 @@ func (s *Builder) getBuildPlaneClient(ctx context.Context, build *openchoreov1al
 
 // GetBuildPlaneClient gets the build plane client for a given build - public method for controller access
 func (s *Builder) GetBuildPlaneClient(ctx context.Context, buildPlane *openchoreov1alpha1.BuildPlane) (client.Client, error) {
-	bpClient, err := kubernetesClient.GetK8sClient(s.k8sClientMgr, buildPlane.Namespace, buildPlane.Name, buildPlane.Spec.KubernetesCluster)
+	bpClient, err := kubernetesClient.GetK8sClient(ctx, s.k8sClientMgr, s.client, kubernetesClient.PlaneKindBuildPlane, buildPlane.Namespace, buildPlane.Name, buildPlane.Spec.KubernetesCluster)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get build plane client: %w", err)
 	}
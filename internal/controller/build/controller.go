@@ -122,7 +122,7 @@ const (
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if r.engine == nil {
-		r.engine = NewBuilder(r.Client, kubernetesClient.NewManager())
+		r.engine = NewBuilder(r.Client, kubernetesClient.SharedManager())
 
 		// Register build engines here to avoid circular imports
 		r.engine.registerBuildEngines()
@@ -6,12 +6,14 @@ package buildplane
 import (
 	"context"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
 )
 
 // BuildPlaneReconciler reconciles a BuildPlane object
@@ -34,9 +36,18 @@ type BuildPlaneReconciler struct {
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.21.0/pkg/reconcile
 func (r *BuildPlaneReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = logf.FromContext(ctx)
-
-	// TODO(user): your logic here
+	logger := logf.FromContext(ctx)
+
+	buildPlane := &openchoreov1alpha1.BuildPlane{}
+	if err := r.Get(ctx, req.NamespacedName, buildPlane); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Drop the cached client so that its credentials are not kept after the build plane is gone
+			logger.Info("BuildPlane resource not found. Evicting its cached client.")
+			kubernetesClient.EvictK8sClient(kubernetesClient.SharedManager(), kubernetesClient.PlaneKindBuildPlane, req.Namespace, req.Name)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
 
 	return ctrl.Result{}, nil
 }
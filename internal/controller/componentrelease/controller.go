@@ -7,8 +7,13 @@ import (
 	"context"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
@@ -16,23 +21,90 @@ import (
 // Reconciler reconciles a ComponentRelease object
 type Reconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// APIReader reads from the API server without the cache. It is used to confirm that a release
+	// is still unreferenced right before it is pruned. Defaults to Client when nil.
+	APIReader client.Reader
+
+	// DefaultRetention is the retention policy applied to the fields a Component does not set
+	// in spec.releaseRetention. The zero value does not prune releases.
+	DefaultRetention openchoreov1alpha1.ReleaseRetentionPolicy
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=componentreleases,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=componentreleases/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=componentreleases/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=components,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=components/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releasebindings,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=promotionrequests,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
-// Reconcile is part of the main kubernetes reconciliation loop
+// Reconcile prunes the ComponentReleases of the component that owns the reconciled ComponentRelease
+// according to the release retention policy of the component.
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	// No-op controller - ComponentReleases are managed externally
-	return ctrl.Result{}, nil
+	logger := log.FromContext(ctx)
+
+	componentRelease := &openchoreov1alpha1.ComponentRelease{}
+	if err := r.Get(ctx, req.NamespacedName, componentRelease); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "Failed to get ComponentRelease")
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !componentRelease.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	return r.pruneComponentReleases(ctx, componentRelease.Namespace, componentRelease.Spec.Owner)
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("componentrelease-controller")
+	}
+
+	// A release may become prunable when a ReleaseBinding moves to another release or the
+	// retention policy of the component changes. Both enqueue a release of the component,
+	// which prunes all releases of that component.
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreov1alpha1.ComponentRelease{}).
+		Watches(&openchoreov1alpha1.ReleaseBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.releaseForReleaseBinding)).
+		Watches(&openchoreov1alpha1.Component{},
+			handler.EnqueueRequestsFromMapFunc(r.releaseForComponent)).
 		Named("componentrelease").
 		Complete(r)
 }
+
+// releaseForReleaseBinding returns a reconcile request for the release bound by the ReleaseBinding
+func (r *Reconciler) releaseForReleaseBinding(_ context.Context, obj client.Object) []reconcile.Request {
+	releaseBinding := obj.(*openchoreov1alpha1.ReleaseBinding)
+	if releaseBinding.Spec.ReleaseName == "" {
+		return nil
+	}
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{
+			Name:      releaseBinding.Spec.ReleaseName,
+			Namespace: releaseBinding.Namespace,
+		},
+	}}
+}
+
+// releaseForComponent returns a reconcile request for the latest release of the Component
+func (r *Reconciler) releaseForComponent(_ context.Context, obj client.Object) []reconcile.Request {
+	comp := obj.(*openchoreov1alpha1.Component)
+	if comp.Status.LatestRelease == nil {
+		return nil
+	}
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{
+			Name:      comp.Status.LatestRelease.Name,
+			Namespace: comp.Namespace,
+		},
+	}}
+}
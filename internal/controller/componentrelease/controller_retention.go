@@ -0,0 +1,236 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentrelease
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// pruneComponentReleases deletes the ComponentReleases of a component that are not retained by its
+// retention policy and records the pruned releases in the Component status.
+func (r *Reconciler) pruneComponentReleases(ctx context.Context, namespace string,
+	owner openchoreov1alpha1.ComponentReleaseOwner) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("component", owner.ComponentName)
+
+	// The component may already be deleted. Its releases are still pruned with the default policy.
+	// Releases are not pruned unless the component or the controller manager sets a retention policy.
+	var comp *openchoreov1alpha1.Component
+	existing := &openchoreov1alpha1.Component{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: owner.ComponentName}, existing)
+	switch {
+	case err == nil:
+		comp = existing
+	case !apierrors.IsNotFound(err):
+		return ctrl.Result{}, fmt.Errorf("failed to get component: %w", err)
+	}
+
+	policy := effectiveRetentionPolicy(r.DefaultRetention, comp)
+	if policy.KeepLast == nil && policy.MaxAge == nil {
+		return ctrl.Result{}, nil
+	}
+
+	var releaseList openchoreov1alpha1.ComponentReleaseList
+	if err := r.List(ctx, &releaseList, client.InNamespace(namespace)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list component releases: %w", err)
+	}
+	releases := make([]openchoreov1alpha1.ComponentRelease, 0, len(releaseList.Items))
+	for _, release := range releaseList.Items {
+		if release.Spec.Owner == owner && release.DeletionTimestamp.IsZero() {
+			releases = append(releases, release)
+		}
+	}
+
+	protected, err := protectedReleases(ctx, r.Client, namespace, owner, comp)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	now := time.Now()
+	prunable, requeueAfter := selectPrunableReleases(releases, protected, policy, now)
+
+	if len(prunable) == 0 {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	// The cache may not have observed a ReleaseBinding or promotion request that started using one
+	// of the releases yet, so the protected releases are computed again from the API server before
+	// anything is deleted.
+	protected, err = protectedReleases(ctx, r.apiReader(), namespace, owner, comp)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	pruned := make([]string, 0, len(prunable))
+	for i := range prunable {
+		release := &prunable[i]
+		if protected[release.Name] {
+			continue
+		}
+		// ComponentReleases are immutable, so the UID is enough to make sure the release that was
+		// evaluated is the one deleted
+		if err := r.Delete(ctx, release, client.Preconditions{UID: &release.UID}); client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete component release %q: %w", release.Name, err)
+		}
+		logger.Info("Pruned ComponentRelease", "release", release.Name, "created", release.CreationTimestamp.Time)
+		pruned = append(pruned, release.Name)
+	}
+
+	if len(pruned) > 0 && comp != nil {
+		r.Recorder.Eventf(comp, corev1.EventTypeNormal, "ComponentReleasesPruned",
+			"Pruned %d ComponentRelease(s) by the retention policy: %s", len(pruned), strings.Join(pruned, ", "))
+
+		base := client.MergeFrom(comp.DeepCopy())
+		pruneTime := metav1.NewTime(now)
+		comp.Status.ReleaseRetention = &openchoreov1alpha1.ReleaseRetentionStatus{
+			LastPrunedAt:       &pruneTime,
+			LastPrunedReleases: pruned,
+		}
+		if err := r.Status().Patch(ctx, comp, base); err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// apiReader returns the reader used to read objects directly from the API server
+func (r *Reconciler) apiReader() client.Reader {
+	if r.APIReader != nil {
+		return r.APIReader
+	}
+	return r.Client
+}
+
+// protectedReleases returns the names of the releases that must never be pruned: the latest release
+// of the component, the releases bound, being rolled out or recorded in the history of any
+// ReleaseBinding in the namespace and the releases requested by the promotion requests of the
+// component that are not decided yet. Bindings are not filtered by owner, so a release stays
+// protected even if a binding of another component refers to it by name.
+func protectedReleases(ctx context.Context, reader client.Reader, namespace string,
+	owner openchoreov1alpha1.ComponentReleaseOwner, comp *openchoreov1alpha1.Component) (map[string]bool, error) {
+	protected := make(map[string]bool)
+	if comp != nil && comp.Status.LatestRelease != nil {
+		protected[comp.Status.LatestRelease.Name] = true
+	}
+
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := reader.List(ctx, &bindings, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	for i := range bindings.Items {
+		addBindingReleases(protected, &bindings.Items[i])
+	}
+
+	var promotionRequests openchoreov1alpha1.PromotionRequestList
+	if err := reader.List(ctx, &promotionRequests, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list promotion requests: %w", err)
+	}
+	for i := range promotionRequests.Items {
+		request := &promotionRequests.Items[i]
+		if request.Spec.Owner.ProjectName != owner.ProjectName || request.Spec.Owner.ComponentName != owner.ComponentName {
+			continue
+		}
+		switch request.Status.Phase {
+		case "", openchoreov1alpha1.PromotionRequestPhasePending, openchoreov1alpha1.PromotionRequestPhaseApproved:
+			protected[request.Spec.ReleaseName] = true
+		}
+	}
+
+	return protected, nil
+}
+
+// addBindingReleases adds the releases a ReleaseBinding deploys or can roll back to to the protected set
+func addBindingReleases(protected map[string]bool, binding *openchoreov1alpha1.ReleaseBinding) {
+	if binding.Spec.ReleaseName != "" {
+		protected[binding.Spec.ReleaseName] = true
+	}
+	if rollout := binding.Status.Rollout; rollout != nil {
+		if rollout.StableRelease != "" {
+			protected[rollout.StableRelease] = true
+		}
+		if rollout.CanaryRelease != "" {
+			protected[rollout.CanaryRelease] = true
+		}
+	}
+	for _, entry := range binding.Status.History {
+		if entry.ReleaseName != "" {
+			protected[entry.ReleaseName] = true
+		}
+	}
+}
+
+// effectiveRetentionPolicy merges the retention policy of the component over the default policy.
+// A policy without KeepLast and MaxAge prunes nothing.
+func effectiveRetentionPolicy(defaults openchoreov1alpha1.ReleaseRetentionPolicy,
+	comp *openchoreov1alpha1.Component) openchoreov1alpha1.ReleaseRetentionPolicy {
+	policy := defaults
+	if comp != nil && comp.Spec.ReleaseRetention != nil {
+		if comp.Spec.ReleaseRetention.KeepLast != nil {
+			policy.KeepLast = comp.Spec.ReleaseRetention.KeepLast
+		}
+		if comp.Spec.ReleaseRetention.MaxAge != nil {
+			policy.MaxAge = comp.Spec.ReleaseRetention.MaxAge
+		}
+	}
+	return policy
+}
+
+// selectPrunableReleases returns the releases that are not protected and are either not among the
+// KeepLast most recent releases or older than MaxAge. It also returns the time until the next
+// retained release exceeds MaxAge, or zero if no release will expire.
+func selectPrunableReleases(releases []openchoreov1alpha1.ComponentRelease, protected map[string]bool,
+	policy openchoreov1alpha1.ReleaseRetentionPolicy, now time.Time) ([]openchoreov1alpha1.ComponentRelease, time.Duration) {
+	sorted := make([]openchoreov1alpha1.ComponentRelease, len(releases))
+	copy(sorted, releases)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ti, tj := sorted[i].CreationTimestamp, sorted[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return tj.Before(&ti)
+		}
+		return sorted[i].Name > sorted[j].Name
+	})
+
+	var maxAge time.Duration
+	if policy.MaxAge != nil {
+		maxAge = policy.MaxAge.Duration
+	}
+
+	var prunable []openchoreov1alpha1.ComponentRelease
+	var requeueAfter time.Duration
+	for i, release := range sorted {
+		if protected[release.Name] {
+			continue
+		}
+		if policy.KeepLast != nil && i >= int(*policy.KeepLast) {
+			prunable = append(prunable, release)
+			continue
+		}
+		if maxAge <= 0 {
+			continue
+		}
+		expiresIn := release.CreationTimestamp.Add(maxAge).Sub(now)
+		if expiresIn <= 0 {
+			prunable = append(prunable, release)
+			continue
+		}
+		if requeueAfter == 0 || expiresIn < requeueAfter {
+			requeueAfter = expiresIn
+		}
+	}
+	return prunable, requeueAfter
+}
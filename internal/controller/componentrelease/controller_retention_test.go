@@ -0,0 +1,172 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package componentrelease
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestSelectPrunableReleases(t *testing.T) {
+	now := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+	release := func(name string, age time.Duration) openchoreov1alpha1.ComponentRelease {
+		return openchoreov1alpha1.ComponentRelease{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(now.Add(-age))},
+		}
+	}
+	// Listed out of order to make sure the releases are sorted by age
+	releases := []openchoreov1alpha1.ComponentRelease{
+		release("greeter-v2", 72*time.Hour),
+		release("greeter-v4", time.Hour),
+		release("greeter-v1", 96*time.Hour),
+		release("greeter-v3", 48*time.Hour),
+	}
+	keepLast := func(n int32) *int32 { return &n }
+	maxAge := func(d time.Duration) *metav1.Duration { return &metav1.Duration{Duration: d} }
+
+	tests := []struct {
+		name             string
+		protected        map[string]bool
+		policy           openchoreov1alpha1.ReleaseRetentionPolicy
+		want             []string
+		wantRequeueAfter time.Duration
+	}{
+		{
+			name:   "Keep all",
+			policy: openchoreov1alpha1.ReleaseRetentionPolicy{KeepLast: keepLast(10)},
+		},
+		{
+			name:   "Keep last two",
+			policy: openchoreov1alpha1.ReleaseRetentionPolicy{KeepLast: keepLast(2)},
+			want:   []string{"greeter-v2", "greeter-v1"},
+		},
+		{
+			name:      "Bound release is never pruned",
+			protected: map[string]bool{"greeter-v1": true},
+			policy:    openchoreov1alpha1.ReleaseRetentionPolicy{KeepLast: keepLast(1)},
+			want:      []string{"greeter-v3", "greeter-v2"},
+		},
+		{
+			name:             "Max age",
+			policy:           openchoreov1alpha1.ReleaseRetentionPolicy{KeepLast: keepLast(10), MaxAge: maxAge(60 * time.Hour)},
+			want:             []string{"greeter-v2", "greeter-v1"},
+			wantRequeueAfter: 12 * time.Hour,
+		},
+		{
+			name:             "Max age and keep last",
+			protected:        map[string]bool{"greeter-v2": true},
+			policy:           openchoreov1alpha1.ReleaseRetentionPolicy{KeepLast: keepLast(3), MaxAge: maxAge(60 * time.Hour)},
+			want:             []string{"greeter-v1"},
+			wantRequeueAfter: 12 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prunable, requeueAfter := selectPrunableReleases(releases, tt.protected, tt.policy, now)
+
+			var got []string
+			for _, release := range prunable {
+				got = append(got, release.Name)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("selectPrunableReleases() mismatch (-want +got):\n%s", diff)
+			}
+			if requeueAfter != tt.wantRequeueAfter {
+				t.Errorf("requeueAfter = %v, want %v", requeueAfter, tt.wantRequeueAfter)
+			}
+		})
+	}
+}
+
+func TestAddBindingReleases(t *testing.T) {
+	binding := &openchoreov1alpha1.ReleaseBinding{
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{ReleaseName: "greeter-v3"},
+		Status: openchoreov1alpha1.ReleaseBindingStatus{
+			Rollout: &openchoreov1alpha1.RolloutStatus{StableRelease: "greeter-v2", CanaryRelease: "greeter-v3"},
+			History: []openchoreov1alpha1.ReleaseHistoryEntry{{ReleaseName: "greeter-v2"}, {ReleaseName: "greeter-v1"}},
+		},
+	}
+
+	protected := map[string]bool{"greeter-v4": true}
+	addBindingReleases(protected, binding)
+
+	want := map[string]bool{"greeter-v1": true, "greeter-v2": true, "greeter-v3": true, "greeter-v4": true}
+	if diff := cmp.Diff(want, protected); diff != "" {
+		t.Errorf("addBindingReleases() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestEffectiveRetentionPolicy(t *testing.T) {
+	five, three := int32(5), int32(3)
+	day := &metav1.Duration{Duration: 24 * time.Hour}
+	defaults := openchoreov1alpha1.ReleaseRetentionPolicy{KeepLast: &five, MaxAge: day}
+
+	comp := &openchoreov1alpha1.Component{
+		Spec: openchoreov1alpha1.ComponentSpec{
+			ReleaseRetention: &openchoreov1alpha1.ReleaseRetentionPolicy{KeepLast: &three},
+		},
+	}
+	got := effectiveRetentionPolicy(defaults, comp)
+	if *got.KeepLast != 3 || got.MaxAge != day {
+		t.Errorf("effectiveRetentionPolicy() = %d/%v, want 3/%v", *got.KeepLast, got.MaxAge, day)
+	}
+
+	got = effectiveRetentionPolicy(openchoreov1alpha1.ReleaseRetentionPolicy{}, nil)
+	if got.KeepLast != nil || got.MaxAge != nil {
+		t.Errorf("effectiveRetentionPolicy() without policy = %+v, want no pruning", got)
+	}
+}
+
+func TestProtectedReleases(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	owner := openchoreov1alpha1.ComponentReleaseOwner{ProjectName: "default", ComponentName: "greeter"}
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "greeter-development", Namespace: "default-org"},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "default", ComponentName: "greeter"},
+				ReleaseName: "greeter-v4",
+			},
+			Status: openchoreov1alpha1.ReleaseBindingStatus{
+				History: []openchoreov1alpha1.ReleaseHistoryEntry{{ReleaseName: "greeter-v4"}, {ReleaseName: "greeter-v2"}},
+			},
+		},
+		// A binding of another component that refers to a release of this component by name
+		&openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "reader-development", Namespace: "default-org"},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "default", ComponentName: "reader"},
+				ReleaseName: "greeter-v1",
+			},
+		},
+		&openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "greeter-staging", Namespace: "other-org"},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "default", ComponentName: "greeter"},
+				ReleaseName: "greeter-v3",
+			},
+		},
+	).Build()
+
+	got, err := protectedReleases(context.Background(), reader, "default-org", owner, nil)
+	if err != nil {
+		t.Fatalf("protectedReleases() error = %v", err)
+	}
+	want := map[string]bool{"greeter-v1": true, "greeter-v2": true, "greeter-v4": true}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("protectedReleases() mismatch (-want +got):\n%s", diff)
+	}
+}
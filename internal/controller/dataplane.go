@@ -0,0 +1,45 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+)
+
+// ConnectsToDataPlane reports whether the control plane connects to the cluster of the dataplane.
+// Dataplanes managed by an agent apply the resources of their Releases themselves, and ExportOnly
+// GitOps dataplanes are synced from the repository by a GitOps agent.
+func ConnectsToDataPlane(dataPlane *openchoreov1alpha1.DataPlane) bool {
+	if dataPlane.Spec.Agent != nil || dataPlane.Spec.KubernetesCluster == nil {
+		return false
+	}
+	return dataPlane.Spec.GitOps == nil || dataPlane.Spec.GitOps.Mode != openchoreov1alpha1.GitOpsExportModeExportOnly
+}
+
+// GetDataPlaneClientOfEnv returns the client of the dataplane of the environment, or nil if the
+// control plane does not connect to the dataplane
+func GetDataPlaneClientOfEnv(ctx context.Context, c client.Client, k8sClientMgr *kubernetesClient.KubeMultiClientManager,
+	env *openchoreov1alpha1.Environment) (client.Client, error) {
+	dataPlane, err := GetDataplaneOfEnv(ctx, c, env)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dataplane for environment %s: %w", env.Name, err)
+	}
+
+	if !ConnectsToDataPlane(dataPlane) {
+		return nil, nil
+	}
+
+	dpClient, err := kubernetesClient.GetK8sClient(ctx, k8sClientMgr, c, kubernetesClient.PlaneKindDataPlane,
+		dataPlane.Namespace, dataPlane.Name, *dataPlane.Spec.KubernetesCluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DP client: %w", err)
+	}
+	return dpClient, nil
+}
@@ -6,6 +6,7 @@ package dataplane
 import (
 	"context"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -67,10 +68,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	// Report the connection of the agent of an agent managed dataplane.
+	// The dataplane is requeued to detect a missed heartbeat.
+	requeueAfter := markAgentConnected(dataPlane, time.Now())
+
 	// Handle create
 	// Ignore reconcile if the Dataplane is already available since this is a one-time create
 	if r.shouldIgnoreReconcile(dataPlane) {
-		return ctrl.Result{}, nil
+		return controller.UpdateStatusConditionsAndRequeueAfter(ctx, r.Client, old, dataPlane, requeueAfter)
 	}
 
 	// Set the observed generation
@@ -89,7 +94,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	r.Recorder.Event(dataPlane, corev1.EventTypeNormal, "ReconcileComplete", fmt.Sprintf("Successfully created %s", dataPlane.Name))
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *Reconciler) shouldIgnoreReconcile(dataPlane *openchoreov1alpha1.DataPlane) bool {
@@ -0,0 +1,51 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataplane
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// defaultAgentHeartbeatTimeout is how long after the last heartbeat an agent is considered disconnected
+// if the dataplane does not configure it
+const defaultAgentHeartbeatTimeout = 2 * time.Minute
+
+// markAgentConnected sets the AgentConnected condition of an agent managed dataplane from the last
+// heartbeat of its agent. It returns the time until the agent is considered disconnected, or zero if
+// the agent is not connected or the dataplane is not managed by an agent.
+func markAgentConnected(dataPlane *openchoreov1alpha1.DataPlane, now time.Time) time.Duration {
+	if dataPlane.Spec.Agent == nil {
+		meta.RemoveStatusCondition(&dataPlane.Status.Conditions, string(ConditionAgentConnected))
+		return 0
+	}
+
+	timeout := defaultAgentHeartbeatTimeout
+	if dataPlane.Spec.Agent.HeartbeatTimeout != nil {
+		timeout = dataPlane.Spec.Agent.HeartbeatTimeout.Duration
+	}
+
+	agent := dataPlane.Status.Agent
+	if agent == nil || agent.LastHeartbeatTime == nil {
+		controller.MarkFalseCondition(dataPlane, ConditionAgentConnected, ReasonAgentNotConnected,
+			"No heartbeat received from the dataplane agent")
+		return 0
+	}
+
+	remaining := agent.LastHeartbeatTime.Add(timeout).Sub(now)
+	if remaining <= 0 {
+		controller.MarkFalseCondition(dataPlane, ConditionAgentConnected, ReasonAgentHeartbeatExpired,
+			fmt.Sprintf("Last heartbeat from the dataplane agent was at %s", agent.LastHeartbeatTime.UTC().Format(time.RFC3339)))
+		return 0
+	}
+
+	controller.MarkTrueCondition(dataPlane, ConditionAgentConnected, ReasonAgentHeartbeatReceived,
+		fmt.Sprintf("Dataplane agent %s is connected", agent.Version))
+	return remaining
+}
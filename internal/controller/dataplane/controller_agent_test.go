@@ -0,0 +1,90 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataplane
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestMarkAgentConnected(t *testing.T) {
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	heartbeat := func(ago time.Duration) *openchoreov1alpha1.DataPlaneAgentStatus {
+		at := metav1.NewTime(now.Add(-ago))
+		return &openchoreov1alpha1.DataPlaneAgentStatus{LastHeartbeatTime: &at, Version: "v0.4.0"}
+	}
+
+	tests := []struct {
+		name             string
+		agent            *openchoreov1alpha1.DataPlaneAgent
+		status           *openchoreov1alpha1.DataPlaneAgentStatus
+		wantStatus       metav1.ConditionStatus
+		wantReason       string
+		wantRequeueAfter time.Duration
+	}{
+		{name: "Not agent managed"},
+		{
+			name:       "No heartbeat yet",
+			agent:      &openchoreov1alpha1.DataPlaneAgent{},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: string(ReasonAgentNotConnected),
+		},
+		{
+			name:             "Recent heartbeat",
+			agent:            &openchoreov1alpha1.DataPlaneAgent{},
+			status:           heartbeat(30 * time.Second),
+			wantStatus:       metav1.ConditionTrue,
+			wantReason:       string(ReasonAgentHeartbeatReceived),
+			wantRequeueAfter: 90 * time.Second,
+		},
+		{
+			name:       "Expired heartbeat",
+			agent:      &openchoreov1alpha1.DataPlaneAgent{},
+			status:     heartbeat(3 * time.Minute),
+			wantStatus: metav1.ConditionFalse,
+			wantReason: string(ReasonAgentHeartbeatExpired),
+		},
+		{
+			name:             "Custom heartbeat timeout",
+			agent:            &openchoreov1alpha1.DataPlaneAgent{HeartbeatTimeout: &metav1.Duration{Duration: 5 * time.Minute}},
+			status:           heartbeat(3 * time.Minute),
+			wantStatus:       metav1.ConditionTrue,
+			wantReason:       string(ReasonAgentHeartbeatReceived),
+			wantRequeueAfter: 2 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataPlane := &openchoreov1alpha1.DataPlane{
+				Spec:   openchoreov1alpha1.DataPlaneSpec{Agent: tt.agent},
+				Status: openchoreov1alpha1.DataPlaneStatus{Agent: tt.status},
+			}
+
+			requeueAfter := markAgentConnected(dataPlane, now)
+
+			if requeueAfter != tt.wantRequeueAfter {
+				t.Errorf("requeueAfter = %v, want %v", requeueAfter, tt.wantRequeueAfter)
+			}
+			cond := meta.FindStatusCondition(dataPlane.Status.Conditions, string(ConditionAgentConnected))
+			if tt.wantReason == "" {
+				if cond != nil {
+					t.Errorf("unexpected AgentConnected condition %+v", cond)
+				}
+				return
+			}
+			if cond == nil {
+				t.Fatalf("AgentConnected condition not set")
+			}
+			if cond.Status != tt.wantStatus || cond.Reason != tt.wantReason {
+				t.Errorf("AgentConnected = %s/%s, want %s/%s", cond.Status, cond.Reason, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+}
@@ -15,6 +15,9 @@ const (
 
 	// ConditionFinalizing represents whether the dataplane is being finalized
 	ConditionFinalizing controller.ConditionType = "Finalizing"
+
+	// ConditionAgentConnected represents whether the agent of an agent managed dataplane is connected
+	ConditionAgentConnected controller.ConditionType = "AgentConnected"
 )
 
 const (
@@ -23,6 +26,13 @@ const (
 
 	// ReasonDataplaneFinalizing is the reason used when a dataplane's dependents are being deleted
 	ReasonDataplaneFinalizing controller.ConditionReason = "DataplaneFinalizing"
+
+	// ReasonAgentHeartbeatReceived is the reason used when the agent sent a heartbeat within the heartbeat timeout
+	ReasonAgentHeartbeatReceived controller.ConditionReason = "HeartbeatReceived"
+	// ReasonAgentNotConnected is the reason used when no agent has connected to the dataplane yet
+	ReasonAgentNotConnected controller.ConditionReason = "AgentNotConnected"
+	// ReasonAgentHeartbeatExpired is the reason used when the last heartbeat of the agent is older than the heartbeat timeout
+	ReasonAgentHeartbeatExpired controller.ConditionReason = "HeartbeatExpired"
 )
 
 // NewDataPlaneCreatedCondition creates a condition to indicate the dataplane is created/ready
@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
 	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/labels"
 )
@@ -64,6 +65,9 @@ func (r *Reconciler) finalize(ctx context.Context, old, dataPlane *openchoreov1a
 		return ctrl.Result{}, nil
 	}
 
+	// Drop the cached client so that its credentials are not kept after the dataplane is gone
+	kubernetesClient.EvictK8sClient(kubernetesClient.SharedManager(), kubernetesClient.PlaneKindDataPlane, dataPlane.Namespace, dataPlane.Name)
+
 	// Remove the finalizer once cleanup is done
 	if controllerutil.RemoveFinalizer(dataPlane, DataPlaneCleanupFinalizer) {
 		if err := r.Update(ctx, dataPlane); err != nil {
@@ -0,0 +1,63 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestConnectsToDataPlane(t *testing.T) {
+	cluster := &openchoreov1alpha1.KubernetesClusterSpec{Server: "https://dp.example.com"}
+
+	tests := []struct {
+		name string
+		spec openchoreov1alpha1.DataPlaneSpec
+		want bool
+	}{
+		{
+			name: "Cluster credentials",
+			spec: openchoreov1alpha1.DataPlaneSpec{KubernetesCluster: cluster},
+			want: true,
+		},
+		{
+			name: "Managed by an agent",
+			spec: openchoreov1alpha1.DataPlaneSpec{Agent: &openchoreov1alpha1.DataPlaneAgent{}},
+			want: false,
+		},
+		{
+			name: "GitOps ExportAndApply",
+			spec: openchoreov1alpha1.DataPlaneSpec{
+				KubernetesCluster: cluster,
+				GitOps:            &openchoreov1alpha1.GitOpsExport{Mode: openchoreov1alpha1.GitOpsExportModeExportAndApply},
+			},
+			want: true,
+		},
+		{
+			name: "GitOps ExportOnly with cluster credentials",
+			spec: openchoreov1alpha1.DataPlaneSpec{
+				KubernetesCluster: cluster,
+				GitOps:            &openchoreov1alpha1.GitOpsExport{Mode: openchoreov1alpha1.GitOpsExportModeExportOnly},
+			},
+			want: false,
+		},
+		{
+			name: "GitOps ExportOnly without cluster credentials",
+			spec: openchoreov1alpha1.DataPlaneSpec{
+				GitOps: &openchoreov1alpha1.GitOpsExport{Mode: openchoreov1alpha1.GitOpsExportModeExportOnly},
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataPlane := &openchoreov1alpha1.DataPlane{Spec: tt.spec}
+			if got := ConnectsToDataPlane(dataPlane); got != tt.want {
+				t.Errorf("ConnectsToDataPlane() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -86,7 +86,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, controller.IgnoreHierarchyNotFoundError(err)
 	}
 
-	dpClient, err := r.getDPClient(ctx, deploymentCtx.Environment)
+	dpClient, err := controller.GetDataPlaneClientOfEnv(ctx, r.Client, r.k8sClientMgr, deploymentCtx.Environment)
 	if err != nil {
 		logger.Error(err, "Error getting DP client")
 		return ctrl.Result{}, err
@@ -135,7 +135,7 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	}
 
 	if r.k8sClientMgr == nil {
-		r.k8sClientMgr = kubernetesClient.NewManager()
+		r.k8sClientMgr = kubernetesClient.SharedManager()
 	}
 
 	// Set up the index for the deployment artifact reference
@@ -178,6 +178,11 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 // makeExternalResourceHandlers creates the chain of external resource handlers that are used to
 // bring the external resources to the desired state.
 func (r *Reconciler) makeExternalResourceHandlers(dpClient client.Client) []dataplane.ResourceHandler[dataplane.DeploymentContext] {
+	// No data plane resources are managed when the control plane does not connect to the data plane
+	if dpClient == nil {
+		return nil
+	}
+
 	var handlers []dataplane.ResourceHandler[dataplane.DeploymentContext]
 
 	// IMPORTANT: The order of the handlers is important when reconciling the resources.
@@ -193,26 +198,6 @@ func (r *Reconciler) makeExternalResourceHandlers(dpClient client.Client) []data
 	return handlers
 }
 
-func (r *Reconciler) getDPClient(ctx context.Context, env *openchoreov1alpha1.Environment) (client.Client, error) {
-	dataplaneRes, err := controller.GetDataplaneOfEnv(ctx, r.Client, env)
-	if err != nil {
-		// Return an error if dataplane retrieval fails
-		return nil, fmt.Errorf("failed to get dataplane for environment %s: %w", env.Name, err)
-	}
-
-	dpClient, err := kubernetesClient.GetK8sClient(
-		r.k8sClientMgr,
-		dataplaneRes.Namespace,
-		dataplaneRes.Name,
-		dataplaneRes.Spec.KubernetesCluster,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DP client: %w", err)
-	}
-
-	return dpClient, nil
-}
-
 // reconcileExternalResources reconciles the provided external resources based on the deployment context.
 func (r *Reconciler) reconcileExternalResources(
 	ctx context.Context,
@@ -63,7 +63,7 @@ func (r *Reconciler) finalize(ctx context.Context, old, deployment *openchoreov1
 		return ctrl.Result{}, fmt.Errorf("failed to construct deployment context for finalization: %w", err)
 	}
 
-	dpClient, err := r.getDPClient(ctx, deploymentCtx.Environment)
+	dpClient, err := controller.GetDataPlaneClientOfEnv(ctx, r.Client, r.k8sClientMgr, deploymentCtx.Environment)
 	if err != nil {
 		logger.Error(err, "Error getting DP client")
 		return ctrl.Result{}, err
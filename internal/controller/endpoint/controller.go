@@ -78,7 +78,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
-	dpClient, err := r.getDPClient(ctx, epCtx.Environment)
+	dpClient, err := controller.GetDataPlaneClientOfEnv(ctx, r.Client, r.k8sClientMgr, epCtx.Environment)
 	if err != nil {
 		logger.Error(err, "Error getting DP client")
 		return ctrl.Result{}, err
@@ -120,6 +120,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 }
 
 func (r *Reconciler) makeExternalResourceHandlers(dpClient client.Client) []dataplane.ResourceHandler[dataplane.EndpointContext] {
+	// No data plane resources are managed when the control plane does not connect to the data plane
+	if dpClient == nil {
+		return nil
+	}
+
 	// Define the resource handlers for the external resources
 	resourceHandlers := []dataplane.ResourceHandler[dataplane.EndpointContext]{
 		k8sintegrations.NewHTTPRouteHandler(dpClient, visibility.NewPublicVisibilityStrategy()),
@@ -186,7 +191,7 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	}
 
 	if r.k8sClientMgr == nil {
-		r.k8sClientMgr = kubernetesClient.NewManager()
+		r.k8sClientMgr = kubernetesClient.SharedManager()
 	}
 
 	if err := r.setupDataPlaneRefIndex(context.Background(), mgr); err != nil {
@@ -207,27 +212,3 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	return builder.Complete(r)
 }
-
-func (r *Reconciler) getDPClient(ctx context.Context, env *openchoreov1alpha1.Environment) (client.Client, error) {
-	// Retrieve the dataplane associated with the environment
-	dataplaneRes, err := controller.GetDataplaneOfEnv(ctx, r.Client, env)
-	if err != nil {
-		// Return an error if dataplane retrieval fails
-		return nil, fmt.Errorf("failed to get dataplane for environment %s: %w", env.Name, err)
-	}
-
-	// Get the DP client using the credentials from the dataplane
-	dpClient, err := kubernetesClient.GetK8sClient(
-		r.k8sClientMgr,
-		dataplaneRes.Namespace,
-		dataplaneRes.Name,
-		dataplaneRes.Spec.KubernetesCluster,
-	)
-	if err != nil {
-		// Return an error if client creation fails
-		return nil, fmt.Errorf("failed to get DP client: %w", err)
-	}
-
-	// Return the DP client
-	return dpClient, nil
-}
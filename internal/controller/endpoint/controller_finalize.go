@@ -51,7 +51,7 @@ func (r *Reconciler) finalize(ctx context.Context, old, ep *openchoreov1alpha1.E
 		return ctrl.Result{}, fmt.Errorf("failed to construct endpoint context for finalization: %w", err)
 	}
 
-	dpClient, err := r.getDPClient(ctx, epCtx.Environment)
+	dpClient, err := controller.GetDataPlaneClientOfEnv(ctx, r.Client, r.k8sClientMgr, epCtx.Environment)
 	if err != nil {
 		logger.Error(err, "Error getting DP client")
 		return ctrl.Result{}, err
@@ -5,7 +5,6 @@ package environment
 
 import (
 	"context"
-	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -102,7 +101,7 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	}
 
 	if r.k8sClientMgr == nil {
-		r.k8sClientMgr = kubernetesClient.NewManager()
+		r.k8sClientMgr = kubernetesClient.SharedManager()
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -117,6 +116,11 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 }
 
 func (r *Reconciler) makeExternalResourceHandlers(dpClient client.Client) []dataplane.ResourceHandler[dataplane.EnvironmentContext] {
+	// No data plane resources are managed when the control plane does not connect to the data plane
+	if dpClient == nil {
+		return nil
+	}
+
 	// Environments only has k8s namespaces as external resources
 	resourceHandlers := []dataplane.ResourceHandler[dataplane.EnvironmentContext]{
 		k8sintegrations.NewNamespacesHandler(dpClient),
@@ -124,24 +128,3 @@ func (r *Reconciler) makeExternalResourceHandlers(dpClient client.Client) []data
 
 	return resourceHandlers
 }
-
-func (r *Reconciler) getDPClient(ctx context.Context, env *openchoreov1alpha1.Environment) (client.Client, error) {
-	dataplaneRes, err := controller.GetDataplaneOfEnv(ctx, r.Client, env)
-	if err != nil {
-		// Return an error if dataplane retrieval fails
-		return nil, fmt.Errorf("failed to get dataplane for environment %s: %w", env.Name, err)
-	}
-
-	dpClient, err := kubernetesClient.GetK8sClient(
-		r.k8sClientMgr,
-		dataplaneRes.Namespace,
-		dataplaneRes.Name,
-		dataplaneRes.Spec.KubernetesCluster,
-	)
-	if err != nil {
-		// Return an error if client creation fails
-		return nil, fmt.Errorf("failed to get DP client: %w", err)
-	}
-
-	return dpClient, nil
-}
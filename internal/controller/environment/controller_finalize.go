@@ -77,7 +77,7 @@ func (r *Reconciler) finalize(ctx context.Context, old, environment *openchoreov
 		return ctrl.Result{}, nil
 	}
 
-	dpClient, err := r.getDPClient(ctx, envCtx.Environment)
+	dpClient, err := controller.GetDataPlaneClientOfEnv(ctx, r.Client, r.k8sClientMgr, envCtx.Environment)
 	if err != nil {
 		logger.Error(err, "Error getting DP client")
 		return ctrl.Result{}, err
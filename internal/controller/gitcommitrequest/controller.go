@@ -5,19 +5,12 @@ package gitcommitrequest
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"io/fs"
 	"os"
 	"path/filepath"
 	"time"
 
-	jsonpatch "github.com/evanphx/json-patch/v5"
-	git "github.com/go-git/go-git/v5"
-	"github.com/go-git/go-git/v5/plumbing"
-	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/plumbing/transport"
-	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -37,56 +30,42 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=gitcommitrequests,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=gitcommitrequests/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=gitcommitrequests/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the GitCommitRequest object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
+// Reconcile clones the requested repository, applies the file edits, commits them and pushes
+// the commit to the requested branch. A GitCommitRequest is processed until it succeeds once;
+// failed attempts are retried with backoff.
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
-	// Fetch the Workload instance for this reconcile request
+	// Fetch the GitCommitRequest instance for this reconcile request
 	gcr := &openchoreov1alpha1.GitCommitRequest{}
 	if err := r.Get(ctx, req.NamespacedName, gcr); err != nil {
 		if client.IgnoreNotFound(err) != nil {
-			logger.Error(err, "Failed to get Workload")
+			logger.Error(err, "Failed to get GitCommitRequest")
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{}, nil
 	}
 
 	// Skip if already done
-	if gcr.Status.Phase == "Succeeded" {
+	if gcr.Status.Phase == openchoreov1alpha1.GitCommitRequestPhaseSucceeded {
 		return ctrl.Result{}, nil
 	}
 
-	// 1. Build Git auth
-	var auth transport.AuthMethod
-	if gcr.Spec.AuthSecretRef != "" {
-		sec := &corev1.Secret{}
-		if err := r.Get(ctx,
-			types.NamespacedName{Name: gcr.Spec.AuthSecretRef, Namespace: gcr.Namespace}, sec); err != nil {
-			return r.fail(ctx, gcr, fmt.Errorf("secret: %w", err))
-		}
-		if user, ok := sec.Data["username"]; ok {
-			auth = &http.BasicAuth{
-				Username: string(user),
-				Password: string(sec.Data["password"]),
-			}
+	if gcr.Status.Phase == "" {
+		gcr.Status.Phase = openchoreov1alpha1.GitCommitRequestPhasePending
+		gcr.Status.Message = "preparing commit"
+		if err := r.Status().Update(ctx, gcr); err != nil {
+			return ctrl.Result{}, err
 		}
-		// else if key, ok := sec.Data["ssh-privatekey"]; ok {
-		// signer, _ := ssh.ParsePrivateKey(key)                  // import "golang.org/x/crypto/ssh"
-		// auth = &gitssh.PublicKeys{User: "git", Signer: signer} // import gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
-		// }
 	}
 
-	// 2. Clone repo to a tmp dir
-	tmp, err := os.MkdirTemp("", "repo-*")
+	// Temporary directory holding the clone and the SSH known hosts
+	tmp, err := os.MkdirTemp("", "gitcommitrequest-*")
 	if err != nil {
 		return r.fail(ctx, gcr, fmt.Errorf("failed to create temp directory: %w", err))
 	}
@@ -97,86 +76,52 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}()
 
-	repo, err := git.PlainCloneContext(ctx, tmp, false, &git.CloneOptions{
-		URL:           gcr.Spec.RepoURL,
-		ReferenceName: plumbing.NewBranchReferenceName(gcr.Spec.Branch),
-		SingleBranch:  true,
-		Depth:         1,
-		Auth:          auth,
-	})
-	if err != nil {
-		return r.fail(ctx, gcr, fmt.Errorf("failed to clone repository: %w", err))
+	// 1. Build Git auth
+	var auth transport.AuthMethod
+	if gcr.Spec.AuthSecretRef != "" {
+		sec := &corev1.Secret{}
+		if err := r.Get(ctx,
+			types.NamespacedName{Name: gcr.Spec.AuthSecretRef, Namespace: gcr.Namespace}, sec); err != nil {
+			return r.fail(ctx, gcr, fmt.Errorf("failed to get auth secret: %w", err))
+		}
+		if auth, err = buildAuth(sec, gcr.Spec.RepoURL, tmp); err != nil {
+			return r.fail(ctx, gcr, err)
+		}
 	}
 
-	// 3. Mutate files
-	if err := applyEdits(tmp, gcr.Spec.Files); err != nil {
-		return r.fail(ctx, gcr, fmt.Errorf("failed to apply file edits: %w", err))
-	}
-	wt, err := repo.Worktree()
+	// 2. Clone, edit, commit and push
+	result, err := commitAndPush(ctx, filepath.Join(tmp, "repo"), &gcr.Spec, string(gcr.UID), auth, time.Now())
 	if err != nil {
-		return r.fail(ctx, gcr, fmt.Errorf("failed to get worktree: %w", err))
-	}
-	if _, err := wt.Add("."); err != nil {
-		return r.fail(ctx, gcr, fmt.Errorf("failed to stage changes: %w", err))
+		return r.fail(ctx, gcr, err)
 	}
-	commit, err := wt.Commit(gcr.Spec.Message, &git.CommitOptions{
-		Author: &object.Signature{
-			Name:  gcr.Spec.Author.Name,
-			Email: gcr.Spec.Author.Email,
-			When:  time.Now(),
-		},
-	})
-	if err != nil {
-		return r.fail(ctx, gcr, fmt.Errorf("failed to create commit: %w", err))
+
+	// 3. Update status
+	gcr.Status.Phase = openchoreov1alpha1.GitCommitRequestPhaseSucceeded
+	gcr.Status.ObservedSHA = result.SHA
+	gcr.Status.ObservedBranch = result.Branch
+	if result.Committed {
+		gcr.Status.Message = "commit pushed"
+	} else {
+		gcr.Status.Message = "no changes to commit"
 	}
-	// 4. Push
-	if err := repo.Push(&git.PushOptions{Auth: auth}); err != nil &&
-		!errors.Is(err, git.NoErrAlreadyUpToDate) {
-		return r.fail(ctx, gcr, fmt.Errorf("failed to push commit: %w", err))
+	if err := r.Status().Update(ctx, gcr); err != nil {
+		logger.Error(err, "Failed to update GitCommitRequest status", "sha", result.SHA)
+		return ctrl.Result{}, err
 	}
 
-	// 5. Update status
-	gcr.Status.Phase = "Succeeded"
-	gcr.Status.ObservedSHA = commit.String()
-	gcr.Status.ObservedBranch = gcr.Spec.Branch
-	gcr.Status.Message = "commit pushed"
-	_ = r.Status().Update(ctx, gcr)
-
-	logger.Info("Git commit completed", "sha", commit.String())
+	logger.Info("Git commit completed", "sha", result.SHA, "branch", result.Branch, "committed", result.Committed)
 	return ctrl.Result{}, nil
 }
 
-// helper to set failed status once
-//
-//nolint:unparam
+// fail records the error in the status and returns it so that the request is retried with backoff
 func (r *Reconciler) fail(ctx context.Context,
 	gcr *openchoreov1alpha1.GitCommitRequest, err error) (ctrl.Result, error) {
-	gcr.Status.Phase = "Failed"
+	gcr.Status.Phase = openchoreov1alpha1.GitCommitRequestPhaseFailed
 	gcr.Status.Message = err.Error()
-	_ = r.Status().Update(ctx, gcr)
-	return ctrl.Result{}, err
-}
-
-func applyEdits(root string, edits []openchoreov1alpha1.FileEdit) error {
-	for _, e := range edits {
-		abs := filepath.Join(root, e.Path)
-		if err := os.MkdirAll(filepath.Dir(abs), fs.ModePerm); err != nil {
-			return err
-		}
-		if e.Patch != "" {
-			original, _ := os.ReadFile(abs)
-			p, _ := jsonpatch.DecodePatch([]byte(e.Patch))
-			mod, err := p.Apply(original)
-			if err != nil {
-				return err
-			}
-			e.Content = string(mod)
-		}
-		if err := os.WriteFile(abs, []byte(e.Content), 0o600); err != nil {
-			return err
-		}
+	if updateErr := r.Status().Update(ctx, gcr); updateErr != nil {
+		log.FromContext(ctx).Error(updateErr, "Failed to update GitCommitRequest status")
 	}
-	return nil
+	return ctrl.Result{}, err
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
@@ -62,4 +63,69 @@ var _ = Describe("GitCommitRequest Controller", func() {
 			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
 		})
 	})
+
+	Context("When committing to a local repository", func() {
+		const resourceName = "local-commit"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		AfterEach(func() {
+			resource := &openchoreov1alpha1.GitCommitRequest{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should push the file edits and record the commit", func() {
+			By("Creating a bare repository with a default branch")
+			remotePath, err := newBareRemote(GinkgoT().TempDir(), map[string]string{
+				"apps/greeter/app.json": `{"image":"greeter:v1"}`,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Creating a GitCommitRequest that bumps the image tag")
+			resource := &openchoreov1alpha1.GitCommitRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: openchoreov1alpha1.GitCommitRequestSpec{
+					RepoURL: remotePath,
+					Branch:  "main",
+					Message: "Bump greeter to v2",
+					Files: []openchoreov1alpha1.FileEdit{
+						{
+							Path:  "apps/greeter/app.json",
+							Patch: `[{"op":"replace","path":"/image","value":"greeter:v2"}]`,
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+
+			By("Reconciling the created resource")
+			controllerReconciler := &Reconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Checking the status and the pushed commit")
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Phase).To(Equal(openchoreov1alpha1.GitCommitRequestPhaseSucceeded))
+			Expect(resource.Status.ObservedBranch).To(Equal("main"))
+
+			commit, content, err := readRemoteFile(remotePath, "main", "apps/greeter/app.json")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(Equal(`{"image":"greeter:v2"}`))
+			Expect(resource.Status.ObservedSHA).To(Equal(commit.Hash.String()))
+		})
+	})
 })
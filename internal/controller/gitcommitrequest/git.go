@@ -0,0 +1,324 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitcommitrequest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	corev1 "k8s.io/api/core/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const (
+	// Keys of the auth Secret referenced by GitCommitRequest.Spec.AuthSecretRef
+	secretKeyUsername      = "username"
+	secretKeyPassword      = "password"
+	secretKeySSHPrivateKey = corev1.SSHAuthPrivateKey
+	secretKeySSHPassphrase = "passphrase"
+	secretKeyKnownHosts    = "known_hosts"
+
+	// defaultSSHUser is used when the repository URL does not specify one
+	defaultSSHUser = "git"
+
+	// Author used when the GitCommitRequest does not specify one
+	defaultAuthorName  = "OpenChoreo"
+	defaultAuthorEmail = "noreply@openchoreo.dev"
+
+	// requestTrailer is the commit message trailer identifying the GitCommitRequest a commit was created for
+	requestTrailer = "OpenChoreo-Commit-Request"
+	// maxRequestCommitSearch is how many commits of the branch are searched for an earlier commit of a request
+	maxRequestCommitSearch = 100
+)
+
+// commitResult describes the state of the branch after a GitCommitRequest was processed
+type commitResult struct {
+	// SHA is the commit created for the request, or the commit the branch points to if there was none
+	SHA string
+	// Branch is the branch the commit was pushed to
+	Branch string
+	// Committed is false when the edits did not change the branch
+	Committed bool
+}
+
+// commitAndPush clones the requested branch into workDir, applies the file edits, commits
+// them and pushes the commit to the remote. No commit is created when the edits do not change
+// any file.
+//
+// The commit is tagged with requestID so that a request is only committed once: if the branch
+// already has a commit for the request, for example because the status could not be recorded
+// after the push, that commit is returned instead of applying the edits again.
+func commitAndPush(ctx context.Context, workDir string, spec *openchoreov1alpha1.GitCommitRequestSpec,
+	requestID string, auth transport.AuthMethod, now time.Time) (*commitResult, error) {
+	cloneOpts := &git.CloneOptions{
+		URL:          spec.RepoURL,
+		SingleBranch: true,
+		Auth:         auth,
+	}
+	// The remote default branch is used when no branch is given.
+	// Shallow clones are avoided as go-git cannot reliably push from them.
+	if spec.Branch != "" {
+		cloneOpts.ReferenceName = plumbing.NewBranchReferenceName(spec.Branch)
+	}
+	repo, err := git.PlainCloneContext(ctx, workDir, false, cloneOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone repository: %w", err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+	if !head.Name().IsBranch() {
+		return nil, fmt.Errorf("HEAD of %s is not a branch", spec.RepoURL)
+	}
+	branch := head.Name().Short()
+
+	if requestID != "" {
+		sha, err := findRequestCommit(repo, head.Hash(), requestID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search for an earlier commit of the request: %w", err)
+		}
+		if sha != "" {
+			return &commitResult{SHA: sha, Branch: branch, Committed: true}, nil
+		}
+	}
+
+	if err := prunePaths(workDir, spec.PrunePaths); err != nil {
+		return nil, fmt.Errorf("failed to prune paths: %w", err)
+	}
+	if err := applyEdits(workDir, spec.Files); err != nil {
+		return nil, fmt.Errorf("failed to apply file edits: %w", err)
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktree: %w", err)
+	}
+	if err := wt.AddWithOptions(&git.AddOptions{All: true}); err != nil {
+		return nil, fmt.Errorf("failed to stage changes: %w", err)
+	}
+	status, err := wt.Status()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktree status: %w", err)
+	}
+	if status.IsClean() {
+		return &commitResult{SHA: head.Hash().String(), Branch: branch}, nil
+	}
+
+	message := spec.Message
+	if requestID != "" {
+		message = strings.TrimRight(message, "\n") + "\n\n" + requestTrailerLine(requestID) + "\n"
+	}
+	signature := commitSignature(spec.Author, now)
+	commit, err := wt.Commit(message, &git.CommitOptions{
+		Author:    signature,
+		Committer: signature,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create commit: %w", err)
+	}
+
+	refSpec := config.RefSpec(fmt.Sprintf("%s:%s", head.Name(), head.Name()))
+	if err := repo.PushContext(ctx, &git.PushOptions{
+		RemoteName: git.DefaultRemoteName,
+		RefSpecs:   []config.RefSpec{refSpec},
+		Auth:       auth,
+	}); err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return nil, fmt.Errorf("failed to push commit: %w", err)
+	}
+
+	return &commitResult{SHA: commit.String(), Branch: branch, Committed: true}, nil
+}
+
+// requestTrailerLine returns the commit message trailer identifying the GitCommitRequest
+func requestTrailerLine(requestID string) string {
+	return requestTrailer + ": " + requestID
+}
+
+// findRequestCommit searches the recent history of the branch for a commit created for the request
+// and returns its SHA, or an empty string if there is none
+func findRequestCommit(repo *git.Repository, head plumbing.Hash, requestID string) (string, error) {
+	commits, err := repo.Log(&git.LogOptions{From: head})
+	if err != nil {
+		return "", err
+	}
+	defer commits.Close()
+
+	trailer := requestTrailerLine(requestID)
+	for range maxRequestCommitSearch {
+		commit, err := commits.Next()
+		if errors.Is(err, io.EOF) {
+			return "", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		for _, line := range strings.Split(commit.Message, "\n") {
+			if strings.TrimSpace(line) == trailer {
+				return commit.Hash.String(), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// commitSignature returns the signature of the commit author, falling back to the OpenChoreo identity
+func commitSignature(author openchoreov1alpha1.GitCommitAuthor, now time.Time) *object.Signature {
+	signature := &object.Signature{
+		Name:  author.Name,
+		Email: author.Email,
+		When:  now,
+	}
+	if signature.Name == "" {
+		signature.Name = defaultAuthorName
+	}
+	if signature.Email == "" {
+		signature.Email = defaultAuthorEmail
+	}
+	return signature
+}
+
+// buildAuth builds the git credentials from the auth Secret. SSH keys are used when present,
+// otherwise the username and password are used for HTTPS basic auth.
+// Files created for the SSH known hosts are written to tmpDir.
+func buildAuth(secret *corev1.Secret, repoURL string, tmpDir string) (transport.AuthMethod, error) {
+	if key, ok := secret.Data[secretKeySSHPrivateKey]; ok {
+		user := defaultSSHUser
+		if ep, err := transport.NewEndpoint(repoURL); err == nil && ep.User != "" {
+			user = ep.User
+		}
+		publicKeys, err := gitssh.NewPublicKeys(user, key, string(secret.Data[secretKeySSHPassphrase]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s in secret %s: %w", secretKeySSHPrivateKey, secret.Name, err)
+		}
+		// Without known hosts in the Secret, the default known_hosts files of the controller are used
+		if knownHosts, ok := secret.Data[secretKeyKnownHosts]; ok {
+			knownHostsFile := filepath.Join(tmpDir, secretKeyKnownHosts)
+			if err := os.WriteFile(knownHostsFile, knownHosts, 0o600); err != nil {
+				return nil, fmt.Errorf("failed to write known hosts: %w", err)
+			}
+			callback, err := gitssh.NewKnownHostsCallback(knownHostsFile)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s in secret %s: %w", secretKeyKnownHosts, secret.Name, err)
+			}
+			publicKeys.HostKeyCallback = callback
+		}
+		return publicKeys, nil
+	}
+
+	password, ok := secret.Data[secretKeyPassword]
+	if !ok {
+		return nil, fmt.Errorf("secret %s must contain either %s or %s", secret.Name, secretKeySSHPrivateKey, secretKeyPassword)
+	}
+	// Token based providers accept any non-empty username
+	username := string(secret.Data[secretKeyUsername])
+	if username == "" {
+		username = defaultSSHUser
+	}
+	return &http.BasicAuth{
+		Username: username,
+		Password: string(password),
+	}, nil
+}
+
+// checkPath validates a path of the worktree rooted at root before it is written or removed.
+// The path must name a file or directory below the root outside of the .git directory, and must not
+// traverse symlinks, as a cloned repository can contain links pointing outside of the worktree.
+func checkPath(root, p string) error {
+	if !filepath.IsLocal(p) {
+		return fmt.Errorf("path %q must be relative to the repository root", p)
+	}
+	clean := filepath.Clean(p)
+	if clean == "." {
+		return fmt.Errorf("path %q must name a file or directory below the repository root", p)
+	}
+
+	parts := strings.Split(clean, string(filepath.Separator))
+	for _, part := range parts {
+		if strings.EqualFold(part, git.GitDirName) {
+			return fmt.Errorf("path %q must not be inside a %s directory", p, git.GitDirName)
+		}
+	}
+
+	current := root
+	for _, part := range parts {
+		current = filepath.Join(current, part)
+		info, err := os.Lstat(current)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if info.Mode()&fs.ModeSymlink != 0 {
+			return fmt.Errorf("path %q must not traverse a symlink", p)
+		}
+	}
+	return nil
+}
+
+// prunePaths removes the given files and directories from the worktree rooted at root so that
+// only the file edits remain below them
+func prunePaths(root string, paths []string) error {
+	for _, p := range paths {
+		if err := checkPath(root, p); err != nil {
+			return err
+		}
+		if err := os.RemoveAll(filepath.Join(root, p)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyEdits writes the file edits into the worktree rooted at root. An edit either replaces
+// the file with its content or applies an RFC 6902 JSON patch to the existing file.
+func applyEdits(root string, edits []openchoreov1alpha1.FileEdit) error {
+	for _, e := range edits {
+		if err := checkPath(root, e.Path); err != nil {
+			return err
+		}
+		abs := filepath.Join(root, e.Path)
+		if err := os.MkdirAll(filepath.Dir(abs), fs.ModePerm); err != nil {
+			return err
+		}
+
+		content := []byte(e.Content)
+		if e.Patch != "" {
+			original, err := os.ReadFile(abs)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", e.Path, err)
+			}
+			p, err := jsonpatch.DecodePatch([]byte(e.Patch))
+			if err != nil {
+				return fmt.Errorf("invalid patch for %s: %w", e.Path, err)
+			}
+			content, err = p.Apply(original)
+			if err != nil {
+				return fmt.Errorf("failed to patch %s: %w", e.Path, err)
+			}
+		}
+		if err := os.WriteFile(abs, content, 0o600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
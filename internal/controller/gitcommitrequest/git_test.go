@@ -0,0 +1,461 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitcommitrequest
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// newBareRemote creates a bare repository under dir whose default branch "main" contains the given files.
+// It returns the path of the bare repository, which can be used as the repository URL.
+func newBareRemote(dir string, files map[string]string) (string, error) {
+	remotePath := filepath.Join(dir, "remote.git")
+	remote, err := git.PlainInit(remotePath, true)
+	if err != nil {
+		return "", err
+	}
+	if err := remote.Storer.SetReference(
+		plumbing.NewSymbolicReference(plumbing.HEAD, plumbing.NewBranchReferenceName("main"))); err != nil {
+		return "", err
+	}
+
+	seedPath := filepath.Join(dir, "seed")
+	seed, err := git.PlainInit(seedPath, false)
+	if err != nil {
+		return "", err
+	}
+	if err := applyEdits(seedPath, fileEdits(files)); err != nil {
+		return "", err
+	}
+	wt, err := seed.Worktree()
+	if err != nil {
+		return "", err
+	}
+	if err := wt.AddWithOptions(&git.AddOptions{All: true}); err != nil {
+		return "", err
+	}
+	if _, err := wt.Commit("Initial commit", &git.CommitOptions{
+		Author: &object.Signature{Name: "seed", Email: "seed@example.com", When: time.Now()},
+	}); err != nil {
+		return "", err
+	}
+	head, err := seed.Head()
+	if err != nil {
+		return "", err
+	}
+	if _, err := seed.CreateRemote(&config.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{remotePath}}); err != nil {
+		return "", err
+	}
+	refSpec := config.RefSpec(fmt.Sprintf("%s:refs/heads/main", head.Name()))
+	if err := seed.Push(&git.PushOptions{RefSpecs: []config.RefSpec{refSpec}}); err != nil {
+		return "", err
+	}
+	return remotePath, nil
+}
+
+// readRemoteFile returns the head commit of the branch and the content of the file at that commit
+func readRemoteFile(remotePath, branch, path string) (*object.Commit, string, error) {
+	remote, err := git.PlainOpen(remotePath)
+	if err != nil {
+		return nil, "", err
+	}
+	ref, err := remote.Reference(plumbing.NewBranchReferenceName(branch), true)
+	if err != nil {
+		return nil, "", err
+	}
+	commit, err := remote.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, "", err
+	}
+	file, err := commit.File(path)
+	if err != nil {
+		return nil, "", err
+	}
+	content, err := file.Contents()
+	if err != nil {
+		return nil, "", err
+	}
+	return commit, content, nil
+}
+
+func fileEdits(files map[string]string) []openchoreov1alpha1.FileEdit {
+	edits := make([]openchoreov1alpha1.FileEdit, 0, len(files))
+	for path, content := range files {
+		edits = append(edits, openchoreov1alpha1.FileEdit{Path: path, Content: content})
+	}
+	return edits
+}
+
+func TestCommitAndPush(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	initialFiles := map[string]string{
+		"README.md":             "# GitOps\n",
+		"apps/greeter/app.json": `{"image":"greeter:v1","replicas":1}`,
+	}
+
+	tests := []struct {
+		name          string
+		branch        string
+		files         []openchoreov1alpha1.FileEdit
+		prunePaths    []string
+		wantErr       string
+		wantCommitted bool
+		wantPath      string
+		wantContent   string
+		wantRemoved   string
+	}{
+		{
+			name:          "Replace file content",
+			branch:        "main",
+			files:         []openchoreov1alpha1.FileEdit{{Path: "README.md", Content: "# GitOps repository\n"}},
+			wantCommitted: true,
+			wantPath:      "README.md",
+			wantContent:   "# GitOps repository\n",
+		},
+		{
+			name:          "Create file in a new directory",
+			branch:        "main",
+			files:         []openchoreov1alpha1.FileEdit{{Path: "apps/payments/app.json", Content: `{"image":"payments:v1"}`}},
+			wantCommitted: true,
+			wantPath:      "apps/payments/app.json",
+			wantContent:   `{"image":"payments:v1"}`,
+		},
+		{
+			name:   "Patch file on the default branch",
+			branch: "",
+			files: []openchoreov1alpha1.FileEdit{{
+				Path:  "apps/greeter/app.json",
+				Patch: `[{"op":"replace","path":"/image","value":"greeter:v2"}]`,
+			}},
+			wantCommitted: true,
+			wantPath:      "apps/greeter/app.json",
+			wantContent:   `{"image":"greeter:v2","replicas":1}`,
+		},
+		{
+			name:          "Prune directory before writing files",
+			branch:        "main",
+			prunePaths:    []string{"apps"},
+			files:         []openchoreov1alpha1.FileEdit{{Path: "apps/payments/app.json", Content: `{"image":"payments:v1"}`}},
+			wantCommitted: true,
+			wantPath:      "apps/payments/app.json",
+			wantContent:   `{"image":"payments:v1"}`,
+			wantRemoved:   "apps/greeter/app.json",
+		},
+		{
+			name:          "No changes",
+			branch:        "main",
+			files:         []openchoreov1alpha1.FileEdit{{Path: "README.md", Content: "# GitOps\n"}},
+			wantCommitted: false,
+			wantPath:      "README.md",
+			wantContent:   "# GitOps\n",
+		},
+		{
+			name:    "Missing branch",
+			branch:  "release",
+			files:   []openchoreov1alpha1.FileEdit{{Path: "README.md", Content: "changed"}},
+			wantErr: "failed to clone repository",
+		},
+		{
+			name:    "Invalid patch",
+			branch:  "main",
+			files:   []openchoreov1alpha1.FileEdit{{Path: "apps/greeter/app.json", Patch: `{"op":"replace"}`}},
+			wantErr: "invalid patch",
+		},
+		{
+			name:       "Prune outside the repository",
+			branch:     "main",
+			prunePaths: []string{"../outside"},
+			wantErr:    "failed to prune paths",
+		},
+		{
+			name:       "Prune the repository root",
+			branch:     "main",
+			prunePaths: []string{"."},
+			wantErr:    "failed to prune paths",
+		},
+		{
+			name:       "Prune the git directory",
+			branch:     "main",
+			prunePaths: []string{".git"},
+			wantErr:    "failed to prune paths",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			remotePath, err := newBareRemote(dir, initialFiles)
+			if err != nil {
+				t.Fatalf("failed to create remote: %v", err)
+			}
+			before, _, err := readRemoteFile(remotePath, "main", "README.md")
+			if err != nil {
+				t.Fatalf("failed to read remote: %v", err)
+			}
+
+			spec := &openchoreov1alpha1.GitCommitRequestSpec{
+				RepoURL:    remotePath,
+				Branch:     tt.branch,
+				Message:    "Update " + tt.name,
+				Author:     openchoreov1alpha1.GitCommitAuthor{Name: "Jane Doe", Email: "jane@example.com"},
+				Files:      tt.files,
+				PrunePaths: tt.prunePaths,
+			}
+			result, err := commitAndPush(context.Background(), filepath.Join(dir, "work"), spec, "", nil, now)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("commitAndPush() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("commitAndPush() unexpected error: %v", err)
+			}
+
+			if result.Branch != "main" {
+				t.Errorf("Branch = %q, want %q", result.Branch, "main")
+			}
+			if result.Committed != tt.wantCommitted {
+				t.Errorf("Committed = %v, want %v", result.Committed, tt.wantCommitted)
+			}
+
+			commit, content, err := readRemoteFile(remotePath, "main", tt.wantPath)
+			if err != nil {
+				t.Fatalf("failed to read pushed file: %v", err)
+			}
+			if content != tt.wantContent {
+				t.Errorf("content = %q, want %q", content, tt.wantContent)
+			}
+			if commit.Hash.String() != result.SHA {
+				t.Errorf("remote head = %s, want %s", commit.Hash, result.SHA)
+			}
+			if tt.wantRemoved != "" {
+				if _, err := commit.File(tt.wantRemoved); err == nil {
+					t.Errorf("%s was not removed", tt.wantRemoved)
+				}
+			}
+			if !tt.wantCommitted {
+				if commit.Hash != before.Hash {
+					t.Errorf("remote head moved to %s without changes", commit.Hash)
+				}
+				return
+			}
+			if commit.Message != spec.Message {
+				t.Errorf("message = %q, want %q", commit.Message, spec.Message)
+			}
+			if commit.Author.Name != "Jane Doe" || commit.Author.Email != "jane@example.com" {
+				t.Errorf("author = %s <%s>, want Jane Doe <jane@example.com>", commit.Author.Name, commit.Author.Email)
+			}
+			if len(commit.ParentHashes) != 1 || commit.ParentHashes[0] != before.Hash {
+				t.Errorf("parents = %v, want [%s]", commit.ParentHashes, before.Hash)
+			}
+		})
+	}
+}
+
+func TestCommitAndPush_CommitsRequestOnce(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	dir := t.TempDir()
+	remotePath, err := newBareRemote(dir, map[string]string{"app.json": `{"tags":["v1"]}`})
+	if err != nil {
+		t.Fatalf("failed to create remote: %v", err)
+	}
+
+	// Applying the patch again would append another tag
+	spec := &openchoreov1alpha1.GitCommitRequestSpec{
+		RepoURL: remotePath,
+		Branch:  "main",
+		Message: "Add tag",
+		Files:   []openchoreov1alpha1.FileEdit{{Path: "app.json", Patch: `[{"op":"add","path":"/tags/-","value":"v2"}]`}},
+	}
+	first, err := commitAndPush(context.Background(), filepath.Join(dir, "first"), spec, "request-uid", nil, now)
+	if err != nil {
+		t.Fatalf("commitAndPush() unexpected error: %v", err)
+	}
+	second, err := commitAndPush(context.Background(), filepath.Join(dir, "second"), spec, "request-uid", nil, now)
+	if err != nil {
+		t.Fatalf("commitAndPush() retry unexpected error: %v", err)
+	}
+
+	if !second.Committed || second.SHA != first.SHA {
+		t.Errorf("retry = %+v, want the commit of the first attempt %s", second, first.SHA)
+	}
+	commit, content, err := readRemoteFile(remotePath, "main", "app.json")
+	if err != nil {
+		t.Fatalf("failed to read pushed file: %v", err)
+	}
+	if commit.Hash.String() != first.SHA {
+		t.Errorf("remote head = %s, want %s", commit.Hash, first.SHA)
+	}
+	if content != `{"tags":["v1","v2"]}` {
+		t.Errorf("content = %q, want the patch applied once", content)
+	}
+	if want := "Add tag\n\n" + requestTrailerLine("request-uid") + "\n"; commit.Message != want {
+		t.Errorf("message = %q, want %q", commit.Message, want)
+	}
+}
+
+func TestApplyEdits(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    openchoreov1alpha1.FileEdit
+		wantErr string
+	}{
+		{name: "Relative path", edit: openchoreov1alpha1.FileEdit{Path: "a/b.txt", Content: "b"}},
+		{name: "Parent directory", edit: openchoreov1alpha1.FileEdit{Path: "../escape.txt", Content: "x"}, wantErr: "must be relative"},
+		{name: "Absolute path", edit: openchoreov1alpha1.FileEdit{Path: "/etc/passwd", Content: "x"}, wantErr: "must be relative"},
+		{name: "Empty path", edit: openchoreov1alpha1.FileEdit{Path: "", Content: "x"}, wantErr: "must be relative"},
+		{name: "Repository root", edit: openchoreov1alpha1.FileEdit{Path: ".", Content: "x"}, wantErr: "below the repository root"},
+		{name: "Git directory", edit: openchoreov1alpha1.FileEdit{Path: ".git/config", Content: "x"}, wantErr: "must not be inside"},
+		{name: "Nested git directory", edit: openchoreov1alpha1.FileEdit{Path: "a/.GIT/hooks/pre-commit", Content: "x"}, wantErr: "must not be inside"},
+		{name: "Through a symlink", edit: openchoreov1alpha1.FileEdit{Path: "link/escape.txt", Content: "x"}, wantErr: "must not traverse a symlink"},
+		{name: "Symlink", edit: openchoreov1alpha1.FileEdit{Path: "link", Content: "x"}, wantErr: "must not traverse a symlink"},
+		{
+			name:    "Patch missing file",
+			edit:    openchoreov1alpha1.FileEdit{Path: "missing.json", Patch: `[{"op":"add","path":"/a","value":1}]`},
+			wantErr: "failed to read missing.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			if err := os.Symlink(t.TempDir(), filepath.Join(root, "link")); err != nil {
+				t.Fatalf("failed to create symlink: %v", err)
+			}
+			err := applyEdits(root, []openchoreov1alpha1.FileEdit{tt.edit})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("applyEdits() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("applyEdits() unexpected error: %v", err)
+			}
+			content, err := os.ReadFile(filepath.Join(root, tt.edit.Path))
+			if err != nil || string(content) != tt.edit.Content {
+				t.Errorf("file content = %q (%v), want %q", content, err, tt.edit.Content)
+			}
+		})
+	}
+}
+
+func TestBuildAuth(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	sshKey := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	tests := []struct {
+		name     string
+		repoURL  string
+		data     map[string][]byte
+		wantErr  string
+		validate func(t *testing.T, auth any)
+	}{
+		{
+			name:    "HTTPS basic auth",
+			repoURL: "https://github.com/org/gitops.git",
+			data:    map[string][]byte{"username": []byte("bot"), "password": []byte("s3cret")},
+			validate: func(t *testing.T, auth any) {
+				basic, ok := auth.(*http.BasicAuth)
+				if !ok || basic.Username != "bot" || basic.Password != "s3cret" {
+					t.Errorf("auth = %#v, want basic auth for bot", auth)
+				}
+			},
+		},
+		{
+			name:    "HTTPS token",
+			repoURL: "https://github.com/org/gitops.git",
+			data:    map[string][]byte{"password": []byte("ghp_token")},
+			validate: func(t *testing.T, auth any) {
+				basic, ok := auth.(*http.BasicAuth)
+				if !ok || basic.Username == "" || basic.Password != "ghp_token" {
+					t.Errorf("auth = %#v, want basic auth with the token", auth)
+				}
+			},
+		},
+		{
+			name:    "SSH key with the user from the URL",
+			repoURL: "deploy@gitlab.example.com:org/gitops.git",
+			data:    map[string][]byte{"ssh-privatekey": sshKey},
+			validate: func(t *testing.T, auth any) {
+				keys, ok := auth.(*gitssh.PublicKeys)
+				if !ok || keys.User != "deploy" {
+					t.Errorf("auth = %#v, want SSH public keys for deploy", auth)
+				}
+			},
+		},
+		{
+			name:    "SSH key with known hosts",
+			repoURL: "ssh://github.com/org/gitops.git",
+			data: map[string][]byte{
+				"ssh-privatekey": sshKey,
+				"known_hosts":    []byte("github.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl\n"),
+			},
+			validate: func(t *testing.T, auth any) {
+				keys, ok := auth.(*gitssh.PublicKeys)
+				if !ok || keys.User != "git" || keys.HostKeyCallback == nil {
+					t.Errorf("auth = %#v, want SSH public keys for git with a host key callback", auth)
+				}
+			},
+		},
+		{
+			name:    "Invalid SSH key",
+			repoURL: "git@github.com:org/gitops.git",
+			data:    map[string][]byte{"ssh-privatekey": []byte("not a key")},
+			wantErr: "invalid ssh-privatekey",
+		},
+		{
+			name:    "No credentials",
+			repoURL: "https://github.com/org/gitops.git",
+			data:    map[string][]byte{"username": []byte("bot")},
+			wantErr: "must contain either",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "git-credentials"}, Data: tt.data}
+			auth, err := buildAuth(secret, tt.repoURL, t.TempDir())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("buildAuth() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildAuth() unexpected error: %v", err)
+			}
+			tt.validate(t, auth)
+		})
+	}
+}
@@ -10,11 +10,14 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -34,6 +37,17 @@ type Reconciler struct {
 	client.Client
 	k8sClientMgr *kubernetesClient.KubeMultiClientManager
 	Scheme       *runtime.Scheme
+
+	// HealthChecks determines the health of the resources applied to the data plane.
+	// The built-in checks are used when not set.
+	HealthChecks *HealthCheckRegistry
+
+	// DataPlaneClient is the client of the dataplane the reconciler runs in. It is only set when the
+	// reconciler runs in a dataplane agent, together with AgentDataPlane.
+	DataPlaneClient client.Client
+	// AgentDataPlane is the DataPlane managed by the agent the reconciler runs in.
+	// Releases of other dataplanes are ignored.
+	AgentDataPlane *types.NamespacedName
 }
 
 // TODO: Optimize to apply resource only if spec has changed
@@ -42,6 +56,7 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=releases,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=releases/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=releases/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=gitcommitrequests,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -76,10 +91,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
-	// Get dataplane client for the environment
-	dpClient, err := r.getDPClient(ctx, release.Namespace, release.Spec.EnvironmentName)
+	// Get the environment and its dataplane
+	environment, dataPlane, err := r.getDataPlane(ctx, release.Namespace, release.Spec.EnvironmentName)
 	if err != nil {
-		logger.Error(err, "Failed to get dataplane client")
+		logger.Error(err, "Failed to get dataplane")
 		return ctrl.Result{}, err
 	}
 
@@ -90,6 +105,42 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	// Releases of agent managed dataplanes are applied by the agent running in the dataplane
+	if !r.managesDataPlane(dataPlane) {
+		return ctrl.Result{}, nil
+	}
+
+	// Export the desired resources to the GitOps repository of the dataplane, if configured
+	if gitOps := dataPlane.Spec.GitOps; gitOps != nil {
+		gcr, err := r.exportToGit(ctx, release, gitOps, desiredResources)
+		if err != nil {
+			logger.Error(err, "Failed to export resources to the GitOps repository")
+			return ctrl.Result{}, err
+		}
+		markGitOpsExported(release, gcr)
+
+		// The dataplane is synced from the repository by a GitOps agent, so nothing is applied to it
+		if gitOps.Mode == openchoreov1alpha1.GitOpsExportModeExportOnly {
+			if !apiequality.Semantic.DeepEqual(old.Status, release.Status) {
+				if err := r.Status().Update(ctx, release); err != nil {
+					logger.Error(err, "Failed to update Release status")
+					return ctrl.Result{}, err
+				}
+			}
+			// Progress of the export is observed through the owned GitCommitRequest
+			return ctrl.Result{RequeueAfter: getStableRequeueInterval(release)}, nil
+		}
+	} else {
+		meta.RemoveStatusCondition(&release.Status.Conditions, string(ConditionGitOpsExported))
+	}
+
+	// Get dataplane client for the environment
+	dpClient, err := r.getDPClient(ctx, dataPlane)
+	if err != nil {
+		logger.Error(err, "Failed to get dataplane client")
+		return ctrl.Result{}, err
+	}
+
 	// Ensure namespaces exist before applying resources
 	desiredNamespaces := r.makeDesiredNamespaces(release, desiredResources)
 	if err := r.ensureNamespaces(ctx, dpClient, desiredNamespaces); err != nil {
@@ -98,11 +149,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	// PHASE 1: Apply desired resources to the dataplane
-	// This ensures all resources in the spec are created/updated with proper tracking labels
-	if err := r.applyResources(ctx, dpClient, desiredResources); err != nil {
+	// This ensures all resources in the spec are created/updated with proper tracking labels.
+	// Fields changed in the dataplane by other managers are reverted or only reported, depending on the drift mode
+	driftMode := getDriftMode(release, environment)
+	drifts, err := r.applyResources(ctx, dpClient, desiredResources, driftMode, release.Status.Drift)
+	if err != nil {
 		logger.Error(err, "Failed to apply resources to dataplane")
 		return ctrl.Result{}, err
 	}
+	markDrift(release, driftMode, drifts)
+	if len(drifts) > 0 {
+		logger.Info("Detected drift in the applied resources", "driftMode", driftMode, "drift", formatDrift(drifts))
+	}
 
 	// PHASE 2: Discover live resources that we manage in the dataplane
 	// This queries both current resource types (from spec) and previous resource types (from status)
@@ -146,19 +204,31 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
-// getDPClient gets the dataplane client for the specified environment
-func (r *Reconciler) getDPClient(ctx context.Context, orgName string, environmentName string) (client.Client, error) {
+// getDataPlane gets the specified environment and its dataplane
+func (r *Reconciler) getDataPlane(ctx context.Context, orgName string, environmentName string) (*openchoreov1alpha1.Environment, *openchoreov1alpha1.DataPlane, error) {
 	env := &openchoreov1alpha1.Environment{}
 	if err := r.Get(ctx, client.ObjectKey{Name: environmentName, Namespace: orgName}, env); err != nil {
-		return nil, fmt.Errorf("failed to get environment %s: %w", environmentName, err)
+		return nil, nil, fmt.Errorf("failed to get environment %s: %w", environmentName, err)
 	}
 
 	dataplane := &openchoreov1alpha1.DataPlane{}
 	if err := r.Get(ctx, client.ObjectKey{Name: env.Spec.DataPlaneRef, Namespace: orgName}, dataplane); err != nil {
-		return nil, fmt.Errorf("failed to get dataplane %s for environment %s: %w", env.Spec.DataPlaneRef, environmentName, err)
+		return nil, nil, fmt.Errorf("failed to get dataplane %s for environment %s: %w", env.Spec.DataPlaneRef, environmentName, err)
 	}
 
-	dpClient, err := kubernetesClient.GetK8sClient(r.k8sClientMgr, dataplane.Namespace, dataplane.Name, dataplane.Spec.KubernetesCluster)
+	return env, dataplane, nil
+}
+
+// getDPClient gets the client of the given dataplane
+func (r *Reconciler) getDPClient(ctx context.Context, dataplane *openchoreov1alpha1.DataPlane) (client.Client, error) {
+	if r.DataPlaneClient != nil {
+		return r.DataPlaneClient, nil
+	}
+	if dataplane.Spec.KubernetesCluster == nil {
+		return nil, fmt.Errorf("dataplane %s does not configure a kubernetesCluster", dataplane.Name)
+	}
+
+	dpClient, err := kubernetesClient.GetK8sClient(ctx, r.k8sClientMgr, r.Client, kubernetesClient.PlaneKindDataPlane, dataplane.Namespace, dataplane.Name, *dataplane.Spec.KubernetesCluster)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create dataplane client for %s: %w", dataplane.Name, err)
 	}
@@ -166,18 +236,51 @@ func (r *Reconciler) getDPClient(ctx context.Context, orgName string, environmen
 	return dpClient, nil
 }
 
-// applyResources applies the given resources to the dataplane
-func (r *Reconciler) applyResources(ctx context.Context, dpClient client.Client, resources []*unstructured.Unstructured) error {
+// applyResources applies the given resources to the dataplane and returns the drift found on them.
+//
+// Resources are applied without forcing ownership first, so that fields changed by other field managers
+// since the last apply (e.g., a manual hotfix) fail the apply with a conflict instead of being overwritten.
+// The conflicting fields are reported as drift. In the Correct mode the resource is then applied again with
+// forced ownership to revert the drift. In the DetectOnly mode the drifted resource is left untouched.
+// Fields owned by autoscalers are not drift; they are dropped from the applied resource and left to
+// the autoscaler.
+func (r *Reconciler) applyResources(ctx context.Context, dpClient client.Client, resources []*unstructured.Unstructured,
+	driftMode openchoreov1alpha1.DriftMode, previousDrift []openchoreov1alpha1.ResourceDrift) ([]openchoreov1alpha1.ResourceDrift, error) {
+	now := metav1.Now()
+	var drifts []openchoreov1alpha1.ResourceDrift
 	for _, obj := range resources {
 		resourceID := obj.GetLabels()[labels.LabelKeyReleaseResourceID]
 
 		// Apply the resource using server-side apply
+		err := dpClient.Patch(ctx, obj, client.Apply, client.FieldOwner(ControllerName))
+		if err == nil {
+			continue
+		}
+		fields, drifted := driftedFields(err)
+		if !drifted {
+			return nil, fmt.Errorf("failed to apply resource %s: %w", resourceID, err)
+		}
+		fields, autoscaled := splitAutoscaledFields(fields)
+		for _, path := range autoscaled {
+			unstructured.RemoveNestedField(obj.Object, path...)
+		}
+		if len(fields) == 0 {
+			if err := dpClient.Patch(ctx, obj, client.Apply, client.FieldOwner(ControllerName)); err != nil {
+				return nil, fmt.Errorf("failed to apply resource %s: %w", resourceID, err)
+			}
+			continue
+		}
+		drifts = append(drifts, makeResourceDrift(obj, fields, previousDrift, now))
+		if driftMode == openchoreov1alpha1.DriftModeDetectOnly {
+			continue
+		}
+
 		if err := dpClient.Patch(ctx, obj, client.Apply, client.ForceOwnership, client.FieldOwner(ControllerName)); err != nil {
-			return fmt.Errorf("failed to apply resource %s: %w", resourceID, err)
+			return nil, fmt.Errorf("failed to apply resource %s: %w", resourceID, err)
 		}
 	}
 
-	return nil
+	return drifts, nil
 }
 
 // makeDesiredResources creates the desired resources from the Release spec
@@ -517,12 +620,17 @@ func addJitter(base time.Duration, maxJitter time.Duration) time.Duration {
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if (r.DataPlaneClient == nil) != (r.AgentDataPlane == nil) {
+		return fmt.Errorf("DataPlaneClient and AgentDataPlane must be set together")
+	}
+
 	if r.k8sClientMgr == nil {
-		r.k8sClientMgr = kubernetesClient.NewManager()
+		r.k8sClientMgr = kubernetesClient.SharedManager()
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreov1alpha1.Release{}).
+		Owns(&openchoreov1alpha1.GitCommitRequest{}).
 		Named("release").
 		Complete(r)
 }
@@ -0,0 +1,18 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// managesDataPlane reports whether the reconciler applies the Releases of the given dataplane.
+// In the control plane, Releases are applied to every dataplane that is not managed by an agent.
+// In an agent, only the Releases of the dataplane the agent runs in are applied.
+func (r *Reconciler) managesDataPlane(dataPlane *openchoreov1alpha1.DataPlane) bool {
+	if r.AgentDataPlane != nil {
+		return dataPlane.Namespace == r.AgentDataPlane.Namespace && dataPlane.Name == r.AgentDataPlane.Name
+	}
+	return dataPlane.Spec.Agent == nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestManagesDataPlane(t *testing.T) {
+	direct := &openchoreov1alpha1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "acme", Name: "cloud"},
+	}
+	agentManaged := &openchoreov1alpha1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "acme", Name: "on-prem"},
+		Spec:       openchoreov1alpha1.DataPlaneSpec{Agent: &openchoreov1alpha1.DataPlaneAgent{}},
+	}
+	otherOrg := agentManaged.DeepCopy()
+	otherOrg.Namespace = "globex"
+
+	tests := []struct {
+		name           string
+		agentDataPlane *types.NamespacedName
+		dataPlane      *openchoreov1alpha1.DataPlane
+		want           bool
+	}{
+		{name: "Control plane with direct dataplane", dataPlane: direct, want: true},
+		{name: "Control plane with agent managed dataplane", dataPlane: agentManaged, want: false},
+		{
+			name:           "Agent with its own dataplane",
+			agentDataPlane: &types.NamespacedName{Namespace: "acme", Name: "on-prem"},
+			dataPlane:      agentManaged,
+			want:           true,
+		},
+		{
+			name:           "Agent with a direct dataplane",
+			agentDataPlane: &types.NamespacedName{Namespace: "acme", Name: "on-prem"},
+			dataPlane:      direct,
+			want:           false,
+		},
+		{
+			name:           "Agent with a dataplane of another organization",
+			agentDataPlane: &types.NamespacedName{Namespace: "acme", Name: "on-prem"},
+			dataPlane:      otherOrg,
+			want:           false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reconciler{AgentDataPlane: tt.agentDataPlane}
+			if got := r.managesDataPlane(tt.dataPlane); got != tt.want {
+				t.Errorf("managesDataPlane() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -14,6 +14,10 @@ import (
 const (
 	// ConditionFinalizing represents whether the Release is being finalized
 	ConditionFinalizing controller.ConditionType = "Finalizing"
+	// ConditionGitOpsExported represents whether the Release resources are exported to the GitOps repository
+	ConditionGitOpsExported controller.ConditionType = "GitOpsExported"
+	// ConditionDrifted represents whether applied resources were changed in the dataplane outside of the Release
+	ConditionDrifted controller.ConditionType = "Drifted"
 )
 
 // Constants for condition reasons
@@ -25,6 +29,24 @@ const (
 	ReasonCleanupInProgress controller.ConditionReason = "CleanupInProgress"
 	// ReasonCleanupFailed cleanup of dataplane resources failed
 	ReasonCleanupFailed controller.ConditionReason = "CleanupFailed"
+
+	// Reasons for GitOpsExported condition type
+
+	// ReasonExportSucceeded the resources are committed to the GitOps repository
+	ReasonExportSucceeded controller.ConditionReason = "ExportSucceeded"
+	// ReasonExportInProgress the commit to the GitOps repository is pending
+	ReasonExportInProgress controller.ConditionReason = "ExportInProgress"
+	// ReasonExportFailed the commit to the GitOps repository failed
+	ReasonExportFailed controller.ConditionReason = "ExportFailed"
+
+	// Reasons for Drifted condition type
+
+	// ReasonNoDrift the applied resources match the Release
+	ReasonNoDrift controller.ConditionReason = "NoDrift"
+	// ReasonDriftDetected fields of applied resources were changed by other field managers and were not reverted
+	ReasonDriftDetected controller.ConditionReason = "DriftDetected"
+	// ReasonDriftCorrected fields of applied resources were changed by other field managers and were reverted
+	ReasonDriftCorrected controller.ConditionReason = "DriftCorrected"
 )
 
 func NewReleaseFinalizingCondition(generation int64) metav1.Condition {
@@ -0,0 +1,160 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// getDriftMode returns the drift mode of the Release, falling back to the drift mode of its environment.
+func getDriftMode(release *openchoreov1alpha1.Release, environment *openchoreov1alpha1.Environment) openchoreov1alpha1.DriftMode {
+	if release.Spec.DriftMode != "" {
+		return release.Spec.DriftMode
+	}
+	if environment != nil && environment.Spec.DriftMode != "" {
+		return environment.Spec.DriftMode
+	}
+	return openchoreov1alpha1.DriftModeCorrect
+}
+
+// driftedFields extracts the fields owned by other field managers from a server-side apply conflict.
+// The second return value is false if the error is not an apply conflict.
+func driftedFields(err error) ([]openchoreov1alpha1.DriftedField, bool) {
+	var statusErr apierrors.APIStatus
+	if !apierrors.IsConflict(err) || !errors.As(err, &statusErr) || statusErr.Status().Details == nil {
+		return nil, false
+	}
+
+	var fields []openchoreov1alpha1.DriftedField
+	for _, cause := range statusErr.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		fields = append(fields, openchoreov1alpha1.DriftedField{
+			Path:    cause.Field,
+			Manager: conflictManager(cause.Message),
+		})
+	}
+	return fields, len(fields) > 0
+}
+
+// autoscalerManagers are the field managers of autoscalers. Fields owned by them, e.g. the replicas of
+// a Deployment scaled by a HorizontalPodAutoscaler, change at runtime by design and are not drift.
+var autoscalerManagers = map[string]bool{
+	// HorizontalPodAutoscalers, including the ones created by KEDA, update replicas through the scale
+	// subresource as the controller manager
+	"kube-controller-manager":         true,
+	"cluster-proportional-autoscaler": true,
+}
+
+// splitAutoscaledFields separates the fields owned by autoscalers from the drifted fields.
+// Fields of autoscalers that cannot be removed from the applied resource are kept as drift.
+func splitAutoscaledFields(fields []openchoreov1alpha1.DriftedField) (drifted []openchoreov1alpha1.DriftedField, autoscaled [][]string) {
+	for _, field := range fields {
+		if path, ok := fieldPath(field.Path); ok && autoscalerManagers[field.Manager] {
+			autoscaled = append(autoscaled, path)
+			continue
+		}
+		drifted = append(drifted, field)
+	}
+	return drifted, autoscaled
+}
+
+// fieldPath splits a conflict field path such as ".spec.replicas" into its keys.
+// Paths into lists, e.g. `.spec.containers[name="main"].image`, are not supported.
+func fieldPath(path string) ([]string, bool) {
+	if !strings.HasPrefix(path, ".") || strings.ContainsAny(path, "[]") {
+		return nil, false
+	}
+	return strings.Split(strings.TrimPrefix(path, "."), "."), true
+}
+
+// conflictManager returns the field manager named in a conflict cause message,
+// e.g. `conflict with "kubectl-edit" using apps/v1` returns "kubectl-edit".
+func conflictManager(message string) string {
+	quoted, err := strconv.QuotedPrefix(strings.TrimPrefix(message, "conflict with "))
+	if err != nil {
+		return ""
+	}
+	manager, err := strconv.Unquote(quoted)
+	if err != nil {
+		return ""
+	}
+	return manager
+}
+
+// makeResourceDrift records the drifted fields of an applied resource. The detection time of a
+// resource that was already drifted is kept.
+func makeResourceDrift(obj *unstructured.Unstructured, fields []openchoreov1alpha1.DriftedField,
+	previous []openchoreov1alpha1.ResourceDrift, now metav1.Time) openchoreov1alpha1.ResourceDrift {
+	gvk := obj.GroupVersionKind()
+	drift := openchoreov1alpha1.ResourceDrift{
+		ID:         obj.GetLabels()[labels.LabelKeyReleaseResourceID],
+		Group:      gvk.Group,
+		Version:    gvk.Version,
+		Kind:       gvk.Kind,
+		Name:       obj.GetName(),
+		Namespace:  obj.GetNamespace(),
+		Fields:     fields,
+		DetectedAt: now,
+	}
+	for _, p := range previous {
+		if p.ID == drift.ID {
+			drift.DetectedAt = p.DetectedAt
+			break
+		}
+	}
+	return drift
+}
+
+// markDrift records the drift found while applying the resources in the status of the Release.
+// In the DetectOnly mode the drifted resources are listed in the status and the Drifted condition
+// is set to true. In the Correct mode the drift has been reverted, so only the condition reports it.
+func markDrift(release *openchoreov1alpha1.Release, mode openchoreov1alpha1.DriftMode, drifts []openchoreov1alpha1.ResourceDrift) {
+	if len(drifts) == 0 {
+		release.Status.Drift = nil
+		controller.MarkFalseCondition(release, ConditionDrifted, ReasonNoDrift,
+			"Applied resources match the Release")
+		return
+	}
+
+	if mode == openchoreov1alpha1.DriftModeDetectOnly {
+		release.Status.Drift = drifts
+		controller.MarkTrueCondition(release, ConditionDrifted, ReasonDriftDetected,
+			fmt.Sprintf("Drifted resources are not updated until the drift is resolved: %s", formatDrift(drifts)))
+		return
+	}
+
+	release.Status.Drift = nil
+	controller.MarkFalseCondition(release, ConditionDrifted, ReasonDriftCorrected,
+		fmt.Sprintf("Reverted drifted resources: %s", formatDrift(drifts)))
+}
+
+// formatDrift describes the drifted fields of each resource, e.g.
+// "deployment (.spec.replicas by kubectl-edit)".
+func formatDrift(drifts []openchoreov1alpha1.ResourceDrift) string {
+	resources := make([]string, len(drifts))
+	for i, drift := range drifts {
+		fields := make([]string, len(drift.Fields))
+		for j, field := range drift.Fields {
+			fields[j] = field.Path
+			if field.Manager != "" {
+				fields[j] = fmt.Sprintf("%s by %s", field.Path, field.Manager)
+			}
+		}
+		resources[i] = fmt.Sprintf("%s (%s)", drift.ID, strings.Join(fields, ", "))
+	}
+	return strings.Join(resources, "; ")
+}
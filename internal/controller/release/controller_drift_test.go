@@ -0,0 +1,176 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func TestDriftedFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		want        []openchoreov1alpha1.DriftedField
+		wantDrifted bool
+	}{
+		{
+			name: "Apply conflict",
+			err: apierrors.NewApplyConflict([]metav1.StatusCause{
+				{Type: metav1.CauseTypeFieldManagerConflict, Field: ".spec.replicas",
+					Message: `conflict with "kubectl-edit" using apps/v1 at 2025-06-01T10:00:00Z`},
+				{Type: metav1.CauseTypeFieldManagerConflict, Field: `.spec.template.spec.containers[name="main"].image`,
+					Message: `conflict with "kubectl-set"`},
+			}, "Apply failed with 2 conflicts"),
+			want: []openchoreov1alpha1.DriftedField{
+				{Path: ".spec.replicas", Manager: "kubectl-edit"},
+				{Path: `.spec.template.spec.containers[name="main"].image`, Manager: "kubectl-set"},
+			},
+			wantDrifted: true,
+		},
+		{
+			name:        "Other conflict",
+			err:         apierrors.NewConflict(openchoreov1alpha1.GroupVersion.WithResource("releases").GroupResource(), "greeter", errors.New("modified")),
+			wantDrifted: false,
+		},
+		{
+			name:        "Other error",
+			err:         errors.New("connection refused"),
+			wantDrifted: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, drifted := driftedFields(tt.err)
+			if drifted != tt.wantDrifted {
+				t.Fatalf("driftedFields() drifted = %v, want %v", drifted, tt.wantDrifted)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("driftedFields() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSplitAutoscaledFields(t *testing.T) {
+	fields := []openchoreov1alpha1.DriftedField{
+		{Path: ".spec.replicas", Manager: "kube-controller-manager"},
+		{Path: `.spec.template.spec.containers[name="main"].image`, Manager: "kube-controller-manager"},
+		{Path: ".spec.template.metadata.labels.tier", Manager: "kubectl-edit"},
+	}
+
+	drifted, autoscaled := splitAutoscaledFields(fields)
+	wantDrifted := []openchoreov1alpha1.DriftedField{
+		{Path: `.spec.template.spec.containers[name="main"].image`, Manager: "kube-controller-manager"},
+		{Path: ".spec.template.metadata.labels.tier", Manager: "kubectl-edit"},
+	}
+	if diff := cmp.Diff(wantDrifted, drifted); diff != "" {
+		t.Errorf("splitAutoscaledFields() drifted mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([][]string{{"spec", "replicas"}}, autoscaled); diff != "" {
+		t.Errorf("splitAutoscaledFields() autoscaled mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetDriftMode(t *testing.T) {
+	tests := []struct {
+		name            string
+		releaseMode     openchoreov1alpha1.DriftMode
+		environmentMode openchoreov1alpha1.DriftMode
+		want            openchoreov1alpha1.DriftMode
+	}{
+		{name: "Default", want: openchoreov1alpha1.DriftModeCorrect},
+		{name: "Environment", environmentMode: openchoreov1alpha1.DriftModeDetectOnly, want: openchoreov1alpha1.DriftModeDetectOnly},
+		{name: "Release overrides environment", releaseMode: openchoreov1alpha1.DriftModeCorrect,
+			environmentMode: openchoreov1alpha1.DriftModeDetectOnly, want: openchoreov1alpha1.DriftModeCorrect},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			release := &openchoreov1alpha1.Release{Spec: openchoreov1alpha1.ReleaseSpec{DriftMode: tt.releaseMode}}
+			environment := &openchoreov1alpha1.Environment{Spec: openchoreov1alpha1.EnvironmentSpec{DriftMode: tt.environmentMode}}
+			if got := getDriftMode(release, environment); got != tt.want {
+				t.Errorf("getDriftMode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkDrift(t *testing.T) {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("apps/v1")
+	obj.SetKind("Deployment")
+	obj.SetName("greeter")
+	obj.SetNamespace("dp-acme-shop-dev")
+	obj.SetLabels(map[string]string{labels.LabelKeyReleaseResourceID: "deployment"})
+	fields := []openchoreov1alpha1.DriftedField{{Path: ".spec.replicas", Manager: "kubectl-edit"}}
+
+	firstSeen := metav1.NewTime(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	now := metav1.NewTime(firstSeen.Add(time.Hour))
+	drift := makeResourceDrift(obj, fields, []openchoreov1alpha1.ResourceDrift{{ID: "deployment", DetectedAt: firstSeen}}, now)
+	if !drift.DetectedAt.Equal(&firstSeen) {
+		t.Errorf("DetectedAt = %v, want the time of the first detection %v", drift.DetectedAt, firstSeen)
+	}
+
+	tests := []struct {
+		name       string
+		mode       openchoreov1alpha1.DriftMode
+		drifts     []openchoreov1alpha1.ResourceDrift
+		wantStatus metav1.ConditionStatus
+		wantReason string
+		wantDrift  int
+	}{
+		{name: "No drift", mode: openchoreov1alpha1.DriftModeDetectOnly,
+			wantStatus: metav1.ConditionFalse, wantReason: string(ReasonNoDrift)},
+		{name: "Drift detected", mode: openchoreov1alpha1.DriftModeDetectOnly, drifts: []openchoreov1alpha1.ResourceDrift{drift},
+			wantStatus: metav1.ConditionTrue, wantReason: string(ReasonDriftDetected), wantDrift: 1},
+		{name: "Drift corrected", mode: openchoreov1alpha1.DriftModeCorrect, drifts: []openchoreov1alpha1.ResourceDrift{drift},
+			wantStatus: metav1.ConditionFalse, wantReason: string(ReasonDriftCorrected)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			release := &openchoreov1alpha1.Release{}
+			release.Status.Drift = []openchoreov1alpha1.ResourceDrift{drift}
+
+			markDrift(release, tt.mode, tt.drifts)
+
+			cond := meta.FindStatusCondition(release.Status.Conditions, string(ConditionDrifted))
+			if cond == nil {
+				t.Fatalf("Drifted condition not set")
+			}
+			if cond.Status != tt.wantStatus || cond.Reason != tt.wantReason {
+				t.Errorf("Drifted condition = %s/%s, want %s/%s", cond.Status, cond.Reason, tt.wantStatus, tt.wantReason)
+			}
+			if len(release.Status.Drift) != tt.wantDrift {
+				t.Errorf("len(status.drift) = %d, want %d", len(release.Status.Drift), tt.wantDrift)
+			}
+		})
+	}
+}
+
+func TestFormatDrift(t *testing.T) {
+	drifts := []openchoreov1alpha1.ResourceDrift{
+		{ID: "deployment", Fields: []openchoreov1alpha1.DriftedField{
+			{Path: ".spec.replicas", Manager: "kubectl-edit"},
+			{Path: ".metadata.labels.tier"},
+		}},
+		{ID: "service", Fields: []openchoreov1alpha1.DriftedField{{Path: ".spec.type", Manager: "kubectl-patch"}}},
+	}
+	want := "deployment (.spec.replicas by kubectl-edit, .metadata.labels.tier); service (.spec.type by kubectl-patch)"
+	if got := formatDrift(drifts); got != want {
+		t.Errorf("formatDrift() = %q, want %q", got, want)
+	}
+}
@@ -55,8 +55,48 @@ func (r *Reconciler) finalize(ctx context.Context, old, release *openchoreov1alp
 		return ctrl.Result{}, nil
 	}
 
-	// STEP 2: Get dataplane client and find all managed resources
-	dpClient, err := r.getDPClient(ctx, release.Namespace, release.Spec.EnvironmentName)
+	// STEP 2: Get the dataplane and remove the exported resources from the GitOps repository, if configured
+	_, dataPlane, err := r.getDataPlane(ctx, release.Namespace, release.Spec.EnvironmentName)
+	if err != nil {
+		meta.SetStatusCondition(&release.Status.Conditions, NewReleaseCleanupFailedCondition(release.Generation, err))
+		if updateErr := controller.UpdateStatusConditions(ctx, r.Client, old, release); updateErr != nil {
+			return ctrl.Result{}, updateErr
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get dataplane for finalization: %w", err)
+	}
+
+	// The agent running in the dataplane cleans up the resources and removes the finalizer
+	if !r.managesDataPlane(dataPlane) {
+		return ctrl.Result{}, nil
+	}
+
+	if gitOps := dataPlane.Spec.GitOps; gitOps != nil {
+		// Exporting no resources prunes the directory of the Release
+		gcr, err := r.exportToGit(ctx, release, gitOps, nil)
+		if err != nil {
+			meta.SetStatusCondition(&release.Status.Conditions, NewReleaseCleanupFailedCondition(release.Generation, err))
+			if updateErr := controller.UpdateStatusConditions(ctx, r.Client, old, release); updateErr != nil {
+				return ctrl.Result{}, updateErr
+			}
+			return ctrl.Result{}, fmt.Errorf("failed to remove resources from the GitOps repository: %w", err)
+		}
+		if gcr.Status.Phase != openchoreov1alpha1.GitCommitRequestPhaseSucceeded {
+			logger := log.FromContext(ctx).WithValues("release", release.Name)
+			logger.Info("Removal from the GitOps repository is still pending, retrying...", "gitCommitRequest", gcr.Name)
+			return ctrl.Result{RequeueAfter: time.Second * 5}, nil
+		}
+
+		// The GitOps agent removes the resources from the dataplane
+		if gitOps.Mode == openchoreov1alpha1.GitOpsExportModeExportOnly {
+			if err := r.deleteExportRequests(ctx, release); err != nil {
+				return ctrl.Result{}, err
+			}
+			return r.removeFinalizer(ctx, release)
+		}
+	}
+
+	// STEP 3: Get dataplane client and find all managed resources
+	dpClient, err := r.getDPClient(ctx, dataPlane)
 	if err != nil {
 		meta.SetStatusCondition(&release.Status.Conditions, NewReleaseCleanupFailedCondition(release.Generation, err))
 		if updateErr := controller.UpdateStatusConditions(ctx, r.Client, old, release); updateErr != nil {
@@ -65,7 +105,7 @@ func (r *Reconciler) finalize(ctx context.Context, old, release *openchoreov1alp
 		return ctrl.Result{}, fmt.Errorf("failed to get dataplane client for finalization: %w", err)
 	}
 
-	// STEP 3: List all live resources we manage (use empty desired resources since we want to delete everything)
+	// STEP 4: List all live resources we manage (use empty desired resources since we want to delete everything)
 	var emptyDesiredResources []*unstructured.Unstructured
 	gvks := findAllKnownGVKs(emptyDesiredResources, release.Status.Resources)
 	liveResources, err := r.listLiveResourcesByGVKs(ctx, dpClient, release, gvks)
@@ -77,7 +117,7 @@ func (r *Reconciler) finalize(ctx context.Context, old, release *openchoreov1alp
 		return ctrl.Result{}, fmt.Errorf("failed to list live resources for cleanup: %w", err)
 	}
 
-	// STEP 4: Delete all live resources (since we want to delete everything, all live resources are "stale")
+	// STEP 5: Delete all live resources (since we want to delete everything, all live resources are "stale")
 	if err := r.deleteResources(ctx, dpClient, liveResources); err != nil {
 		meta.SetStatusCondition(&release.Status.Conditions, NewReleaseCleanupFailedCondition(release.Generation, err))
 		if updateErr := controller.UpdateStatusConditions(ctx, r.Client, old, release); updateErr != nil {
@@ -86,14 +126,25 @@ func (r *Reconciler) finalize(ctx context.Context, old, release *openchoreov1alp
 		return ctrl.Result{}, fmt.Errorf("failed to delete resources during finalization: %w", err)
 	}
 
-	// STEP 5: Check if any resources still exist - if so, requeue for retry
+	// STEP 6: Check if any resources still exist - if so, requeue for retry
 	if len(liveResources) > 0 {
 		logger := log.FromContext(ctx).WithValues("release", release.Name)
 		logger.Info("Resource deletion is still pending, retrying...", "remainingResources", len(liveResources))
 		return ctrl.Result{RequeueAfter: time.Second * 5}, nil
 	}
 
-	// STEP 6: All resources cleaned up - remove the finalizer
+	// STEP 7: All resources cleaned up - remove the export requests, which are not owned by the Release
+	// while it is being deleted, and the finalizer
+	if dataPlane.Spec.GitOps != nil {
+		if err := r.deleteExportRequests(ctx, release); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	return r.removeFinalizer(ctx, release)
+}
+
+// removeFinalizer removes the finalizer from the Release once all resources are cleaned up.
+func (r *Reconciler) removeFinalizer(ctx context.Context, release *openchoreov1alpha1.Release) (ctrl.Result, error) {
 	if controllerutil.RemoveFinalizer(release, DataPlaneCleanupFinalizer) {
 		if err := r.Update(ctx, release); err != nil {
 			return ctrl.Result{}, err
@@ -0,0 +1,185 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// exportDir returns the directory of the repository the resources of the Release are exported to.
+// The layout is <path>/<organization>/<project>/<component>/<environment>.
+func exportDir(gitOps *openchoreov1alpha1.GitOpsExport, release *openchoreov1alpha1.Release) string {
+	return path.Join(
+		strings.TrimPrefix(gitOps.Path, "/"),
+		release.Namespace,
+		release.Spec.Owner.ProjectName,
+		release.Spec.Owner.ComponentName,
+		release.Spec.EnvironmentName,
+	)
+}
+
+// isSecret reports whether the resource is a Kubernetes Secret
+func isSecret(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == "" && gvk.Kind == "Secret"
+}
+
+// makeExportFiles renders each resource as a YAML file named after its resource ID in dir.
+// Secrets are left out, as the repository would hold their data in plain text.
+// The files are sorted by path so that the same resources always produce the same files.
+func makeExportFiles(dir string, resources []*unstructured.Unstructured) ([]openchoreov1alpha1.FileEdit, error) {
+	files := make([]openchoreov1alpha1.FileEdit, 0, len(resources))
+	for _, obj := range resources {
+		if isSecret(obj) {
+			continue
+		}
+		resourceID := obj.GetLabels()[labels.LabelKeyReleaseResourceID]
+		content, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal resource %s: %w", resourceID, err)
+		}
+		files = append(files, openchoreov1alpha1.FileEdit{
+			Path:    path.Join(dir, resourceID+".yaml"),
+			Content: string(content),
+		})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+	return files, nil
+}
+
+// exportHash returns a hash of the exported directory and its files
+func exportHash(dir string, files []openchoreov1alpha1.FileEdit) string {
+	h := sha256.New()
+	h.Write([]byte(dir))
+	for _, f := range files {
+		h.Write([]byte{0})
+		h.Write([]byte(f.Path))
+		h.Write([]byte{0})
+		h.Write([]byte(f.Content))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// exportToGit ensures a GitCommitRequest exists that writes the given resources to the GitOps repository.
+// The request is named after the exported content, so an unchanged Release reuses the existing request.
+// Requests for previous content of the Release are deleted once a new request is created.
+//
+// The request is owned by the Release, except while the Release is being deleted: the request removing
+// its resources would otherwise be garbage collected along with it before it was pushed. finalize
+// deletes that request with deleteExportRequests before it removes the finalizer.
+func (r *Reconciler) exportToGit(ctx context.Context, release *openchoreov1alpha1.Release,
+	gitOps *openchoreov1alpha1.GitOpsExport, resources []*unstructured.Unstructured) (*openchoreov1alpha1.GitCommitRequest, error) {
+	logger := log.FromContext(ctx)
+
+	dir := exportDir(gitOps, release)
+	files, err := makeExportFiles(dir, resources)
+	if err != nil {
+		return nil, err
+	}
+	name := dpkubernetes.GenerateK8sName(release.Name, "export", exportHash(dir, files)[:12])
+
+	existing := &openchoreov1alpha1.GitCommitRequest{}
+	err = r.Get(ctx, client.ObjectKey{Name: name, Namespace: release.Namespace}, existing)
+	if err == nil {
+		return existing, nil
+	}
+	if client.IgnoreNotFound(err) != nil {
+		return nil, fmt.Errorf("failed to get GitCommitRequest %s: %w", name, err)
+	}
+
+	// Remove the requests of previous exports so that only the latest content is retried on failures
+	if err := r.deleteExportRequests(ctx, release); err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("Export release %s/%s to environment %s", release.Namespace, release.Name, release.Spec.EnvironmentName)
+	if len(files) == 0 {
+		message = fmt.Sprintf("Remove release %s/%s from environment %s", release.Namespace, release.Name, release.Spec.EnvironmentName)
+	}
+	gcr := &openchoreov1alpha1.GitCommitRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: release.Namespace,
+			Labels:    exportLabels(release),
+		},
+		Spec: openchoreov1alpha1.GitCommitRequestSpec{
+			RepoURL:       gitOps.RepoURL,
+			Branch:        gitOps.Branch,
+			Message:       message,
+			Author:        gitOps.Author,
+			AuthSecretRef: gitOps.AuthSecretRef,
+			Files:         files,
+			PrunePaths:    []string{dir},
+		},
+	}
+	if release.DeletionTimestamp.IsZero() {
+		if err := controllerutil.SetControllerReference(release, gcr, r.Scheme); err != nil {
+			return nil, fmt.Errorf("failed to set owner of GitCommitRequest %s: %w", name, err)
+		}
+	}
+	if err := r.Create(ctx, gcr); err != nil {
+		return nil, fmt.Errorf("failed to create GitCommitRequest %s: %w", name, err)
+	}
+
+	logger.Info("Created GitCommitRequest to export the Release", "gitCommitRequest", name, "path", dir)
+	return gcr, nil
+}
+
+// exportLabels returns the labels of the GitCommitRequests exporting the Release
+func exportLabels(release *openchoreov1alpha1.Release) map[string]string {
+	return map[string]string{
+		labels.LabelKeyManagedBy:        ControllerName,
+		labels.LabelKeyReleaseName:      release.Name,
+		labels.LabelKeyReleaseNamespace: release.Namespace,
+	}
+}
+
+// deleteExportRequests deletes the GitCommitRequests exporting the Release
+func (r *Reconciler) deleteExportRequests(ctx context.Context, release *openchoreov1alpha1.Release) error {
+	requests := &openchoreov1alpha1.GitCommitRequestList{}
+	if err := r.List(ctx, requests, client.InNamespace(release.Namespace), client.MatchingLabels(exportLabels(release))); err != nil {
+		return fmt.Errorf("failed to list GitCommitRequests: %w", err)
+	}
+	for i := range requests.Items {
+		if err := r.Delete(ctx, &requests.Items[i]); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete GitCommitRequest %s: %w", requests.Items[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// markGitOpsExported reflects the progress of the GitCommitRequest in the GitOpsExported condition
+func markGitOpsExported(release *openchoreov1alpha1.Release, gcr *openchoreov1alpha1.GitCommitRequest) {
+	switch gcr.Status.Phase {
+	case openchoreov1alpha1.GitCommitRequestPhaseSucceeded:
+		controller.MarkTrueCondition(release, ConditionGitOpsExported, ReasonExportSucceeded,
+			fmt.Sprintf("Exported to %s at %s", gcr.Status.ObservedBranch, gcr.Status.ObservedSHA))
+	case openchoreov1alpha1.GitCommitRequestPhaseFailed:
+		controller.MarkFalseCondition(release, ConditionGitOpsExported, ReasonExportFailed, gcr.Status.Message)
+	default:
+		controller.MarkFalseCondition(release, ConditionGitOpsExported, ReasonExportInProgress,
+			fmt.Sprintf("Waiting for GitCommitRequest %s", gcr.Name))
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func TestExportDir(t *testing.T) {
+	release := &openchoreov1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{Name: "greeter-dev", Namespace: "acme"},
+		Spec: openchoreov1alpha1.ReleaseSpec{
+			Owner:           openchoreov1alpha1.ReleaseOwner{ProjectName: "shop", ComponentName: "greeter"},
+			EnvironmentName: "development",
+		},
+	}
+
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "Repository root", path: "", want: "acme/shop/greeter/development"},
+		{name: "Sub directory", path: "clusters/dev", want: "clusters/dev/acme/shop/greeter/development"},
+		{name: "Leading slash", path: "/clusters/dev/", want: "clusters/dev/acme/shop/greeter/development"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gitOps := &openchoreov1alpha1.GitOpsExport{RepoURL: "https://example.com/gitops.git", Path: tt.path}
+			if got := exportDir(gitOps, release); got != tt.want {
+				t.Errorf("exportDir() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMakeExportFiles(t *testing.T) {
+	newResource := func(id, kind, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		obj.SetKind(kind)
+		obj.SetName(name)
+		obj.SetLabels(map[string]string{labels.LabelKeyReleaseResourceID: id})
+		return obj
+	}
+	resources := []*unstructured.Unstructured{
+		newResource("service", "Service", "greeter"),
+		newResource("config", "ConfigMap", "greeter-config"),
+		newResource("credentials", "Secret", "greeter-credentials"),
+	}
+
+	files, err := makeExportFiles("acme/shop/greeter/development", resources)
+	if err != nil {
+		t.Fatalf("makeExportFiles() unexpected error: %v", err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("got %d files, want 2 without the Secret", len(files))
+	}
+	if files[0].Path != "acme/shop/greeter/development/config.yaml" ||
+		files[1].Path != "acme/shop/greeter/development/service.yaml" {
+		t.Errorf("paths = [%s %s], want files sorted by resource ID", files[0].Path, files[1].Path)
+	}
+	wantContent := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  labels:\n    " +
+		labels.LabelKeyReleaseResourceID + ": config\n  name: greeter-config\n"
+	if files[0].Content != wantContent {
+		t.Errorf("content = %q, want %q", files[0].Content, wantContent)
+	}
+
+	// The hash only depends on the exported content, not on the order of the resources
+	reversed, err := makeExportFiles("acme/shop/greeter/development", []*unstructured.Unstructured{resources[1], resources[0]})
+	if err != nil {
+		t.Fatalf("makeExportFiles() unexpected error: %v", err)
+	}
+	if exportHash("acme/shop/greeter/development", files) != exportHash("acme/shop/greeter/development", reversed) {
+		t.Errorf("exportHash() differs for the same resources")
+	}
+	if exportHash("acme/shop/greeter/development", files) == exportHash("acme/shop/greeter/production", files) {
+		t.Errorf("exportHash() is equal for different directories")
+	}
+	if exportHash("acme/shop/greeter/development", files) == exportHash("acme/shop/greeter/development", files[:1]) {
+		t.Errorf("exportHash() is equal for different files")
+	}
+}
+
+func TestMarkGitOpsExported(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     openchoreov1alpha1.GitCommitRequestStatus
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name:       "Commit pushed",
+			status:     openchoreov1alpha1.GitCommitRequestStatus{Phase: openchoreov1alpha1.GitCommitRequestPhaseSucceeded, ObservedSHA: "abc123"},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: string(ReasonExportSucceeded),
+		},
+		{
+			name:       "Commit pending",
+			status:     openchoreov1alpha1.GitCommitRequestStatus{Phase: openchoreov1alpha1.GitCommitRequestPhasePending},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: string(ReasonExportInProgress),
+		},
+		{
+			name:       "Not processed yet",
+			wantStatus: metav1.ConditionFalse,
+			wantReason: string(ReasonExportInProgress),
+		},
+		{
+			name:       "Push failed",
+			status:     openchoreov1alpha1.GitCommitRequestStatus{Phase: openchoreov1alpha1.GitCommitRequestPhaseFailed, Message: "authentication required"},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: string(ReasonExportFailed),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			release := &openchoreov1alpha1.Release{}
+			gcr := &openchoreov1alpha1.GitCommitRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: "greeter-dev-export"},
+				Status:     tt.status,
+			}
+			markGitOpsExported(release, gcr)
+			cond := meta.FindStatusCondition(release.Status.Conditions, string(ConditionGitOpsExported))
+			if cond == nil {
+				t.Fatalf("condition %s not set", ConditionGitOpsExported)
+			}
+			if cond.Status != tt.wantStatus || cond.Reason != tt.wantReason {
+				t.Errorf("condition = (%s, %s), want (%s, %s)", cond.Status, cond.Reason, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestExportToGit(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	ctx := context.Background()
+	gitOps := &openchoreov1alpha1.GitOpsExport{RepoURL: "https://example.com/gitops.git", Branch: "main", AuthSecretRef: "gitops-credentials"}
+
+	newRelease := func() *openchoreov1alpha1.Release {
+		return &openchoreov1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{Name: "greeter-dev", Namespace: "acme", UID: "release-uid"},
+			Spec: openchoreov1alpha1.ReleaseSpec{
+				Owner:           openchoreov1alpha1.ReleaseOwner{ProjectName: "shop", ComponentName: "greeter"},
+				EnvironmentName: "development",
+			},
+		}
+	}
+	newResource := func(image string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("apps/v1")
+		obj.SetKind("Deployment")
+		obj.SetName("greeter")
+		obj.SetLabels(map[string]string{labels.LabelKeyReleaseResourceID: "deployment"})
+		_ = unstructured.SetNestedField(obj.Object, image, "spec", "template", "spec", "containers", "image")
+		return obj
+	}
+	listRequests := func(t *testing.T, c client.Client) []openchoreov1alpha1.GitCommitRequest {
+		t.Helper()
+		list := &openchoreov1alpha1.GitCommitRequestList{}
+		if err := c.List(ctx, list, client.InNamespace("acme")); err != nil {
+			t.Fatalf("failed to list GitCommitRequests: %v", err)
+		}
+		return list.Items
+	}
+
+	t.Run("creates one request per exported content", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		r := &Reconciler{Client: c, Scheme: scheme}
+		release := newRelease()
+
+		first, err := r.exportToGit(ctx, release, gitOps, []*unstructured.Unstructured{newResource("greeter:v1")})
+		if err != nil {
+			t.Fatalf("exportToGit() unexpected error: %v", err)
+		}
+		if len(first.Spec.Files) != 1 || first.Spec.Files[0].Path != "acme/shop/greeter/development/deployment.yaml" {
+			t.Errorf("files = %+v, want the deployment in the release directory", first.Spec.Files)
+		}
+		if len(first.Spec.PrunePaths) != 1 || first.Spec.PrunePaths[0] != "acme/shop/greeter/development" {
+			t.Errorf("prunePaths = %v, want the release directory", first.Spec.PrunePaths)
+		}
+		if first.Spec.AuthSecretRef != "gitops-credentials" || first.Spec.Branch != "main" {
+			t.Errorf("spec = %+v, want the repository settings of the data plane", first.Spec)
+		}
+		if owner := metav1.GetControllerOf(first); owner == nil || owner.UID != release.UID {
+			t.Errorf("owner = %v, want the Release", owner)
+		}
+
+		again, err := r.exportToGit(ctx, release, gitOps, []*unstructured.Unstructured{newResource("greeter:v1")})
+		if err != nil {
+			t.Fatalf("exportToGit() unexpected error: %v", err)
+		}
+		if again.Name != first.Name {
+			t.Errorf("unchanged export created %s, want %s to be reused", again.Name, first.Name)
+		}
+
+		updated, err := r.exportToGit(ctx, release, gitOps, []*unstructured.Unstructured{newResource("greeter:v2")})
+		if err != nil {
+			t.Fatalf("exportToGit() unexpected error: %v", err)
+		}
+		if updated.Name == first.Name {
+			t.Errorf("changed export reused %s", first.Name)
+		}
+		if requests := listRequests(t, c); len(requests) != 1 || requests[0].Name != updated.Name {
+			t.Errorf("requests = %v, want only %s", requests, updated.Name)
+		}
+	})
+
+	t.Run("does not own the removal of a deleted release", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		r := &Reconciler{Client: c, Scheme: scheme}
+		release := newRelease()
+		now := metav1.Now()
+		release.DeletionTimestamp = &now
+
+		gcr, err := r.exportToGit(ctx, release, gitOps, nil)
+		if err != nil {
+			t.Fatalf("exportToGit() unexpected error: %v", err)
+		}
+		if len(gcr.Spec.Files) != 0 || len(gcr.Spec.PrunePaths) != 1 {
+			t.Errorf("spec = %+v, want the release directory to be pruned", gcr.Spec)
+		}
+		if len(gcr.OwnerReferences) != 0 {
+			t.Errorf("ownerReferences = %v, want none", gcr.OwnerReferences)
+		}
+
+		if err := r.deleteExportRequests(ctx, release); err != nil {
+			t.Fatalf("deleteExportRequests() unexpected error: %v", err)
+		}
+		if requests := listRequests(t, c); len(requests) != 0 {
+			t.Errorf("requests = %v, want none", requests)
+		}
+	})
+}
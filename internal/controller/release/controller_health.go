@@ -0,0 +1,212 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// HealthCheckFunc determines the health of a live resource in the data plane.
+type HealthCheckFunc func(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error)
+
+// HealthCheckRegistry resolves the health check to use for a resource kind.
+// Checks are keyed by group and kind so that they apply to every served version.
+type HealthCheckRegistry struct {
+	checks map[schema.GroupKind]HealthCheckFunc
+}
+
+// defaultHealthChecks is used by reconcilers that are not given a registry.
+var defaultHealthChecks = NewHealthCheckRegistry()
+
+// NewHealthCheckRegistry returns a registry populated with the built-in health checks.
+func NewHealthCheckRegistry() *HealthCheckRegistry {
+	r := &HealthCheckRegistry{checks: make(map[schema.GroupKind]HealthCheckFunc)}
+	r.Register(schema.GroupKind{Group: "apps", Kind: "Deployment"}, getDeploymentHealth)
+	r.Register(schema.GroupKind{Group: "apps", Kind: "StatefulSet"}, getStatefulSetHealth)
+	r.Register(schema.GroupKind{Group: "", Kind: "Pod"}, getPodHealth)
+	r.Register(schema.GroupKind{Group: "", Kind: "Service"}, getServiceHealth)
+	r.Register(schema.GroupKind{Group: "", Kind: "PersistentVolumeClaim"}, getPersistentVolumeClaimHealth)
+	r.Register(schema.GroupKind{Group: "batch", Kind: "CronJob"}, getCronJobHealth)
+	r.Register(schema.GroupKind{Group: "batch", Kind: "Job"}, getJobHealth)
+	r.Register(schema.GroupKind{Group: gatewayv1.GroupName, Kind: "HTTPRoute"}, getHTTPRouteHealth)
+	r.Register(schema.GroupKind{Group: "external-secrets.io", Kind: "ExternalSecret"}, getExternalSecretHealth)
+	r.Register(schema.GroupKind{Group: "argoproj.io", Kind: "Workflow"}, getArgoWorkflowHealth)
+	return r
+}
+
+// Register sets the health check for a group and kind, replacing any existing check.
+func (r *HealthCheckRegistry) Register(gk schema.GroupKind, check HealthCheckFunc) {
+	r.checks[gk] = check
+}
+
+// Get returns the health check for the given resource type.
+// Resources without a registered check are considered healthy once they exist.
+func (r *HealthCheckRegistry) Get(gvk schema.GroupVersionKind) HealthCheckFunc {
+	if check, ok := r.checks[gvk.GroupKind()]; ok {
+		return check
+	}
+	return getUnknownResourceHealth
+}
+
+func getJobHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	var job batchv1.Job
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &job); err != nil {
+		return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("failed to convert to job: %w", err)
+	}
+
+	for _, c := range job.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobFailed, batchv1.JobFailureTarget:
+			return openchoreov1alpha1.HealthStatusDegraded, nil
+		case batchv1.JobComplete, batchv1.JobSuccessCriteriaMet:
+			return openchoreov1alpha1.HealthStatusHealthy, nil
+		case batchv1.JobSuspended:
+			return openchoreov1alpha1.HealthStatusSuspended, nil
+		}
+	}
+
+	if job.Spec.Suspend != nil && *job.Spec.Suspend {
+		return openchoreov1alpha1.HealthStatusSuspended, nil
+	}
+
+	// Job is still running or waiting for pods to be scheduled
+	return openchoreov1alpha1.HealthStatusProgressing, nil
+}
+
+func getServiceHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	var service corev1.Service
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &service); err != nil {
+		return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("failed to convert to service: %w", err)
+	}
+
+	// Only LoadBalancer services depend on an external controller to become usable
+	if service.Spec.Type == corev1.ServiceTypeLoadBalancer && len(service.Status.LoadBalancer.Ingress) == 0 {
+		return openchoreov1alpha1.HealthStatusProgressing, nil
+	}
+	return openchoreov1alpha1.HealthStatusHealthy, nil
+}
+
+func getPersistentVolumeClaimHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	var pvc corev1.PersistentVolumeClaim
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &pvc); err != nil {
+		return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("failed to convert to persistentvolumeclaim: %w", err)
+	}
+
+	switch pvc.Status.Phase {
+	case corev1.ClaimBound:
+		return openchoreov1alpha1.HealthStatusHealthy, nil
+	case corev1.ClaimLost:
+		return openchoreov1alpha1.HealthStatusDegraded, nil
+	default:
+		// Pending claims are waiting for provisioning or for a consumer (WaitForFirstConsumer)
+		return openchoreov1alpha1.HealthStatusProgressing, nil
+	}
+}
+
+func getHTTPRouteHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	var route gatewayv1.HTTPRoute
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &route); err != nil {
+		return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("failed to convert to httproute: %w", err)
+	}
+
+	// No gateway has picked up the route yet
+	if len(route.Status.Parents) == 0 {
+		return openchoreov1alpha1.HealthStatusProgressing, nil
+	}
+
+	health := openchoreov1alpha1.HealthStatusHealthy
+	for _, parent := range route.Status.Parents {
+		for _, condType := range []gatewayv1.RouteConditionType{
+			gatewayv1.RouteConditionAccepted,
+			gatewayv1.RouteConditionResolvedRefs,
+		} {
+			cond := findCondition(parent.Conditions, string(condType))
+			switch {
+			case cond == nil || (cond.ObservedGeneration != 0 && cond.ObservedGeneration < route.Generation):
+				// The gateway has not reconciled the latest spec yet
+				health = openchoreov1alpha1.HealthStatusProgressing
+			case cond.Status == metav1.ConditionFalse:
+				return openchoreov1alpha1.HealthStatusDegraded, nil
+			case cond.Status != metav1.ConditionTrue:
+				health = openchoreov1alpha1.HealthStatusProgressing
+			}
+		}
+	}
+	return health, nil
+}
+
+func getExternalSecretHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	conditions, err := getStatusConditions(obj)
+	if err != nil {
+		return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("failed to read externalsecret conditions: %w", err)
+	}
+
+	ready := findCondition(conditions, "Ready")
+	switch {
+	case ready == nil:
+		return openchoreov1alpha1.HealthStatusProgressing, nil
+	case ready.Status == metav1.ConditionTrue:
+		return openchoreov1alpha1.HealthStatusHealthy, nil
+	case ready.Status == metav1.ConditionFalse:
+		// SecretSyncedError and similar reasons mean the provider rejected the request
+		return openchoreov1alpha1.HealthStatusDegraded, nil
+	default:
+		return openchoreov1alpha1.HealthStatusProgressing, nil
+	}
+}
+
+func getArgoWorkflowHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+	phase, _, err := unstructured.NestedString(obj.Object, "status", "phase")
+	if err != nil {
+		return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("failed to read workflow phase: %w", err)
+	}
+
+	switch phase {
+	case "Succeeded":
+		return openchoreov1alpha1.HealthStatusHealthy, nil
+	case "Failed", "Error":
+		return openchoreov1alpha1.HealthStatusDegraded, nil
+	default:
+		// Empty, Pending and Running
+		return openchoreov1alpha1.HealthStatusProgressing, nil
+	}
+}
+
+// getStatusConditions reads status.conditions of an arbitrary resource in the standard metav1.Condition shape.
+func getStatusConditions(obj *unstructured.Unstructured) ([]metav1.Condition, error) {
+	raw, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !found {
+		return nil, err
+	}
+
+	var status struct {
+		Conditions []metav1.Condition `json:"conditions"`
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(map[string]any{"conditions": raw}, &status); err != nil {
+		return nil, err
+	}
+	return status.Conditions, nil
+}
+
+func findCondition(conditions []metav1.Condition, condType string) *metav1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == condType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// HealthRuleConfig is the format of the file passed to the controller manager with --health-check-config.
+//
+// Example:
+//
+//	rules:
+//	- group: postgresql.cnpg.io
+//	  kind: Cluster
+//	  degraded: "has(object.status) && has(object.status.phase) && object.status.phase.contains('failed')"
+//	  healthy: "has(object.status) && has(object.status.readyInstances) && object.status.readyInstances == object.spec.instances"
+type HealthRuleConfig struct {
+	Rules []HealthRule `json:"rules"`
+}
+
+// HealthRule judges the health of a resource kind using CEL expressions evaluated against the
+// live object, which is available as the "object" variable. Each expression must return a bool.
+//
+// The expressions are evaluated in the order degraded, suspended, progressing, healthy and the first
+// one that returns true determines the health. If none of them match, the resource is Progressing.
+// A rule replaces the built-in health check for the same group and kind.
+type HealthRule struct {
+	Group       string `json:"group"`
+	Kind        string `json:"kind"`
+	Degraded    string `json:"degraded,omitempty"`
+	Suspended   string `json:"suspended,omitempty"`
+	Progressing string `json:"progressing,omitempty"`
+	Healthy     string `json:"healthy,omitempty"`
+}
+
+// LoadHealthRules reads health rules from a YAML or JSON file.
+func LoadHealthRules(path string) ([]HealthRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read health check config: %w", err)
+	}
+
+	var config HealthRuleConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse health check config %s: %w", path, err)
+	}
+	return config.Rules, nil
+}
+
+// RegisterRules compiles the given rules and registers them, replacing the checks for the same kinds.
+// No rule is registered if any of them fails to compile.
+func (r *HealthCheckRegistry) RegisterRules(rules []HealthRule) error {
+	checks := make(map[schema.GroupKind]HealthCheckFunc, len(rules))
+	for i, rule := range rules {
+		gk := schema.GroupKind{Group: rule.Group, Kind: rule.Kind}
+		if _, exists := checks[gk]; exists {
+			return fmt.Errorf("rules[%d]: duplicate health rule for %s", i, gk)
+		}
+		check, err := NewCELHealthCheck(rule)
+		if err != nil {
+			return fmt.Errorf("rules[%d]: %w", i, err)
+		}
+		checks[gk] = check
+	}
+
+	for gk, check := range checks {
+		r.Register(gk, check)
+	}
+	return nil
+}
+
+// celHealthExpression is a compiled health rule expression together with the status it yields.
+type celHealthExpression struct {
+	status  openchoreov1alpha1.HealthStatus
+	source  string
+	program cel.Program
+}
+
+// NewCELHealthCheck compiles a health rule into a health check function.
+func NewCELHealthCheck(rule HealthRule) (HealthCheckFunc, error) {
+	if rule.Kind == "" {
+		return nil, fmt.Errorf("kind is required")
+	}
+
+	env, err := cel.NewEnv(
+		cel.OptionalTypes(),
+		cel.Variable("object", cel.DynType),
+		ext.Strings(),
+		ext.Lists(),
+		ext.Sets(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+
+	ordered := []struct {
+		status openchoreov1alpha1.HealthStatus
+		source string
+	}{
+		{openchoreov1alpha1.HealthStatusDegraded, rule.Degraded},
+		{openchoreov1alpha1.HealthStatusSuspended, rule.Suspended},
+		{openchoreov1alpha1.HealthStatusProgressing, rule.Progressing},
+		{openchoreov1alpha1.HealthStatusHealthy, rule.Healthy},
+	}
+
+	var expressions []celHealthExpression
+	for _, e := range ordered {
+		if e.source == "" {
+			continue
+		}
+		ast, issues := env.Compile(e.source)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("invalid %s expression for %s.%s: %w", e.status, rule.Kind, rule.Group, issues.Err())
+		}
+		if !ast.OutputType().IsAssignableType(cel.BoolType) {
+			return nil, fmt.Errorf("%s expression for %s.%s must return a bool, got %s", e.status, rule.Kind, rule.Group, ast.OutputType())
+		}
+		program, err := env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build %s expression for %s.%s: %w", e.status, rule.Kind, rule.Group, err)
+		}
+		expressions = append(expressions, celHealthExpression{status: e.status, source: e.source, program: program})
+	}
+	if len(expressions) == 0 {
+		return nil, fmt.Errorf("health rule for %s.%s has no expressions", rule.Kind, rule.Group)
+	}
+
+	return func(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
+		activation := map[string]any{"object": obj.Object}
+		for _, e := range expressions {
+			out, _, err := e.program.Eval(activation)
+			if err != nil {
+				return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("failed to evaluate %s expression %q: %w", e.status, e.source, err)
+			}
+			matched, ok := out.Value().(bool)
+			if !ok {
+				return openchoreov1alpha1.HealthStatusUnknown, fmt.Errorf("%s expression %q returned %T, expected bool", e.status, e.source, out.Value())
+			}
+			if matched {
+				return e.status, nil
+			}
+		}
+		return openchoreov1alpha1.HealthStatusProgressing, nil
+	}, nil
+}
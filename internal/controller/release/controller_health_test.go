@@ -0,0 +1,385 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func mustUnstructured(t *testing.T, manifest string) *unstructured.Unstructured {
+	t.Helper()
+	data, err := yaml.YAMLToJSON([]byte(manifest))
+	if err != nil {
+		t.Fatalf("failed to convert manifest: %v", err)
+	}
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(data); err != nil {
+		t.Fatalf("failed to parse manifest: %v", err)
+	}
+	return obj
+}
+
+func TestBuiltinHealthChecks(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		want     openchoreov1alpha1.HealthStatus
+	}{
+		{
+			name: "completed job",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+status:
+  conditions:
+  - type: Complete
+    status: "True"
+`,
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "failed job",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+status:
+  conditions:
+  - type: Failed
+    status: "True"
+`,
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name: "running job",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+status:
+  active: 1
+`,
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+		{
+			name: "load balancer service without ingress",
+			manifest: `
+apiVersion: v1
+kind: Service
+spec:
+  type: LoadBalancer
+`,
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+		{
+			name: "load balancer service with ingress",
+			manifest: `
+apiVersion: v1
+kind: Service
+spec:
+  type: LoadBalancer
+status:
+  loadBalancer:
+    ingress:
+    - ip: 10.0.0.1
+`,
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "cluster ip service",
+			manifest: `
+apiVersion: v1
+kind: Service
+spec:
+  type: ClusterIP
+`,
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "pending pvc",
+			manifest: `
+apiVersion: v1
+kind: PersistentVolumeClaim
+status:
+  phase: Pending
+`,
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+		{
+			name: "lost pvc",
+			manifest: `
+apiVersion: v1
+kind: PersistentVolumeClaim
+status:
+  phase: Lost
+`,
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name: "httproute accepted with resolved refs",
+			manifest: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  generation: 2
+status:
+  parents:
+  - parentRef:
+      name: gateway-external
+    controllerName: gateway.envoyproxy.io/gatewayclass-controller
+    conditions:
+    - type: Accepted
+      status: "True"
+      reason: Accepted
+      observedGeneration: 2
+      lastTransitionTime: "2025-01-01T00:00:00Z"
+      message: ""
+    - type: ResolvedRefs
+      status: "True"
+      reason: ResolvedRefs
+      observedGeneration: 2
+      lastTransitionTime: "2025-01-01T00:00:00Z"
+      message: ""
+`,
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "httproute with unresolved backend",
+			manifest: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+status:
+  parents:
+  - parentRef:
+      name: gateway-external
+    controllerName: gateway.envoyproxy.io/gatewayclass-controller
+    conditions:
+    - type: Accepted
+      status: "True"
+      reason: Accepted
+      lastTransitionTime: "2025-01-01T00:00:00Z"
+      message: ""
+    - type: ResolvedRefs
+      status: "False"
+      reason: BackendNotFound
+      lastTransitionTime: "2025-01-01T00:00:00Z"
+      message: ""
+`,
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name: "httproute not yet reconciled for latest generation",
+			manifest: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  generation: 3
+status:
+  parents:
+  - parentRef:
+      name: gateway-external
+    controllerName: gateway.envoyproxy.io/gatewayclass-controller
+    conditions:
+    - type: Accepted
+      status: "True"
+      reason: Accepted
+      observedGeneration: 2
+      lastTransitionTime: "2025-01-01T00:00:00Z"
+      message: ""
+`,
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+		{
+			name: "synced external secret",
+			manifest: `
+apiVersion: external-secrets.io/v1beta1
+kind: ExternalSecret
+status:
+  conditions:
+  - type: Ready
+    status: "True"
+    reason: SecretSynced
+    lastTransitionTime: "2025-01-01T00:00:00Z"
+`,
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "external secret sync error",
+			manifest: `
+apiVersion: external-secrets.io/v1beta1
+kind: ExternalSecret
+status:
+  conditions:
+  - type: Ready
+    status: "False"
+    reason: SecretSyncedError
+    lastTransitionTime: "2025-01-01T00:00:00Z"
+`,
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name: "failed argo workflow",
+			manifest: `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+status:
+  phase: Failed
+`,
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name: "resource without a health check",
+			manifest: `
+apiVersion: v1
+kind: ConfigMap
+`,
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+	}
+
+	registry := NewHealthCheckRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := mustUnstructured(t, tt.manifest)
+			got, err := registry.Get(obj.GroupVersionKind())(obj)
+			if err != nil {
+				t.Fatalf("health check returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("health = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCELHealthRules(t *testing.T) {
+	registry := NewHealthCheckRegistry()
+	err := registry.RegisterRules([]HealthRule{
+		{
+			Group:     "postgresql.cnpg.io",
+			Kind:      "Cluster",
+			Degraded:  "has(object.status) && has(object.status.phase) && object.status.phase.contains('failed')",
+			Suspended: "has(object.spec.hibernation) && object.spec.hibernation",
+			Healthy:   "has(object.status) && has(object.status.readyInstances) && object.status.readyInstances == object.spec.instances",
+		},
+		{
+			// Overrides the built-in Service check
+			Kind:    "Service",
+			Healthy: "true",
+		},
+	})
+	if err != nil {
+		t.Fatalf("RegisterRules() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		manifest string
+		want     openchoreov1alpha1.HealthStatus
+	}{
+		{
+			name: "healthy expression matches",
+			manifest: `
+apiVersion: postgresql.cnpg.io/v1
+kind: Cluster
+spec:
+  instances: 3
+status:
+  readyInstances: 3
+`,
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		{
+			name: "degraded takes precedence",
+			manifest: `
+apiVersion: postgresql.cnpg.io/v1
+kind: Cluster
+spec:
+  instances: 3
+status:
+  phase: Setting up primary failed
+  readyInstances: 3
+`,
+			want: openchoreov1alpha1.HealthStatusDegraded,
+		},
+		{
+			name: "suspended expression matches",
+			manifest: `
+apiVersion: postgresql.cnpg.io/v1
+kind: Cluster
+spec:
+  instances: 3
+  hibernation: true
+`,
+			want: openchoreov1alpha1.HealthStatusSuspended,
+		},
+		{
+			name: "no expression matches",
+			manifest: `
+apiVersion: postgresql.cnpg.io/v1
+kind: Cluster
+spec:
+  instances: 3
+status:
+  readyInstances: 1
+`,
+			want: openchoreov1alpha1.HealthStatusProgressing,
+		},
+		{
+			name: "rule replaces built-in check",
+			manifest: `
+apiVersion: v1
+kind: Service
+spec:
+  type: LoadBalancer
+`,
+			want: openchoreov1alpha1.HealthStatusHealthy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := mustUnstructured(t, tt.manifest)
+			got, err := registry.Get(obj.GroupVersionKind())(obj)
+			if err != nil {
+				t.Fatalf("health check returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("health = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCELHealthRulesInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		rule HealthRule
+	}{
+		{name: "missing kind", rule: HealthRule{Healthy: "true"}},
+		{name: "no expressions", rule: HealthRule{Kind: "Cluster"}},
+		{name: "syntax error", rule: HealthRule{Kind: "Cluster", Healthy: "object.status.ready =="}},
+		{name: "non-bool expression", rule: HealthRule{Kind: "Cluster", Healthy: "'ready'"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewHealthCheckRegistry()
+			rules := []HealthRule{{Kind: "Service", Healthy: "false"}, tt.rule}
+			if err := registry.RegisterRules(rules); err == nil {
+				t.Fatalf("RegisterRules() expected an error")
+			}
+
+			// A failed registration must not replace any existing check
+			obj := mustUnstructured(t, "apiVersion: v1\nkind: Service\nspec:\n  type: ClusterIP\n")
+			got, err := registry.Get(obj.GroupVersionKind())(obj)
+			if err != nil || got != openchoreov1alpha1.HealthStatusHealthy {
+				t.Errorf("expected built-in Service check to be kept, got %s (err: %v)", got, err)
+			}
+		})
+	}
+}
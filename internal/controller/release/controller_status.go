@@ -15,7 +15,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -33,6 +32,7 @@ func (r *Reconciler) updateStatus(ctx context.Context, old, release *openchoreov
 
 	// Update the status
 	release.Status.Resources = resourceStatuses
+	release.Status.ObservedGeneration = release.Generation
 
 	// Check if the entire status actually changed and skip update if not
 	if apiequality.Semantic.DeepEqual(old.Status, release.Status) {
@@ -94,7 +94,7 @@ func (r *Reconciler) buildResourceStatus(ctx context.Context, old *openchoreov1a
 			}
 
 			// Get health check function for this resource type
-			healthCheckFunc := GetHealthCheckFunc(gvk)
+			healthCheckFunc := r.healthChecks().Get(gvk)
 			if healthCheckFunc != nil {
 				health, err := healthCheckFunc(liveResource)
 				if err != nil {
@@ -147,6 +147,14 @@ func (r *Reconciler) buildResourceStatus(ctx context.Context, old *openchoreov1a
 	return resourceStatuses
 }
 
+// healthChecks returns the configured health check registry, falling back to the built-in checks
+func (r *Reconciler) healthChecks() *HealthCheckRegistry {
+	if r.HealthChecks == nil {
+		return defaultHealthChecks
+	}
+	return r.HealthChecks
+}
+
 // hasTransitioningResources checks if any resources are in a transitioning state
 func (r *Reconciler) hasTransitioningResources(resources []openchoreov1alpha1.ResourceStatus) bool {
 	for _, resource := range resources {
@@ -163,21 +171,6 @@ func (r *Reconciler) hasTransitioningResources(resources []openchoreov1alpha1.Re
 	return false
 }
 
-func GetHealthCheckFunc(gvk schema.GroupVersionKind) func(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
-	switch {
-	case gvk.Group == "apps" && gvk.Kind == "Deployment":
-		return getDeploymentHealth
-	case gvk.Group == "apps" && gvk.Kind == "StatefulSet":
-		return getStatefulSetHealth
-	case gvk.Group == "" && gvk.Kind == "Pod":
-		return getPodHealth
-	case gvk.Group == "batch" && gvk.Kind == "CronJob":
-		return getCronJobHealth
-		// TODO: Add gateway http route health check, and other resources as needed
-	}
-	return getUnknownResourceHealth
-}
-
 func getDeploymentHealth(obj *unstructured.Unstructured) (openchoreov1alpha1.HealthStatus, error) {
 	// Convert unstructured object to Deployment
 	var deployment appsv1.Deployment
@@ -0,0 +1,238 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+)
+
+// Connection parameters understood for connections of type api
+const (
+	connectionParamProjectName   = "projectName"
+	connectionParamComponentName = "componentName"
+	connectionParamEndpoint      = "endpoint"
+)
+
+// ConnectionNotResolvedError reports a workload connection whose target is not available in the
+// environment of the ReleaseBinding, e.g. because the target component is not deployed there yet.
+type ConnectionNotResolvedError struct {
+	// Connection is the name of the workload connection.
+	Connection string
+
+	// Reason describes why the connection could not be resolved.
+	Reason string
+}
+
+func (e *ConnectionNotResolvedError) Error() string {
+	return fmt.Sprintf("connection %q cannot be resolved: %s", e.Connection, e.Reason)
+}
+
+// ResolveConnections resolves each api connection of the workload to the endpoint of the target
+// component in the environment of the ReleaseBinding. The target is reached through the Service
+// in the Release of its ReleaseBinding, so a connection only resolves once the target is deployed.
+// A *ConnectionNotResolvedError is returned for the first connection that cannot be resolved.
+func ResolveConnections(ctx context.Context, c client.Reader, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	workload *openchoreov1alpha1.Workload) (map[string]pipelinecontext.ConnectionContext, error) {
+	if workload == nil || len(workload.Spec.Connections) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(workload.Spec.Connections))
+	for name := range workload.Spec.Connections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resolved := make(map[string]pipelinecontext.ConnectionContext, len(names))
+	for _, name := range names {
+		connection := workload.Spec.Connections[name]
+		if connection.Type != openchoreov1alpha1.ConnectionTypeAPI {
+			continue
+		}
+		conn, err := resolveAPIConnection(ctx, c, releaseBinding, name, connection)
+		if err != nil {
+			return nil, err
+		}
+		resolved[name] = *conn
+	}
+	return resolved, nil
+}
+
+// resolveAPIConnection resolves a single api connection. The target project defaults to the project
+// of the ReleaseBinding.
+func resolveAPIConnection(ctx context.Context, c client.Reader, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	name string, connection openchoreov1alpha1.WorkloadConnection) (*pipelinecontext.ConnectionContext, error) {
+	projectName := connection.Params[connectionParamProjectName]
+	if projectName == "" {
+		projectName = releaseBinding.Spec.Owner.ProjectName
+	}
+	componentName := connection.Params[connectionParamComponentName]
+	endpointName := connection.Params[connectionParamEndpoint]
+	if componentName == "" || endpointName == "" {
+		return nil, &ConnectionNotResolvedError{Connection: name,
+			Reason: fmt.Sprintf("params %q and %q are required", connectionParamComponentName, connectionParamEndpoint)}
+	}
+	environment := releaseBinding.Spec.Environment
+
+	target, err := findReleaseBinding(ctx, c, releaseBinding.Namespace, projectName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, &ConnectionNotResolvedError{Connection: name,
+			Reason: fmt.Sprintf("component %s/%s is not deployed to environment %q", projectName, componentName, environment)}
+	}
+
+	componentRelease := &openchoreov1alpha1.ComponentRelease{}
+	if err := c.Get(ctx, client.ObjectKey{Name: target.Spec.ReleaseName, Namespace: target.Namespace}, componentRelease); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, &ConnectionNotResolvedError{Connection: name,
+				Reason: fmt.Sprintf("ComponentRelease %q of component %s/%s not found", target.Spec.ReleaseName, projectName, componentName)}
+		}
+		return nil, fmt.Errorf("failed to get ComponentRelease %s: %w", target.Spec.ReleaseName, err)
+	}
+	endpoint, ok := componentRelease.Spec.Workload.Endpoints[endpointName]
+	if !ok {
+		return nil, &ConnectionNotResolvedError{Connection: name,
+			Reason: fmt.Sprintf("component %s/%s has no endpoint %q", projectName, componentName, endpointName)}
+	}
+
+	// Consumers in other projects reach the endpoint from the organization scope, which the endpoint
+	// has to allow
+	visibility := openchoreov1alpha1.EndpointExposeLevelProject
+	if projectName != releaseBinding.Spec.Owner.ProjectName {
+		visibility = openchoreov1alpha1.EndpointExposeLevelOrganization
+		if endpoint.Visibility == "" || endpoint.Visibility == openchoreov1alpha1.EndpointExposeLevelProject {
+			return nil, &ConnectionNotResolvedError{Connection: name,
+				Reason: fmt.Sprintf("endpoint %q of component %s/%s is only visible within project %q",
+					endpointName, projectName, componentName, projectName)}
+		}
+	}
+
+	release := &openchoreov1alpha1.Release{}
+	if err := c.Get(ctx, client.ObjectKey{Name: MakeReleaseName(componentName, environment), Namespace: target.Namespace}, release); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, &ConnectionNotResolvedError{Connection: name,
+				Reason: fmt.Sprintf("component %s/%s has no Release in environment %q yet", projectName, componentName, environment)}
+		}
+		return nil, fmt.Errorf("failed to get Release of component %s: %w", componentName, err)
+	}
+	host, port, found := findServiceAddress(release.Spec.Resources, endpoint.Port)
+	if !found {
+		return nil, &ConnectionNotResolvedError{Connection: name,
+			Reason: fmt.Sprintf("no Service of component %s/%s exposes endpoint %q", projectName, componentName, endpointName)}
+	}
+
+	scheme := connectionScheme(endpoint.Type, port)
+	return &pipelinecontext.ConnectionContext{
+		Host:       host,
+		Port:       port,
+		Scheme:     scheme,
+		BasePath:   endpoint.BasePath,
+		URL:        makeConnectionURL(scheme, host, port, endpoint.BasePath),
+		Visibility: string(visibility),
+	}, nil
+}
+
+// findReleaseBinding returns the ReleaseBinding of a component in an environment, or nil if there is none.
+// The ReleaseBindings are looked up through the binding target index. Readers without the index,
+// e.g. a client reading from the API server, fall back to listing the ReleaseBindings of the namespace.
+func findReleaseBinding(ctx context.Context, c client.Reader, namespace, projectName, componentName,
+	environment string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	bindings := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := c.List(ctx, bindings, client.InNamespace(namespace),
+		client.MatchingFields{bindingTargetIndex: makeBindingTargetKey(environment, projectName, componentName)}); err != nil {
+		if err := c.List(ctx, bindings, client.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("failed to list ReleaseBindings: %w", err)
+		}
+	}
+	for i := range bindings.Items {
+		binding := &bindings.Items[i]
+		if binding.Spec.Owner.ProjectName == projectName && binding.Spec.Owner.ComponentName == componentName &&
+			binding.Spec.Environment == environment && binding.DeletionTimestamp.IsZero() {
+			return binding, nil
+		}
+	}
+	return nil, nil
+}
+
+// findServiceAddress looks for a Service in the resources of a Release that forwards to the given
+// container port, and returns its cluster DNS name and service port. A Service port matches when
+// its targetPort (or its port, if no targetPort is set) equals the container port.
+func findServiceAddress(resources []openchoreov1alpha1.Resource, containerPort int32) (string, int32, bool) {
+	for _, resource := range resources {
+		if resource.Object == nil {
+			continue
+		}
+		var typeMeta struct {
+			APIVersion string `json:"apiVersion"`
+			Kind       string `json:"kind"`
+		}
+		if err := json.Unmarshal(resource.Object.Raw, &typeMeta); err != nil ||
+			typeMeta.APIVersion != "v1" || typeMeta.Kind != "Service" {
+			continue
+		}
+
+		service := &corev1.Service{}
+		if err := json.Unmarshal(resource.Object.Raw, service); err != nil {
+			continue
+		}
+		for _, port := range service.Spec.Ports {
+			targetPort := port.TargetPort
+			if targetPort.Type == intstr.Int && targetPort.IntVal == 0 {
+				targetPort = intstr.FromInt32(port.Port)
+			}
+			if targetPort.Type == intstr.Int && targetPort.IntVal == containerPort {
+				return fmt.Sprintf("%s.%s.svc.cluster.local", service.Name, service.Namespace), port.Port, true
+			}
+		}
+	}
+	return "", 0, false
+}
+
+// connectionScheme returns the scheme used to reach an endpoint of the given type.
+func connectionScheme(endpointType openchoreov1alpha1.EndpointType, port int32) string {
+	switch endpointType {
+	case openchoreov1alpha1.EndpointTypeGRPC:
+		return "grpc"
+	case openchoreov1alpha1.EndpointTypeWebsocket:
+		return "ws"
+	case openchoreov1alpha1.EndpointTypeHTTP, openchoreov1alpha1.EndpointTypeREST, openchoreov1alpha1.EndpointTypeGraphQL:
+		if port == 443 || port == 8443 {
+			return "https"
+		}
+		return "http"
+	default:
+		// No scheme for raw TCP/UDP
+		return ""
+	}
+}
+
+// makeConnectionURL builds the address of an endpoint, omitting the default port of the scheme.
+// The base path is appended for endpoints reached through a scheme.
+func makeConnectionURL(scheme, host string, port int32, basePath string) string {
+	if scheme == "" {
+		return fmt.Sprintf("%s:%d", host, port)
+	}
+	if basePath != "" && !strings.HasPrefix(basePath, "/") {
+		basePath = "/" + basePath
+	}
+	basePath = strings.TrimSuffix(basePath, "/")
+	if (scheme == "http" && port == 80) || (scheme == "https" && port == 443) {
+		return fmt.Sprintf("%s://%s%s", scheme, host, basePath)
+	}
+	return fmt.Sprintf("%s://%s:%d%s", scheme, host, port, basePath)
+}
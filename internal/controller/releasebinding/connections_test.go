@@ -0,0 +1,252 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+)
+
+func TestResolveConnections(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	catalogBinding := &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "catalog-dev", Namespace: "acme"},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "catalog"},
+			Environment: "dev",
+			ReleaseName: "catalog-v1",
+		},
+	}
+	catalogRelease := &openchoreov1alpha1.ComponentRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "catalog-v1", Namespace: "acme"},
+		Spec: openchoreov1alpha1.ComponentReleaseSpec{
+			Workload: openchoreov1alpha1.WorkloadTemplateSpec{
+				Endpoints: map[string]openchoreov1alpha1.WorkloadEndpoint{
+					"rest": {Type: openchoreov1alpha1.EndpointTypeREST, Port: 8080, BasePath: "/api/v1"},
+					"internal": {Type: openchoreov1alpha1.EndpointTypeHTTP, Port: 8080,
+						Visibility: openchoreov1alpha1.EndpointExposeLevelProject},
+				},
+			},
+		},
+	}
+	catalogDeployed := &openchoreov1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{Name: "catalog-dev", Namespace: "acme"},
+		Spec: openchoreov1alpha1.ReleaseSpec{
+			EnvironmentName: "dev",
+			Resources: []openchoreov1alpha1.Resource{
+				{ID: "deployment-catalog", Object: &runtime.RawExtension{Raw: []byte(
+					`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"catalog-dev-1a2b3c4d","namespace":"dp-acme-shop-dev"}}`)}},
+				{ID: "service-catalog", Object: &runtime.RawExtension{Raw: []byte(
+					`{"apiVersion":"v1","kind":"Service","metadata":{"name":"catalog","namespace":"dp-acme-shop-dev"},` +
+						`"spec":{"ports":[{"name":"http","port":80,"targetPort":8080}]}}`)}},
+			},
+		},
+	}
+
+	inventoryBinding := &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "inventory-catalog-dev", Namespace: "acme"},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "inventory", ComponentName: "catalog"},
+			Environment: "dev",
+			ReleaseName: "inventory-catalog-v1",
+		},
+	}
+	inventoryRelease := &openchoreov1alpha1.ComponentRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "inventory-catalog-v1", Namespace: "acme"},
+		Spec: openchoreov1alpha1.ComponentReleaseSpec{
+			Workload: openchoreov1alpha1.WorkloadTemplateSpec{
+				Endpoints: map[string]openchoreov1alpha1.WorkloadEndpoint{
+					"rest": {Type: openchoreov1alpha1.EndpointTypeREST, Port: 8080,
+						Visibility: openchoreov1alpha1.EndpointExposeLevelOrganization},
+					"internal": {Type: openchoreov1alpha1.EndpointTypeHTTP, Port: 8080},
+				},
+			},
+		},
+	}
+	inventoryDeployed := &openchoreov1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{Name: "catalog-dev", Namespace: "acme"},
+		Spec: openchoreov1alpha1.ReleaseSpec{
+			EnvironmentName: "dev",
+			Resources: []openchoreov1alpha1.Resource{
+				{ID: "service-catalog", Object: &runtime.RawExtension{Raw: []byte(
+					`{"apiVersion":"v1","kind":"Service","metadata":{"name":"catalog","namespace":"dp-acme-inventory-dev"},` +
+						`"spec":{"ports":[{"name":"http","port":8080}]}}`)}},
+			},
+		},
+	}
+
+	binding := &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "frontend-dev", Namespace: "acme"},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "frontend"},
+			Environment: "dev",
+		},
+	}
+	newWorkload := func(params map[string]string) *openchoreov1alpha1.Workload {
+		return &openchoreov1alpha1.Workload{
+			Spec: openchoreov1alpha1.WorkloadSpec{
+				WorkloadTemplateSpec: openchoreov1alpha1.WorkloadTemplateSpec{
+					Connections: map[string]openchoreov1alpha1.WorkloadConnection{
+						"catalog": {Type: openchoreov1alpha1.ConnectionTypeAPI, Params: params},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		objects         []client.Object
+		params          map[string]string
+		withoutIndex    bool
+		want            map[string]pipelinecontext.ConnectionContext
+		wantNotResolved bool
+	}{
+		{
+			name:    "Target deployed in the environment",
+			objects: []client.Object{catalogBinding, catalogRelease, catalogDeployed},
+			params:  map[string]string{"componentName": "catalog", "endpoint": "rest"},
+			want: map[string]pipelinecontext.ConnectionContext{
+				"catalog": {
+					Host:       "catalog.dp-acme-shop-dev.svc.cluster.local",
+					Port:       80,
+					Scheme:     "http",
+					BasePath:   "/api/v1",
+					URL:        "http://catalog.dp-acme-shop-dev.svc.cluster.local/api/v1",
+					Visibility: "Project",
+				},
+			},
+		},
+		{
+			name:         "Target deployed in the environment read without the index",
+			objects:      []client.Object{catalogBinding, catalogRelease, catalogDeployed},
+			params:       map[string]string{"componentName": "catalog", "endpoint": "internal"},
+			withoutIndex: true,
+			want: map[string]pipelinecontext.ConnectionContext{
+				"catalog": {
+					Host:       "catalog.dp-acme-shop-dev.svc.cluster.local",
+					Port:       80,
+					Scheme:     "http",
+					URL:        "http://catalog.dp-acme-shop-dev.svc.cluster.local",
+					Visibility: "Project",
+				},
+			},
+		},
+		{
+			name:    "Organization endpoint of another project",
+			objects: []client.Object{inventoryBinding, inventoryRelease, inventoryDeployed},
+			params:  map[string]string{"projectName": "inventory", "componentName": "catalog", "endpoint": "rest"},
+			want: map[string]pipelinecontext.ConnectionContext{
+				"catalog": {
+					Host:       "catalog.dp-acme-inventory-dev.svc.cluster.local",
+					Port:       8080,
+					Scheme:     "http",
+					URL:        "http://catalog.dp-acme-inventory-dev.svc.cluster.local:8080",
+					Visibility: "Organization",
+				},
+			},
+		},
+		{
+			name:            "Project endpoint of another project",
+			objects:         []client.Object{inventoryBinding, inventoryRelease, inventoryDeployed},
+			params:          map[string]string{"projectName": "inventory", "componentName": "catalog", "endpoint": "internal"},
+			wantNotResolved: true,
+		},
+		{
+			name:            "Target not bound to the environment",
+			objects:         []client.Object{catalogRelease, catalogDeployed},
+			params:          map[string]string{"componentName": "catalog", "endpoint": "rest"},
+			wantNotResolved: true,
+		},
+		{
+			name:            "Target Release not created yet",
+			objects:         []client.Object{catalogBinding, catalogRelease},
+			params:          map[string]string{"componentName": "catalog", "endpoint": "rest"},
+			wantNotResolved: true,
+		},
+		{
+			name:            "Unknown endpoint",
+			objects:         []client.Object{catalogBinding, catalogRelease, catalogDeployed},
+			params:          map[string]string{"componentName": "catalog", "endpoint": "grpc"},
+			wantNotResolved: true,
+		},
+		{
+			name:            "Target in another project",
+			objects:         []client.Object{catalogBinding, catalogRelease, catalogDeployed},
+			params:          map[string]string{"projectName": "inventory", "componentName": "catalog", "endpoint": "rest"},
+			wantNotResolved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...)
+			if !tt.withoutIndex {
+				builder = builder.WithIndex(&openchoreov1alpha1.ReleaseBinding{}, bindingTargetIndex,
+					func(obj client.Object) []string {
+						binding := obj.(*openchoreov1alpha1.ReleaseBinding)
+						return []string{makeBindingTargetKey(binding.Spec.Environment,
+							binding.Spec.Owner.ProjectName, binding.Spec.Owner.ComponentName)}
+					})
+			}
+			c := builder.Build()
+
+			got, err := ResolveConnections(context.Background(), c, binding, newWorkload(tt.params))
+			var connErr *ConnectionNotResolvedError
+			if gotNotResolved := errors.As(err, &connErr); gotNotResolved != tt.wantNotResolved {
+				t.Fatalf("ResolveConnections() error = %v, want not resolved %v", err, tt.wantNotResolved)
+			}
+			if tt.wantNotResolved {
+				if connErr.Connection != "catalog" {
+					t.Errorf("error connection = %q, want %q", connErr.Connection, "catalog")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveConnections() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ResolveConnections() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMakeConnectionURL(t *testing.T) {
+	tests := []struct {
+		scheme   string
+		port     int32
+		basePath string
+		want     string
+	}{
+		{scheme: "http", port: 80, want: "http://catalog.svc"},
+		{scheme: "http", port: 80, basePath: "/api/v1", want: "http://catalog.svc/api/v1"},
+		{scheme: "https", port: 8443, basePath: "api/", want: "https://catalog.svc:8443/api"},
+		{scheme: "", port: 5432, basePath: "/db", want: "catalog.svc:5432"},
+		{scheme: "http", port: 8080, want: "http://catalog.svc:8080"},
+		{scheme: "https", port: 443, want: "https://catalog.svc"},
+		{scheme: "grpc", port: 50051, want: "grpc://catalog.svc:50051"},
+		{scheme: "", port: 5432, want: "catalog.svc:5432"},
+	}
+
+	for _, tt := range tests {
+		if got := makeConnectionURL(tt.scheme, "catalog.svc", tt.port, tt.basePath); got != tt.want {
+			t.Errorf("makeConnectionURL(%q, %d, %q) = %q, want %q", tt.scheme, tt.port, tt.basePath, got, tt.want)
+		}
+	}
+}
@@ -5,9 +5,10 @@ package releasebinding
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -16,18 +17,23 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/controller"
-	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
 	"github.com/openchoreo/openchoreo/internal/labels"
 	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
-	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/policy"
 )
 
+// connectionRetryInterval is how often a binding with unresolved connections is rendered again
+const connectionRetryInterval = 30 * time.Second
+
 // Reconciler reconciles a ReleaseBinding object
 type Reconciler struct {
 	client.Client
@@ -36,6 +42,10 @@ type Reconciler struct {
 	// Pipeline is the component rendering pipeline, shared across all reconciliations.
 	// This enables CEL environment caching across different component types and reconciliations.
 	Pipeline *componentpipeline.Pipeline
+
+	// Metrics evaluates the analysis metrics of progressive rollouts.
+	// Optional - rollouts that define analysis metrics wait until it is configured.
+	Metrics MetricsQuerier
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=releasebindings,verbs=get;list;watch;create;update;patch;delete
@@ -47,6 +57,7 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=dataplanes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=releases,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=guardrailpolicies,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop
@@ -106,6 +117,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, nil
 	}
 
+	// Record the release in the binding history, which is what rollbacks choose from
+	RecordReleaseHistory(releaseBinding, "", metav1.Now())
+
 	// Fetch Environment object
 	environment := &openchoreov1alpha1.Environment{}
 	if err := r.Get(ctx, types.NamespacedName{
@@ -214,202 +228,68 @@ func (r *Reconciler) validateComponentRelease(componentRelease *openchoreov1alph
 	return nil
 }
 
-// buildMetadataContext creates the MetadataContext from ComponentRelease, component, project, dataplane, and environment.
-func (r *Reconciler) buildMetadataContext(
-	componentRelease *openchoreov1alpha1.ComponentRelease,
-	component *openchoreov1alpha1.Component,
-	project *openchoreov1alpha1.Project,
-	dataPlane *openchoreov1alpha1.DataPlane,
-	environment *openchoreov1alpha1.Environment,
-	environmentName string,
-) pipelinecontext.MetadataContext {
-	// Extract information
-	organizationName := componentRelease.Namespace
-	projectName := componentRelease.Spec.Owner.ProjectName
-	componentName := componentRelease.Spec.Owner.ComponentName
-	componentUID := string(component.UID)
-	projectUID := string(project.UID)
-	dataPlaneName := dataPlane.Name
-	dataPlaneUID := string(dataPlane.UID)
-	environmentUID := string(environment.UID)
-
-	// Generate base name using platform naming conventions
-	// Format: {component}-{env}-{hash}
-	baseName := dpkubernetes.GenerateK8sName(componentName, environmentName)
-
-	// Generate namespace using platform naming conventions
-	// Format: dp-{org}-{project}-{env}-{hash}
-	namespace := dpkubernetes.GenerateK8sNameWithLengthLimit(
-		dpkubernetes.MaxNamespaceNameLength,
-		"dp", organizationName, projectName, environmentName,
-	)
-
-	// Build standard labels
-	standardLabels := map[string]string{
-		labels.LabelKeyOrganizationName: organizationName,
-		labels.LabelKeyProjectName:      projectName,
-		labels.LabelKeyComponentName:    componentName,
-		labels.LabelKeyEnvironmentName:  environmentName,
-	}
-
-	// Build pod selectors
-	podSelectors := map[string]string{
-		labels.LabelKeyComponentUID:   componentUID,
-		labels.LabelKeyEnvironmentUID: environmentUID,
-		labels.LabelKeyProjectUID:     projectUID,
-	}
-
-	return pipelinecontext.MetadataContext{
-		Name:            baseName,
-		Namespace:       namespace,
-		Labels:          standardLabels,
-		Annotations:     map[string]string{},
-		PodSelectors:    podSelectors,
-		ComponentName:   componentName,
-		ComponentUID:    componentUID,
-		ProjectName:     projectName,
-		ProjectUID:      projectUID,
-		DataPlaneName:   dataPlaneName,
-		DataPlaneUID:    dataPlaneUID,
-		EnvironmentName: environmentName,
-		EnvironmentUID:  environmentUID,
-	}
-}
-
-// collectSecretReferences collects all SecretReferences needed for rendering from workload and releaseBinding.
-func (r *Reconciler) collectSecretReferences(ctx context.Context, workload *openchoreov1alpha1.Workload, releaseBinding *openchoreov1alpha1.ReleaseBinding) (map[string]*openchoreov1alpha1.SecretReference, error) {
-	secretRefs := make(map[string]*openchoreov1alpha1.SecretReference)
-
-	// Helper function to collect secret reference
-	collectSecretRef := func(refName string, namespace string) error {
-		if refName == "" {
-			return nil
-		}
-		if _, exists := secretRefs[refName]; !exists {
-			secretRef := &openchoreov1alpha1.SecretReference{}
-			if err := r.Get(ctx, client.ObjectKey{
-				Name:      refName,
-				Namespace: namespace,
-			}, secretRef); err != nil {
-				return fmt.Errorf("failed to get SecretReference %s: %w", refName, err)
-			}
-			secretRefs[refName] = secretRef
-		}
-		return nil
-	}
-
-	if workload != nil {
-		for _, container := range workload.Spec.Containers {
-			for _, env := range container.Env {
-				if env.ValueFrom != nil && env.ValueFrom.SecretRef != nil {
-					if err := collectSecretRef(env.ValueFrom.SecretRef.Name, workload.Namespace); err != nil {
-						return nil, err
-					}
-				}
-			}
-
-			for _, file := range container.Files {
-				if file.ValueFrom != nil && file.ValueFrom.SecretRef != nil {
-					if err := collectSecretRef(file.ValueFrom.SecretRef.Name, workload.Namespace); err != nil {
-						return nil, err
-					}
-				}
-			}
-		}
-	}
-
-	// Collect from releaseBinding workload overrides if present
-	if releaseBinding.Spec.WorkloadOverrides != nil {
-		for _, container := range releaseBinding.Spec.WorkloadOverrides.Containers {
-			for _, env := range container.Env {
-				if env.ValueFrom != nil && env.ValueFrom.SecretRef != nil {
-					if err := collectSecretRef(env.ValueFrom.SecretRef.Name, releaseBinding.Namespace); err != nil {
-						return nil, err
-					}
-				}
-			}
-
-			for _, file := range container.Files {
-				if file.ValueFrom != nil && file.ValueFrom.SecretRef != nil {
-					if err := collectSecretRef(file.ValueFrom.SecretRef.Name, releaseBinding.Namespace); err != nil {
-						return nil, err
-					}
-				}
-			}
-		}
-	}
-
-	return secretRefs, nil
-}
-
 // reconcileRelease creates or updates the Release resource and sets appropriate status conditions.
 func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
 	componentRelease *openchoreov1alpha1.ComponentRelease, environment *openchoreov1alpha1.Environment,
 	dataPlane *openchoreov1alpha1.DataPlane, component *openchoreov1alpha1.Component, project *openchoreov1alpha1.Project) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
-	// Build MetadataContext with computed names
-	metadataContext := r.buildMetadataContext(componentRelease, component, project, dataPlane, environment, releaseBinding.Spec.Environment)
-
-	// Build Component from ComponentRelease for rendering
-	// The pipeline expects a Component object, so we need to reconstruct it from the ComponentRelease
-	snapshotComponent := buildComponentFromRelease(componentRelease)
-	snapshotComponentType := buildComponentTypeFromRelease(componentRelease)
-	snapshotTraits := buildTraitsFromRelease(componentRelease)
-	snapshotWorkload := buildWorkloadFromRelease(componentRelease)
-
-	// Collect all SecretReferences needed for rendering (must be done after workload merge)
-	secretReferences, err := r.collectSecretReferences(ctx, snapshotWorkload, releaseBinding)
-	if err != nil {
-		msg := fmt.Sprintf("Failed to collect SecretReferences: %v", err)
-		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
-			ReasonRenderingFailed, msg)
-		logger.Error(err, "Failed to collect SecretReferences")
-		return ctrl.Result{}, fmt.Errorf("failed to collect SecretReferences: %w", err)
-	}
-
-	// Prepare RenderInput
-	renderInput := &componentpipeline.RenderInput{
-		ComponentType:    snapshotComponentType,
-		Component:        snapshotComponent,
-		Traits:           snapshotTraits,
-		Workload:         snapshotWorkload,
-		Environment:      environment,
+	renderSources := &RenderSources{
 		ReleaseBinding:   releaseBinding,
+		ComponentRelease: componentRelease,
+		Environment:      environment,
 		DataPlane:        dataPlane,
-		SecretReferences: secretReferences,
-		Metadata:         metadataContext,
-	}
-
-	// Render resources using the shared pipeline instance
-	renderOutput, err := r.Pipeline.Render(renderInput)
-	if err != nil {
-		msg := fmt.Sprintf("Failed to render resources: %v", err)
-		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
-			ReasonRenderingFailed, msg)
-		logger.Error(err, "Failed to render resources")
-		return ctrl.Result{}, fmt.Errorf("failed to render resources: %w", err)
+		Component:        component,
+		Project:          project,
 	}
 
-	// Log warnings if any
-	if len(renderOutput.Metadata.Warnings) > 0 {
-		logger.Info("Rendering completed with warnings",
-			"warnings", renderOutput.Metadata.Warnings)
-	}
+	var releaseResources []openchoreov1alpha1.Resource
+	var rollout *rolloutResources
+	if releaseBinding.Spec.Rollout == nil {
+		releaseBinding.Status.Rollout = nil
 
-	// Convert rendered resources to Release format
-	releaseResources, err := r.convertToReleaseResources(renderOutput.Resources)
-	if err != nil {
-		msg := fmt.Sprintf("Failed to convert resources: %v", err)
-		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
-			ReasonRenderingFailed, msg)
-		logger.Error(err, "Failed to convert resources to Release format")
-		return ctrl.Result{}, fmt.Errorf("failed to convert resources: %w", err)
+		var err error
+		releaseResources, err = r.renderRelease(ctx, renderSources)
+		if err != nil {
+			var connErr *ConnectionNotResolvedError
+			if errors.As(err, &connErr) {
+				return r.markConnectionNotResolved(ctx, releaseBinding, connErr), nil
+			}
+			var policyErr *policy.ViolationError
+			if errors.As(err, &policyErr) {
+				r.markPolicyViolation(ctx, releaseBinding, policyErr)
+				return ctrl.Result{}, nil
+			}
+			msg := fmt.Sprintf("Failed to render Release: %v", err)
+			controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+				ReasonRenderingFailed, msg)
+			logger.Error(err, "Failed to render Release")
+			return ctrl.Result{}, err
+		}
+	} else {
+		var err error
+		rollout, err = r.renderRolloutRelease(ctx, renderSources)
+		if err != nil {
+			var connErr *ConnectionNotResolvedError
+			if errors.As(err, &connErr) {
+				return r.markConnectionNotResolved(ctx, releaseBinding, connErr), nil
+			}
+			var policyErr *policy.ViolationError
+			if errors.As(err, &policyErr) {
+				r.markPolicyViolation(ctx, releaseBinding, policyErr)
+				return ctrl.Result{}, nil
+			}
+			msg := fmt.Sprintf("Failed to render rollout: %v", err)
+			controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+				ReasonRenderingFailed, msg)
+			logger.Error(err, "Failed to render rollout")
+			return ctrl.Result{}, fmt.Errorf("failed to render rollout: %w", err)
+		}
+		releaseResources = rollout.Resources
 	}
 
 	// Create or update Release
-	// Release name format: {component}-{environment}-{releaseHash}
-	releaseName := fmt.Sprintf("%s-%s", componentRelease.Spec.Owner.ComponentName, releaseBinding.Spec.Environment)
+	releaseName := MakeReleaseName(componentRelease.Spec.Owner.ComponentName, releaseBinding.Spec.Environment)
 	release := &openchoreov1alpha1.Release{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      releaseName,
@@ -443,6 +323,8 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 			},
 			EnvironmentName: releaseBinding.Spec.Environment,
 			Resources:       releaseResources,
+			// The drift mode is set on the Release itself, keep it across renders
+			DriftMode: release.Spec.DriftMode,
 		}
 
 		return controllerutil.SetControllerReference(releaseBinding, release, r.Scheme)
@@ -487,122 +369,164 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 	// Set overall Ready condition based on ReleaseSynced and ResourcesReady
 	r.setReadyCondition(releaseBinding)
 
+	// Move a progressive rollout forward now that the Release reflects its current step
+	if rollout != nil {
+		// The Release status is only judged once it was reported for the current spec. Otherwise the health
+		// belongs to the previous step, and the rollout would move on before the new step was applied.
+		if release.Status.ObservedGeneration < release.Generation {
+			logger.Info("Waiting for the Release to be applied before evaluating the rollout",
+				"release", release.Name, "generation", release.Generation,
+				"observedGeneration", release.Status.ObservedGeneration)
+			return ctrl.Result{RequeueAfter: rolloutPollInterval}, nil
+		}
+		health := evaluateRolloutHealth(rollout.Resources, rollout.CanaryIDs, release.Status.Resources)
+		return r.progressRollout(ctx, releaseBinding, health, rollout, metav1.Now()), nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
-// Helper functions to build snapshot structures from ComponentRelease
-
-func buildComponentFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.Component {
-	return &openchoreov1alpha1.Component{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      componentRelease.Spec.Owner.ComponentName,
-			Namespace: componentRelease.Namespace,
-		},
-		Spec: openchoreov1alpha1.ComponentSpec{
-			Owner: openchoreov1alpha1.ComponentOwner{
-				ProjectName: componentRelease.Spec.Owner.ProjectName,
-			},
-			Parameters: componentRelease.Spec.ComponentProfile.Parameters,
-			Traits:     componentRelease.Spec.ComponentProfile.Traits,
-		},
-	}
+// markConnectionNotResolved reports a workload connection that cannot be resolved yet. The binding is
+// rendered again when a Release in the environment changes, or after connectionRetryInterval.
+func (r *Reconciler) markConnectionNotResolved(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	connErr *ConnectionNotResolvedError) ctrl.Result {
+	controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced, ReasonConnectionNotResolved, connErr.Error())
+	r.setReadyCondition(releaseBinding)
+	log.FromContext(ctx).Info("Waiting for connection target", "connection", connErr.Connection, "reason", connErr.Reason)
+	return ctrl.Result{RequeueAfter: connectionRetryInterval}
 }
 
-func buildComponentTypeFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.ComponentType {
-	return &openchoreov1alpha1.ComponentType{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "from-release", // Name doesn't matter for rendering
-			Namespace: componentRelease.Namespace,
-		},
-		Spec: componentRelease.Spec.ComponentType,
+// markPolicyViolation reports rendered resources that violate enforced guardrail rules. The Release is
+// left untouched, and the binding is rendered again when the binding or a GuardrailPolicy changes.
+func (r *Reconciler) markPolicyViolation(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	policyErr *policy.ViolationError) {
+	violations := make([]string, len(policyErr.Violations))
+	for i, violation := range policyErr.Violations {
+		violations[i] = violation.String()
 	}
+	msg := fmt.Sprintf("Rendered resources violate guardrail policies:\n%s", strings.Join(violations, "\n"))
+	controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced, ReasonPolicyViolation, msg)
+	r.setReadyCondition(releaseBinding)
+	log.FromContext(ctx).Info("Rendered resources violate guardrail policies", "violations", violations)
 }
 
-func buildTraitsFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) []openchoreov1alpha1.Trait {
-	if len(componentRelease.Spec.Traits) == 0 {
-		return nil
-	}
+// renderRelease renders the resources of the bound ComponentRelease into the Release format.
+func (r *Reconciler) renderRelease(ctx context.Context, src *RenderSources) ([]openchoreov1alpha1.Resource, error) {
+	logger := log.FromContext(ctx)
 
-	traits := make([]openchoreov1alpha1.Trait, 0, len(componentRelease.Spec.Traits))
-	for name, spec := range componentRelease.Spec.Traits {
-		traits = append(traits, openchoreov1alpha1.Trait{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      name,
-				Namespace: componentRelease.Namespace,
-			},
-			Spec: spec,
-		})
+	// Build the pipeline input from the ComponentRelease snapshot
+	renderInput, err := BuildRenderInput(ctx, r.Client, src)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build render input: %w", err)
 	}
-	return traits
-}
 
-func buildWorkloadFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.Workload {
-	return &openchoreov1alpha1.Workload{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "from-release", // Name doesn't matter for rendering
-			Namespace: componentRelease.Namespace,
-		},
-		Spec: openchoreov1alpha1.WorkloadSpec{
-			Owner: openchoreov1alpha1.WorkloadOwner{
-				ProjectName:   componentRelease.Spec.Owner.ProjectName,
-				ComponentName: componentRelease.Spec.Owner.ComponentName,
-			},
-			WorkloadTemplateSpec: componentRelease.Spec.Workload,
-		},
+	// Render resources using the shared pipeline instance
+	renderOutput, err := r.Pipeline.Render(renderInput)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render resources: %w", err)
 	}
-}
-
-// convertToReleaseResources converts unstructured resources to Release.Resource format
-func (r *Reconciler) convertToReleaseResources(
-	resources []map[string]any,
-) ([]openchoreov1alpha1.Resource, error) {
-	releaseResources := make([]openchoreov1alpha1.Resource, 0, len(resources))
 
-	for i, resource := range resources {
-		// Generate resource ID
-		id := r.generateResourceID(resource, i)
-
-		// Marshal to JSON bytes
-		rawJSON, err := json.Marshal(resource)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal resource to JSON (resourceID: %s): %w", id, err)
-		}
+	// Log warnings if any
+	if len(renderOutput.Metadata.Warnings) > 0 {
+		logger.Info("Rendering completed with warnings",
+			"warnings", renderOutput.Metadata.Warnings)
+	}
 
-		releaseResources = append(releaseResources, openchoreov1alpha1.Resource{
-			ID: id,
-			Object: &runtime.RawExtension{
-				Raw: rawJSON,
-			},
-		})
+	// Convert rendered resources to Release format
+	releaseResources, err := ConvertToReleaseResources(renderOutput.Resources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert resources: %w", err)
 	}
 	return releaseResources, nil
 }
 
-// generateResourceID creates a unique ID for a resource
-func (r *Reconciler) generateResourceID(resource map[string]any, index int) string {
-	kind, _ := resource["kind"].(string)
-	metadata, _ := resource["metadata"].(map[string]any)
-	name, _ := metadata["name"].(string)
-
-	if kind != "" && name != "" {
-		resourceID := fmt.Sprintf("%s-%s", strings.ToLower(kind), name)
-		if len(resourceID) > dpkubernetes.MaxLabelNameLength {
-			return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxLabelNameLength,
-				strings.ToLower(kind),
-				name)
-		}
-		return resourceID
+// renderRolloutRelease updates the rollout status for the bound ComponentRelease and renders the
+// stable and canary tracks for the current phase.
+func (r *Reconciler) renderRolloutRelease(ctx context.Context, src *RenderSources) (*rolloutResources, error) {
+	releaseBinding := src.ReleaseBinding
+	now := metav1.Now()
+	syncRolloutTarget(releaseBinding, now)
+	status := releaseBinding.Status.Rollout
+
+	stableRelease, err := r.getRolloutRelease(ctx, releaseBinding, status.StableRelease, src.ComponentRelease)
+	if apierrors.IsNotFound(err) {
+		// Without the stable snapshot there is nothing to roll back to, adopt the bound release instead
+		log.FromContext(ctx).Info("Stable ComponentRelease not found, adopting the bound release",
+			"stableRelease", status.StableRelease, "componentRelease", src.ComponentRelease.Name)
+		releaseBinding.Status.Rollout = nil
+		syncRolloutTarget(releaseBinding, now)
+		status = releaseBinding.Status.Rollout
+		stableRelease = src.ComponentRelease
+	} else if err != nil {
+		return nil, err
+	}
+
+	// The canary track is held back until the stable Services are narrowed to the stable pods,
+	// as the canary pods would otherwise also be selected by the stable Services
+	if !status.StableTrackLabeled {
+		return r.renderRollout(ctx, src, stableRelease, nil, 0, false)
+	}
+
+	var canaryRelease *openchoreov1alpha1.ComponentRelease
+	var canaryWeight int32
+	switch status.Phase {
+	case openchoreov1alpha1.RolloutPhaseProgressing:
+		canaryRelease = src.ComponentRelease
+		canaryWeight = status.CanaryWeight
+	case openchoreov1alpha1.RolloutPhasePromoting:
+		// The stable track is replaced by the new release while the canary keeps serving all traffic
+		canaryRelease = src.ComponentRelease
+		stableRelease = src.ComponentRelease
+		canaryWeight = 100
+	}
+
+	return r.renderRollout(ctx, src, stableRelease, canaryRelease, canaryWeight, true)
+}
+
+// getRolloutRelease returns the named ComponentRelease of a rollout, reusing the bound release if it matches.
+func (r *Reconciler) getRolloutRelease(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	name string, bound *openchoreov1alpha1.ComponentRelease) (*openchoreov1alpha1.ComponentRelease, error) {
+	if name == bound.Name {
+		return bound, nil
 	}
 
-	// Fallback: use index
-	return fmt.Sprintf("resource-%d", index)
+	componentRelease := &openchoreov1alpha1.ComponentRelease{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: releaseBinding.Namespace}, componentRelease); err != nil {
+		return nil, err
+	}
+	if err := r.validateComponentRelease(componentRelease, releaseBinding); err != nil {
+		return nil, fmt.Errorf("invalid ComponentRelease %q: %w", name, err)
+	}
+	return componentRelease, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	ctx := context.Background()
+
+	// Set up field indexes for efficient lookups
+	if err := r.setupBindingTargetIndex(ctx, mgr); err != nil {
+		return fmt.Errorf("failed to setup binding target index: %w", err)
+	}
+
+	if err := r.setupReleaseNameIndex(ctx, mgr); err != nil {
+		return fmt.Errorf("failed to setup release name index: %w", err)
+	}
+
+	if err := r.setupConnectionTargetIndex(ctx, mgr); err != nil {
+		return fmt.Errorf("failed to setup connection target index: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&openchoreov1alpha1.ReleaseBinding{}).
 		Owns(&openchoreov1alpha1.Release{}).
+		// Render the connections of other bindings in the environment again when a target Release changes
+		Watches(&openchoreov1alpha1.Release{},
+			handler.EnqueueRequestsFromMapFunc(r.listReleaseBindingsForRelease),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&openchoreov1alpha1.GuardrailPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.listReleaseBindingsForGuardrailPolicy),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Named("releasebinding").
 		Complete(r)
 }
@@ -56,6 +56,11 @@ const (
 
 	// ReasonRenderingFailed indicates failure to render resources
 	ReasonRenderingFailed controller.ConditionReason = "RenderingFailed"
+	// ReasonConnectionNotResolved indicates a workload connection targets a component that is not deployed
+	// to the environment
+	ReasonConnectionNotResolved controller.ConditionReason = "ConnectionNotResolved"
+	// ReasonPolicyViolation indicates the rendered resources violate an enforced guardrail policy
+	ReasonPolicyViolation controller.ConditionReason = "PolicyViolation"
 
 	// Release management issues (Status=False)
 
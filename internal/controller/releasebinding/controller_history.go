@@ -0,0 +1,42 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// maxReleaseHistory is the number of releases kept in the history of a ReleaseBinding.
+// It matches the MaxItems validation of status.history.
+const maxReleaseHistory = 20
+
+// RecordReleaseHistory adds the release referenced by the ReleaseBinding to the front of its history
+// if it is not the most recently recorded release.
+//
+// boundBy is the user who bound the release. The controller records releases without a user; the
+// OpenChoreo API records the user through the status subresource after it changes the release, and
+// fills in the user of an entry the controller recorded in the meantime.
+func RecordReleaseHistory(releaseBinding *openchoreov1alpha1.ReleaseBinding, boundBy string, now metav1.Time) {
+	releaseName := releaseBinding.Spec.ReleaseName
+	history := releaseBinding.Status.History
+	if len(history) > 0 && history[0].ReleaseName == releaseName {
+		if history[0].BoundBy == "" {
+			history[0].BoundBy = boundBy
+		}
+		return
+	}
+
+	entry := openchoreov1alpha1.ReleaseHistoryEntry{
+		ReleaseName: releaseName,
+		BoundAt:     now,
+		BoundBy:     boundBy,
+	}
+	history = append([]openchoreov1alpha1.ReleaseHistoryEntry{entry}, history...)
+	if len(history) > maxReleaseHistory {
+		history = history[:maxReleaseHistory]
+	}
+	releaseBinding.Status.History = history
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestRecordReleaseHistory(t *testing.T) {
+	earlier := metav1.NewTime(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	now := metav1.NewTime(earlier.Add(time.Hour))
+	previous := openchoreov1alpha1.ReleaseHistoryEntry{ReleaseName: "greeter-v1", BoundAt: earlier, BoundBy: "alice@example.com"}
+
+	tests := []struct {
+		name        string
+		releaseName string
+		boundBy     string
+		history     []openchoreov1alpha1.ReleaseHistoryEntry
+		want        []openchoreov1alpha1.ReleaseHistoryEntry
+	}{
+		{
+			name:        "First release",
+			releaseName: "greeter-v1",
+			want:        []openchoreov1alpha1.ReleaseHistoryEntry{{ReleaseName: "greeter-v1", BoundAt: now}},
+		},
+		{
+			name:        "Same release",
+			releaseName: "greeter-v1",
+			boundBy:     "bob@example.com",
+			history:     []openchoreov1alpha1.ReleaseHistoryEntry{previous},
+			want:        []openchoreov1alpha1.ReleaseHistoryEntry{previous},
+		},
+		{
+			name:        "New release bound through the API",
+			releaseName: "greeter-v2",
+			boundBy:     "bob@example.com",
+			history:     []openchoreov1alpha1.ReleaseHistoryEntry{previous},
+			want: []openchoreov1alpha1.ReleaseHistoryEntry{
+				{ReleaseName: "greeter-v2", BoundAt: now, BoundBy: "bob@example.com"},
+				previous,
+			},
+		},
+		{
+			name:        "Release recorded by the controller before the API",
+			releaseName: "greeter-v2",
+			boundBy:     "bob@example.com",
+			history:     []openchoreov1alpha1.ReleaseHistoryEntry{{ReleaseName: "greeter-v2", BoundAt: earlier}, previous},
+			want: []openchoreov1alpha1.ReleaseHistoryEntry{
+				{ReleaseName: "greeter-v2", BoundAt: earlier, BoundBy: "bob@example.com"},
+				previous,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			releaseBinding := &openchoreov1alpha1.ReleaseBinding{
+				Spec:   openchoreov1alpha1.ReleaseBindingSpec{ReleaseName: tt.releaseName},
+				Status: openchoreov1alpha1.ReleaseBindingStatus{History: tt.history},
+			}
+
+			RecordReleaseHistory(releaseBinding, tt.boundBy, now)
+
+			if diff := cmp.Diff(tt.want, releaseBinding.Status.History); diff != "" {
+				t.Errorf("RecordReleaseHistory() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRecordReleaseHistoryLimit(t *testing.T) {
+	releaseBinding := &openchoreov1alpha1.ReleaseBinding{}
+	start := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	for i := 0; i < maxReleaseHistory+5; i++ {
+		releaseBinding.Spec.ReleaseName = fmt.Sprintf("greeter-v%d", i)
+		RecordReleaseHistory(releaseBinding, "", metav1.NewTime(start.Add(time.Duration(i)*time.Minute)))
+	}
+
+	history := releaseBinding.Status.History
+	if len(history) != maxReleaseHistory {
+		t.Fatalf("len(history) = %d, want %d", len(history), maxReleaseHistory)
+	}
+	if got, want := history[0].ReleaseName, fmt.Sprintf("greeter-v%d", maxReleaseHistory+4); got != want {
+		t.Errorf("history[0] = %q, want the latest release %q", got, want)
+	}
+	if got, want := history[maxReleaseHistory-1].ReleaseName, "greeter-v5"; got != want {
+		t.Errorf("oldest entry = %q, want %q", got, want)
+	}
+}
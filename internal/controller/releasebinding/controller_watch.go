@@ -0,0 +1,201 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/trait"
+)
+
+const (
+	// bindingTargetIndex is the field index name for ReleaseBinding environment and owner fields
+	bindingTargetIndex = "spec.environment/spec.owner.projectName/spec.owner.componentName"
+	// releaseNameIndex is the field index name for the ComponentRelease bound by a ReleaseBinding
+	releaseNameIndex = "spec.releaseName"
+	// connectionTargetIndex is the field index name for the components targeted by the api
+	// connections of a ComponentRelease
+	connectionTargetIndex = "spec.workload.connections"
+)
+
+// makeBindingTargetKey returns the bindingTargetIndex key of a component in an environment.
+func makeBindingTargetKey(environment, projectName, componentName string) string {
+	return fmt.Sprintf("%s/%s/%s", environment, projectName, componentName)
+}
+
+// makeConnectionTargetKey returns the connectionTargetIndex key of a component.
+func makeConnectionTargetKey(projectName, componentName string) string {
+	return fmt.Sprintf("%s/%s", projectName, componentName)
+}
+
+// setupBindingTargetIndex sets up the field index for ReleaseBinding environment and owner fields
+func (r *Reconciler) setupBindingTargetIndex(ctx context.Context, mgr ctrl.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(ctx, &openchoreov1alpha1.ReleaseBinding{},
+		bindingTargetIndex, func(obj client.Object) []string {
+			binding := obj.(*openchoreov1alpha1.ReleaseBinding)
+			return []string{makeBindingTargetKey(binding.Spec.Environment,
+				binding.Spec.Owner.ProjectName, binding.Spec.Owner.ComponentName)}
+		})
+}
+
+// setupReleaseNameIndex sets up the field index for the ComponentRelease bound by a ReleaseBinding
+func (r *Reconciler) setupReleaseNameIndex(ctx context.Context, mgr ctrl.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(ctx, &openchoreov1alpha1.ReleaseBinding{},
+		releaseNameIndex, func(obj client.Object) []string {
+			binding := obj.(*openchoreov1alpha1.ReleaseBinding)
+			if binding.Spec.ReleaseName == "" {
+				return []string{}
+			}
+			return []string{binding.Spec.ReleaseName}
+		})
+}
+
+// setupConnectionTargetIndex sets up the field index for the components targeted by the api connections
+// of a ComponentRelease. The target project defaults to the project of the ComponentRelease.
+func (r *Reconciler) setupConnectionTargetIndex(ctx context.Context, mgr ctrl.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(ctx, &openchoreov1alpha1.ComponentRelease{},
+		connectionTargetIndex, func(obj client.Object) []string {
+			componentRelease := obj.(*openchoreov1alpha1.ComponentRelease)
+			targets := []string{}
+			for _, connection := range componentRelease.Spec.Workload.Connections {
+				if connection.Type != openchoreov1alpha1.ConnectionTypeAPI {
+					continue
+				}
+				projectName := connection.Params[connectionParamProjectName]
+				if projectName == "" {
+					projectName = componentRelease.Spec.Owner.ProjectName
+				}
+				targets = append(targets, makeConnectionTargetKey(projectName, connection.Params[connectionParamComponentName]))
+			}
+			return targets
+		})
+}
+
+// listReleaseBindingsForRelease enqueues the ReleaseBindings in the environment of the given Release
+// whose bound ComponentRelease has an api connection targeting the component of the Release, so that
+// their connections are rendered again when the Release changes.
+func (r *Reconciler) listReleaseBindingsForRelease(ctx context.Context, obj client.Object) []reconcile.Request {
+	release := obj.(*openchoreov1alpha1.Release)
+	logger := ctrl.LoggerFrom(ctx)
+
+	var consumers openchoreov1alpha1.ComponentReleaseList
+	if err := r.List(ctx, &consumers, client.InNamespace(release.Namespace),
+		client.MatchingFields{connectionTargetIndex: makeConnectionTargetKey(
+			release.Spec.Owner.ProjectName, release.Spec.Owner.ComponentName)}); err != nil {
+		logger.Error(err, "Failed to list ComponentReleases for Release", "release", release.Name, "namespace", release.Namespace)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, consumer := range consumers.Items {
+		var bindings openchoreov1alpha1.ReleaseBindingList
+		if err := r.List(ctx, &bindings, client.InNamespace(release.Namespace),
+			client.MatchingFields{releaseNameIndex: consumer.Name}); err != nil {
+			logger.Error(err, "Failed to list ReleaseBindings for Release", "release", release.Name, "namespace", release.Namespace)
+			return nil
+		}
+		for _, binding := range bindings.Items {
+			if binding.Spec.Environment != release.Spec.EnvironmentName {
+				continue
+			}
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: binding.Name, Namespace: binding.Namespace},
+			})
+		}
+	}
+	return requests
+}
+
+// listReleaseBindingsForGuardrailPolicy enqueues the ReleaseBindings the rules of the given GuardrailPolicy
+// apply to: bindings whose Release contains a resource targeted by a rule, and bindings whose rendering
+// was stopped by a policy violation. Updates map both the old and the new policy, so the bindings of
+// removed rules are enqueued as well.
+func (r *Reconciler) listReleaseBindingsForGuardrailPolicy(ctx context.Context, obj client.Object) []reconcile.Request {
+	guardrailPolicy := obj.(*openchoreov1alpha1.GuardrailPolicy)
+	logger := ctrl.LoggerFrom(ctx)
+
+	var releases openchoreov1alpha1.ReleaseList
+	if err := r.List(ctx, &releases, client.InNamespace(guardrailPolicy.Namespace)); err != nil {
+		logger.Error(err, "Failed to list Releases for GuardrailPolicy", "guardrailPolicy", guardrailPolicy.Name,
+			"namespace", guardrailPolicy.Namespace)
+		return nil
+	}
+	targeted := sets.New[string]()
+	for i := range releases.Items {
+		owner := metav1.GetControllerOf(&releases.Items[i])
+		if owner == nil || owner.Kind != "ReleaseBinding" {
+			continue
+		}
+		if policyTargetsResources(guardrailPolicy, releases.Items[i].Spec.Resources) {
+			targeted.Insert(owner.Name)
+		}
+	}
+
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := r.List(ctx, &bindings, client.InNamespace(guardrailPolicy.Namespace)); err != nil {
+		logger.Error(err, "Failed to list ReleaseBindings for GuardrailPolicy", "guardrailPolicy", guardrailPolicy.Name,
+			"namespace", guardrailPolicy.Namespace)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, binding := range bindings.Items {
+		synced := meta.FindStatusCondition(binding.Status.Conditions, string(ConditionReleaseSynced))
+		violated := synced != nil && synced.Reason == string(ReasonPolicyViolation)
+		if !violated && !targeted.Has(binding.Name) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: binding.Name, Namespace: binding.Namespace},
+		})
+	}
+	return requests
+}
+
+// policyTargetsResources reports whether a rule of the policy applies to any of the given Release resources.
+func policyTargetsResources(guardrailPolicy *openchoreov1alpha1.GuardrailPolicy, resources []openchoreov1alpha1.Resource) bool {
+	// Rules select resources by apiVersion and kind only
+	objects := make([]map[string]any, 0, len(resources))
+	for _, resource := range resources {
+		if resource.Object == nil {
+			continue
+		}
+		var typeMeta struct {
+			APIVersion string `json:"apiVersion"`
+			Kind       string `json:"kind"`
+		}
+		if err := json.Unmarshal(resource.Object.Raw, &typeMeta); err != nil {
+			continue
+		}
+		objects = append(objects, map[string]any{"apiVersion": typeMeta.APIVersion, "kind": typeMeta.Kind})
+	}
+	if len(objects) == 0 {
+		return false
+	}
+
+	for _, rule := range guardrailPolicy.Spec.Rules {
+		if rule.Target == nil {
+			return true
+		}
+		if len(trait.FindTargetResources(objects, trait.TargetSpec{
+			Kind:    rule.Target.Kind,
+			Group:   rule.Target.Group,
+			Version: rule.Target.Version,
+		})) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestPolicyTargetsResources(t *testing.T) {
+	resources := []openchoreov1alpha1.Resource{
+		{ID: "deployment", Object: &runtime.RawExtension{Raw: []byte(
+			`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"api"}}`)}},
+		{ID: "service", Object: &runtime.RawExtension{Raw: []byte(
+			`{"apiVersion":"v1","kind":"Service","metadata":{"name":"api"}}`)}},
+	}
+
+	tests := []struct {
+		name      string
+		targets   []*openchoreov1alpha1.GuardrailTarget
+		resources []openchoreov1alpha1.Resource
+		want      bool
+	}{
+		{
+			name:      "Rule without target",
+			targets:   []*openchoreov1alpha1.GuardrailTarget{nil},
+			resources: resources,
+			want:      true,
+		},
+		{
+			name:      "Rule targeting a rendered kind",
+			targets:   []*openchoreov1alpha1.GuardrailTarget{{Group: "apps", Kind: "Deployment"}},
+			resources: resources,
+			want:      true,
+		},
+		{
+			name: "Rules targeting other kinds",
+			targets: []*openchoreov1alpha1.GuardrailTarget{
+				{Group: "batch", Kind: "CronJob"},
+				{Group: "apps", Kind: "Service"},
+			},
+			resources: resources,
+			want:      false,
+		},
+		{
+			name:    "Release without resources",
+			targets: []*openchoreov1alpha1.GuardrailTarget{nil},
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guardrailPolicy := &openchoreov1alpha1.GuardrailPolicy{}
+			for _, target := range tt.targets {
+				guardrailPolicy.Spec.Rules = append(guardrailPolicy.Spec.Rules,
+					openchoreov1alpha1.GuardrailRule{Name: "rule", Target: target, Rule: "${true}"})
+			}
+			if got := policyTargetsResources(guardrailPolicy, tt.resources); got != tt.want {
+				t.Errorf("policyTargetsResources() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+)
+
+// RenderSources holds the resolved objects needed to render a ReleaseBinding.
+// It is shared by the reconciler and by callers that render a binding without
+// persisting the result (e.g. dry-run).
+type RenderSources struct {
+	ReleaseBinding   *openchoreov1alpha1.ReleaseBinding
+	ComponentRelease *openchoreov1alpha1.ComponentRelease
+	Environment      *openchoreov1alpha1.Environment
+	DataPlane        *openchoreov1alpha1.DataPlane
+	Component        *openchoreov1alpha1.Component
+	Project          *openchoreov1alpha1.Project
+}
+
+// MakeReleaseName returns the name of the Release created for a component in an environment.
+func MakeReleaseName(componentName, environment string) string {
+	return fmt.Sprintf("%s-%s", componentName, environment)
+}
+
+// BuildRenderInput builds the component pipeline input for a ReleaseBinding from the
+// ComponentRelease snapshot. SecretReferences referenced by the workload and the binding's
+// workload overrides are fetched, the workload connections are resolved, and the guardrail
+// policies of the organization are listed, using the given reader.
+func BuildRenderInput(ctx context.Context, c client.Reader, src *RenderSources) (*componentpipeline.RenderInput, error) {
+	// Build MetadataContext with computed names
+	metadataContext := pipelinecontext.BuildMetadataContext(&pipelinecontext.MetadataContextInput{
+		OrganizationName: src.ComponentRelease.Namespace,
+		ProjectName:      src.ComponentRelease.Spec.Owner.ProjectName,
+		ProjectUID:       string(src.Project.UID),
+		ComponentName:    src.ComponentRelease.Spec.Owner.ComponentName,
+		ComponentUID:     string(src.Component.UID),
+		EnvironmentName:  src.ReleaseBinding.Spec.Environment,
+		EnvironmentUID:   string(src.Environment.UID),
+		DataPlaneName:    src.DataPlane.Name,
+		DataPlaneUID:     string(src.DataPlane.UID),
+	})
+
+	// The pipeline expects a Component object, so we need to reconstruct it from the ComponentRelease
+	snapshotWorkload := buildWorkloadFromRelease(src.ComponentRelease)
+
+	secretReferences, err := CollectSecretReferences(ctx, c, snapshotWorkload, src.ReleaseBinding)
+	if err != nil {
+		return nil, err
+	}
+
+	connections, err := ResolveConnections(ctx, c, src.ReleaseBinding, snapshotWorkload)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := &openchoreov1alpha1.GuardrailPolicyList{}
+	if err := c.List(ctx, policies, client.InNamespace(src.ReleaseBinding.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list GuardrailPolicies: %w", err)
+	}
+
+	return &componentpipeline.RenderInput{
+		ComponentType:    buildComponentTypeFromRelease(src.ComponentRelease),
+		Component:        buildComponentFromRelease(src.ComponentRelease),
+		Traits:           buildTraitsFromRelease(src.ComponentRelease),
+		Workload:         snapshotWorkload,
+		Environment:      src.Environment,
+		ReleaseBinding:   src.ReleaseBinding,
+		DataPlane:        src.DataPlane,
+		SecretReferences: secretReferences,
+		Metadata:         metadataContext,
+		Connections:      connections,
+		Policies:         policies.Items,
+	}, nil
+}
+
+// CollectSecretReferences collects all SecretReferences needed for rendering from workload and releaseBinding.
+func CollectSecretReferences(ctx context.Context, c client.Reader, workload *openchoreov1alpha1.Workload,
+	releaseBinding *openchoreov1alpha1.ReleaseBinding) (map[string]*openchoreov1alpha1.SecretReference, error) {
+	secretRefs := make(map[string]*openchoreov1alpha1.SecretReference)
+
+	// Helper function to collect secret reference
+	collectSecretRef := func(refName string, namespace string) error {
+		if refName == "" {
+			return nil
+		}
+		if _, exists := secretRefs[refName]; !exists {
+			secretRef := &openchoreov1alpha1.SecretReference{}
+			if err := c.Get(ctx, client.ObjectKey{
+				Name:      refName,
+				Namespace: namespace,
+			}, secretRef); err != nil {
+				return fmt.Errorf("failed to get SecretReference %s: %w", refName, err)
+			}
+			secretRefs[refName] = secretRef
+		}
+		return nil
+	}
+
+	if workload != nil {
+		for _, container := range workload.Spec.Containers {
+			for _, env := range container.Env {
+				if env.ValueFrom != nil && env.ValueFrom.SecretRef != nil {
+					if err := collectSecretRef(env.ValueFrom.SecretRef.Name, workload.Namespace); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			for _, file := range container.Files {
+				if file.ValueFrom != nil && file.ValueFrom.SecretRef != nil {
+					if err := collectSecretRef(file.ValueFrom.SecretRef.Name, workload.Namespace); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+
+	// Collect from releaseBinding workload overrides if present
+	if releaseBinding.Spec.WorkloadOverrides != nil {
+		for _, container := range releaseBinding.Spec.WorkloadOverrides.Containers {
+			for _, env := range container.Env {
+				if env.ValueFrom != nil && env.ValueFrom.SecretRef != nil {
+					if err := collectSecretRef(env.ValueFrom.SecretRef.Name, releaseBinding.Namespace); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			for _, file := range container.Files {
+				if file.ValueFrom != nil && file.ValueFrom.SecretRef != nil {
+					if err := collectSecretRef(file.ValueFrom.SecretRef.Name, releaseBinding.Namespace); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+
+	return secretRefs, nil
+}
+
+// Helper functions to build snapshot structures from ComponentRelease
+
+func buildComponentFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.Component {
+	return &openchoreov1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      componentRelease.Spec.Owner.ComponentName,
+			Namespace: componentRelease.Namespace,
+		},
+		Spec: openchoreov1alpha1.ComponentSpec{
+			Owner: openchoreov1alpha1.ComponentOwner{
+				ProjectName: componentRelease.Spec.Owner.ProjectName,
+			},
+			Parameters: componentRelease.Spec.ComponentProfile.Parameters,
+			Traits:     componentRelease.Spec.ComponentProfile.Traits,
+		},
+	}
+}
+
+func buildComponentTypeFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.ComponentType {
+	return &openchoreov1alpha1.ComponentType{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "from-release", // Name doesn't matter for rendering
+			Namespace: componentRelease.Namespace,
+		},
+		Spec: componentRelease.Spec.ComponentType,
+	}
+}
+
+func buildTraitsFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) []openchoreov1alpha1.Trait {
+	if len(componentRelease.Spec.Traits) == 0 {
+		return nil
+	}
+
+	traits := make([]openchoreov1alpha1.Trait, 0, len(componentRelease.Spec.Traits))
+	for name, spec := range componentRelease.Spec.Traits {
+		traits = append(traits, openchoreov1alpha1.Trait{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: componentRelease.Namespace,
+			},
+			Spec: spec,
+		})
+	}
+	return traits
+}
+
+func buildWorkloadFromRelease(componentRelease *openchoreov1alpha1.ComponentRelease) *openchoreov1alpha1.Workload {
+	return &openchoreov1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "from-release", // Name doesn't matter for rendering
+			Namespace: componentRelease.Namespace,
+		},
+		Spec: openchoreov1alpha1.WorkloadSpec{
+			Owner: openchoreov1alpha1.WorkloadOwner{
+				ProjectName:   componentRelease.Spec.Owner.ProjectName,
+				ComponentName: componentRelease.Spec.Owner.ComponentName,
+			},
+			WorkloadTemplateSpec: componentRelease.Spec.Workload,
+		},
+	}
+}
+
+// ConvertToReleaseResources converts unstructured resources to Release.Resource format
+func ConvertToReleaseResources(resources []map[string]any) ([]openchoreov1alpha1.Resource, error) {
+	releaseResources := make([]openchoreov1alpha1.Resource, 0, len(resources))
+
+	for i, resource := range resources {
+		// Generate resource ID
+		id := GenerateResourceID(resource, i)
+
+		// Marshal to JSON bytes
+		rawJSON, err := json.Marshal(resource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal resource to JSON (resourceID: %s): %w", id, err)
+		}
+
+		releaseResources = append(releaseResources, openchoreov1alpha1.Resource{
+			ID: id,
+			Object: &runtime.RawExtension{
+				Raw: rawJSON,
+			},
+		})
+	}
+	return releaseResources, nil
+}
+
+// GenerateResourceID creates a unique ID for a resource
+func GenerateResourceID(resource map[string]any, index int) string {
+	kind, _ := resource["kind"].(string)
+	metadata, _ := resource["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+
+	if kind != "" && name != "" {
+		resourceID := fmt.Sprintf("%s-%s", strings.ToLower(kind), name)
+		if len(resourceID) > dpkubernetes.MaxLabelNameLength {
+			return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxLabelNameLength,
+				strings.ToLower(kind),
+				name)
+		}
+		return resourceID
+	}
+
+	// Fallback: use index
+	return fmt.Sprintf("resource-%d", index)
+}
@@ -0,0 +1,345 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+const (
+	// defaultRolloutInterval is how long a step is observed when neither the step nor the analysis sets a duration
+	defaultRolloutInterval = time.Minute
+
+	// rolloutPollInterval is how often a rollout waiting for the resources to become healthy is re-evaluated
+	rolloutPollInterval = 10 * time.Second
+)
+
+// MetricsQuerier evaluates the PromQL queries of a rollout analysis.
+// It is implemented by the observer's prometheus.MetricsService.
+type MetricsQuerier interface {
+	QueryScalar(ctx context.Context, query string, at time.Time) (float64, error)
+}
+
+// rolloutQueryEngine renders the ${...} expressions of rollout analysis queries
+var rolloutQueryEngine = template.NewEngine()
+
+// rolloutHealth is the aggregated health of the two tracks of a rollout, as reported in the Release status.
+type rolloutHealth struct {
+	Stable openchoreov1alpha1.HealthStatus
+	Canary openchoreov1alpha1.HealthStatus
+}
+
+// rolloutSteps returns the effective steps of a rollout strategy.
+func rolloutSteps(strategy *openchoreov1alpha1.RolloutStrategy) []openchoreov1alpha1.RolloutStep {
+	if strategy.Type == openchoreov1alpha1.RolloutStrategyBlueGreen {
+		// Preview the new release without traffic, then switch over in one go
+		return []openchoreov1alpha1.RolloutStep{{Weight: 0}, {Weight: 100}}
+	}
+	if len(strategy.Steps) == 0 {
+		return []openchoreov1alpha1.RolloutStep{{Weight: 100}}
+	}
+	return strategy.Steps
+}
+
+// rolloutStepPause returns how long the given step is observed before the analysis runs.
+func rolloutStepPause(strategy *openchoreov1alpha1.RolloutStrategy, step int32) time.Duration {
+	steps := rolloutSteps(strategy)
+	if int(step) < len(steps) && steps[step].Pause != nil {
+		return steps[step].Pause.Duration
+	}
+	return rolloutAnalysisInterval(strategy)
+}
+
+func rolloutAnalysisInterval(strategy *openchoreov1alpha1.RolloutStrategy) time.Duration {
+	if strategy.Analysis != nil && strategy.Analysis.Interval != nil {
+		return strategy.Analysis.Interval.Duration
+	}
+	return defaultRolloutInterval
+}
+
+// syncRolloutTarget reconciles the rollout status with spec.releaseName.
+//
+// The first release bound with a rollout strategy becomes the stable release directly. Binding a different
+// release starts a new rollout, binding the stable release again aborts the rollout in progress, and a
+// release that was rolled back is not retried until the binding points at another release.
+func syncRolloutTarget(releaseBinding *openchoreov1alpha1.ReleaseBinding, now metav1.Time) {
+	strategy := releaseBinding.Spec.Rollout
+	if strategy == nil {
+		releaseBinding.Status.Rollout = nil
+		return
+	}
+
+	target := releaseBinding.Spec.ReleaseName
+	status := releaseBinding.Status.Rollout
+	if status == nil || status.StableRelease == "" {
+		releaseBinding.Status.Rollout = &openchoreov1alpha1.RolloutStatus{
+			Phase:         openchoreov1alpha1.RolloutPhaseStable,
+			StableRelease: target,
+			StepStartedAt: &now,
+			Message:       fmt.Sprintf("Release %q is serving all traffic", target),
+		}
+		return
+	}
+
+	switch {
+	case target == status.StableRelease:
+		if status.Phase != openchoreov1alpha1.RolloutPhaseStable {
+			if status.CanaryRelease != "" {
+				status.Message = fmt.Sprintf("Rollout of release %q aborted, release %q is serving all traffic",
+					status.CanaryRelease, target)
+			} else {
+				status.Message = fmt.Sprintf("Release %q is serving all traffic", target)
+			}
+			status.Phase = openchoreov1alpha1.RolloutPhaseStable
+			status.CanaryRelease = ""
+			status.CurrentStep = 0
+			status.CanaryWeight = 0
+			status.StepStartedAt = &now
+		}
+
+	case target == status.CanaryRelease:
+		// Rollout of the target is already in progress
+
+	case target == status.FailedRelease && status.Phase == openchoreov1alpha1.RolloutPhaseRolledBack:
+		// Keep serving the stable release until a different release is bound
+
+	default:
+		steps := rolloutSteps(strategy)
+		status.Phase = openchoreov1alpha1.RolloutPhaseProgressing
+		status.CanaryRelease = target
+		status.CurrentStep = 0
+		status.CanaryWeight = steps[0].Weight
+		status.StepStartedAt = &now
+		status.Message = fmt.Sprintf("Rolling out release %q with %d%% of the traffic", target, steps[0].Weight)
+	}
+}
+
+// progressRollout moves the rollout forward based on the health of both tracks and the analysis metrics.
+// It must only be called once the Release reflects the current rollout status, so that the health
+// belongs to the resources rendered for the current step.
+func (r *Reconciler) progressRollout(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	health rolloutHealth, tracks *rolloutResources, now metav1.Time) ctrl.Result {
+	strategy := releaseBinding.Spec.Rollout
+	status := releaseBinding.Status.Rollout
+	if strategy == nil || status == nil {
+		return ctrl.Result{}
+	}
+
+	// The stable pods are labeled with the release track first. Once they are ready, the stable Services
+	// are narrowed to them and the rollout can start.
+	if !status.StableTrackLabeled {
+		if health.Stable != openchoreov1alpha1.HealthStatusHealthy {
+			status.Message = fmt.Sprintf("Waiting for the pods of release %q to be labeled with the stable release track",
+				status.StableRelease)
+			return ctrl.Result{RequeueAfter: rolloutPollInterval}
+		}
+		status.StableTrackLabeled = true
+		status.StepStartedAt = &now
+		if status.Phase == openchoreov1alpha1.RolloutPhaseProgressing {
+			status.Message = fmt.Sprintf("Rolling out release %q with %d%% of the traffic", status.CanaryRelease, status.CanaryWeight)
+		} else {
+			status.Message = fmt.Sprintf("Release %q is serving all traffic", status.StableRelease)
+		}
+		return ctrl.Result{Requeue: true}
+	}
+
+	switch status.Phase {
+	case openchoreov1alpha1.RolloutPhaseProgressing:
+		if health.Canary == openchoreov1alpha1.HealthStatusDegraded {
+			rollbackRollout(status, fmt.Sprintf("release %q is degraded", status.CanaryRelease), now)
+			return ctrl.Result{Requeue: true}
+		}
+		if health.Canary != openchoreov1alpha1.HealthStatusHealthy {
+			status.Message = fmt.Sprintf("Waiting for release %q to become healthy at %d%% of the traffic",
+				status.CanaryRelease, status.CanaryWeight)
+			return ctrl.Result{RequeueAfter: rolloutPollInterval}
+		}
+
+		pause := rolloutStepPause(strategy, status.CurrentStep)
+		if status.StepStartedAt != nil {
+			if elapsed := now.Sub(status.StepStartedAt.Time); elapsed < pause {
+				status.Message = fmt.Sprintf("Observing release %q at %d%% of the traffic",
+					status.CanaryRelease, status.CanaryWeight)
+				return ctrl.Result{RequeueAfter: pause - elapsed}
+			}
+		}
+
+		passed, reason, err := r.analyzeRollout(ctx, strategy, tracks, now.Time)
+		if err != nil {
+			// Inconclusive analysis keeps the current step until the metrics can be evaluated
+			status.Message = fmt.Sprintf("Rollout analysis of release %q could not be evaluated: %v", status.CanaryRelease, err)
+			return ctrl.Result{RequeueAfter: rolloutAnalysisInterval(strategy)}
+		}
+		if !passed {
+			rollbackRollout(status, reason, now)
+			return ctrl.Result{Requeue: true}
+		}
+
+		steps := rolloutSteps(strategy)
+		if next := status.CurrentStep + 1; int(next) < len(steps) {
+			status.CurrentStep = next
+			status.CanaryWeight = steps[next].Weight
+			status.StepStartedAt = &now
+			status.Message = fmt.Sprintf("Rolling out release %q with %d%% of the traffic", status.CanaryRelease, status.CanaryWeight)
+			return ctrl.Result{Requeue: true}
+		}
+
+		// All steps passed, move the stable track to the new release while the canary takes all traffic
+		status.Phase = openchoreov1alpha1.RolloutPhasePromoting
+		status.CanaryWeight = 100
+		status.StepStartedAt = &now
+		status.Message = fmt.Sprintf("Promoting release %q", status.CanaryRelease)
+		return ctrl.Result{Requeue: true}
+
+	case openchoreov1alpha1.RolloutPhasePromoting:
+		if health.Stable == openchoreov1alpha1.HealthStatusDegraded {
+			rollbackRollout(status, fmt.Sprintf("release %q is degraded after promotion", status.CanaryRelease), now)
+			return ctrl.Result{Requeue: true}
+		}
+		if health.Stable != openchoreov1alpha1.HealthStatusHealthy {
+			status.Message = fmt.Sprintf("Waiting for the stable track to become healthy with release %q", status.CanaryRelease)
+			return ctrl.Result{RequeueAfter: rolloutPollInterval}
+		}
+
+		status.Phase = openchoreov1alpha1.RolloutPhaseStable
+		status.StableRelease = status.CanaryRelease
+		status.CanaryRelease = ""
+		status.CurrentStep = 0
+		status.CanaryWeight = 0
+		status.StepStartedAt = &now
+		status.Message = fmt.Sprintf("Release %q is serving all traffic", status.StableRelease)
+		return ctrl.Result{Requeue: true}
+	}
+
+	return ctrl.Result{}
+}
+
+// rollbackRollout returns all traffic to the stable release and remembers the failed release.
+func rollbackRollout(status *openchoreov1alpha1.RolloutStatus, reason string, now metav1.Time) {
+	status.Message = fmt.Sprintf("Rolled back release %q: %s", status.CanaryRelease, reason)
+	status.Phase = openchoreov1alpha1.RolloutPhaseRolledBack
+	status.FailedRelease = status.CanaryRelease
+	status.CanaryRelease = ""
+	status.CurrentStep = 0
+	status.CanaryWeight = 0
+	status.StepStartedAt = &now
+}
+
+// analyzeRollout evaluates the analysis metrics of a rollout.
+// It returns false with a reason if a metric is out of range and an error if a metric could not be evaluated.
+func (r *Reconciler) analyzeRollout(ctx context.Context, strategy *openchoreov1alpha1.RolloutStrategy,
+	tracks *rolloutResources, now time.Time) (bool, string, error) {
+	if strategy.Analysis == nil || len(strategy.Analysis.Metrics) == 0 {
+		return true, "", nil
+	}
+	if r.Metrics == nil {
+		return false, "", fmt.Errorf("no metrics backend is configured for rollout analysis")
+	}
+
+	inputs := map[string]any{
+		"stable": rolloutTrackVariables(tracks.Stable),
+		"canary": rolloutTrackVariables(tracks.Canary),
+	}
+
+	for _, metric := range strategy.Analysis.Metrics {
+		rendered, err := rolloutQueryEngine.Render(metric.Query, inputs)
+		if err != nil {
+			return false, "", fmt.Errorf("failed to render query of metric %q: %w", metric.Name, err)
+		}
+		query, ok := rendered.(string)
+		if !ok {
+			return false, "", fmt.Errorf("query of metric %q must render to a string, got %T", metric.Name, rendered)
+		}
+
+		value, err := r.Metrics.QueryScalar(ctx, query, now)
+		if err != nil {
+			return false, "", fmt.Errorf("failed to query metric %q: %w", metric.Name, err)
+		}
+
+		if metric.Min != nil && value < metric.Min.AsApproximateFloat64() {
+			return false, fmt.Sprintf("metric %q is %g, below the minimum of %s", metric.Name, value, metric.Min.String()), nil
+		}
+		if metric.Max != nil && value > metric.Max.AsApproximateFloat64() {
+			return false, fmt.Sprintf("metric %q is %g, above the maximum of %s", metric.Name, value, metric.Max.String()), nil
+		}
+	}
+	return true, "", nil
+}
+
+// rolloutTrackVariables exposes the metadata of a track to analysis queries.
+func rolloutTrackVariables(metadata *pipelinecontext.MetadataContext) map[string]any {
+	if metadata == nil {
+		return map[string]any{}
+	}
+	podSelectors := make(map[string]any, len(metadata.PodSelectors))
+	for k, v := range metadata.PodSelectors {
+		podSelectors[k] = v
+	}
+	return map[string]any{
+		"name":          metadata.Name,
+		"namespace":     metadata.Namespace,
+		"componentName": metadata.ComponentName,
+		"podSelectors":  podSelectors,
+	}
+}
+
+// evaluateRolloutHealth aggregates the health of the resources of each track from the Release status.
+// A track is only healthy once every one of its resources has been observed in the data plane.
+func evaluateRolloutHealth(resources []openchoreov1alpha1.Resource, canaryIDs map[string]bool,
+	statuses []openchoreov1alpha1.ResourceStatus) rolloutHealth {
+	observed := make(map[string]openchoreov1alpha1.HealthStatus, len(statuses))
+	for _, s := range statuses {
+		observed[s.ID] = s.HealthStatus
+	}
+
+	health := rolloutHealth{
+		Stable: openchoreov1alpha1.HealthStatusHealthy,
+		Canary: openchoreov1alpha1.HealthStatusHealthy,
+	}
+	for _, res := range resources {
+		track := &health.Stable
+		if canaryIDs[res.ID] {
+			track = &health.Canary
+		}
+
+		status, ok := observed[res.ID]
+		switch {
+		case !ok:
+			*track = worseHealth(*track, openchoreov1alpha1.HealthStatusProgressing)
+		case status == openchoreov1alpha1.HealthStatusSuspended:
+			// Scaled down workloads do not block a rollout
+		default:
+			*track = worseHealth(*track, status)
+		}
+	}
+	return health
+}
+
+// worseHealth returns the more severe of two health statuses for rollout gating.
+func worseHealth(a, b openchoreov1alpha1.HealthStatus) openchoreov1alpha1.HealthStatus {
+	rank := func(h openchoreov1alpha1.HealthStatus) int {
+		switch h {
+		case openchoreov1alpha1.HealthStatusDegraded:
+			return 3
+		case openchoreov1alpha1.HealthStatusHealthy, openchoreov1alpha1.HealthStatusSuspended:
+			return 0
+		default:
+			// Progressing, Unknown and not yet reported
+			return 1
+		}
+	}
+	if rank(b) > rank(a) {
+		return b
+	}
+	return a
+}
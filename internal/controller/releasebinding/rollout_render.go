@@ -0,0 +1,353 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+)
+
+const (
+	// releaseTrackStable labels the pods of the release serving production traffic
+	releaseTrackStable = "stable"
+	// releaseTrackCanary labels the pods of the release being rolled out
+	releaseTrackCanary = "canary"
+
+	// canaryResourceIDPrefix is prepended to the Release resource IDs of the canary track
+	canaryResourceIDPrefix = "canary-"
+)
+
+// rolloutResources is the result of rendering the stable and canary tracks of a rollout.
+type rolloutResources struct {
+	// Resources are the Release resources of both tracks
+	Resources []openchoreov1alpha1.Resource
+
+	// CanaryIDs are the Release resource IDs that belong to the canary track
+	CanaryIDs map[string]bool
+
+	// Stable and Canary are the metadata the tracks were rendered with.
+	// Canary is nil when only the stable track is rendered.
+	Stable *pipelinecontext.MetadataContext
+	Canary *pipelinecontext.MetadataContext
+
+	// Warnings are the rendering warnings of both tracks
+	Warnings []string
+}
+
+// RenderRollout renders the Release resources of a ReleaseBinding with a rollout strategy the way the reconciler
+// renders them on its next reconciliation, along with the rendering warnings of both tracks. The rollout status
+// of the binding is moved to the bound release in place, so callers that do not persist the binding should
+// pass a copy.
+func RenderRollout(ctx context.Context, c client.Client, pipeline *componentpipeline.Pipeline,
+	src *RenderSources) ([]openchoreov1alpha1.Resource, []string, error) {
+	r := &Reconciler{Client: c, Pipeline: pipeline}
+	rollout, err := r.renderRolloutRelease(ctx, src)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rollout.Resources, rollout.Warnings, nil
+}
+
+// renderRollout renders the stable track from stableRelease and, if canaryRelease is set, the canary track
+// next to it with the given share of the HTTPRoute traffic. The stable Services are narrowed to the stable
+// release track only if narrowServices is set, see setStableTrackSelectors.
+//
+// The canary track is rendered with its own metadata name, component name and a release track pod selector so
+// that its workloads and Services do not collide with the stable ones. Canary HTTPRoutes are dropped; instead the
+// stable HTTPRoutes get a weighted backendRef for the canary counterpart of each stable Service.
+func (r *Reconciler) renderRollout(ctx context.Context, src *RenderSources,
+	stableRelease, canaryRelease *openchoreov1alpha1.ComponentRelease, canaryWeight int32,
+	narrowServices bool) (*rolloutResources, error) {
+	stableSrc := *src
+	stableSrc.ComponentRelease = stableRelease
+	stableInput, stableOutput, err := r.renderTrack(ctx, &stableSrc, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render stable release %q: %w", stableRelease.Name, err)
+	}
+	stableRendered := stableOutput.Resources
+	setStableTrackSelectors(stableRendered, narrowServices)
+
+	out := &rolloutResources{
+		CanaryIDs: map[string]bool{},
+		Stable:    &stableInput.Metadata,
+		Warnings:  stableOutput.Metadata.Warnings,
+	}
+
+	var canaryRendered []map[string]any
+	if canaryRelease != nil {
+		canarySrc := *src
+		canarySrc.ComponentRelease = canaryRelease
+		canaryInput, canaryOutput, err := r.renderTrack(ctx, &canarySrc, func(input *componentpipeline.RenderInput) {
+			input.Metadata = canaryMetadata(input.Metadata)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to render canary release %q: %w", canaryRelease.Name, err)
+		}
+		out.Canary = &canaryInput.Metadata
+		out.Warnings = append(out.Warnings, canaryOutput.Metadata.Warnings...)
+
+		// Traffic for the canary is routed through the stable HTTPRoutes
+		for _, res := range canaryOutput.Resources {
+			if !isHTTPRoute(res) {
+				canaryRendered = append(canaryRendered, res)
+			}
+		}
+		if err := checkTrackCollisions(stableRendered, canaryRendered); err != nil {
+			return nil, err
+		}
+
+		services := mapCanaryServices(stableRendered, canaryRendered, *out.Stable, *out.Canary)
+		splitHTTPRouteTraffic(stableRendered, services, canaryWeight)
+	}
+
+	stableResources, err := ConvertToReleaseResources(stableRendered)
+	if err != nil {
+		return nil, err
+	}
+	canaryResources, err := ConvertToReleaseResources(canaryRendered)
+	if err != nil {
+		return nil, err
+	}
+	for i := range canaryResources {
+		canaryResources[i].ID = canaryResourceID(canaryResources[i].ID)
+		out.CanaryIDs[canaryResources[i].ID] = true
+	}
+
+	out.Resources = append(stableResources, canaryResources...)
+	return out, nil
+}
+
+// renderTrack builds the pipeline input for one ComponentRelease, lets the caller adjust it and renders it.
+func (r *Reconciler) renderTrack(ctx context.Context, src *RenderSources,
+	customize func(*componentpipeline.RenderInput)) (*componentpipeline.RenderInput, *componentpipeline.RenderOutput, error) {
+	input, err := BuildRenderInput(ctx, r.Client, src)
+	if err != nil {
+		return nil, nil, err
+	}
+	if customize != nil {
+		customize(input)
+	}
+
+	output, err := r.Pipeline.Render(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(output.Metadata.Warnings) > 0 {
+		log.FromContext(ctx).Info("Rendering completed with warnings",
+			"componentRelease", src.ComponentRelease.Name, "warnings", output.Metadata.Warnings)
+	}
+	return input, output, nil
+}
+
+// canaryMetadata derives the metadata of the canary track from the metadata of the stable track.
+func canaryMetadata(stable pipelinecontext.MetadataContext) pipelinecontext.MetadataContext {
+	canary := stable
+	canary.Name = dpkubernetes.GenerateK8sName(stable.ComponentName, stable.EnvironmentName, releaseTrackCanary)
+	canary.ComponentName = stable.ComponentName + "-" + releaseTrackCanary
+
+	canary.Labels = maps.Clone(stable.Labels)
+	if canary.Labels == nil {
+		canary.Labels = map[string]string{}
+	}
+	canary.Labels[labels.LabelKeyReleaseTrack] = releaseTrackCanary
+
+	canary.PodSelectors = maps.Clone(stable.PodSelectors)
+	if canary.PodSelectors == nil {
+		canary.PodSelectors = map[string]string{}
+	}
+	canary.PodSelectors[labels.LabelKeyReleaseTrack] = releaseTrackCanary
+	return canary
+}
+
+// setStableTrackSelectors labels the pods of the stable workloads and, if narrowServices is set, narrows the
+// stable Services to them. Workload selectors are left untouched as they are immutable; the pod selectors of
+// the canary track are a superset of the stable ones, so the stable Services would otherwise also select
+// canary pods.
+//
+// The Services must only be narrowed once the labeled pods are ready. Narrowing them in the same update as the
+// pod template would leave the Services without endpoints until the labeled pods replaced the existing ones.
+func setStableTrackSelectors(resources []map[string]any, narrowServices bool) {
+	for _, res := range resources {
+		obj := unstructured.Unstructured{Object: res}
+		gvk := obj.GroupVersionKind()
+
+		var path []string
+		switch {
+		case gvk.Group == appsAPIGroup && (gvk.Kind == "Deployment" || gvk.Kind == "StatefulSet"):
+			path = []string{"spec", "template", "metadata", "labels"}
+		case gvk.Group == "" && gvk.Kind == "Service" && narrowServices:
+			path = []string{"spec", "selector"}
+		default:
+			continue
+		}
+
+		values, found, err := unstructured.NestedStringMap(res, path...)
+		if err != nil || (!found && gvk.Kind == "Service") {
+			// Services without a selector are backed by manually managed endpoints
+			continue
+		}
+		if values == nil {
+			values = map[string]string{}
+		}
+		values[labels.LabelKeyReleaseTrack] = releaseTrackStable
+		_ = unstructured.SetNestedStringMap(res, values, path...)
+	}
+}
+
+// checkTrackCollisions makes sure the canary track does not render a resource the stable track also renders.
+// This happens when a template uses a fixed name instead of deriving it from the metadata.
+func checkTrackCollisions(stable, canary []map[string]any) error {
+	key := func(res map[string]any) string {
+		obj := unstructured.Unstructured{Object: res}
+		return fmt.Sprintf("%s/%s/%s", obj.GroupVersionKind().GroupKind(), obj.GetNamespace(), obj.GetName())
+	}
+
+	existing := make(map[string]bool, len(stable))
+	for _, res := range stable {
+		existing[key(res)] = true
+	}
+	for _, res := range canary {
+		if existing[key(res)] {
+			obj := unstructured.Unstructured{Object: res}
+			return fmt.Errorf("%s %q is rendered by both the stable and the canary release; "+
+				"resource names must be derived from metadata.name or metadata.componentName to use a rollout strategy",
+				obj.GetKind(), obj.GetName())
+		}
+	}
+	return nil
+}
+
+// mapCanaryServices pairs each stable Service with the canary Service rendered from the same template.
+// The canary name is found by substituting the canary metadata into the stable name.
+func mapCanaryServices(stable, canary []map[string]any, stableMeta, canaryMeta pipelinecontext.MetadataContext) map[string]string {
+	stableServices := serviceNames(stable)
+	canaryServices := serviceNames(canary)
+
+	canarySet := make(map[string]bool, len(canaryServices))
+	for _, name := range canaryServices {
+		canarySet[name] = true
+	}
+
+	mapping := make(map[string]string)
+	for _, name := range stableServices {
+		var candidate string
+		switch {
+		case strings.Contains(name, stableMeta.Name):
+			candidate = strings.ReplaceAll(name, stableMeta.Name, canaryMeta.Name)
+		case strings.HasPrefix(name, stableMeta.ComponentName):
+			candidate = canaryMeta.ComponentName + strings.TrimPrefix(name, stableMeta.ComponentName)
+		}
+		if canarySet[candidate] {
+			mapping[name] = candidate
+		}
+	}
+
+	// A single Service on each side is unambiguous even if its name is not derived from the metadata
+	if len(mapping) == 0 && len(stableServices) == 1 && len(canaryServices) == 1 {
+		mapping[stableServices[0]] = canaryServices[0]
+	}
+	return mapping
+}
+
+func serviceNames(resources []map[string]any) []string {
+	var names []string
+	for _, res := range resources {
+		obj := unstructured.Unstructured{Object: res}
+		if gvk := obj.GroupVersionKind(); gvk.Group == "" && gvk.Kind == "Service" {
+			names = append(names, obj.GetName())
+		}
+	}
+	return names
+}
+
+// splitHTTPRouteTraffic adds a weighted backendRef for the canary Service next to every backendRef
+// of an HTTPRoute that points at a stable Service with a canary counterpart.
+func splitHTTPRouteTraffic(resources []map[string]any, services map[string]string, canaryWeight int32) {
+	if len(services) == 0 {
+		return
+	}
+
+	for _, res := range resources {
+		if !isHTTPRoute(res) {
+			continue
+		}
+		// The rules are modified in place; rendered resources are not guaranteed to hold
+		// only JSON-compatible types, which the copying unstructured helpers require
+		val, found, err := unstructured.NestedFieldNoCopy(res, "spec", "rules")
+		if err != nil || !found {
+			continue
+		}
+		rules, ok := val.([]any)
+		if !ok {
+			continue
+		}
+
+		for _, rule := range rules {
+			ruleMap, ok := rule.(map[string]any)
+			if !ok {
+				continue
+			}
+			backendRefs, ok := ruleMap["backendRefs"].([]any)
+			if !ok {
+				continue
+			}
+
+			split := make([]any, 0, len(backendRefs)*2)
+			for _, ref := range backendRefs {
+				refMap, ok := ref.(map[string]any)
+				if !ok || !isServiceBackendRef(refMap) {
+					split = append(split, ref)
+					continue
+				}
+				name, _ := refMap["name"].(string)
+				canaryName, ok := services[name]
+				if !ok {
+					split = append(split, ref)
+					continue
+				}
+
+				stableRef := maps.Clone(refMap)
+				stableRef["weight"] = int64(100 - canaryWeight)
+				canaryRef := maps.Clone(refMap)
+				canaryRef["name"] = canaryName
+				canaryRef["weight"] = int64(canaryWeight)
+				split = append(split, stableRef, canaryRef)
+			}
+			ruleMap["backendRefs"] = split
+		}
+	}
+}
+
+func isHTTPRoute(res map[string]any) bool {
+	obj := unstructured.Unstructured{Object: res}
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == "gateway.networking.k8s.io" && gvk.Kind == "HTTPRoute"
+}
+
+func isServiceBackendRef(ref map[string]any) bool {
+	group, _ := ref["group"].(string)
+	kind, _ := ref["kind"].(string)
+	return group == "" && (kind == "" || kind == "Service")
+}
+
+// canaryResourceID prefixes a Release resource ID of the canary track, keeping it a valid label value.
+func canaryResourceID(id string) string {
+	prefixed := canaryResourceIDPrefix + id
+	if len(prefixed) > dpkubernetes.MaxLabelNameLength {
+		return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxLabelNameLength, releaseTrackCanary, id)
+	}
+	return prefixed
+}
@@ -0,0 +1,337 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+)
+
+type fakeMetrics struct {
+	value   float64
+	err     error
+	queries []string
+}
+
+func (f *fakeMetrics) QueryScalar(_ context.Context, query string, _ time.Time) (float64, error) {
+	f.queries = append(f.queries, query)
+	return f.value, f.err
+}
+
+func newRolloutBinding(strategy *openchoreov1alpha1.RolloutStrategy, releaseName string) *openchoreov1alpha1.ReleaseBinding {
+	return &openchoreov1alpha1.ReleaseBinding{
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			ReleaseName: releaseName,
+			Rollout:     strategy,
+		},
+	}
+}
+
+func TestSyncRolloutTarget(t *testing.T) {
+	now := metav1.Now()
+	strategy := &openchoreov1alpha1.RolloutStrategy{
+		Type:  openchoreov1alpha1.RolloutStrategyCanary,
+		Steps: []openchoreov1alpha1.RolloutStep{{Weight: 10}, {Weight: 50}},
+	}
+
+	rb := newRolloutBinding(strategy, "v1")
+	syncRolloutTarget(rb, now)
+	if got := rb.Status.Rollout; got.Phase != openchoreov1alpha1.RolloutPhaseStable || got.StableRelease != "v1" {
+		t.Fatalf("first release should become stable, got %+v", got)
+	}
+
+	rb.Spec.ReleaseName = "v2"
+	syncRolloutTarget(rb, now)
+	if got := rb.Status.Rollout; got.Phase != openchoreov1alpha1.RolloutPhaseProgressing ||
+		got.CanaryRelease != "v2" || got.CanaryWeight != 10 {
+		t.Fatalf("new release should start a rollout at the first step, got %+v", got)
+	}
+
+	// Pointing back at the stable release aborts the rollout
+	rb.Spec.ReleaseName = "v1"
+	syncRolloutTarget(rb, now)
+	if got := rb.Status.Rollout; got.Phase != openchoreov1alpha1.RolloutPhaseStable || got.CanaryRelease != "" {
+		t.Fatalf("rollout should be aborted, got %+v", got)
+	}
+
+	// A rolled back release is not retried
+	rb.Status.Rollout.Phase = openchoreov1alpha1.RolloutPhaseRolledBack
+	rb.Status.Rollout.FailedRelease = "v2"
+	rb.Spec.ReleaseName = "v2"
+	syncRolloutTarget(rb, now)
+	if got := rb.Status.Rollout; got.Phase != openchoreov1alpha1.RolloutPhaseRolledBack || got.CanaryRelease != "" {
+		t.Fatalf("failed release should not be retried, got %+v", got)
+	}
+
+	rb.Spec.ReleaseName = "v3"
+	syncRolloutTarget(rb, now)
+	if got := rb.Status.Rollout; got.Phase != openchoreov1alpha1.RolloutPhaseProgressing || got.CanaryRelease != "v3" {
+		t.Fatalf("a different release should start a new rollout, got %+v", got)
+	}
+
+	rb.Spec.Rollout = nil
+	syncRolloutTarget(rb, now)
+	if rb.Status.Rollout != nil {
+		t.Fatalf("rollout status should be cleared without a strategy")
+	}
+}
+
+func TestProgressRollout(t *testing.T) {
+	start := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	healthy := rolloutHealth{Stable: openchoreov1alpha1.HealthStatusHealthy, Canary: openchoreov1alpha1.HealthStatusHealthy}
+	tracks := &rolloutResources{
+		Stable: &pipelinecontext.MetadataContext{Name: "api-prod-1234", Namespace: "dp-ns", ComponentName: "api"},
+		Canary: &pipelinecontext.MetadataContext{Name: "api-prod-canary-5678", Namespace: "dp-ns", ComponentName: "api-canary"},
+	}
+	newStrategy := func() *openchoreov1alpha1.RolloutStrategy {
+		return &openchoreov1alpha1.RolloutStrategy{
+			Type:  openchoreov1alpha1.RolloutStrategyCanary,
+			Steps: []openchoreov1alpha1.RolloutStep{{Weight: 20}, {Weight: 60}},
+			Analysis: &openchoreov1alpha1.RolloutAnalysis{
+				Interval: &metav1.Duration{Duration: time.Minute},
+				Metrics: []openchoreov1alpha1.RolloutMetric{{
+					Name:  "error-rate",
+					Query: `sum(rate(errors{service="${canary.componentName}"}[1m]))`,
+					Max:   resource.NewMilliQuantity(50, resource.DecimalSI),
+				}},
+			},
+		}
+	}
+	newProgressing := func() *openchoreov1alpha1.ReleaseBinding {
+		rb := newRolloutBinding(newStrategy(), "v2")
+		rb.Status.Rollout = &openchoreov1alpha1.RolloutStatus{
+			Phase:              openchoreov1alpha1.RolloutPhaseProgressing,
+			StableRelease:      "v1",
+			StableTrackLabeled: true,
+			CanaryRelease:      "v2",
+			CanaryWeight:       20,
+			StepStartedAt:      &start,
+		}
+		return rb
+	}
+	after := func(d time.Duration) metav1.Time { return metav1.NewTime(start.Add(d)) }
+
+	t.Run("labels the stable pods before narrowing the stable Services", func(t *testing.T) {
+		metrics := &fakeMetrics{}
+		r := &Reconciler{Metrics: metrics}
+		rb := newProgressing()
+		rb.Status.Rollout.StableTrackLabeled = false
+
+		progressing := rolloutHealth{Stable: openchoreov1alpha1.HealthStatusProgressing, Canary: openchoreov1alpha1.HealthStatusHealthy}
+		result := r.progressRollout(context.Background(), rb, progressing, tracks, after(5*time.Minute))
+		if result.RequeueAfter != rolloutPollInterval || rb.Status.Rollout.StableTrackLabeled {
+			t.Fatalf("expected to wait for the stable pods, got result %+v and status %+v", result, rb.Status.Rollout)
+		}
+
+		labeledAt := after(6 * time.Minute)
+		r.progressRollout(context.Background(), rb, healthy, tracks, labeledAt)
+		if got := rb.Status.Rollout; !got.StableTrackLabeled || got.CurrentStep != 0 || !got.StepStartedAt.Equal(&labeledAt) {
+			t.Fatalf("expected the stable track to be labeled without advancing, got %+v", got)
+		}
+		if len(metrics.queries) != 0 {
+			t.Fatalf("expected no analysis before the stable track is labeled, got %v", metrics.queries)
+		}
+	})
+
+	t.Run("waits for the canary to become healthy", func(t *testing.T) {
+		r := &Reconciler{Metrics: &fakeMetrics{}}
+		rb := newProgressing()
+		health := rolloutHealth{Stable: openchoreov1alpha1.HealthStatusHealthy, Canary: openchoreov1alpha1.HealthStatusProgressing}
+		result := r.progressRollout(context.Background(), rb, health, tracks, after(5*time.Minute))
+		if result.RequeueAfter != rolloutPollInterval || rb.Status.Rollout.CurrentStep != 0 {
+			t.Fatalf("expected to wait, got result %+v and status %+v", result, rb.Status.Rollout)
+		}
+	})
+
+	t.Run("waits for the step pause", func(t *testing.T) {
+		metrics := &fakeMetrics{}
+		r := &Reconciler{Metrics: metrics}
+		rb := newProgressing()
+		result := r.progressRollout(context.Background(), rb, healthy, tracks, after(20*time.Second))
+		if result.RequeueAfter != 40*time.Second || len(metrics.queries) != 0 {
+			t.Fatalf("expected to wait 40s without querying, got %+v (queries %v)", result, metrics.queries)
+		}
+	})
+
+	t.Run("advances when the analysis passes", func(t *testing.T) {
+		metrics := &fakeMetrics{value: 0.01}
+		r := &Reconciler{Metrics: metrics}
+		rb := newProgressing()
+		r.progressRollout(context.Background(), rb, healthy, tracks, after(time.Minute))
+		if got := rb.Status.Rollout; got.CurrentStep != 1 || got.CanaryWeight != 60 {
+			t.Fatalf("expected second step, got %+v", got)
+		}
+		if want := `sum(rate(errors{service="api-canary"}[1m]))`; len(metrics.queries) != 1 || metrics.queries[0] != want {
+			t.Fatalf("expected rendered query %q, got %v", want, metrics.queries)
+		}
+
+		// Passing the last step promotes the canary
+		r.progressRollout(context.Background(), rb, healthy, tracks, after(2*time.Minute))
+		if got := rb.Status.Rollout; got.Phase != openchoreov1alpha1.RolloutPhasePromoting || got.CanaryWeight != 100 {
+			t.Fatalf("expected promotion, got %+v", got)
+		}
+
+		r.progressRollout(context.Background(), rb, healthy, tracks, after(3*time.Minute))
+		if got := rb.Status.Rollout; got.Phase != openchoreov1alpha1.RolloutPhaseStable ||
+			got.StableRelease != "v2" || got.CanaryRelease != "" {
+			t.Fatalf("expected v2 to become stable, got %+v", got)
+		}
+	})
+
+	t.Run("rolls back when a metric is out of range", func(t *testing.T) {
+		r := &Reconciler{Metrics: &fakeMetrics{value: 0.2}}
+		rb := newProgressing()
+		r.progressRollout(context.Background(), rb, healthy, tracks, after(time.Minute))
+		if got := rb.Status.Rollout; got.Phase != openchoreov1alpha1.RolloutPhaseRolledBack ||
+			got.FailedRelease != "v2" || got.StableRelease != "v1" || got.CanaryWeight != 0 {
+			t.Fatalf("expected rollback, got %+v", got)
+		}
+	})
+
+	t.Run("rolls back when the canary is degraded", func(t *testing.T) {
+		r := &Reconciler{Metrics: &fakeMetrics{}}
+		rb := newProgressing()
+		health := rolloutHealth{Stable: openchoreov1alpha1.HealthStatusHealthy, Canary: openchoreov1alpha1.HealthStatusDegraded}
+		r.progressRollout(context.Background(), rb, health, tracks, after(time.Second))
+		if got := rb.Status.Rollout; got.Phase != openchoreov1alpha1.RolloutPhaseRolledBack {
+			t.Fatalf("expected rollback, got %+v", got)
+		}
+	})
+
+	t.Run("keeps the step when the analysis is inconclusive", func(t *testing.T) {
+		r := &Reconciler{Metrics: &fakeMetrics{err: errors.New("connection refused")}}
+		rb := newProgressing()
+		result := r.progressRollout(context.Background(), rb, healthy, tracks, after(time.Minute))
+		if got := rb.Status.Rollout; got.Phase != openchoreov1alpha1.RolloutPhaseProgressing || got.CurrentStep != 0 ||
+			result.RequeueAfter != time.Minute {
+			t.Fatalf("expected to retry the analysis, got %+v (result %+v)", got, result)
+		}
+	})
+}
+
+func TestRolloutSteps(t *testing.T) {
+	blueGreen := rolloutSteps(&openchoreov1alpha1.RolloutStrategy{
+		Type:  openchoreov1alpha1.RolloutStrategyBlueGreen,
+		Steps: []openchoreov1alpha1.RolloutStep{{Weight: 30}},
+	})
+	if len(blueGreen) != 2 || blueGreen[0].Weight != 0 || blueGreen[1].Weight != 100 {
+		t.Errorf("unexpected blue-green steps: %+v", blueGreen)
+	}
+
+	canary := rolloutSteps(&openchoreov1alpha1.RolloutStrategy{Type: openchoreov1alpha1.RolloutStrategyCanary})
+	if len(canary) != 1 || canary[0].Weight != 100 {
+		t.Errorf("unexpected default canary steps: %+v", canary)
+	}
+}
+
+func TestEvaluateRolloutHealth(t *testing.T) {
+	resources := []openchoreov1alpha1.Resource{{ID: "deployment-api"}, {ID: "canary-deployment-api"}, {ID: "canary-service-api"}}
+	canaryIDs := map[string]bool{"canary-deployment-api": true, "canary-service-api": true}
+
+	health := evaluateRolloutHealth(resources, canaryIDs, []openchoreov1alpha1.ResourceStatus{
+		{ID: "deployment-api", HealthStatus: openchoreov1alpha1.HealthStatusHealthy},
+		{ID: "canary-deployment-api", HealthStatus: openchoreov1alpha1.HealthStatusHealthy},
+	})
+	if health.Stable != openchoreov1alpha1.HealthStatusHealthy || health.Canary != openchoreov1alpha1.HealthStatusProgressing {
+		t.Errorf("resources missing from the status should be progressing, got %+v", health)
+	}
+
+	health = evaluateRolloutHealth(resources, canaryIDs, []openchoreov1alpha1.ResourceStatus{
+		{ID: "deployment-api", HealthStatus: openchoreov1alpha1.HealthStatusHealthy},
+		{ID: "canary-deployment-api", HealthStatus: openchoreov1alpha1.HealthStatusDegraded},
+		{ID: "canary-service-api", HealthStatus: openchoreov1alpha1.HealthStatusProgressing},
+	})
+	if health.Canary != openchoreov1alpha1.HealthStatusDegraded {
+		t.Errorf("degraded should take precedence, got %+v", health)
+	}
+}
+
+func TestRolloutTrafficSplit(t *testing.T) {
+	stableMeta := pipelinecontext.MetadataContext{Name: "api-prod-1234", ComponentName: "api", EnvironmentName: "prod"}
+	canaryMeta := canaryMetadata(stableMeta)
+
+	stable := []map[string]any{
+		{
+			"apiVersion": "apps/v1", "kind": "Deployment",
+			"metadata": map[string]any{"name": "api-prod-1234"},
+			"spec": map[string]any{
+				"template": map[string]any{"metadata": map[string]any{"labels": map[string]any{"app": "api"}}},
+			},
+		},
+		{
+			"apiVersion": "v1", "kind": "Service",
+			"metadata": map[string]any{"name": "api"},
+			"spec":     map[string]any{"selector": map[string]any{"app": "api"}},
+		},
+		{
+			"apiVersion": "gateway.networking.k8s.io/v1", "kind": "HTTPRoute",
+			"metadata": map[string]any{"name": "api"},
+			"spec": map[string]any{
+				"rules": []any{map[string]any{
+					"backendRefs": []any{map[string]any{"name": "api", "port": int64(80)}},
+				}},
+			},
+		},
+	}
+	canary := []map[string]any{
+		{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": map[string]any{"name": canaryMeta.Name}},
+		{"apiVersion": "v1", "kind": "Service", "metadata": map[string]any{"name": "api-canary"}},
+	}
+
+	// Until the stable pods are labeled, the stable Service keeps selecting all pods
+	setStableTrackSelectors(stable, false)
+	selector, _, _ := unstructured.NestedStringMap(stable[1], "spec", "selector")
+	if _, ok := selector[labels.LabelKeyReleaseTrack]; ok {
+		t.Errorf("stable Service should not be narrowed before the pods are labeled, got %v", selector)
+	}
+	podLabels, _, _ := unstructured.NestedStringMap(stable[0], "spec", "template", "metadata", "labels")
+	if podLabels[labels.LabelKeyReleaseTrack] != releaseTrackStable {
+		t.Errorf("stable pods should be labeled before the Service is narrowed, got %v", podLabels)
+	}
+
+	setStableTrackSelectors(stable, true)
+	if err := checkTrackCollisions(stable, canary); err != nil {
+		t.Fatalf("unexpected collision: %v", err)
+	}
+	services := mapCanaryServices(stable, canary, stableMeta, canaryMeta)
+	if services["api"] != "api-canary" {
+		t.Fatalf("expected api to map to api-canary, got %v", services)
+	}
+	splitHTTPRouteTraffic(stable, services, 25)
+
+	podLabels, _, _ = unstructured.NestedStringMap(stable[0], "spec", "template", "metadata", "labels")
+	if podLabels[labels.LabelKeyReleaseTrack] != releaseTrackStable {
+		t.Errorf("stable pods should carry the stable track label, got %v", podLabels)
+	}
+	selector, _, _ = unstructured.NestedStringMap(stable[1], "spec", "selector")
+	if selector[labels.LabelKeyReleaseTrack] != releaseTrackStable {
+		t.Errorf("stable Service should select stable pods only, got %v", selector)
+	}
+
+	rules, _, _ := unstructured.NestedSlice(stable[2], "spec", "rules")
+	refs := rules[0].(map[string]any)["backendRefs"].([]any)
+	if len(refs) != 2 {
+		t.Fatalf("expected stable and canary backendRefs, got %v", refs)
+	}
+	stableRef, canaryRef := refs[0].(map[string]any), refs[1].(map[string]any)
+	if stableRef["name"] != "api" || stableRef["weight"] != int64(75) ||
+		canaryRef["name"] != "api-canary" || canaryRef["weight"] != int64(25) || canaryRef["port"] != int64(80) {
+		t.Errorf("unexpected backendRefs: %v", refs)
+	}
+
+	collision := []map[string]any{{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]any{"name": "shared"}}}
+	if err := checkTrackCollisions(collision, collision); err == nil {
+		t.Errorf("expected resources with fixed names to be rejected")
+	}
+}
@@ -5,46 +5,269 @@ package secretreference
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
-	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	esov1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/externalsecrets/v1"
 )
 
+const (
+	// defaultRefreshInterval is used when the SecretReference does not specify a refresh interval
+	defaultRefreshInterval = time.Hour
+
+	// notReadyRequeueInterval is the maximum time to wait before re-checking a SecretReference that is not ready
+	notReadyRequeueInterval = time.Minute
+)
+
+// clusterSecretStoreGVK is the ESO cluster scoped secret store referenced by DataPlane.Spec.SecretStoreRef
+var clusterSecretStoreGVK = schema.GroupVersionKind{Group: esov1.Group, Version: esov1.Version, Kind: "ClusterSecretStore"}
+
 // Reconciler reconciles a SecretReference object
 type Reconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	k8sClientMgr *kubernetesClient.KubeMultiClientManager
+	Scheme       *runtime.Scheme
+}
+
+// secretStoreIssue describes why a data plane cannot serve the SecretReference
+type secretStoreIssue struct {
+	reason  controller.ConditionReason
+	message string
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=dataplanes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releasebindings,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=componentreleases,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releases,verbs=get;list;watch
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the SecretReference object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
+// Reconcile validates the secret stores of the data planes that consume the SecretReference,
+// records the ExternalSecrets rendered from it and reports the outcome in the Ready condition.
+// The SecretReference is re-evaluated every RefreshInterval.
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
+	logger := log.FromContext(ctx)
+
+	// Fetch the SecretReference instance
+	secretRef := &openchoreov1alpha1.SecretReference{}
+	if err := r.Get(ctx, req.NamespacedName, secretRef); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The SecretReference resource may have been deleted since it triggered the reconcile
+			logger.Info("SecretReference resource not found. Ignoring since it must be deleted.")
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object
+		logger.Error(err, "Failed to get SecretReference")
+		return ctrl.Result{}, err
+	}
+
+	// Keep a copy of the old SecretReference object
+	old := secretRef.DeepCopy()
+
+	usages, err := r.findDataPlaneUsages(ctx, secretRef)
+	if err != nil {
+		logger.Error(err, "Failed to find the data planes using the SecretReference")
+		return ctrl.Result{}, err
+	}
+
+	var secretStores []openchoreov1alpha1.SecretStoreReference
+	var issues []string
+	var firstIssue *secretStoreIssue
+	for _, usage := range usages {
+		secretStores = append(secretStores, usage.externalSecrets...)
+		if issue := r.checkDataPlane(ctx, usage); issue != nil {
+			issues = append(issues, fmt.Sprintf("dataplane %s: %s", usage.dataPlane.Name, issue.message))
+			if firstIssue == nil {
+				firstIssue = issue
+			}
+		}
+	}
+	secretRef.Status.SecretStores = secretStores
+
+	switch {
+	case len(usages) == 0:
+		controller.MarkTrueCondition(secretRef, ConditionReady, ReasonNotInUse,
+			"SecretReference is not used by any data plane")
+	case firstIssue != nil:
+		controller.MarkFalseCondition(secretRef, ConditionReady, firstIssue.reason, strings.Join(issues, "; "))
+	default:
+		controller.MarkTrueCondition(secretRef, ConditionReady, ReasonSecretStoresReady,
+			fmt.Sprintf("Secret stores are ready on data planes: %s", dataPlaneNames(usages)))
+	}
+
+	// Only bump the refresh time when something changed or the refresh interval elapsed, so that
+	// reconciles triggered by our own status updates settle down
+	now := time.Now()
+	refreshInterval := getRefreshInterval(secretRef)
+	if !equality.Semantic.DeepEqual(old.Status, secretRef.Status) || refreshDue(secretRef, refreshInterval, now) {
+		secretRef.Status.LastRefreshTime = &metav1.Time{Time: now}
+	}
+
+	if !equality.Semantic.DeepEqual(old.Status, secretRef.Status) {
+		if err := r.Status().Update(ctx, secretRef); err != nil {
+			logger.Error(err, "Failed to update SecretReference status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	requeueAfter := secretRef.Status.LastRefreshTime.Add(refreshInterval).Sub(now)
+	if firstIssue != nil && requeueAfter > notReadyRequeueInterval {
+		requeueAfter = notReadyRequeueInterval
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// checkDataPlane validates the secret store of a consuming data plane and the sync state of the
+// ExternalSecrets rendered into it. It returns nil if the data plane can serve the SecretReference.
+func (r *Reconciler) checkDataPlane(ctx context.Context, usage *dataPlaneUsage) *secretStoreIssue {
+	dataPlane := usage.dataPlane
+	if dataPlane.Spec.SecretStoreRef == nil || dataPlane.Spec.SecretStoreRef.Name == "" {
+		return &secretStoreIssue{
+			reason:  ReasonSecretStoreNotConfigured,
+			message: "no secret store is configured",
+		}
+	}
+	storeName := dataPlane.Spec.SecretStoreRef.Name
+
+	// The ExternalSecrets of data planes the control plane does not connect to are applied by the
+	// agent managing the data plane, or by a GitOps agent syncing it from the repository
+	if !controller.ConnectsToDataPlane(dataPlane) {
+		return nil
+	}
+
+	dpClient, err := kubernetesClient.GetK8sClient(ctx, r.k8sClientMgr, r.Client, kubernetesClient.PlaneKindDataPlane, dataPlane.Namespace, dataPlane.Name, *dataPlane.Spec.KubernetesCluster)
+	if err != nil {
+		return &secretStoreIssue{
+			reason:  ReasonDataPlaneUnreachable,
+			message: fmt.Sprintf("failed to create dataplane client: %v", err),
+		}
+	}
+
+	store := &unstructured.Unstructured{}
+	store.SetGroupVersionKind(clusterSecretStoreGVK)
+	if err := dpClient.Get(ctx, client.ObjectKey{Name: storeName}, store); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &secretStoreIssue{
+				reason:  ReasonSecretStoreNotFound,
+				message: fmt.Sprintf("ClusterSecretStore %s not found", storeName),
+			}
+		}
+		return &secretStoreIssue{
+			reason:  ReasonDataPlaneUnreachable,
+			message: fmt.Sprintf("failed to get ClusterSecretStore %s: %v", storeName, err),
+		}
+	}
+	if ready, message := readyCondition(store); !ready {
+		return &secretStoreIssue{
+			reason:  ReasonSecretStoreNotReady,
+			message: fmt.Sprintf("ClusterSecretStore %s is not ready: %s", storeName, message),
+		}
+	}
+
+	for _, ref := range usage.externalSecrets {
+		externalSecret := &unstructured.Unstructured{}
+		externalSecret.SetGroupVersionKind(esov1.ExtSecretGroupVersionKind)
+		if err := dpClient.Get(ctx, client.ObjectKey{Name: ref.ExternalSecretName, Namespace: ref.Namespace}, externalSecret); err != nil {
+			if apierrors.IsNotFound(err) {
+				// Not applied yet, the release controller reports apply failures
+				continue
+			}
+			return &secretStoreIssue{
+				reason:  ReasonDataPlaneUnreachable,
+				message: fmt.Sprintf("failed to get ExternalSecret %s/%s: %v", ref.Namespace, ref.ExternalSecretName, err),
+			}
+		}
+		if ready, message := readyCondition(externalSecret); !ready {
+			return &secretStoreIssue{
+				reason:  ReasonExternalSecretNotSynced,
+				message: fmt.Sprintf("ExternalSecret %s/%s is not synced: %s", ref.Namespace, ref.ExternalSecretName, message),
+			}
+		}
+	}
+
+	return nil
+}
+
+// readyCondition returns whether the ESO resource reports a Ready=True condition along with the
+// condition message
+func readyCondition(obj *unstructured.Unstructured) (bool, string) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok || condition["type"] != "Ready" {
+			continue
+		}
+		message, _ := condition["message"].(string)
+		return condition["status"] == string(metav1.ConditionTrue), message
+	}
+	return false, "status is not reported yet"
+}
 
-	// TODO(user): your logic here
+func getRefreshInterval(secretRef *openchoreov1alpha1.SecretReference) time.Duration {
+	if secretRef.Spec.RefreshInterval != nil && secretRef.Spec.RefreshInterval.Duration > 0 {
+		return secretRef.Spec.RefreshInterval.Duration
+	}
+	return defaultRefreshInterval
+}
 
-	return ctrl.Result{}, nil
+func refreshDue(secretRef *openchoreov1alpha1.SecretReference, refreshInterval time.Duration, now time.Time) bool {
+	lastRefresh := secretRef.Status.LastRefreshTime
+	return lastRefresh == nil || !now.Before(lastRefresh.Add(refreshInterval))
+}
+
+func dataPlaneNames(usages []*dataPlaneUsage) string {
+	names := make([]string, 0, len(usages))
+	for _, usage := range usages {
+		names = append(names, usage.dataPlane.Name)
+	}
+	return strings.Join(names, ", ")
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.k8sClientMgr == nil {
+		r.k8sClientMgr = kubernetesClient.SharedManager()
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&openchoreodevv1alpha1.SecretReference{}).
+		For(&openchoreov1alpha1.SecretReference{}).
 		Named("secretreference").
+		// Watch the resources that determine where the SecretReference is used
+		// Status updates such as agent heartbeats do not change where the SecretReference is used
+		Watches(
+			&openchoreov1alpha1.DataPlane{},
+			handler.EnqueueRequestsFromMapFunc(r.listSecretReferencesInNamespace),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&openchoreov1alpha1.Environment{},
+			handler.EnqueueRequestsFromMapFunc(r.listSecretReferencesInNamespace),
+		).
+		Watches(
+			&openchoreov1alpha1.ReleaseBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.listSecretReferencesInNamespace),
+		).
 		Complete(r)
 }
@@ -0,0 +1,43 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionReady indicates whether the secret stores of all the data planes using the
+	// SecretReference are able to serve it
+	ConditionReady controller.ConditionType = "Ready"
+)
+
+// Constants for condition reasons
+
+const (
+	// Success states (Status=True)
+
+	// ReasonSecretStoresReady indicates the secret store of every consuming data plane is ready
+	ReasonSecretStoresReady controller.ConditionReason = "SecretStoresReady"
+	// ReasonNotInUse indicates no data plane is using the SecretReference yet
+	ReasonNotInUse controller.ConditionReason = "NotInUse"
+
+	// Configuration issues (Status=False)
+
+	// ReasonSecretStoreNotConfigured indicates a consuming DataPlane has no SecretStoreRef
+	ReasonSecretStoreNotConfigured controller.ConditionReason = "SecretStoreNotConfigured"
+	// ReasonSecretStoreNotFound indicates the referenced ClusterSecretStore doesn't exist in the data plane
+	ReasonSecretStoreNotFound controller.ConditionReason = "SecretStoreNotFound"
+	// ReasonSecretStoreNotReady indicates the referenced ClusterSecretStore is not ready in the data plane
+	ReasonSecretStoreNotReady controller.ConditionReason = "SecretStoreNotReady"
+
+	// Data plane issues (Status=False)
+
+	// ReasonDataPlaneUnreachable indicates the data plane could not be queried
+	ReasonDataPlaneUnreachable controller.ConditionReason = "DataPlaneUnreachable"
+	// ReasonExternalSecretNotSynced indicates an ExternalSecret consuming the reference failed to sync
+	ReasonExternalSecretNotSynced controller.ConditionReason = "ExternalSecretNotSynced"
+)
@@ -0,0 +1,213 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	esov1 "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/externalsecrets/v1"
+)
+
+// dataPlaneUsage describes how a single DataPlane consumes a SecretReference
+type dataPlaneUsage struct {
+	dataPlane *openchoreov1alpha1.DataPlane
+	// externalSecrets are the ExternalSecrets rendered for the data plane that pull the referenced data
+	externalSecrets []openchoreov1alpha1.SecretStoreReference
+}
+
+// findDataPlaneUsages finds the DataPlanes in the organization that consume the SecretReference,
+// either as an image pull secret or through the workloads bound to their environments.
+// The result is sorted by data plane name.
+func (r *Reconciler) findDataPlaneUsages(ctx context.Context,
+	secretRef *openchoreov1alpha1.SecretReference) ([]*dataPlaneUsage, error) {
+	namespace := secretRef.Namespace
+
+	dataPlaneList := &openchoreov1alpha1.DataPlaneList{}
+	if err := r.List(ctx, dataPlaneList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list data planes: %w", err)
+	}
+	dataPlanes := make(map[string]*openchoreov1alpha1.DataPlane, len(dataPlaneList.Items))
+	for i := range dataPlaneList.Items {
+		dataPlanes[dataPlaneList.Items[i].Name] = &dataPlaneList.Items[i]
+	}
+
+	environmentList := &openchoreov1alpha1.EnvironmentList{}
+	if err := r.List(ctx, environmentList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list environments: %w", err)
+	}
+	environmentDataPlanes := make(map[string]string, len(environmentList.Items))
+	for _, env := range environmentList.Items {
+		environmentDataPlanes[env.Name] = env.Spec.DataPlaneRef
+	}
+
+	usages := make(map[string]*dataPlaneUsage)
+	addUsage := func(dataPlaneName string) {
+		dataPlane, ok := dataPlanes[dataPlaneName]
+		if !ok {
+			return
+		}
+		if _, exists := usages[dataPlaneName]; !exists {
+			usages[dataPlaneName] = &dataPlaneUsage{dataPlane: dataPlane}
+		}
+	}
+
+	// Image pull secrets are rendered into every environment of the data plane
+	for name, dataPlane := range dataPlanes {
+		if slices.Contains(dataPlane.Spec.ImagePullSecretRefs, secretRef.Name) {
+			addUsage(name)
+		}
+	}
+
+	// Workload secrets are rendered into the environments the workload is bound to
+	releaseBindingList := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := r.List(ctx, releaseBindingList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	componentReleases := make(map[string]*openchoreov1alpha1.ComponentRelease)
+	for i := range releaseBindingList.Items {
+		binding := &releaseBindingList.Items[i]
+		if binding.Spec.ReleaseName == "" {
+			continue
+		}
+
+		componentRelease, ok := componentReleases[binding.Spec.ReleaseName]
+		if !ok {
+			componentRelease = &openchoreov1alpha1.ComponentRelease{}
+			if err := r.Get(ctx, client.ObjectKey{Name: binding.Spec.ReleaseName, Namespace: namespace}, componentRelease); err != nil {
+				if client.IgnoreNotFound(err) != nil {
+					return nil, fmt.Errorf("failed to get component release %s: %w", binding.Spec.ReleaseName, err)
+				}
+				// The release binding controller reports missing component releases
+				componentRelease = nil
+			}
+			componentReleases[binding.Spec.ReleaseName] = componentRelease
+		}
+
+		if bindingReferencesSecret(componentRelease, binding, secretRef.Name) {
+			addUsage(environmentDataPlanes[binding.Spec.Environment])
+		}
+	}
+
+	if len(usages) == 0 {
+		return nil, nil
+	}
+
+	// Record the ExternalSecrets that were rendered for the consuming data planes
+	releaseList := &openchoreov1alpha1.ReleaseList{}
+	if err := r.List(ctx, releaseList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list releases: %w", err)
+	}
+	for _, release := range releaseList.Items {
+		usage, ok := usages[environmentDataPlanes[release.Spec.EnvironmentName]]
+		if !ok {
+			continue
+		}
+		for _, resource := range release.Spec.Resources {
+			externalSecret := decodeExternalSecret(resource)
+			if externalSecret == nil || !externalSecretConsumes(externalSecret, secretRef) {
+				continue
+			}
+			usage.externalSecrets = append(usage.externalSecrets, openchoreov1alpha1.SecretStoreReference{
+				Name:               externalSecret.Spec.SecretStoreRef.Name,
+				Namespace:          externalSecret.Namespace,
+				Kind:               esov1.ExtSecretKind,
+				DataPlane:          usage.dataPlane.Name,
+				ExternalSecretName: externalSecret.Name,
+			})
+		}
+	}
+
+	result := make([]*dataPlaneUsage, 0, len(usages))
+	for _, usage := range usages {
+		sort.Slice(usage.externalSecrets, func(i, j int) bool {
+			if usage.externalSecrets[i].Namespace != usage.externalSecrets[j].Namespace {
+				return usage.externalSecrets[i].Namespace < usage.externalSecrets[j].Namespace
+			}
+			return usage.externalSecrets[i].ExternalSecretName < usage.externalSecrets[j].ExternalSecretName
+		})
+		result = append(result, usage)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].dataPlane.Name < result[j].dataPlane.Name
+	})
+	return result, nil
+}
+
+// bindingReferencesSecret checks whether the workload captured in the component release or the
+// workload overrides of the binding reference the named SecretReference
+func bindingReferencesSecret(componentRelease *openchoreov1alpha1.ComponentRelease,
+	binding *openchoreov1alpha1.ReleaseBinding, secretRefName string) bool {
+	if componentRelease != nil {
+		for _, container := range componentRelease.Spec.Workload.Containers {
+			if envReferencesSecret(container.Env, secretRefName) || filesReferenceSecret(container.Files, secretRefName) {
+				return true
+			}
+		}
+	}
+
+	if binding.Spec.WorkloadOverrides != nil {
+		for _, container := range binding.Spec.WorkloadOverrides.Containers {
+			if envReferencesSecret(container.Env, secretRefName) || filesReferenceSecret(container.Files, secretRefName) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func envReferencesSecret(env []openchoreov1alpha1.EnvVar, secretRefName string) bool {
+	for _, e := range env {
+		if e.ValueFrom != nil && e.ValueFrom.SecretRef != nil && e.ValueFrom.SecretRef.Name == secretRefName {
+			return true
+		}
+	}
+	return false
+}
+
+func filesReferenceSecret(files []openchoreov1alpha1.FileVar, secretRefName string) bool {
+	for _, f := range files {
+		if f.ValueFrom != nil && f.ValueFrom.SecretRef != nil && f.ValueFrom.SecretRef.Name == secretRefName {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeExternalSecret returns the ExternalSecret held by a release resource, or nil if the
+// resource is not an ExternalSecret
+func decodeExternalSecret(resource openchoreov1alpha1.Resource) *esov1.ExternalSecret {
+	if resource.Object == nil || len(resource.Object.Raw) == 0 {
+		return nil
+	}
+
+	externalSecret := &esov1.ExternalSecret{}
+	if err := json.Unmarshal(resource.Object.Raw, externalSecret); err != nil {
+		return nil
+	}
+	if externalSecret.Kind != esov1.ExtSecretKind || externalSecret.GroupVersionKind().Group != esov1.Group {
+		return nil
+	}
+	return externalSecret
+}
+
+// externalSecretConsumes checks whether the ExternalSecret pulls any of the remote references
+// declared by the SecretReference
+func externalSecretConsumes(externalSecret *esov1.ExternalSecret, secretRef *openchoreov1alpha1.SecretReference) bool {
+	for _, data := range externalSecret.Spec.Data {
+		for _, source := range secretRef.Spec.Data {
+			if data.RemoteRef.Key == source.RemoteRef.Key && data.RemoteRef.Property == source.RemoteRef.Property {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,276 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+func TestBindingReferencesSecret(t *testing.T) {
+	secretEnv := []openchoreov1alpha1.EnvVar{{
+		Key: "TOKEN",
+		ValueFrom: &openchoreov1alpha1.EnvVarValueFrom{
+			SecretRef: &openchoreov1alpha1.SecretKeyRef{Name: "github-pat", Key: "token"},
+		},
+	}}
+	secretFiles := []openchoreov1alpha1.FileVar{{
+		Key:       "token",
+		MountPath: "/secrets",
+		ValueFrom: &openchoreov1alpha1.EnvVarValueFrom{
+			SecretRef: &openchoreov1alpha1.SecretKeyRef{Name: "github-pat", Key: "token"},
+		},
+	}}
+
+	releaseWith := func(container openchoreov1alpha1.Container) *openchoreov1alpha1.ComponentRelease {
+		return &openchoreov1alpha1.ComponentRelease{
+			Spec: openchoreov1alpha1.ComponentReleaseSpec{
+				Workload: openchoreov1alpha1.WorkloadTemplateSpec{
+					Containers: map[string]openchoreov1alpha1.Container{"main": container},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		componentRelease *openchoreov1alpha1.ComponentRelease
+		overrides        *openchoreov1alpha1.WorkloadOverrideTemplateSpec
+		want             bool
+	}{
+		{
+			name:             "Workload env references the secret",
+			componentRelease: releaseWith(openchoreov1alpha1.Container{Env: secretEnv}),
+			want:             true,
+		},
+		{
+			name:             "Workload file references the secret",
+			componentRelease: releaseWith(openchoreov1alpha1.Container{Files: secretFiles}),
+			want:             true,
+		},
+		{
+			name: "Override references the secret",
+			overrides: &openchoreov1alpha1.WorkloadOverrideTemplateSpec{
+				Containers: map[string]openchoreov1alpha1.ContainerOverride{"main": {Env: secretEnv}},
+			},
+			want: true,
+		},
+		{
+			name: "Workload references another secret",
+			componentRelease: releaseWith(openchoreov1alpha1.Container{Env: []openchoreov1alpha1.EnvVar{{
+				Key: "PASSWORD",
+				ValueFrom: &openchoreov1alpha1.EnvVarValueFrom{
+					SecretRef: &openchoreov1alpha1.SecretKeyRef{Name: "db-credentials", Key: "password"},
+				},
+			}}}),
+			want: false,
+		},
+		{
+			name:             "Workload uses literal values",
+			componentRelease: releaseWith(openchoreov1alpha1.Container{Env: []openchoreov1alpha1.EnvVar{{Key: "LOG_LEVEL", Value: "info"}}}),
+			want:             false,
+		},
+		{
+			name: "Missing component release",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			binding := &openchoreov1alpha1.ReleaseBinding{
+				Spec: openchoreov1alpha1.ReleaseBindingSpec{WorkloadOverrides: tt.overrides},
+			}
+			if got := bindingReferencesSecret(tt.componentRelease, binding, "github-pat"); got != tt.want {
+				t.Errorf("bindingReferencesSecret() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExternalSecretConsumes(t *testing.T) {
+	secretRef := &openchoreov1alpha1.SecretReference{
+		Spec: openchoreov1alpha1.SecretReferenceSpec{
+			Data: []openchoreov1alpha1.SecretDataSource{{
+				SecretKey: "token",
+				RemoteRef: openchoreov1alpha1.RemoteReference{Key: "secret/data/github/pat", Property: "token"},
+			}},
+		},
+	}
+
+	tests := []struct {
+		name string
+		raw  string
+		want bool
+	}{
+		{
+			name: "ExternalSecret pulls the remote reference",
+			raw: `{"apiVersion":"external-secrets.io/v1","kind":"ExternalSecret","metadata":{"name":"app-secrets","namespace":"dp-ns"},
+				"spec":{"secretStoreRef":{"name":"default","kind":"ClusterSecretStore"},
+				"data":[{"secretKey":"TOKEN","remoteRef":{"key":"secret/data/github/pat","property":"token"}}]}}`,
+			want: true,
+		},
+		{
+			name: "ExternalSecret pulls another property",
+			raw: `{"apiVersion":"external-secrets.io/v1","kind":"ExternalSecret","metadata":{"name":"app-secrets"},
+				"spec":{"data":[{"secretKey":"USER","remoteRef":{"key":"secret/data/github/pat","property":"user"}}]}}`,
+			want: false,
+		},
+		{
+			name: "Not an ExternalSecret",
+			raw: `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"app-secrets"},
+				"spec":{"data":[{"secretKey":"TOKEN","remoteRef":{"key":"secret/data/github/pat","property":"token"}}]}}`,
+			want: false,
+		},
+		{
+			name: "Invalid object",
+			raw:  `not json`,
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := openchoreov1alpha1.Resource{ID: "secret", Object: &runtime.RawExtension{Raw: []byte(tt.raw)}}
+			externalSecret := decodeExternalSecret(resource)
+			got := externalSecret != nil && externalSecretConsumes(externalSecret, secretRef)
+			if got != tt.want {
+				t.Errorf("consumes = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadyCondition(t *testing.T) {
+	tests := []struct {
+		name        string
+		conditions  []any
+		wantReady   bool
+		wantMessage string
+	}{
+		{
+			name:        "Ready",
+			conditions:  []any{map[string]any{"type": "Ready", "status": "True", "message": "store validated"}},
+			wantReady:   true,
+			wantMessage: "store validated",
+		},
+		{
+			name:        "Not ready",
+			conditions:  []any{map[string]any{"type": "Ready", "status": "False", "message": "could not authenticate"}},
+			wantReady:   false,
+			wantMessage: "could not authenticate",
+		},
+		{
+			name:        "No conditions",
+			wantReady:   false,
+			wantMessage: "status is not reported yet",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{Object: map[string]any{}}
+			if tt.conditions != nil {
+				obj.Object["status"] = map[string]any{"conditions": tt.conditions}
+			}
+			ready, message := readyCondition(obj)
+			if ready != tt.wantReady || message != tt.wantMessage {
+				t.Errorf("readyCondition() = (%v, %q), want (%v, %q)", ready, message, tt.wantReady, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestRefreshDue(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		lastRefresh *metav1.Time
+		want        bool
+	}{
+		{name: "Never refreshed", want: true},
+		{name: "Refreshed recently", lastRefresh: &metav1.Time{Time: now.Add(-10 * time.Minute)}, want: false},
+		{name: "Interval elapsed", lastRefresh: &metav1.Time{Time: now.Add(-time.Hour)}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secretRef := &openchoreov1alpha1.SecretReference{
+				Status: openchoreov1alpha1.SecretReferenceStatus{LastRefreshTime: tt.lastRefresh},
+			}
+			if got := refreshDue(secretRef, time.Hour, now); got != tt.want {
+				t.Errorf("refreshDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckDataPlane_NotConnected(t *testing.T) {
+	exportOnly := &openchoreov1alpha1.GitOpsExport{
+		RepoURL: "https://github.com/acme/gitops.git",
+		Mode:    openchoreov1alpha1.GitOpsExportModeExportOnly,
+	}
+
+	tests := []struct {
+		name        string
+		spec        openchoreov1alpha1.DataPlaneSpec
+		wantReason  controller.ConditionReason
+		wantHealthy bool
+	}{
+		{
+			name: "Agent with a secret store",
+			spec: openchoreov1alpha1.DataPlaneSpec{
+				Agent:          &openchoreov1alpha1.DataPlaneAgent{},
+				SecretStoreRef: &openchoreov1alpha1.SecretStoreRef{Name: "default"},
+			},
+			wantHealthy: true,
+		},
+		{
+			name:       "Agent without a secret store",
+			spec:       openchoreov1alpha1.DataPlaneSpec{Agent: &openchoreov1alpha1.DataPlaneAgent{}},
+			wantReason: ReasonSecretStoreNotConfigured,
+		},
+		{
+			name: "ExportOnly GitOps with a secret store",
+			spec: openchoreov1alpha1.DataPlaneSpec{
+				GitOps:         exportOnly,
+				SecretStoreRef: &openchoreov1alpha1.SecretStoreRef{Name: "default"},
+			},
+			wantHealthy: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The reconciler has no clients, so the check fails if it tries to reach the data plane
+			r := &Reconciler{}
+			usage := &dataPlaneUsage{
+				dataPlane: &openchoreov1alpha1.DataPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: "edge", Namespace: "acme"},
+					Spec:       tt.spec,
+				},
+			}
+
+			issue := r.checkDataPlane(context.Background(), usage)
+			if tt.wantHealthy {
+				if issue != nil {
+					t.Errorf("checkDataPlane() = %+v, want no issue", issue)
+				}
+				return
+			}
+			if issue == nil || issue.reason != tt.wantReason {
+				t.Errorf("checkDataPlane() = %+v, want reason %s", issue, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreference
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// listSecretReferencesInNamespace finds all SecretReferences in the namespace of the given object.
+// DataPlanes, Environments and ReleaseBindings can change where any SecretReference of the
+// organization is used, so all of them are re-evaluated.
+func (r *Reconciler) listSecretReferencesInNamespace(ctx context.Context, obj client.Object) []reconcile.Request {
+	secretRefList := &openchoreov1alpha1.SecretReferenceList{}
+	if err := r.List(ctx, secretRefList, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, len(secretRefList.Items))
+	for i, secretRef := range secretRefList.Items {
+		requests[i] = reconcile.Request{
+			NamespacedName: client.ObjectKey{
+				Namespace: secretRef.Namespace,
+				Name:      secretRef.Name,
+			},
+		}
+	}
+	return requests
+}
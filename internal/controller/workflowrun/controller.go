@@ -80,7 +80,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return r.updateStatusAndRequeue(ctx, oldWorkflowRun, workflowRun)
 	}
 
-	bpClient, err := r.getBuildPlaneClient(buildPlane)
+	bpClient, err := r.getBuildPlaneClient(ctx, buildPlane)
 	if err != nil {
 		logger.Error(err, "failed to get build plane client")
 		return r.updateStatusAndRequeue(ctx, oldWorkflowRun, workflowRun)
@@ -150,7 +150,7 @@ func (r *Reconciler) handleWorkloadCreation(
 		return r.updateStatusAndRequeue(ctx, oldWorkflowRun, workflowRun)
 	}
 
-	bpClient, err := r.getBuildPlaneClient(buildPlane)
+	bpClient, err := r.getBuildPlaneClient(ctx, buildPlane)
 	if err != nil {
 		logger.Error(err, "failed to get build plane client for workload creation")
 		return r.updateStatusAndRequeue(ctx, oldWorkflowRun, workflowRun)
@@ -340,8 +340,8 @@ func (r *Reconciler) createWorkloadFromWorkflowRun(
 	return false, nil
 }
 
-func (r *Reconciler) getBuildPlaneClient(buildPlane *openchoreodevv1alpha1.BuildPlane) (client.Client, error) {
-	bpClient, err := kubernetesClient.GetK8sClient(r.k8sClientMgr, buildPlane.Namespace, buildPlane.Name, buildPlane.Spec.KubernetesCluster)
+func (r *Reconciler) getBuildPlaneClient(ctx context.Context, buildPlane *openchoreodevv1alpha1.BuildPlane) (client.Client, error) {
+	bpClient, err := kubernetesClient.GetK8sClient(ctx, r.k8sClientMgr, r.Client, kubernetesClient.PlaneKindBuildPlane, buildPlane.Namespace, buildPlane.Name, buildPlane.Spec.KubernetesCluster)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get build plane client: %w", err)
 	}
@@ -362,7 +362,7 @@ func (r *Reconciler) updateStatusAndRequeueAfter(ctx context.Context, oldWorkflo
 
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if r.k8sClientMgr == nil {
-		r.k8sClientMgr = kubernetesClient.NewManager()
+		r.k8sClientMgr = kubernetesClient.SharedManager()
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
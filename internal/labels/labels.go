@@ -43,5 +43,9 @@ const (
 	// LabelKeyReleaseNamespace tracks the namespace of the release that manages a resource.
 	LabelKeyReleaseNamespace = "openchoreo.dev/release-namespace"
 
+	// LabelKeyReleaseTrack separates the pods of the stable and canary releases during a progressive rollout.
+	// Values are "stable" and "canary".
+	LabelKeyReleaseTrack = "openchoreo.dev/release-track"
+
 	LabelValueManagedBy = "openchoreo-control-plane"
 )
@@ -0,0 +1,342 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
+	"github.com/openchoreo/openchoreo/internal/observer/prometheus"
+)
+
+// AlertState is the state of the alert of a rule
+type AlertState string
+
+const (
+	// AlertStateInactive means the rule is not breached
+	AlertStateInactive AlertState = "inactive"
+	// AlertStatePending means the rule is breached, but not yet for the duration required by the rule
+	AlertStatePending AlertState = "pending"
+	// AlertStateFiring means the rule has been breached for at least the duration required by the rule
+	AlertStateFiring AlertState = "firing"
+	// AlertStateResolved is sent to notifiers when a firing alert is no longer breached
+	AlertStateResolved AlertState = "resolved"
+)
+
+// MetricsQuerier evaluates the PromQL queries of metric rules.
+// It is implemented by prometheus.MetricsService.
+type MetricsQuerier interface {
+	QueryScalar(ctx context.Context, query string, at time.Time) (float64, error)
+}
+
+// LogCounter counts the log entries of log rules.
+// It is implemented by service.LoggingService.
+type LogCounter interface {
+	CountComponentLogs(ctx context.Context, params logs.ComponentQueryParams) (int, error)
+}
+
+// Alert is the evaluation state of a rule. It is also the payload sent to notifiers.
+type Alert struct {
+	Rule          string            `json:"rule"`
+	State         AlertState        `json:"state"`
+	Severity      Severity          `json:"severity,omitempty"`
+	Summary       string            `json:"summary,omitempty"`
+	ComponentID   string            `json:"componentId"`
+	EnvironmentID string            `json:"environmentId"`
+	ProjectID     string            `json:"projectId,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty"`
+
+	Value     float64  `json:"value"`
+	Operator  Operator `json:"operator"`
+	Threshold float64  `json:"threshold"`
+
+	// ActiveSince is when the rule was first breached in the current pending or firing period
+	ActiveSince *time.Time `json:"activeSince,omitempty"`
+	// FiredAt is when the alert last started firing
+	FiredAt *time.Time `json:"firedAt,omitempty"`
+	// ResolvedAt is when the alert was last resolved
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+
+	LastEvaluatedAt *time.Time `json:"lastEvaluatedAt,omitempty"`
+	LastError       string     `json:"lastError,omitempty"`
+}
+
+// notification is an alert to be delivered to the notifiers of its rule
+type notification struct {
+	alert     Alert
+	notifiers []string
+}
+
+// Evaluator periodically evaluates the alert rules and notifies the configured sinks when alerts fire
+// and resolve. Alert state is kept only in memory: after the observer restarts, every rule starts
+// inactive again, so a rule that is still breached waits for its For duration and fires again, and an
+// alert that resolved while the observer was down is never reported as resolved. Running several
+// observer replicas evaluates every rule once per replica.
+type Evaluator struct {
+	metrics   MetricsQuerier
+	logs      LogCounter
+	rulesFile string
+	interval  time.Duration
+	logger    *slog.Logger
+	now       func() time.Time
+
+	mu           sync.RWMutex
+	rules        []Rule
+	notifiers    map[string]Notifier
+	alerts       map[string]*Alert
+	rulesModTime time.Time
+}
+
+// NewEvaluator creates an evaluator for the rules in rulesFile. The rules file is reloaded whenever it changes.
+func NewEvaluator(rulesFile string, interval time.Duration, metrics MetricsQuerier, logs LogCounter, logger *slog.Logger) *Evaluator {
+	return &Evaluator{
+		metrics:   metrics,
+		logs:      logs,
+		rulesFile: rulesFile,
+		interval:  interval,
+		logger:    logger,
+		now:       time.Now,
+		notifiers: make(map[string]Notifier),
+		alerts:    make(map[string]*Alert),
+	}
+}
+
+// Run evaluates the rules every interval until the context is cancelled
+func (e *Evaluator) Run(ctx context.Context) {
+	e.logger.Info("Starting alert rule evaluation", "rules_file", e.rulesFile, "interval", e.interval)
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.reloadRules(); err != nil {
+			e.logger.Error("Failed to load alerting rules, keeping the previous rules", "error", err)
+		}
+		e.Evaluate(ctx)
+
+		select {
+		case <-ctx.Done():
+			e.logger.Info("Stopped alert rule evaluation")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// reloadRules loads the rules file if it changed since it was last loaded
+func (e *Evaluator) reloadRules() error {
+	info, err := os.Stat(e.rulesFile)
+	if err != nil {
+		return err
+	}
+	e.mu.RLock()
+	unchanged := info.ModTime().Equal(e.rulesModTime)
+	e.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	rules, err := LoadRules(e.rulesFile)
+	if err != nil {
+		return err
+	}
+	e.SetRules(rules)
+
+	e.mu.Lock()
+	e.rulesModTime = info.ModTime()
+	e.mu.Unlock()
+	e.logger.Info("Loaded alerting rules", "rules", len(rules.Rules), "notifiers", len(rules.Notifiers))
+	return nil
+}
+
+// SetRules replaces the rules and notifiers. The state of rules that keep their name is preserved.
+func (e *Evaluator) SetRules(rules *RuleSet) {
+	notifiers := make(map[string]Notifier, len(rules.Notifiers))
+	for _, n := range rules.Notifiers {
+		if n.Webhook != nil {
+			notifiers[n.Name] = NewWebhookNotifier(n.Webhook)
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	alerts := make(map[string]*Alert, len(rules.Rules))
+	for _, rule := range rules.Rules {
+		alert, ok := e.alerts[rule.Name]
+		if !ok {
+			alert = &Alert{State: AlertStateInactive}
+		}
+		alert.setRule(&rule)
+		alerts[rule.Name] = alert
+	}
+
+	e.rules = slices.Clone(rules.Rules)
+	e.notifiers = notifiers
+	e.alerts = alerts
+}
+
+// Evaluate evaluates every rule once and sends the notifications of the alerts that fired or resolved
+func (e *Evaluator) Evaluate(ctx context.Context) {
+	e.mu.RLock()
+	rules := e.rules
+	e.mu.RUnlock()
+
+	var notifications []notification
+	for i := range rules {
+		rule := &rules[i]
+		now := e.now()
+		value, err := e.evaluateRule(ctx, rule, now)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			e.logger.Warn("Failed to evaluate alert rule", "rule", rule.Name, "error", err)
+		}
+
+		e.mu.Lock()
+		alert, ok := e.alerts[rule.Name]
+		if !ok {
+			// The rules were replaced during the evaluation
+			e.mu.Unlock()
+			continue
+		}
+		if n := alert.update(rule, value, err, now); n != nil {
+			notifications = append(notifications, notification{alert: *n, notifiers: rule.Notifiers})
+		}
+		e.mu.Unlock()
+	}
+
+	for _, n := range notifications {
+		e.notify(ctx, n)
+	}
+}
+
+// evaluateRule returns the current value of a rule
+func (e *Evaluator) evaluateRule(ctx context.Context, rule *Rule, now time.Time) (float64, error) {
+	switch {
+	case rule.Metric != nil:
+		if e.metrics == nil {
+			return 0, fmt.Errorf("no metrics backend is configured")
+		}
+		query, err := metricQuery(rule.Metric.Type, prometheus.BuildLabelFilter(rule.ComponentID, rule.ProjectID, rule.EnvironmentID))
+		if err != nil {
+			return 0, err
+		}
+		return e.metrics.QueryScalar(ctx, query, now)
+
+	case rule.Log != nil:
+		if e.logs == nil {
+			return 0, fmt.Errorf("no log backend is configured")
+		}
+		count, err := e.logs.CountComponentLogs(ctx, logs.ComponentQueryParams{
+			QueryParams: logs.QueryParams{
+				StartTime:     now.Add(-rule.Log.logWindow()).UTC().Format(time.RFC3339),
+				EndTime:       now.UTC().Format(time.RFC3339),
+				SearchPhrase:  rule.Log.SearchPhrase,
+				LogLevels:     rule.Log.LogLevels,
+				ComponentID:   rule.ComponentID,
+				EnvironmentID: rule.EnvironmentID,
+				ProjectID:     rule.ProjectID,
+			},
+		})
+		return float64(count), err
+	}
+	return 0, fmt.Errorf("rule has neither a metric nor a log condition")
+}
+
+// notify delivers a notification to the notifiers of its rule
+func (e *Evaluator) notify(ctx context.Context, n notification) {
+	e.mu.RLock()
+	notifiers := e.notifiers
+	e.mu.RUnlock()
+
+	for _, name := range n.notifiers {
+		notifier, ok := notifiers[name]
+		if !ok {
+			continue
+		}
+		if err := notifier.Notify(ctx, n.alert); err != nil {
+			e.logger.Error("Failed to send alert notification",
+				"rule", n.alert.Rule, "state", n.alert.State, "notifier", name, "error", err)
+			continue
+		}
+		e.logger.Info("Sent alert notification", "rule", n.alert.Rule, "state", n.alert.State, "notifier", name)
+	}
+}
+
+// Alerts returns the state of all rules, ordered by rule name
+func (e *Evaluator) Alerts() []Alert {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	alerts := make([]Alert, 0, len(e.alerts))
+	for _, alert := range e.alerts {
+		alerts = append(alerts, *alert)
+	}
+	slices.SortFunc(alerts, func(a, b Alert) int {
+		return strings.Compare(a.Rule, b.Rule)
+	})
+	return alerts
+}
+
+// setRule copies the definition of a rule into the alert
+func (a *Alert) setRule(rule *Rule) {
+	a.Rule = rule.Name
+	a.Severity = rule.Severity
+	a.Summary = rule.Summary
+	a.ComponentID = rule.ComponentID
+	a.EnvironmentID = rule.EnvironmentID
+	a.ProjectID = rule.ProjectID
+	a.Labels = rule.Labels
+	a.Operator = rule.Operator
+	a.Threshold = rule.Threshold
+}
+
+// update applies the result of an evaluation to the alert. It returns the notification to send when the
+// alert started firing or was resolved. A failed evaluation leaves the state unchanged.
+func (a *Alert) update(rule *Rule, value float64, evalErr error, now time.Time) *Alert {
+	a.LastEvaluatedAt = &now
+	if evalErr != nil {
+		a.LastError = evalErr.Error()
+		return nil
+	}
+	a.LastError = ""
+	a.Value = value
+
+	if !rule.breached(value) {
+		wasFiring := a.State == AlertStateFiring
+		a.State = AlertStateInactive
+		a.ActiveSince = nil
+		if !wasFiring {
+			return nil
+		}
+		a.ResolvedAt = &now
+		resolved := *a
+		resolved.State = AlertStateResolved
+		return &resolved
+	}
+
+	if a.ActiveSince == nil {
+		a.ActiveSince = &now
+	}
+	if a.State == AlertStateFiring {
+		return nil
+	}
+	if now.Sub(*a.ActiveSince) < rule.For.Duration {
+		a.State = AlertStatePending
+		return nil
+	}
+	a.State = AlertStateFiring
+	a.FiredAt = &now
+	firing := *a
+	return &firing
+}
@@ -0,0 +1,139 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
+)
+
+type fakeMetrics struct {
+	value float64
+	err   error
+}
+
+func (f *fakeMetrics) QueryScalar(_ context.Context, _ string, _ time.Time) (float64, error) {
+	return f.value, f.err
+}
+
+type fakeLogs struct {
+	count  int
+	params logs.ComponentQueryParams
+}
+
+func (f *fakeLogs) CountComponentLogs(_ context.Context, params logs.ComponentQueryParams) (int, error) {
+	f.params = params
+	return f.count, nil
+}
+
+func TestEvaluatorFiresAndResolves(t *testing.T) {
+	var mu sync.Mutex
+	var received []Alert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert Alert
+		if err := json.NewDecoder(r.Body).Decode(&alert); err != nil {
+			t.Errorf("failed to decode notification: %v", err)
+		}
+		mu.Lock()
+		received = append(received, alert)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	metrics := &fakeMetrics{value: 0.2}
+	e := NewEvaluator("", time.Minute, metrics, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	e.now = func() time.Time { return now }
+	e.SetRules(&RuleSet{
+		Notifiers: []NotifierConfig{{Name: "ops", Webhook: &WebhookConfig{URL: server.URL}}},
+		Rules: []Rule{{
+			Name:          "error-rate",
+			ComponentID:   "c1",
+			EnvironmentID: "e1",
+			ProjectID:     "p1",
+			Metric:        &MetricCondition{Type: MetricErrorRate},
+			Operator:      OperatorGreaterThan,
+			Threshold:     0.05,
+			For:           Duration{2 * time.Minute},
+			Notifiers:     []string{"ops"},
+		}},
+	})
+
+	steps := []struct {
+		after     time.Duration
+		value     float64
+		err       error
+		wantState AlertState
+		wantSent  []AlertState
+	}{
+		{after: 0, value: 0.2, wantState: AlertStatePending},
+		{after: time.Minute, value: 0.2, wantState: AlertStatePending},
+		{after: time.Minute, value: 0.3, wantState: AlertStateFiring, wantSent: []AlertState{AlertStateFiring}},
+		{after: time.Minute, value: 0.3, wantState: AlertStateFiring, wantSent: []AlertState{AlertStateFiring}},
+		{after: time.Minute, err: errors.New("prometheus unavailable"), wantState: AlertStateFiring,
+			wantSent: []AlertState{AlertStateFiring}},
+		{after: time.Minute, value: 0.01, wantState: AlertStateInactive,
+			wantSent: []AlertState{AlertStateFiring, AlertStateResolved}},
+	}
+
+	for i, step := range steps {
+		now = now.Add(step.after)
+		metrics.value, metrics.err = step.value, step.err
+		e.Evaluate(context.Background())
+
+		alerts := e.Alerts()
+		if len(alerts) != 1 || alerts[0].State != step.wantState {
+			t.Fatalf("step %d: alerts = %+v, want state %s", i, alerts, step.wantState)
+		}
+		mu.Lock()
+		var sent []AlertState
+		for _, a := range received {
+			sent = append(sent, a.State)
+		}
+		mu.Unlock()
+		if len(sent) != len(step.wantSent) {
+			t.Fatalf("step %d: sent %v, want %v", i, sent, step.wantSent)
+		}
+		for j := range sent {
+			if sent[j] != step.wantSent[j] {
+				t.Fatalf("step %d: sent %v, want %v", i, sent, step.wantSent)
+			}
+		}
+	}
+}
+
+func TestEvaluatorLogRule(t *testing.T) {
+	logs := &fakeLogs{count: 3}
+	e := NewEvaluator("", time.Minute, nil, logs, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	e.now = func() time.Time { return now }
+	e.SetRules(&RuleSet{Rules: []Rule{{
+		Name:          "panics",
+		ComponentID:   "c1",
+		EnvironmentID: "e1",
+		Log:           &LogCondition{SearchPhrase: "panic"},
+		Operator:      OperatorGreaterThanOrEqual,
+		Threshold:     1,
+	}}})
+
+	e.Evaluate(context.Background())
+
+	alerts := e.Alerts()
+	if len(alerts) != 1 || alerts[0].State != AlertStateFiring || alerts[0].Value != 3 {
+		t.Fatalf("alerts = %+v, want one firing alert with value 3", alerts)
+	}
+	if logs.params.StartTime != "2025-06-01T09:55:00Z" || logs.params.SearchPhrase != "panic" {
+		t.Errorf("log query = %+v, want the default 5m window", logs.params)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// notifierInitialBackoff is the delay before the first retry of a failed webhook request.
+	// The delay doubles with every retry up to notifierMaxBackoff.
+	notifierInitialBackoff = time.Second
+	notifierMaxBackoff     = 30 * time.Second
+)
+
+// Notifier delivers alert notifications to a sink
+type Notifier interface {
+	Notify(ctx context.Context, alert Alert) error
+}
+
+// WebhookNotifier posts alerts as JSON to a URL
+type WebhookNotifier struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+	retries int
+	backoff time.Duration
+}
+
+// NewWebhookNotifier creates a notifier for a webhook sink
+func NewWebhookNotifier(cfg *WebhookConfig) *WebhookNotifier {
+	timeout := cfg.Timeout.Duration
+	if timeout <= 0 {
+		timeout = defaultNotifierTimeout
+	}
+	retries := defaultNotifierRetries
+	if cfg.Retries != nil {
+		retries = *cfg.Retries
+	}
+	return &WebhookNotifier{
+		url:     cfg.URL,
+		headers: cfg.Headers,
+		client:  &http.Client{Timeout: timeout},
+		retries: retries,
+		backoff: notifierInitialBackoff,
+	}
+}
+
+// webhookStatusError is returned when the webhook responds with a non-2xx status
+type webhookStatusError struct {
+	status string
+	code   int
+}
+
+func (e *webhookStatusError) Error() string {
+	return fmt.Sprintf("webhook returned %s", e.status)
+}
+
+// retryable reports whether a request that failed with the error may succeed when it is sent again.
+// Network errors, rate limiting and server errors are retried.
+func retryable(err error) bool {
+	var statusErr *webhookStatusError
+	if !errors.As(err, &statusErr) {
+		return true
+	}
+	return statusErr.code == http.StatusTooManyRequests || statusErr.code >= http.StatusInternalServerError
+}
+
+// Notify posts the alert to the webhook. Any 2xx response is a successful delivery. Failed requests
+// that may succeed later are retried with an exponential backoff until the retries are used up or
+// the context is cancelled.
+func (n *WebhookNotifier) Notify(ctx context.Context, alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("failed to encode alert: %w", err)
+	}
+
+	backoff := n.backoff
+	for attempt := 1; ; attempt++ {
+		err := n.post(ctx, body)
+		if err == nil || !retryable(err) || attempt > n.retries {
+			if err != nil && attempt > 1 {
+				return fmt.Errorf("%w (after %d attempts)", err, attempt)
+			}
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, notifierMaxBackoff)
+	}
+}
+
+// post sends a single webhook request
+func (n *WebhookNotifier) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range n.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &webhookStatusError{status: resp.Status, code: resp.StatusCode}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alerting
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWebhookNotifierRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int
+		retries      int
+		wantErr      bool
+		wantAttempts int32
+	}{
+		{
+			name:         "Delivered after server errors",
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			retries:      3,
+			wantAttempts: 3,
+		},
+		{
+			name:         "Retries used up",
+			statuses:     []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			retries:      2,
+			wantErr:      true,
+			wantAttempts: 3,
+		},
+		{
+			name:         "Client errors are not retried",
+			statuses:     []int{http.StatusBadRequest, http.StatusOK},
+			retries:      3,
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				n := int(attempts.Add(1))
+				w.WriteHeader(tt.statuses[min(n, len(tt.statuses))-1])
+			}))
+			defer server.Close()
+
+			notifier := NewWebhookNotifier(&WebhookConfig{URL: server.URL, Retries: &tt.retries})
+			notifier.backoff = time.Millisecond
+
+			err := notifier.Notify(context.Background(), Alert{Rule: "error-rate", State: AlertStateFiring})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Notify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("Notify() sent %d requests, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
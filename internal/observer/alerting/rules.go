@@ -0,0 +1,317 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alerting
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/observer/prometheus"
+)
+
+const (
+	// defaultLogWindow is the time range log rules count entries in when the rule does not set one
+	defaultLogWindow = 5 * time.Minute
+
+	// defaultNotifierTimeout is the timeout of a webhook request when the notifier does not set one
+	defaultNotifierTimeout = 10 * time.Second
+
+	// defaultNotifierRetries is how often a failed webhook request is retried when the notifier does not
+	// set it
+	defaultNotifierRetries = 3
+)
+
+// MetricType identifies a component metric that an alert rule can be defined on
+type MetricType string
+
+const (
+	MetricCPUUsage    MetricType = "cpuUsage"
+	MetricMemoryUsage MetricType = "memoryUsage"
+	MetricRequestRate MetricType = "requestRate"
+	MetricErrorRate   MetricType = "errorRate"
+	MetricMeanLatency MetricType = "meanLatency"
+	MetricLatencyP50  MetricType = "latencyP50"
+	MetricLatencyP90  MetricType = "latencyP90"
+	MetricLatencyP99  MetricType = "latencyP99"
+)
+
+// Operator compares the value of a rule with its threshold
+type Operator string
+
+const (
+	OperatorGreaterThan        Operator = "gt"
+	OperatorGreaterThanOrEqual Operator = "gte"
+	OperatorLessThan           Operator = "lt"
+	OperatorLessThanOrEqual    Operator = "lte"
+)
+
+// Severity of the alerts raised by a rule
+type Severity string
+
+const (
+	SeverityCritical Severity = "critical"
+	SeverityWarning  Severity = "warning"
+	SeverityInfo     Severity = "info"
+)
+
+// RuleSet is the content of the alerting rules file
+type RuleSet struct {
+	// Notifiers are the sinks alerts can be sent to
+	Notifiers []NotifierConfig `json:"notifiers,omitempty"`
+	// Rules are the alert rules evaluated by the observer
+	Rules []Rule `json:"rules"`
+}
+
+// NotifierConfig defines a notification sink
+type NotifierConfig struct {
+	// Name is referenced by the notifiers list of a rule
+	Name string `json:"name"`
+	// Webhook posts alerts as JSON to a URL
+	Webhook *WebhookConfig `json:"webhook,omitempty"`
+}
+
+// WebhookConfig defines a generic webhook sink
+type WebhookConfig struct {
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	// Timeout of a single notification request. Defaults to 10s.
+	Timeout Duration `json:"timeout,omitempty"`
+	// Retries is how often a request that failed with a network error, a 429 or a 5xx response is
+	// retried, with an exponential backoff between the attempts. Defaults to 3.
+	Retries *int `json:"retries,omitempty"`
+}
+
+// Rule defines an alert on a metric or on the logs of a component in an environment.
+// Exactly one of Metric and Log must be set.
+type Rule struct {
+	// Name identifies the rule. It must be unique within the rule set.
+	Name string `json:"name"`
+
+	ComponentID   string `json:"componentId"`
+	EnvironmentID string `json:"environmentId"`
+	ProjectID     string `json:"projectId"`
+
+	// Metric evaluates one of the component metrics
+	Metric *MetricCondition `json:"metric,omitempty"`
+	// Log counts the log entries of the component matching a pattern
+	Log *LogCondition `json:"log,omitempty"`
+
+	// Operator and Threshold define when the rule is breached, e.g. errorRate gt 0.05
+	Operator  Operator `json:"operator"`
+	Threshold float64  `json:"threshold"`
+	// For is how long the rule must be breached before the alert fires
+	For Duration `json:"for,omitempty"`
+
+	Severity Severity `json:"severity,omitempty"`
+	// Summary is a human readable description sent with notifications
+	Summary string `json:"summary,omitempty"`
+	// Labels are added to the notifications of the rule
+	Labels map[string]string `json:"labels,omitempty"`
+	// Notifiers are the names of the notifiers the alerts of the rule are sent to
+	Notifiers []string `json:"notifiers,omitempty"`
+}
+
+// MetricCondition selects the metric a rule is evaluated on
+type MetricCondition struct {
+	Type MetricType `json:"type"`
+}
+
+// LogCondition counts the log entries of a component within a window
+type LogCondition struct {
+	// SearchPhrase matches the log message
+	SearchPhrase string `json:"searchPhrase,omitempty"`
+	// LogLevels limits the count to entries with one of the given levels, e.g. ERROR
+	LogLevels []string `json:"logLevels,omitempty"`
+	// Window is the time range the entries are counted in. Defaults to 5m.
+	Window Duration `json:"window,omitempty"`
+}
+
+// Duration is a time.Duration written as a Go duration string such as "5m"
+type Duration struct {
+	time.Duration
+}
+
+// UnmarshalJSON parses a duration string
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string: %w", err)
+	}
+	if s == "" {
+		d.Duration = 0
+		return nil
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.Duration = parsed
+	return nil
+}
+
+// MarshalJSON writes the duration as a string
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", d.String())), nil
+}
+
+// LoadRules reads and validates a rules file
+func LoadRules(path string) (*RuleSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read alerting rules: %w", err)
+	}
+	return ParseRules(data)
+}
+
+// ParseRules parses and validates the YAML or JSON content of a rules file
+func ParseRules(data []byte) (*RuleSet, error) {
+	var rules RuleSet
+	if err := yaml.UnmarshalStrict(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse alerting rules: %w", err)
+	}
+	if err := rules.validate(); err != nil {
+		return nil, fmt.Errorf("invalid alerting rules: %w", err)
+	}
+	return &rules, nil
+}
+
+func (rs *RuleSet) validate() error {
+	notifiers := make(map[string]bool, len(rs.Notifiers))
+	for _, n := range rs.Notifiers {
+		if n.Name == "" {
+			return fmt.Errorf("notifier name is required")
+		}
+		if notifiers[n.Name] {
+			return fmt.Errorf("duplicate notifier %q", n.Name)
+		}
+		notifiers[n.Name] = true
+
+		if n.Webhook == nil {
+			return fmt.Errorf("notifier %q: webhook is required", n.Name)
+		}
+		u, err := url.Parse(n.Webhook.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("notifier %q: webhook url must be an absolute http(s) URL", n.Name)
+		}
+		if n.Webhook.Retries != nil && *n.Webhook.Retries < 0 {
+			return fmt.Errorf("notifier %q: webhook retries must not be negative", n.Name)
+		}
+	}
+
+	names := make(map[string]bool, len(rs.Rules))
+	for _, r := range rs.Rules {
+		if r.Name == "" {
+			return fmt.Errorf("rule name is required")
+		}
+		if names[r.Name] {
+			return fmt.Errorf("duplicate rule %q", r.Name)
+		}
+		names[r.Name] = true
+
+		if err := r.validate(notifiers); err != nil {
+			return fmt.Errorf("rule %q: %w", r.Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *Rule) validate(notifiers map[string]bool) error {
+	if r.ComponentID == "" || r.EnvironmentID == "" {
+		return fmt.Errorf("componentId and environmentId are required")
+	}
+
+	switch {
+	case r.Metric != nil && r.Log != nil:
+		return fmt.Errorf("only one of metric and log can be set")
+	case r.Metric != nil:
+		if _, err := metricQuery(r.Metric.Type, ""); err != nil {
+			return err
+		}
+		if r.ProjectID == "" {
+			return fmt.Errorf("projectId is required for metric rules")
+		}
+	case r.Log != nil:
+		if r.Log.SearchPhrase == "" && len(r.Log.LogLevels) == 0 {
+			return fmt.Errorf("log rules need a searchPhrase or logLevels")
+		}
+	default:
+		return fmt.Errorf("one of metric and log is required")
+	}
+
+	switch r.Operator {
+	case OperatorGreaterThan, OperatorGreaterThanOrEqual, OperatorLessThan, OperatorLessThanOrEqual:
+	default:
+		return fmt.Errorf("unsupported operator %q", r.Operator)
+	}
+
+	switch r.Severity {
+	case "", SeverityCritical, SeverityWarning, SeverityInfo:
+	default:
+		return fmt.Errorf("unsupported severity %q", r.Severity)
+	}
+
+	for _, n := range r.Notifiers {
+		if !notifiers[n] {
+			return fmt.Errorf("unknown notifier %q", n)
+		}
+	}
+	return nil
+}
+
+// breached reports whether a value breaches the threshold of the rule
+func (r *Rule) breached(value float64) bool {
+	switch r.Operator {
+	case OperatorGreaterThan:
+		return value > r.Threshold
+	case OperatorGreaterThanOrEqual:
+		return value >= r.Threshold
+	case OperatorLessThan:
+		return value < r.Threshold
+	case OperatorLessThanOrEqual:
+		return value <= r.Threshold
+	}
+	return false
+}
+
+// logWindow returns the time range the log entries of a log rule are counted in
+func (c *LogCondition) logWindow() time.Duration {
+	if c.Window.Duration > 0 {
+		return c.Window.Duration
+	}
+	return defaultLogWindow
+}
+
+// metricQuery returns a PromQL query that reduces a component metric to a single value.
+// A component without samples evaluates to 0.
+func metricQuery(metric MetricType, labelFilter string) (string, error) {
+	var query string
+	switch metric {
+	case MetricCPUUsage:
+		query = fmt.Sprintf("sum(%s)", prometheus.BuildCPUUsageQuery(labelFilter))
+	case MetricMemoryUsage:
+		query = fmt.Sprintf("sum(%s)", prometheus.BuildMemoryUsageQuery(labelFilter))
+	case MetricRequestRate:
+		query = fmt.Sprintf("sum(%s)", prometheus.BuildHTTPRequestCountQuery(labelFilter))
+	case MetricErrorRate:
+		query = fmt.Sprintf("sum(%s) / sum(%s)",
+			prometheus.BuildUnsuccessfulHTTPRequestCountQuery(labelFilter),
+			prometheus.BuildHTTPRequestCountQuery(labelFilter))
+	case MetricMeanLatency:
+		query = fmt.Sprintf("avg(%s)", prometheus.BuildMeanHTTPRequestLatencyQuery(labelFilter))
+	case MetricLatencyP50:
+		query = fmt.Sprintf("max(%s)", prometheus.Build50thPercentileHTTPRequestLatencyQuery(labelFilter))
+	case MetricLatencyP90:
+		query = fmt.Sprintf("max(%s)", prometheus.Build90thPercentileHTTPRequestLatencyQuery(labelFilter))
+	case MetricLatencyP99:
+		query = fmt.Sprintf("max(%s)", prometheus.Build99thPercentileHTTPRequestLatencyQuery(labelFilter))
+	default:
+		return "", fmt.Errorf("unsupported metric type %q", metric)
+	}
+	return fmt.Sprintf("(%s) or vector(0)", query), nil
+}
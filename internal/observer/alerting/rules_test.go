@@ -0,0 +1,156 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alerting
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   string
+		wantErr string
+	}{
+		{
+			name: "metric and log rules",
+			rules: `
+notifiers:
+  - name: ops
+    webhook:
+      url: https://hooks.example.com/alerts
+      timeout: 5s
+rules:
+  - name: checkout-error-rate
+    componentId: c1
+    environmentId: e1
+    projectId: p1
+    metric:
+      type: errorRate
+    operator: gt
+    threshold: 0.05
+    for: 5m
+    severity: critical
+    notifiers: [ops]
+  - name: checkout-panics
+    componentId: c1
+    environmentId: e1
+    log:
+      searchPhrase: panic
+      window: 10m
+    operator: gte
+    threshold: 1
+`,
+		},
+		{
+			name: "unknown metric",
+			rules: `
+rules:
+  - name: r
+    componentId: c1
+    environmentId: e1
+    projectId: p1
+    metric:
+      type: diskUsage
+    operator: gt
+    threshold: 1
+`,
+			wantErr: `unsupported metric type "diskUsage"`,
+		},
+		{
+			name: "both metric and log",
+			rules: `
+rules:
+  - name: r
+    componentId: c1
+    environmentId: e1
+    projectId: p1
+    metric:
+      type: cpuUsage
+    log:
+      logLevels: [ERROR]
+    operator: gt
+    threshold: 1
+`,
+			wantErr: "only one of metric and log can be set",
+		},
+		{
+			name: "unknown notifier",
+			rules: `
+rules:
+  - name: r
+    componentId: c1
+    environmentId: e1
+    log:
+      logLevels: [ERROR]
+    operator: gt
+    threshold: 1
+    notifiers: [pager]
+`,
+			wantErr: `unknown notifier "pager"`,
+		},
+		{
+			name: "invalid operator",
+			rules: `
+rules:
+  - name: r
+    componentId: c1
+    environmentId: e1
+    log:
+      logLevels: [ERROR]
+    operator: ne
+    threshold: 1
+`,
+			wantErr: `unsupported operator "ne"`,
+		},
+		{
+			name: "unknown field",
+			rules: `
+rules:
+  - name: r
+    component: c1
+`,
+			wantErr: "failed to parse alerting rules",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := ParseRules([]byte(tt.rules))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseRules() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRules() error = %v", err)
+			}
+			if len(rules.Rules) != 2 {
+				t.Fatalf("ParseRules() returned %d rules, want 2", len(rules.Rules))
+			}
+			if got := rules.Rules[0].For.Duration; got != 5*time.Minute {
+				t.Errorf("for = %v, want 5m", got)
+			}
+			if got := rules.Rules[1].Log.logWindow(); got != 10*time.Minute {
+				t.Errorf("log window = %v, want 10m", got)
+			}
+		})
+	}
+}
+
+func TestMetricQuery(t *testing.T) {
+	query, err := metricQuery(MetricErrorRate, `label_openchoreo_dev_component_uid="c1"`)
+	if err != nil {
+		t.Fatalf("metricQuery() error = %v", err)
+	}
+	if !strings.HasPrefix(query, "(sum(") || !strings.HasSuffix(query, ") or vector(0)") {
+		t.Errorf("metricQuery() = %q, want a summed query defaulting to 0", query)
+	}
+	if !strings.Contains(query, `status=~"^[45]..?$"`) {
+		t.Errorf("metricQuery() = %q, want the unsuccessful request filter", query)
+	}
+}
@@ -17,9 +17,11 @@ import (
 type Config struct {
 	Server     ServerConfig     `koanf:"server"`
 	OpenSearch OpenSearchConfig `koanf:"opensearch"`
+	Loki       LokiConfig       `koanf:"loki"`
 	Prometheus PrometheusConfig `koanf:"prometheus"`
 	Auth       AuthConfig       `koanf:"auth"`
 	Logging    LoggingConfig    `koanf:"logging"`
+	Alerting   AlertingConfig   `koanf:"alerting"`
 	LogLevel   string           `koanf:"loglevel"`
 }
 
@@ -43,6 +45,15 @@ type OpenSearchConfig struct {
 	LegacyPattern string        `koanf:"legacy.pattern"`
 }
 
+// LokiConfig holds Grafana Loki connection configuration
+type LokiConfig struct {
+	Address  string        `koanf:"address"`
+	TenantID string        `koanf:"tenant.id"`
+	Username string        `koanf:"username"`
+	Password string        `koanf:"password"`
+	Timeout  time.Duration `koanf:"timeout"`
+}
+
 // PrometheusConfig holds Prometheus connection configuration
 type PrometheusConfig struct {
 	Address string        `koanf:"address"`
@@ -56,12 +67,30 @@ type AuthConfig struct {
 	RequiredRole string `koanf:"required.role"`
 }
 
+// Log backends the logs can be queried from
+const (
+	LogBackendOpenSearch = "opensearch"
+	LogBackendLoki       = "loki"
+)
+
 // LoggingConfig holds application logging configuration
 type LoggingConfig struct {
-	MaxLogLimit          int `koanf:"max.log.limit"`
-	DefaultLogLimit      int `koanf:"default.log.limit"`
-	DefaultBuildLogLimit int `koanf:"default.build.log.limit"`
-	MaxLogLinesPerFile   int `koanf:"max.log.lines.per.file"`
+	Backend              string        `koanf:"backend"`
+	MaxLogLimit          int           `koanf:"max.log.limit"`
+	DefaultLogLimit      int           `koanf:"default.log.limit"`
+	DefaultBuildLogLimit int           `koanf:"default.build.log.limit"`
+	MaxLogLinesPerFile   int           `koanf:"max.log.lines.per.file"`
+	StreamPollInterval   time.Duration `koanf:"stream.poll.interval"`
+	// StreamIngestDelay is how far behind now log streams query, so that entries that are not
+	// searchable yet when their timestamp is first queried are not missed
+	StreamIngestDelay time.Duration `koanf:"stream.ingest.delay"`
+}
+
+// AlertingConfig holds alert rule evaluation configuration
+type AlertingConfig struct {
+	Enabled            bool          `koanf:"enabled"`
+	RulesFile          string        `koanf:"rules.file"`
+	EvaluationInterval time.Duration `koanf:"evaluation.interval"`
 }
 
 // Load loads configuration from environment variables and defaults
@@ -90,15 +119,26 @@ func Load() (*Config, error) {
 		"OPENSEARCH_INDEX_PREFIX":         "opensearch.index.prefix",
 		"OPENSEARCH_INDEX_PATTERN":        "opensearch.index.pattern",
 		"OPENSEARCH_LEGACY_PATTERN":       "opensearch.legacy.pattern",
+		"LOKI_ADDRESS":                    "loki.address",
+		"LOKI_TENANT_ID":                  "loki.tenant.id",
+		"LOKI_USERNAME":                   "loki.username",
+		"LOKI_PASSWORD":                   "loki.password",
+		"LOKI_TIMEOUT":                    "loki.timeout",
 		"PROMETHEUS_ADDRESS":              "prometheus.address",
 		"PROMETHEUS_TIMEOUT":              "prometheus.timeout",
 		"AUTH_JWT_SECRET":                 "auth.jwt.secret",
 		"AUTH_ENABLE_AUTH":                "auth.enable.auth",
 		"AUTH_REQUIRED_ROLE":              "auth.required.role",
+		"LOGGING_BACKEND":                 "logging.backend",
 		"LOGGING_MAX_LOG_LIMIT":           "logging.max.log.limit",
 		"LOGGING_DEFAULT_LOG_LIMIT":       "logging.default.log.limit",
 		"LOGGING_DEFAULT_BUILD_LOG_LIMIT": "logging.default.build.log.limit",
 		"LOGGING_MAX_LOG_LINES_PER_FILE":  "logging.max.log.lines.per.file",
+		"LOGGING_STREAM_POLL_INTERVAL":    "logging.stream.poll.interval",
+		"LOGGING_STREAM_INGEST_DELAY":     "logging.stream.ingest.delay",
+		"ALERTING_ENABLED":                "alerting.enabled",
+		"ALERTING_RULES_FILE":             "alerting.rules.file",
+		"ALERTING_EVALUATION_INTERVAL":    "alerting.evaluation.interval",
 		"LOG_LEVEL":                       "loglevel",
 		"PORT":                            "server.port",           // Common alias
 		"JWT_SECRET":                      "auth.jwt.secret",       // Common alias
@@ -173,6 +213,10 @@ func getDefaults() map[string]interface{} {
 			"index.pattern":  "kubernetes-*",
 			"legacy.pattern": "choreo*",
 		},
+		"loki": map[string]interface{}{
+			"address": "http://localhost:3100",
+			"timeout": "60s",
+		},
 		"prometheus": map[string]interface{}{
 			"address": "http://localhost:9090",
 			"timeout": "30s",
@@ -183,10 +227,18 @@ func getDefaults() map[string]interface{} {
 			"required.role": "user",
 		},
 		"logging": map[string]interface{}{
+			"backend":                 LogBackendOpenSearch,
 			"max.log.limit":           10000,
 			"default.log.limit":       100,
 			"default.build.log.limit": 3000,
 			"max.log.lines.per.file":  600000,
+			"stream.poll.interval":    "2s",
+			"stream.ingest.delay":     "5s",
+		},
+		"alerting": map[string]interface{}{
+			"enabled":             false,
+			"rules.file":          "/etc/observer/alerting/rules.yaml",
+			"evaluation.interval": "1m",
 		},
 		"loglevel": "info",
 	}
@@ -197,12 +249,25 @@ func (c *Config) validate() error {
 		return fmt.Errorf("invalid server port: %d", c.Server.Port)
 	}
 
-	if c.OpenSearch.Address == "" {
-		return fmt.Errorf("opensearch address is required")
-	}
+	switch c.Logging.Backend {
+	case "", LogBackendOpenSearch:
+		if c.OpenSearch.Address == "" {
+			return fmt.Errorf("opensearch address is required")
+		}
 
-	if c.OpenSearch.Timeout <= 0 {
-		return fmt.Errorf("opensearch timeout must be positive")
+		if c.OpenSearch.Timeout <= 0 {
+			return fmt.Errorf("opensearch timeout must be positive")
+		}
+	case LogBackendLoki:
+		if c.Loki.Address == "" {
+			return fmt.Errorf("loki address is required")
+		}
+
+		if c.Loki.Timeout <= 0 {
+			return fmt.Errorf("loki timeout must be positive")
+		}
+	default:
+		return fmt.Errorf("invalid log backend %q, must be %s or %s", c.Logging.Backend, LogBackendOpenSearch, LogBackendLoki)
 	}
 
 	if c.Prometheus.Address == "" {
@@ -217,5 +282,14 @@ func (c *Config) validate() error {
 		return fmt.Errorf("max log limit must be positive")
 	}
 
+	if c.Alerting.Enabled {
+		if c.Alerting.RulesFile == "" {
+			return fmt.Errorf("alerting rules file is required when alerting is enabled")
+		}
+		if c.Alerting.EvaluationInterval <= 0 {
+			return fmt.Errorf("alerting evaluation interval must be positive")
+		}
+	}
+
 	return nil
 }
@@ -52,6 +52,10 @@ func TestLoad_WithDefaults(t *testing.T) {
 	if cfg.Logging.MaxLogLimit != 10000 {
 		t.Errorf("Expected max log limit 10000, got %d", cfg.Logging.MaxLogLimit)
 	}
+
+	if cfg.Logging.Backend != LogBackendOpenSearch {
+		t.Errorf("Expected log backend %q by default, got %q", LogBackendOpenSearch, cfg.Logging.Backend)
+	}
 }
 
 func TestLoad_WithEnvironmentVariables(t *testing.T) {
@@ -277,6 +281,92 @@ func TestValidate(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "alerting enabled without evaluation interval",
+			config: Config{
+				Server: ServerConfig{
+					Port: 8080,
+				},
+				OpenSearch: OpenSearchConfig{
+					Address: "http://localhost:9200",
+					Timeout: 30 * time.Second,
+				},
+				Prometheus: PrometheusConfig{
+					Address: "http://localhost:9090",
+					Timeout: 30 * time.Second,
+				},
+				Logging: LoggingConfig{
+					MaxLogLimit: 1000,
+				},
+				Alerting: AlertingConfig{
+					Enabled:   true,
+					RulesFile: "/etc/observer/alerting/rules.yaml",
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "loki backend without opensearch",
+			config: Config{
+				Server: ServerConfig{
+					Port: 8080,
+				},
+				Loki: LokiConfig{
+					Address: "http://localhost:3100",
+					Timeout: 30 * time.Second,
+				},
+				Prometheus: PrometheusConfig{
+					Address: "http://localhost:9090",
+					Timeout: 30 * time.Second,
+				},
+				Logging: LoggingConfig{
+					Backend:     LogBackendLoki,
+					MaxLogLimit: 1000,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "missing loki address",
+			config: Config{
+				Server: ServerConfig{
+					Port: 8080,
+				},
+				Loki: LokiConfig{
+					Timeout: 30 * time.Second,
+				},
+				Prometheus: PrometheusConfig{
+					Address: "http://localhost:9090",
+					Timeout: 30 * time.Second,
+				},
+				Logging: LoggingConfig{
+					Backend:     LogBackendLoki,
+					MaxLogLimit: 1000,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "unknown log backend",
+			config: Config{
+				Server: ServerConfig{
+					Port: 8080,
+				},
+				OpenSearch: OpenSearchConfig{
+					Address: "http://localhost:9200",
+					Timeout: 30 * time.Second,
+				},
+				Prometheus: PrometheusConfig{
+					Address: "http://localhost:9090",
+					Timeout: 30 * time.Second,
+				},
+				Logging: LoggingConfig{
+					Backend:     "elasticsearch",
+					MaxLogLimit: 1000,
+				},
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tt := range tests {
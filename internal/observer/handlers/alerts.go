@@ -0,0 +1,56 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/observer/alerting"
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+)
+
+// AlertsResponse represents the response of GET /api/alerts
+type AlertsResponse struct {
+	Alerts []alerting.Alert `json:"alerts"`
+}
+
+// AlertsHandler contains the HTTP handlers for the alerting API
+type AlertsHandler struct {
+	evaluator *alerting.Evaluator
+	logger    *slog.Logger
+}
+
+// NewAlertsHandler creates a new alerts handler instance
+func NewAlertsHandler(evaluator *alerting.Evaluator, logger *slog.Logger) *AlertsHandler {
+	return &AlertsHandler{
+		evaluator: evaluator,
+		logger:    logger,
+	}
+}
+
+// GetAlerts handles GET /api/alerts
+func (h *AlertsHandler) GetAlerts(w http.ResponseWriter, r *http.Request) {
+	componentID := r.URL.Query().Get("componentId")
+	environmentID := r.URL.Query().Get("environmentId")
+	state := alerting.AlertState(r.URL.Query().Get("state"))
+
+	alerts := make([]alerting.Alert, 0)
+	for _, alert := range h.evaluator.Alerts() {
+		if componentID != "" && alert.ComponentID != componentID {
+			continue
+		}
+		if environmentID != "" && alert.EnvironmentID != environmentID {
+			continue
+		}
+		if state != "" && alert.State != state {
+			continue
+		}
+		alerts = append(alerts, alert)
+	}
+
+	if err := httputil.WriteJSON(w, http.StatusOK, AlertsResponse{Alerts: alerts}); err != nil {
+		h.logger.Error("Failed to write JSON response", "error", err)
+	}
+}
@@ -4,11 +4,13 @@
 package handlers
 
 import (
+	"errors"
 	"log/slog"
 	"net/http"
 	"time"
 
 	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
 	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
 	"github.com/openchoreo/openchoreo/internal/observer/service"
 )
@@ -23,11 +25,13 @@ const (
 	ErrorTypeMissingParameter = "missingParameter"
 	ErrorTypeInvalidRequest   = "invalidRequest"
 	ErrorTypeInternalError    = "internalError"
+	ErrorTypeNotImplemented   = "notImplemented"
 
 	// Error codes
 	ErrorCodeMissingParameter = "OBS-L-10"
 	ErrorCodeInvalidRequest   = "OBS-L-12"
 	ErrorCodeInternalError    = "OBS-L-25"
+	ErrorCodeNotImplemented   = "OBS-L-26"
 
 	// Error messages
 	ErrorMsgComponentIDRequired     = "Component ID is required"
@@ -151,8 +155,8 @@ func (h *Handler) GetComponentLogs(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Build query parameters
-	params := opensearch.ComponentQueryParams{
-		QueryParams: opensearch.QueryParams{
+	params := logs.ComponentQueryParams{
+		QueryParams: logs.QueryParams{
 			StartTime:     req.StartTime,
 			EndTime:       req.EndTime,
 			SearchPhrase:  req.SearchPhrase,
@@ -164,7 +168,7 @@ func (h *Handler) GetComponentLogs(w http.ResponseWriter, r *http.Request) {
 			Namespace:     req.Namespace,
 			Versions:      req.Versions,
 			VersionIDs:    req.VersionIDs,
-			LogType:       opensearch.ExtractLogType(req.LogType),
+			LogType:       logs.ExtractLogType(req.LogType),
 		},
 		BuildID:   req.BuildID,
 		BuildUUID: req.BuildUUID,
@@ -206,7 +210,7 @@ func (h *Handler) GetProjectLogs(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Build query parameters
-	params := opensearch.QueryParams{
+	params := logs.QueryParams{
 		StartTime:     req.StartTime,
 		EndTime:       req.EndTime,
 		SearchPhrase:  req.SearchPhrase,
@@ -217,7 +221,7 @@ func (h *Handler) GetProjectLogs(w http.ResponseWriter, r *http.Request) {
 		EnvironmentID: req.EnvironmentID,
 		Versions:      req.Versions,
 		VersionIDs:    req.VersionIDs,
-		LogType:       opensearch.ExtractLogType(req.LogType),
+		LogType:       logs.ExtractLogType(req.LogType),
 	}
 
 	// Execute query
@@ -250,14 +254,14 @@ func (h *Handler) GetGatewayLogs(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Build query parameters
-	params := opensearch.GatewayQueryParams{
-		QueryParams: opensearch.QueryParams{
+	params := logs.GatewayQueryParams{
+		QueryParams: logs.QueryParams{
 			StartTime:    req.StartTime,
 			EndTime:      req.EndTime,
 			SearchPhrase: req.SearchPhrase,
 			Limit:        req.Limit,
 			SortOrder:    req.SortOrder,
-			LogType:      opensearch.ExtractLogType(req.LogType),
+			LogType:      logs.ExtractLogType(req.LogType),
 		},
 		OrganizationID:    req.OrganizationID,
 		APIIDToVersionMap: req.APIIDToVersionMap,
@@ -300,7 +304,7 @@ func (h *Handler) GetOrganizationLogs(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Build query parameters
-	params := opensearch.QueryParams{
+	params := logs.QueryParams{
 		StartTime:      req.StartTime,
 		EndTime:        req.EndTime,
 		SearchPhrase:   req.SearchPhrase,
@@ -311,7 +315,7 @@ func (h *Handler) GetOrganizationLogs(w http.ResponseWriter, r *http.Request) {
 		Namespace:      req.Namespace,
 		Versions:       req.Versions,
 		VersionIDs:     req.VersionIDs,
-		LogType:        opensearch.ExtractLogType(req.LogType),
+		LogType:        logs.ExtractLogType(req.LogType),
 		OrganizationID: orgID, // Add the organization ID from URL parameter
 	}
 
@@ -375,6 +379,10 @@ func (h *Handler) GetComponentTraces(w http.ResponseWriter, r *http.Request) {
 	// Execute query
 	ctx := r.Context()
 	result, err := h.service.GetComponentTraces(ctx, req)
+	if errors.Is(err, service.ErrTracesUnavailable) {
+		h.writeErrorResponse(w, http.StatusNotImplemented, ErrorTypeNotImplemented, ErrorCodeNotImplemented, err.Error())
+		return
+	}
 	if err != nil {
 		h.logger.Error("Failed to get component traces", "error", err)
 		h.writeErrorResponse(w, http.StatusInternalServerError, ErrorTypeInternalError, ErrorCodeInternalError, ErrorMsgFailedToRetrieveLogs)
@@ -0,0 +1,244 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/httputil"
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
+	"github.com/openchoreo/openchoreo/internal/observer/service"
+	"github.com/openchoreo/openchoreo/internal/server/sse"
+)
+
+// keepAliveInterval is how long a log stream may stay idle before a keep-alive comment is sent,
+// so that proxies do not close the connection
+const keepAliveInterval = 15 * time.Second
+
+// Server-Sent Event names used by the log streams
+const (
+	EventLog   = "log"
+	EventError = "error"
+)
+
+// sseWriter writes the entries of a log stream as Server-Sent Events
+type sseWriter struct {
+	*sse.Writer
+
+	// lastLogTime and lastLogIDs are the timestamp of the newest log event sent and the IDs of the
+	// entries sent with that timestamp, which make up the ID of the next log event
+	lastLogTime time.Time
+	lastLogIDs  []string
+}
+
+// newSSEWriter prepares an event stream response for a log stream
+func newSSEWriter(w http.ResponseWriter) (*sseWriter, error) {
+	writer, err := sse.NewWriter(w)
+	if err != nil {
+		return nil, err
+	}
+	return &sseWriter{Writer: writer}, nil
+}
+
+// sendLogs returns a LogBatchFunc that writes each entry as a log event. The event ID is the
+// timestamp of the entry followed by the IDs of the entries sent with that timestamp, which a
+// reconnecting client sends back in the Last-Event-ID header.
+func (s *sseWriter) sendLogs() service.LogBatchFunc {
+	return func(logs []logs.LogEntry) error {
+		if len(logs) == 0 {
+			return s.KeepAlive(keepAliveInterval)
+		}
+		for _, entry := range logs {
+			if !entry.Timestamp.Equal(s.lastLogTime) {
+				s.lastLogTime = entry.Timestamp
+				s.lastLogIDs = s.lastLogIDs[:0]
+			}
+			if entry.ID != "" {
+				s.lastLogIDs = append(s.lastLogIDs, entry.ID)
+			}
+			if err := s.Event(makeLogEventID(s.lastLogTime, s.lastLogIDs), EventLog, entry); err != nil {
+				return err
+			}
+		}
+		return s.Flush()
+	}
+}
+
+// logEventIDSeparator separates the timestamp and the entry IDs in the ID of a log event
+const logEventIDSeparator = ","
+
+// makeLogEventID returns the ID of a log event from the timestamp of the entry and the IDs of the
+// entries sent with that timestamp
+func makeLogEventID(timestamp time.Time, ids []string) string {
+	return strings.Join(append([]string{timestamp.Format(time.RFC3339Nano)}, ids...), logEventIDSeparator)
+}
+
+// streamStart returns the time a log stream starts from and the IDs of the entries at that time the
+// client already received. A reconnecting client resumes at the timestamp of the last event it
+// received, skipping the entries it received with that timestamp; otherwise the start time of the
+// request is used.
+func streamStart(r *http.Request, startTime string) (string, []string) {
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		parts := strings.Split(lastEventID, logEventIDSeparator)
+		if last, err := time.Parse(time.RFC3339Nano, parts[0]); err == nil {
+			return last.Format(time.RFC3339Nano), parts[1:]
+		}
+	}
+	return startTime, nil
+}
+
+// serveLogStream starts an event stream and runs the stream until the client disconnects
+func (h *Handler) serveLogStream(w http.ResponseWriter, r *http.Request, stream func(send service.LogBatchFunc) error) {
+	writer, err := newSSEWriter(w)
+	if err != nil {
+		h.logger.Error("Failed to start log stream", "error", err)
+		h.writeErrorResponse(w, http.StatusInternalServerError, ErrorTypeInternalError, ErrorCodeInternalError, ErrorMsgFailedToRetrieveLogs)
+		return
+	}
+	if err := writer.Start(); err != nil {
+		h.logger.Error("Failed to start log stream", "error", err)
+		return
+	}
+
+	if err := stream(writer.sendLogs()); err != nil {
+		if r.Context().Err() != nil {
+			return
+		}
+		h.logger.Error("Log stream failed", "error", err)
+		if err := writer.Event("", EventError, ErrorResponse{
+			Error:   ErrorTypeInternalError,
+			Code:    ErrorCodeInternalError,
+			Message: ErrorMsgFailedToRetrieveLogs,
+		}); err == nil {
+			_ = writer.Flush()
+		}
+	}
+}
+
+// StreamComponentLogs handles POST /api/logs/component/{componentId}/stream
+func (h *Handler) StreamComponentLogs(w http.ResponseWriter, r *http.Request) {
+	componentID := httputil.GetPathParam(r, "componentId")
+	if componentID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeMissingParameter, ErrorCodeMissingParameter, ErrorMsgComponentIDRequired)
+		return
+	}
+
+	var req ComponentLogsRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, ErrorMsgInvalidRequestFormat)
+		return
+	}
+
+	startTime, sentIDs := streamStart(r, req.StartTime)
+	params := logs.ComponentQueryParams{
+		QueryParams: logs.QueryParams{
+			StartTime:     startTime,
+			SearchPhrase:  req.SearchPhrase,
+			LogLevels:     req.LogLevels,
+			ComponentID:   componentID,
+			EnvironmentID: req.EnvironmentID,
+			Namespace:     req.Namespace,
+			Versions:      req.Versions,
+			VersionIDs:    req.VersionIDs,
+		},
+	}
+
+	h.serveLogStream(w, r, func(send service.LogBatchFunc) error {
+		return h.service.StreamComponentLogs(r.Context(), params, sentIDs, send)
+	})
+}
+
+// StreamProjectLogs handles POST /api/logs/project/{projectId}/stream
+func (h *Handler) StreamProjectLogs(w http.ResponseWriter, r *http.Request) {
+	projectID := httputil.GetPathParam(r, "projectId")
+	if projectID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeMissingParameter, ErrorCodeMissingParameter, ErrorMsgProjectIDRequired)
+		return
+	}
+
+	var req ProjectLogsRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, ErrorMsgInvalidRequestFormat)
+		return
+	}
+
+	startTime, sentIDs := streamStart(r, req.StartTime)
+	params := logs.QueryParams{
+		StartTime:     startTime,
+		SearchPhrase:  req.SearchPhrase,
+		LogLevels:     req.LogLevels,
+		ProjectID:     projectID,
+		EnvironmentID: req.EnvironmentID,
+		Versions:      req.Versions,
+		VersionIDs:    req.VersionIDs,
+		LogType:       logs.ExtractLogType(req.LogType),
+	}
+
+	h.serveLogStream(w, r, func(send service.LogBatchFunc) error {
+		return h.service.StreamProjectLogs(r.Context(), params, req.ComponentIDs, sentIDs, send)
+	})
+}
+
+// StreamGatewayLogs handles POST /api/logs/gateway/stream
+func (h *Handler) StreamGatewayLogs(w http.ResponseWriter, r *http.Request) {
+	var req GatewayLogsRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, ErrorMsgInvalidRequestFormat)
+		return
+	}
+
+	startTime, sentIDs := streamStart(r, req.StartTime)
+	params := logs.GatewayQueryParams{
+		QueryParams: logs.QueryParams{
+			StartTime:    startTime,
+			SearchPhrase: req.SearchPhrase,
+			LogType:      logs.ExtractLogType(req.LogType),
+		},
+		OrganizationID:    req.OrganizationID,
+		APIIDToVersionMap: req.APIIDToVersionMap,
+		GatewayVHosts:     req.GatewayVHosts,
+	}
+
+	h.serveLogStream(w, r, func(send service.LogBatchFunc) error {
+		return h.service.StreamGatewayLogs(r.Context(), params, sentIDs, send)
+	})
+}
+
+// StreamOrganizationLogs handles POST /api/logs/org/{orgId}/stream
+func (h *Handler) StreamOrganizationLogs(w http.ResponseWriter, r *http.Request) {
+	orgID := httputil.GetPathParam(r, "orgId")
+	if orgID == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeMissingParameter, ErrorCodeMissingParameter, ErrorMsgOrganizationIDRequired)
+		return
+	}
+
+	var req OrganizationLogsRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, ErrorTypeInvalidRequest, ErrorCodeInvalidRequest, ErrorMsgInvalidRequestFormat)
+		return
+	}
+
+	startTime, sentIDs := streamStart(r, req.StartTime)
+	params := logs.QueryParams{
+		StartTime:      startTime,
+		SearchPhrase:   req.SearchPhrase,
+		LogLevels:      req.LogLevels,
+		EnvironmentID:  req.EnvironmentID,
+		Namespace:      req.Namespace,
+		Versions:       req.Versions,
+		VersionIDs:     req.VersionIDs,
+		LogType:        logs.ExtractLogType(req.LogType),
+		OrganizationID: orgID,
+	}
+
+	h.serveLogStream(w, r, func(send service.LogBatchFunc) error {
+		return h.service.StreamOrganizationLogs(r.Context(), params, req.PodLabels, sentIDs, send)
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestStreamStart(t *testing.T) {
+	timestamp := time.Date(2025, 6, 1, 10, 0, 1, 500, time.UTC)
+
+	tests := []struct {
+		name        string
+		lastEventID string
+		wantStart   string
+		wantSentIDs []string
+	}{
+		{
+			name:      "New stream",
+			wantStart: "2025-06-01T09:00:00Z",
+		},
+		{
+			name:        "Resume at the last event",
+			lastEventID: makeLogEventID(timestamp, []string{"c", "d"}),
+			wantStart:   timestamp.Format(time.RFC3339Nano),
+			wantSentIDs: []string{"c", "d"},
+		},
+		{
+			name:        "Invalid event ID",
+			lastEventID: "invalid",
+			wantStart:   "2025-06-01T09:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/api/logs/org/acme/stream", nil)
+			if tt.lastEventID != "" {
+				r.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+
+			start, sentIDs := streamStart(r, "2025-06-01T09:00:00Z")
+			if start != tt.wantStart {
+				t.Errorf("streamStart() start = %q, want %q", start, tt.wantStart)
+			}
+			if !slices.Equal(sentIDs, tt.wantSentIDs) {
+				t.Errorf("streamStart() sent IDs = %v, want %v", sentIDs, tt.wantSentIDs)
+			}
+		})
+	}
+}
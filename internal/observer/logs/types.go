@@ -0,0 +1,104 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package logs holds the log query parameters and results shared by the log backends
+package logs
+
+import (
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/labels"
+)
+
+// LogEntry represents a log entry of a log backend
+type LogEntry struct {
+	// ID identifies the entry in the log store. Log streams use it to skip entries they already sent.
+	ID            string            `json:"-"`
+	Timestamp     time.Time         `json:"timestamp"`
+	Log           string            `json:"log"`
+	LogLevel      string            `json:"logLevel"`
+	ComponentID   string            `json:"componentId"`
+	EnvironmentID string            `json:"environmentId"`
+	ProjectID     string            `json:"projectId"`
+	Version       string            `json:"version"`
+	VersionID     string            `json:"versionId"`
+	Namespace     string            `json:"namespace"`
+	PodID         string            `json:"podId"`
+	ContainerName string            `json:"containerName"`
+	Labels        map[string]string `json:"labels"`
+}
+
+// LogResult is the result of a log query of a log backend
+type LogResult struct {
+	Logs       []LogEntry
+	TotalCount int
+	Took       int
+}
+
+// QueryParams holds common query parameters
+type QueryParams struct {
+	StartTime      string   `json:"startTime"`
+	EndTime        string   `json:"endTime"`
+	SearchPhrase   string   `json:"searchPhrase"`
+	LogLevels      []string `json:"logLevels"`
+	Limit          int      `json:"limit"`
+	SortOrder      string   `json:"sortOrder"`
+	ComponentID    string   `json:"componentId,omitempty"`
+	EnvironmentID  string   `json:"environmentId,omitempty"`
+	ProjectID      string   `json:"projectId,omitempty"`
+	OrganizationID string   `json:"organizationId,omitempty"`
+	Namespace      string   `json:"namespace,omitempty"`
+	Versions       []string `json:"versions,omitempty"`
+	VersionIDs     []string `json:"versionIds,omitempty"`
+	LogType        string   `json:"logType,omitempty"`
+	// CountTotal requests the number of all matching entries as the total count of the result. Backends that
+	// count them with an additional query, such as Loki, otherwise return the number of entries in the result.
+	CountTotal bool `json:"-"`
+}
+
+// ComponentQueryParams holds component-specific query parameters
+type ComponentQueryParams struct {
+	QueryParams
+	BuildID   string `json:"buildId,omitempty"`
+	BuildUUID string `json:"buildUuid,omitempty"`
+}
+
+// GatewayQueryParams holds gateway-specific query parameters
+type GatewayQueryParams struct {
+	QueryParams
+	OrganizationID    string            `json:"organizationId"`
+	APIIDToVersionMap map[string]string `json:"apiIdToVersionMap"`
+	GatewayVHosts     []string          `json:"gatewayVHosts"`
+}
+
+// ExtractLogLevel extracts log level from log content using common patterns
+func ExtractLogLevel(log string) string {
+	log = strings.ToUpper(log)
+
+	logLevels := []string{"ERROR", "FATAL", "SEVERE", "WARN", "WARNING", "INFO", "DEBUG", "UNDEFINED"}
+
+	for _, level := range logLevels {
+		if strings.Contains(log, level) {
+			// Normalize WARN/WARNING to WARN
+			if level == "WARNING" {
+				return "WARN"
+			}
+			return level
+		}
+	}
+
+	return "UNDEFINED" // Default to INFO if no level found
+}
+
+// ExtractLogType determines the log type from query parameters or defaults to RUNTIME
+func ExtractLogType(logType string) string {
+	switch strings.ToUpper(logType) {
+	case labels.QueryParamLogTypeBuild:
+		return labels.QueryParamLogTypeBuild
+	case labels.QueryParamLogTypeRuntime:
+		return labels.QueryParamLogTypeRuntime
+	default:
+		return labels.QueryParamLogTypeRuntime // Default to RUNTIME if no valid type specified
+	}
+}
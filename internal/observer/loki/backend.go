@@ -0,0 +1,171 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loki
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
+)
+
+const (
+	// defaultLookback is the time range queried when the query parameters do not set one. It stays within
+	// the default maximum query length of Loki (721h).
+	defaultLookback = 30 * 24 * time.Hour
+
+	// defaultLimit is the number of entries returned when the query parameters do not set a limit
+	defaultLimit = 100
+)
+
+// LogBackend queries the logs stored in Grafana Loki
+type LogBackend struct {
+	client *Client
+}
+
+// NewLogBackend creates a log backend querying Loki with the given client
+func NewLogBackend(client *Client) *LogBackend {
+	return &LogBackend{client: client}
+}
+
+// ComponentLogs retrieves the runtime or build logs of a component
+func (b *LogBackend) ComponentLogs(ctx context.Context, params logs.ComponentQueryParams) (*logs.LogResult, error) {
+	queryParams := params.QueryParams
+	// Build logs are found by their build regardless of the time range, as in OpenSearch
+	if params.LogType == labels.QueryParamLogTypeBuild {
+		queryParams.StartTime, queryParams.EndTime = "", ""
+	}
+	return b.queryLogs(ctx, queryParams, BuildComponentLogsQuery(params))
+}
+
+// ProjectLogs retrieves the logs of a project, limited to the given components if there are any
+func (b *LogBackend) ProjectLogs(ctx context.Context, params logs.QueryParams, componentIDs []string) (*logs.LogResult, error) {
+	return b.queryLogs(ctx, params, BuildProjectLogsQuery(params, componentIDs))
+}
+
+// GatewayLogs retrieves the gateway logs of an organization
+func (b *LogBackend) GatewayLogs(ctx context.Context, params logs.GatewayQueryParams) (*logs.LogResult, error) {
+	return b.queryLogs(ctx, params.QueryParams, BuildGatewayLogsQuery(params))
+}
+
+// OrganizationLogs retrieves the logs of an organization matching the given pod labels
+func (b *LogBackend) OrganizationLogs(ctx context.Context, params logs.QueryParams, podLabels map[string]string) (*logs.LogResult, error) {
+	return b.queryLogs(ctx, params, BuildOrganizationLogsQuery(params, podLabels))
+}
+
+// CountComponentLogs returns the number of log entries of a component matching the query parameters
+func (b *LogBackend) CountComponentLogs(ctx context.Context, params logs.ComponentQueryParams) (int, error) {
+	queryParams := params.QueryParams
+	if params.LogType == labels.QueryParamLogTypeBuild {
+		queryParams.StartTime, queryParams.EndTime = "", ""
+	}
+	start, end, err := timeRange(queryParams.StartTime, queryParams.EndTime)
+	if err != nil {
+		return 0, err
+	}
+	return b.countLogs(ctx, BuildComponentLogsQuery(params), start, end)
+}
+
+// HealthCheck checks that Loki is ready to serve queries
+func (b *LogBackend) HealthCheck(ctx context.Context) error {
+	if err := b.client.HealthCheck(ctx); err != nil {
+		return fmt.Errorf("loki health check failed: %w", err)
+	}
+	return nil
+}
+
+// queryLogs executes a log query over the time range of the query parameters
+func (b *LogBackend) queryLogs(ctx context.Context, params logs.QueryParams, query string) (*logs.LogResult, error) {
+	start, end, err := timeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	direction := DirectionBackward
+	if params.SortOrder == "asc" {
+		direction = DirectionForward
+	}
+
+	response, err := b.client.QueryRange(ctx, query, start, end, limit, direction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	streams, err := response.Streams()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := parseLogEntries(streams, direction)
+	if err != nil {
+		return nil, err
+	}
+
+	// Loki does not count the matching entries of a range query, so they are counted by a metric query
+	// over the whole range when the total is requested
+	totalCount := len(entries)
+	if params.CountTotal {
+		totalCount, err = b.countLogs(ctx, query, start, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &logs.LogResult{
+		Logs:       entries,
+		TotalCount: totalCount,
+		Took:       response.TookMillis(),
+	}, nil
+}
+
+// countLogs returns the number of entries of a log query within the time range
+func (b *LogBackend) countLogs(ctx context.Context, logQuery string, start, end time.Time) (int, error) {
+	response, err := b.client.Query(ctx, BuildCountQuery(logQuery, end.Sub(start)), end)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute count query: %w", err)
+	}
+
+	samples, err := response.Vector()
+	if err != nil {
+		return 0, err
+	}
+	// Loki returns no sample when no entry matches
+	if len(samples) == 0 {
+		return 0, nil
+	}
+	value, ok := samples[0].Value[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected sample value %v", samples[0].Value[1])
+	}
+	count, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid sample value %q: %w", value, err)
+	}
+	return int(count), nil
+}
+
+// timeRange parses the time range of a query, defaulting to the lookback period before now when either
+// bound is not set
+func timeRange(startTime, endTime string) (time.Time, time.Time, error) {
+	if startTime == "" || endTime == "" {
+		end := time.Now()
+		return end.Add(-defaultLookback), end, nil
+	}
+
+	start, err := time.Parse(time.RFC3339Nano, startTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start time %q: %w", startTime, err)
+	}
+	end, err := time.Parse(time.RFC3339Nano, endTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end time %q: %w", endTime, err)
+	}
+	return start, end, nil
+}
@@ -0,0 +1,277 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loki
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
+)
+
+// fakeLoki serves canned responses for the Loki API paths and records the requests it receives
+type fakeLoki struct {
+	responses map[string]string
+	status    int
+	requests  []*http.Request
+}
+
+func (f *fakeLoki) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.requests = append(f.requests, r)
+	if f.status != 0 {
+		http.Error(w, "too many outstanding requests", f.status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = io.WriteString(w, f.responses[r.URL.Path])
+}
+
+func newTestBackend(t *testing.T, fake *fakeLoki) *LogBackend {
+	t.Helper()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(&config.LokiConfig{
+		Address:  server.URL,
+		TenantID: "tenant-1",
+		Timeout:  5 * time.Second,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return NewLogBackend(client)
+}
+
+func TestLogBackend_ComponentLogs(t *testing.T) {
+	fake := &fakeLoki{responses: map[string]string{
+		"/loki/api/v1/query_range": `{
+			"status": "success",
+			"data": {
+				"resultType": "streams",
+				"result": [
+					{
+						"stream": {"openchoreo_dev_component_uid": "component-123", "pod": "web-1", "version": "v1"},
+						"values": [["1704067202000000000", "ERROR request failed"], ["1704067200000000000", "INFO started"]]
+					},
+					{
+						"stream": {"openchoreo_dev_component_uid": "component-123", "pod": "web-2", "version": "v1"},
+						"values": [["1704067201000000000", "WARN slow request"]]
+					}
+				],
+				"stats": {"summary": {"execTime": 0.012}}
+			}
+		}`,
+		"/loki/api/v1/query": `{"status": "success", "data": {"resultType": "vector", "result": [{"metric": {}, "value": [1704070800, "57"]}]}}`,
+	}}
+	backend := newTestBackend(t, fake)
+
+	params := logs.ComponentQueryParams{
+		QueryParams: logs.QueryParams{
+			StartTime:     "2024-01-01T00:00:00Z",
+			EndTime:       "2024-01-01T01:00:00Z",
+			ComponentID:   "component-123",
+			EnvironmentID: "env-456",
+			Limit:         50,
+			SortOrder:     "desc",
+			CountTotal:    true,
+		},
+	}
+	result, err := backend.ComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("ComponentLogs() error = %v", err)
+	}
+
+	if len(fake.requests) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(fake.requests))
+	}
+	req := fake.requests[0]
+	if got := req.Header.Get("X-Scope-OrgID"); got != "tenant-1" {
+		t.Errorf("Expected tenant header tenant-1, got %q", got)
+	}
+	wantParams := url.Values{
+		"query":     {BuildComponentLogsQuery(params)},
+		"start":     {"1704067200000000000"},
+		"end":       {"1704070800000000000"},
+		"limit":     {"50"},
+		"direction": {DirectionBackward},
+	}
+	for key, want := range wantParams {
+		if got := req.URL.Query().Get(key); got != want[0] {
+			t.Errorf("Expected %s %q, got %q", key, want[0], got)
+		}
+	}
+
+	// The total counts all matching entries of the time range, not only the page returned
+	countQuery := fake.requests[1].URL.Query().Get("query")
+	if want := BuildCountQuery(BuildComponentLogsQuery(params), time.Hour); countQuery != want {
+		t.Errorf("Expected count query %s, got %s", want, countQuery)
+	}
+	if len(result.Logs) != 3 || result.TotalCount != 57 || result.Took != 12 {
+		t.Errorf("Expected 3 of 57 entries in 12ms, got %d of %d in %dms", len(result.Logs), result.TotalCount, result.Took)
+	}
+	// Entries of both streams are merged newest first
+	wantLogs := []struct {
+		log, level, pod string
+	}{
+		{"ERROR request failed", "ERROR", "web-1"},
+		{"WARN slow request", "WARN", "web-2"},
+		{"INFO started", "INFO", "web-1"},
+	}
+	for i, want := range wantLogs {
+		entry := result.Logs[i]
+		if entry.Log != want.log || entry.LogLevel != want.level || entry.PodID != want.pod {
+			t.Errorf("Entry %d: expected %+v, got log %q level %q pod %q", i, want, entry.Log, entry.LogLevel, entry.PodID)
+		}
+		if entry.ComponentID != "component-123" || entry.Version != "v1" || entry.ID == "" {
+			t.Errorf("Entry %d: unexpected labels or ID: %+v", i, entry)
+		}
+	}
+	if want := time.Unix(0, 1704067202000000000).UTC(); !result.Logs[0].Timestamp.Equal(want) {
+		t.Errorf("Expected timestamp %v, got %v", want, result.Logs[0].Timestamp)
+	}
+}
+
+func TestLogBackend_OrganizationLogs_Ascending(t *testing.T) {
+	fake := &fakeLoki{responses: map[string]string{
+		"/loki/api/v1/query_range": `{
+			"status": "success",
+			"data": {
+				"resultType": "streams",
+				"result": [
+					{"stream": {"pod": "a"}, "values": [["3", "third"], ["1", "first"]]},
+					{"stream": {"pod": "b"}, "values": [["2", "second"]]}
+				]
+			}
+		}`,
+	}}
+	backend := newTestBackend(t, fake)
+
+	result, err := backend.OrganizationLogs(context.Background(), logs.QueryParams{
+		OrganizationID: "org-1",
+		SortOrder:      "asc",
+	}, nil)
+	if err != nil {
+		t.Fatalf("OrganizationLogs() error = %v", err)
+	}
+
+	// Without CountTotal the entries are not counted by another query
+	if len(fake.requests) != 1 || result.TotalCount != 3 {
+		t.Errorf("Expected 1 request and a total of 3, got %d requests and a total of %d", len(fake.requests), result.TotalCount)
+	}
+	query := fake.requests[0].URL.Query()
+	if got := query.Get("direction"); got != DirectionForward {
+		t.Errorf("Expected direction %s, got %s", DirectionForward, got)
+	}
+	if got := query.Get("limit"); got != "100" {
+		t.Errorf("Expected default limit 100, got %s", got)
+	}
+
+	var lines []string
+	for _, entry := range result.Logs {
+		lines = append(lines, entry.Log)
+	}
+	if got := strings.Join(lines, ","); got != "first,second,third" {
+		t.Errorf("Expected entries oldest first, got %s", got)
+	}
+}
+
+func TestLogBackend_CountComponentLogs(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     int
+	}{
+		{
+			name:     "matching entries",
+			response: `{"status": "success", "data": {"resultType": "vector", "result": [{"metric": {}, "value": [1704070800, "42"]}]}}`,
+			want:     42,
+		},
+		{
+			name:     "no matching entries",
+			response: `{"status": "success", "data": {"resultType": "vector", "result": []}}`,
+			want:     0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeLoki{responses: map[string]string{"/loki/api/v1/query": tt.response}}
+			backend := newTestBackend(t, fake)
+
+			params := logs.ComponentQueryParams{
+				QueryParams: logs.QueryParams{
+					StartTime:   "2024-01-01T00:00:00Z",
+					EndTime:     "2024-01-01T01:00:00Z",
+					ComponentID: "component-123",
+					LogLevels:   []string{"ERROR"},
+				},
+			}
+			count, err := backend.CountComponentLogs(context.Background(), params)
+			if err != nil {
+				t.Fatalf("CountComponentLogs() error = %v", err)
+			}
+			if count != tt.want {
+				t.Errorf("Expected count %d, got %d", tt.want, count)
+			}
+
+			query := fake.requests[0].URL.Query()
+			if want := BuildCountQuery(BuildComponentLogsQuery(params), time.Hour); query.Get("query") != want {
+				t.Errorf("Expected query %s, got %s", want, query.Get("query"))
+			}
+			if got := query.Get("time"); got != "1704070800000000000" {
+				t.Errorf("Expected query time at the end of the range, got %s", got)
+			}
+		})
+	}
+}
+
+func TestLogBackend_Errors(t *testing.T) {
+	fake := &fakeLoki{status: http.StatusTooManyRequests}
+	backend := newTestBackend(t, fake)
+
+	_, err := backend.GatewayLogs(context.Background(), logs.GatewayQueryParams{OrganizationID: "org-1"})
+	if err == nil || !strings.Contains(err.Error(), "too many outstanding requests") {
+		t.Errorf("Expected the Loki error to be returned, got %v", err)
+	}
+
+	if err := backend.HealthCheck(context.Background()); err == nil {
+		t.Error("Expected health check to fail")
+	}
+
+	_, err = backend.ProjectLogs(context.Background(), logs.QueryParams{
+		StartTime: "yesterday",
+		EndTime:   "2024-01-01T01:00:00Z",
+	}, nil)
+	if err == nil || !strings.Contains(err.Error(), "invalid start time") {
+		t.Errorf("Expected an invalid start time error, got %v", err)
+	}
+}
+
+func TestLogBackend_HealthCheck(t *testing.T) {
+	fake := &fakeLoki{}
+	backend := newTestBackend(t, fake)
+
+	if err := backend.HealthCheck(context.Background()); err != nil {
+		t.Errorf("HealthCheck() error = %v", err)
+	}
+	if got := fake.requests[0].URL.Path; got != "/ready" {
+		t.Errorf("Expected health check on /ready, got %s", got)
+	}
+}
+
+func TestNewClient_InvalidAddress(t *testing.T) {
+	for _, address := range []string{"", "localhost:3100", "ftp://loki"} {
+		if _, err := NewClient(&config.LokiConfig{Address: address}, slog.Default()); err == nil {
+			t.Errorf("NewClient(%q) expected an error", address)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loki
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+)
+
+// Directions entries of a range query are returned in
+const (
+	DirectionForward  = "forward"
+	DirectionBackward = "backward"
+)
+
+// maxErrorBody limits how much of an error response is included in the returned error
+const maxErrorBody = 1024
+
+// Client queries the Grafana Loki HTTP API
+type Client struct {
+	baseURL    string
+	config     *config.LokiConfig
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+// NewClient creates a new Loki client with the provided configuration
+func NewClient(cfg *config.LokiConfig, logger *slog.Logger) (*Client, error) {
+	u, err := url.Parse(cfg.Address)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid Loki address %q", cfg.Address)
+	}
+
+	return &Client{
+		baseURL:    strings.TrimSuffix(cfg.Address, "/"),
+		config:     cfg,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+		logger:     logger,
+	}, nil
+}
+
+// QueryRange executes a LogQL log query over a time range, returning up to limit entries
+func (c *Client) QueryRange(ctx context.Context, query string, start, end time.Time, limit int, direction string) (*QueryResponse, error) {
+	c.logger.Debug("Executing Loki range query",
+		"query", query,
+		"start", start,
+		"end", end,
+		"limit", limit,
+		"direction", direction)
+
+	params := url.Values{
+		"query":     {query},
+		"start":     {strconv.FormatInt(start.UnixNano(), 10)},
+		"end":       {strconv.FormatInt(end.UnixNano(), 10)},
+		"limit":     {strconv.Itoa(limit)},
+		"direction": {direction},
+	}
+	var response QueryResponse
+	if err := c.get(ctx, "/loki/api/v1/query_range", params, &response); err != nil {
+		return nil, fmt.Errorf("range query failed: %w", err)
+	}
+	return &response, nil
+}
+
+// Query executes an instant LogQL metric query evaluated at the given time
+func (c *Client) Query(ctx context.Context, query string, at time.Time) (*QueryResponse, error) {
+	c.logger.Debug("Executing Loki instant query", "query", query, "time", at)
+
+	params := url.Values{
+		"query": {query},
+		"time":  {strconv.FormatInt(at.UnixNano(), 10)},
+	}
+	var response QueryResponse
+	if err := c.get(ctx, "/loki/api/v1/query", params, &response); err != nil {
+		return nil, fmt.Errorf("instant query failed: %w", err)
+	}
+	return &response, nil
+}
+
+// HealthCheck checks that Loki is ready to serve queries
+func (c *Client) HealthCheck(ctx context.Context) error {
+	if err := c.get(ctx, "/ready", nil, nil); err != nil {
+		return fmt.Errorf("health check failed: %w", err)
+	}
+	return nil
+}
+
+// get sends a GET request to the Loki API and decodes the JSON response into v, unless v is nil
+func (c *Client) get(ctx context.Context, path string, params url.Values, v any) error {
+	endpoint := c.baseURL + path
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	if c.config.TenantID != "" {
+		req.Header.Set("X-Scope-OrgID", c.config.TenantID)
+	}
+	if c.config.Username != "" {
+		req.SetBasicAuth(c.config.Username, c.config.Password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		c.logger.Error("Loki request returned error",
+			"path", path,
+			"status", resp.Status,
+			"error", string(body))
+		return fmt.Errorf("loki returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	if v == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loki
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
+)
+
+// Stream labels of the log entries. Kubernetes pod labels are expected as stream labels with the characters
+// that are not valid in Loki label names replaced by underscores, as the Kubernetes discovery of Promtail
+// and Grafana Alloy stores them.
+var (
+	componentLabel    = labelName(labels.ComponentID)
+	environmentLabel  = labelName(labels.EnvironmentID)
+	projectLabel      = labelName(labels.ProjectID)
+	versionLabel      = labelName(labels.Version)
+	versionIDLabel    = labelName(labels.VersionID)
+	organizationLabel = labelName(labels.OrganizationUUID)
+	buildIDLabel      = labelName(labels.BuildID)
+	buildUUIDLabel    = labelName(labels.BuildUUID)
+	targetLabel       = labelName(labels.Target)
+)
+
+// Stream labels of the pod of the log entries
+const (
+	namespaceLabel = "namespace"
+	podLabel       = "pod"
+	containerLabel = "container"
+)
+
+// invalidLabelChars matches the characters that are not valid in Loki label names
+var invalidLabelChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// labelName converts a Kubernetes label key to the name of the Loki stream label holding it
+func labelName(key string) string {
+	name := invalidLabelChars.ReplaceAllString(key, "_")
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
+// BuildComponentLogsQuery builds a LogQL query for the runtime or build logs of a component
+func BuildComponentLogsQuery(params logs.ComponentQueryParams) string {
+	matchers := []string{matchEqual(componentLabel, params.ComponentID)}
+
+	// Build logs are identified by their target and build instead of the environment
+	if params.LogType == labels.QueryParamLogTypeBuild {
+		matchers = append(matchers,
+			matchEqual(targetLabel, labels.TargetBuild),
+			matchEqual(buildIDLabel, params.BuildID),
+			matchEqual(buildUUIDLabel, params.BuildUUID))
+	} else {
+		matchers = append(matchers, matchEqual(environmentLabel, params.EnvironmentID))
+	}
+	matchers = append(matchers, matchEqual(namespaceLabel, params.Namespace))
+
+	return selector(matchers...) +
+		searchPhraseFilter(params.SearchPhrase) +
+		logLevelFilter(params.LogLevels) +
+		versionFilter(params.Versions, params.VersionIDs)
+}
+
+// BuildProjectLogsQuery builds a LogQL query for the logs of a project, limited to the given components
+// if there are any
+func BuildProjectLogsQuery(params logs.QueryParams, componentIDs []string) string {
+	return selector(
+		matchEqual(projectLabel, params.ProjectID),
+		matchEqual(environmentLabel, params.EnvironmentID),
+		matchRegex(componentLabel, componentIDs),
+	) +
+		searchPhraseFilter(params.SearchPhrase) +
+		logLevelFilter(params.LogLevels)
+}
+
+// BuildGatewayLogsQuery builds a LogQL query for the gateway logs of an organization. As in OpenSearch,
+// the organization, virtual hosts and APIs are matched in the JSON access log lines of the gateway.
+func BuildGatewayLogsQuery(params logs.GatewayQueryParams) string {
+	query := selector(matchEqual(targetLabel, labels.TargetGateway)) + searchPhraseFilter(params.SearchPhrase)
+
+	if params.OrganizationID != "" {
+		query += " |= " + strconv.Quote(`"apiPath":"/`+params.OrganizationID)
+	}
+
+	if len(params.GatewayVHosts) > 0 {
+		query += " |~ " + strconv.Quote(`"gwHost":"(`+alternatives(params.GatewayVHosts)+`)"`)
+	}
+
+	if len(params.APIIDToVersionMap) > 0 {
+		apiIDs := make([]string, 0, len(params.APIIDToVersionMap))
+		for apiID := range params.APIIDToVersionMap {
+			apiIDs = append(apiIDs, apiID)
+		}
+		sort.Strings(apiIDs)
+		query += " |~ " + strconv.Quote(`"apiUuid":"(`+alternatives(apiIDs)+`)"`)
+	}
+
+	return query
+}
+
+// BuildOrganizationLogsQuery builds a LogQL query for the logs of an organization matching the given pod labels
+func BuildOrganizationLogsQuery(params logs.QueryParams, podLabels map[string]string) string {
+	matchers := []string{
+		matchEqual(organizationLabel, params.OrganizationID),
+		matchEqual(environmentLabel, params.EnvironmentID),
+		matchEqual(namespaceLabel, params.Namespace),
+	}
+
+	keys := make([]string, 0, len(podLabels))
+	for key := range podLabels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		matchers = append(matchers, matchEqual(labelName(key), podLabels[key]))
+	}
+
+	return selector(matchers...) +
+		searchPhraseFilter(params.SearchPhrase) +
+		logLevelFilter(params.LogLevels)
+}
+
+// BuildCountQuery builds a LogQL metric query counting the entries of a log query within the given duration
+// before the query time
+func BuildCountQuery(logQuery string, duration time.Duration) string {
+	seconds := max(int64(duration/time.Second), 1)
+	return fmt.Sprintf("sum(count_over_time(%s [%ds]))", logQuery, seconds)
+}
+
+// selector builds a stream selector from the non-empty matchers. Loki requires a selector to have a matcher
+// that does not match empty values, so all streams with a namespace are selected when there is no matcher.
+func selector(matchers ...string) string {
+	nonEmpty := make([]string, 0, len(matchers))
+	for _, matcher := range matchers {
+		if matcher != "" {
+			nonEmpty = append(nonEmpty, matcher)
+		}
+	}
+	if len(nonEmpty) == 0 {
+		nonEmpty = append(nonEmpty, namespaceLabel+`=~".+"`)
+	}
+	return "{" + strings.Join(nonEmpty, ", ") + "}"
+}
+
+// matchEqual builds a label matcher for a value, or returns an empty string if the value is empty
+func matchEqual(label, value string) string {
+	if value == "" {
+		return ""
+	}
+	return label + "=" + strconv.Quote(value)
+}
+
+// matchRegex builds a label matcher for any of the values, or returns an empty string if there are none
+func matchRegex(label string, values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return label + "=~" + strconv.Quote(alternatives(values))
+}
+
+// searchPhraseFilter builds a line filter for the entries containing the search phrase
+func searchPhraseFilter(searchPhrase string) string {
+	if searchPhrase == "" {
+		return ""
+	}
+	return " |= " + strconv.Quote(searchPhrase)
+}
+
+// logLevelFilter builds a line filter for the entries containing any of the log levels as a word, ignoring case
+// like the match query of OpenSearch
+func logLevelFilter(logLevels []string) string {
+	if len(logLevels) == 0 {
+		return ""
+	}
+	levels := make([]string, 0, len(logLevels))
+	for _, level := range logLevels {
+		levels = append(levels, strings.ToUpper(level))
+	}
+	return " |~ " + strconv.Quote(`(?i)\b(`+alternatives(levels)+`)\b`)
+}
+
+// versionFilter builds a label filter for the entries of any of the versions or version IDs
+func versionFilter(versions, versionIDs []string) string {
+	var filters []string
+	if len(versions) > 0 {
+		filters = append(filters, matchRegex(versionLabel, versions))
+	}
+	if len(versionIDs) > 0 {
+		filters = append(filters, matchRegex(versionIDLabel, versionIDs))
+	}
+	if len(filters) == 0 {
+		return ""
+	}
+	return " | " + strings.Join(filters, " or ")
+}
+
+// alternatives builds a regular expression matching any of the literal values
+func alternatives(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, regexp.QuoteMeta(value))
+	}
+	return strings.Join(quoted, "|")
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loki
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
+)
+
+func TestLabelName(t *testing.T) {
+	tests := map[string]string{
+		labels.ComponentID:       "openchoreo_dev_component_uid",
+		labels.OrganizationUUID:  "organization_name",
+		labels.VersionID:         "version_id",
+		"app.kubernetes.io/name": "app_kubernetes_io_name",
+		"1st-label":              "_1st_label",
+	}
+	for key, want := range tests {
+		if got := labelName(key); got != want {
+			t.Errorf("labelName(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestBuildComponentLogsQuery(t *testing.T) {
+	tests := []struct {
+		name   string
+		params logs.ComponentQueryParams
+		want   string
+	}{
+		{
+			name: "runtime logs with all filters",
+			params: logs.ComponentQueryParams{
+				QueryParams: logs.QueryParams{
+					SearchPhrase:  `say "hi"`,
+					LogLevels:     []string{"error", "warn"},
+					ComponentID:   "component-123",
+					EnvironmentID: "env-456",
+					Namespace:     "default",
+					Versions:      []string{"v1.0.0", "v1.0.1"},
+					VersionIDs:    []string{"version-id-1"},
+					LogType:       labels.QueryParamLogTypeRuntime,
+				},
+			},
+			want: `{openchoreo_dev_component_uid="component-123", openchoreo_dev_environment_uid="env-456", namespace="default"}` +
+				` |= "say \"hi\"" |~ "(?i)\\b(ERROR|WARN)\\b" | version=~"v1\\.0\\.0|v1\\.0\\.1" or version_id=~"version-id-1"`,
+		},
+		{
+			name: "build logs",
+			params: logs.ComponentQueryParams{
+				QueryParams: logs.QueryParams{
+					ComponentID:   "component-123",
+					EnvironmentID: "env-456",
+					LogType:       labels.QueryParamLogTypeBuild,
+				},
+				BuildID:   "build-1",
+				BuildUUID: "uuid-1",
+			},
+			want: `{openchoreo_dev_component_uid="component-123", target="build", build_name="build-1", uuid="uuid-1"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BuildComponentLogsQuery(tt.params); got != tt.want {
+				t.Errorf("BuildComponentLogsQuery() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildProjectLogsQuery(t *testing.T) {
+	params := logs.QueryParams{
+		ProjectID:     "project-1",
+		EnvironmentID: "env-1",
+		LogLevels:     []string{"INFO"},
+	}
+
+	want := `{openchoreo_dev_project_uid="project-1", openchoreo_dev_environment_uid="env-1", openchoreo_dev_component_uid=~"c1|c2"}` +
+		` |~ "(?i)\\b(INFO)\\b"`
+	if got := BuildProjectLogsQuery(params, []string{"c1", "c2"}); got != want {
+		t.Errorf("BuildProjectLogsQuery() =\n%s\nwant\n%s", got, want)
+	}
+
+	want = `{openchoreo_dev_project_uid="project-1", openchoreo_dev_environment_uid="env-1"} |~ "(?i)\\b(INFO)\\b"`
+	if got := BuildProjectLogsQuery(params, nil); got != want {
+		t.Errorf("BuildProjectLogsQuery() without components =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestBuildGatewayLogsQuery(t *testing.T) {
+	params := logs.GatewayQueryParams{
+		QueryParams:       logs.QueryParams{SearchPhrase: "GET"},
+		OrganizationID:    "org-1",
+		GatewayVHosts:     []string{"api.example.com"},
+		APIIDToVersionMap: map[string]string{"api-2": "v2", "api-1": "v1"},
+	}
+
+	want := `{target="gateway"} |= "GET" |= "\"apiPath\":\"/org-1"` +
+		` |~ "\"gwHost\":\"(api\\.example\\.com)\"" |~ "\"apiUuid\":\"(api-1|api-2)\""`
+	if got := BuildGatewayLogsQuery(params); got != want {
+		t.Errorf("BuildGatewayLogsQuery() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestBuildOrganizationLogsQuery(t *testing.T) {
+	params := logs.QueryParams{
+		OrganizationID: "org-1",
+		Namespace:      "dp-org-1",
+		SearchPhrase:   "timeout",
+	}
+	podLabels := map[string]string{"app": "web", "app.kubernetes.io/part-of": "shop"}
+
+	want := `{organization_name="org-1", namespace="dp-org-1", app="web", app_kubernetes_io_part_of="shop"} |= "timeout"`
+	if got := BuildOrganizationLogsQuery(params, podLabels); got != want {
+		t.Errorf("BuildOrganizationLogsQuery() =\n%s\nwant\n%s", got, want)
+	}
+
+	// A selector needs at least one matcher
+	want = `{namespace=~".+"}`
+	if got := BuildOrganizationLogsQuery(logs.QueryParams{}, nil); got != want {
+		t.Errorf("BuildOrganizationLogsQuery() without filters = %s, want %s", got, want)
+	}
+}
+
+func TestBuildCountQuery(t *testing.T) {
+	want := `sum(count_over_time({target="gateway"} [3600s]))`
+	if got := BuildCountQuery(`{target="gateway"}`, time.Hour); got != want {
+		t.Errorf("BuildCountQuery() = %s, want %s", got, want)
+	}
+}
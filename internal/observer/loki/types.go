@@ -0,0 +1,142 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loki
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
+)
+
+// Result types of Loki queries
+const (
+	resultTypeStreams = "streams"
+	resultTypeVector  = "vector"
+)
+
+// QueryResponse represents the response of a Loki query
+type QueryResponse struct {
+	Status string    `json:"status"`
+	Data   QueryData `json:"data"`
+}
+
+// QueryData holds the result of a query, whose structure depends on its result type
+type QueryData struct {
+	ResultType string          `json:"resultType"`
+	Result     json.RawMessage `json:"result"`
+	Stats      struct {
+		Summary struct {
+			ExecTime float64 `json:"execTime"` // Seconds
+		} `json:"summary"`
+	} `json:"stats"`
+}
+
+// Stream is a log stream of a streams result: the entries sharing one set of labels
+type Stream struct {
+	Labels map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"` // Pairs of a nanosecond timestamp and a log line
+}
+
+// Sample is a sample of a vector result
+type Sample struct {
+	Metric map[string]string `json:"metric"`
+	Value  [2]any            `json:"value"` // Pair of a timestamp in seconds and a value formatted as a string
+}
+
+// Streams returns the log streams of a log query result
+func (r *QueryResponse) Streams() ([]Stream, error) {
+	if r.Data.ResultType != resultTypeStreams {
+		return nil, fmt.Errorf("unexpected result type %q, expected %s", r.Data.ResultType, resultTypeStreams)
+	}
+	var streams []Stream
+	if err := json.Unmarshal(r.Data.Result, &streams); err != nil {
+		return nil, fmt.Errorf("failed to parse streams: %w", err)
+	}
+	return streams, nil
+}
+
+// Vector returns the samples of a metric query result
+func (r *QueryResponse) Vector() ([]Sample, error) {
+	if r.Data.ResultType != resultTypeVector {
+		return nil, fmt.Errorf("unexpected result type %q, expected %s", r.Data.ResultType, resultTypeVector)
+	}
+	var samples []Sample
+	if err := json.Unmarshal(r.Data.Result, &samples); err != nil {
+		return nil, fmt.Errorf("failed to parse vector: %w", err)
+	}
+	return samples, nil
+}
+
+// TookMillis returns the execution time of the query in milliseconds
+func (r *QueryResponse) TookMillis() int {
+	return int(r.Data.Stats.Summary.ExecTime * 1000)
+}
+
+// parseLogEntries converts the entries of log streams to log entries sorted by timestamp in the given direction.
+// Loki groups the entries by stream, so the entries of different streams are interleaved here.
+func parseLogEntries(streams []Stream, direction string) ([]logs.LogEntry, error) {
+	var entries []logs.LogEntry
+	for _, stream := range streams {
+		for _, value := range stream.Values {
+			ns, err := strconv.ParseInt(value[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid entry timestamp %q: %w", value[0], err)
+			}
+			entries = append(entries, parseLogEntry(stream.Labels, ns, value[1]))
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if direction == DirectionForward {
+			return entries[i].Timestamp.Before(entries[j].Timestamp)
+		}
+		return entries[i].Timestamp.After(entries[j].Timestamp)
+	})
+	return entries, nil
+}
+
+// parseLogEntry converts an entry of a log stream to a log entry
+func parseLogEntry(streamLabels map[string]string, ns int64, line string) logs.LogEntry {
+	entryLabels := make(map[string]string, len(streamLabels))
+	for k, v := range streamLabels {
+		entryLabels[k] = v
+	}
+
+	return logs.LogEntry{
+		ID:            entryID(streamLabels, ns, line),
+		Timestamp:     time.Unix(0, ns).UTC(),
+		Log:           line,
+		LogLevel:      logs.ExtractLogLevel(line),
+		ComponentID:   streamLabels[componentLabel],
+		EnvironmentID: streamLabels[environmentLabel],
+		ProjectID:     streamLabels[projectLabel],
+		Version:       streamLabels[versionLabel],
+		VersionID:     streamLabels[versionIDLabel],
+		Namespace:     streamLabels[namespaceLabel],
+		PodID:         streamLabels[podLabel],
+		ContainerName: streamLabels[containerLabel],
+		Labels:        entryLabels,
+	}
+}
+
+// entryID identifies a log entry by its timestamp and a hash of its stream and line, as Loki entries have no ID
+func entryID(streamLabels map[string]string, ns int64, line string) string {
+	keys := make([]string, 0, len(streamLabels))
+	for k := range streamLabels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := fnv.New64a()
+	for _, k := range keys {
+		_, _ = h.Write([]byte(k + "=" + streamLabels[k] + ","))
+	}
+	_, _ = h.Write([]byte(line))
+	return fmt.Sprintf("%d-%x", ns, h.Sum64())
+}
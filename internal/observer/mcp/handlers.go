@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
 	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
 	"github.com/openchoreo/openchoreo/internal/observer/service"
 )
@@ -17,22 +18,22 @@ type MCPHandler struct {
 }
 
 // GetComponentLogs retrieves logs for a specific component
-func (h *MCPHandler) GetComponentLogs(ctx context.Context, params opensearch.ComponentQueryParams) (any, error) {
+func (h *MCPHandler) GetComponentLogs(ctx context.Context, params logs.ComponentQueryParams) (any, error) {
 	return h.Service.GetComponentLogs(ctx, params)
 }
 
 // GetProjectLogs retrieves logs for a specific project
-func (h *MCPHandler) GetProjectLogs(ctx context.Context, params opensearch.QueryParams, componentIDs []string) (any, error) {
+func (h *MCPHandler) GetProjectLogs(ctx context.Context, params logs.QueryParams, componentIDs []string) (any, error) {
 	return h.Service.GetProjectLogs(ctx, params, componentIDs)
 }
 
 // GetGatewayLogs retrieves gateway logs
-func (h *MCPHandler) GetGatewayLogs(ctx context.Context, params opensearch.GatewayQueryParams) (any, error) {
+func (h *MCPHandler) GetGatewayLogs(ctx context.Context, params logs.GatewayQueryParams) (any, error) {
 	return h.Service.GetGatewayLogs(ctx, params)
 }
 
 // GetOrganizationLogs retrieves logs for an entire organization
-func (h *MCPHandler) GetOrganizationLogs(ctx context.Context, params opensearch.QueryParams, podLabels map[string]string) (any, error) {
+func (h *MCPHandler) GetOrganizationLogs(ctx context.Context, params logs.QueryParams, podLabels map[string]string) (any, error) {
 	return h.Service.GetOrganizationLogs(ctx, params, podLabels)
 }
 
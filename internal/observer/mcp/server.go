@@ -10,14 +10,15 @@ import (
 
 	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
 
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
 	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
 )
 
 type Handler interface {
-	GetComponentLogs(ctx context.Context, params opensearch.ComponentQueryParams) (any, error)
-	GetProjectLogs(ctx context.Context, params opensearch.QueryParams, componentIDs []string) (any, error)
-	GetGatewayLogs(ctx context.Context, params opensearch.GatewayQueryParams) (any, error)
-	GetOrganizationLogs(ctx context.Context, params opensearch.QueryParams, podLabels map[string]string) (any, error)
+	GetComponentLogs(ctx context.Context, params logs.ComponentQueryParams) (any, error)
+	GetProjectLogs(ctx context.Context, params logs.QueryParams, componentIDs []string) (any, error)
+	GetGatewayLogs(ctx context.Context, params logs.GatewayQueryParams) (any, error)
+	GetOrganizationLogs(ctx context.Context, params logs.QueryParams, podLabels map[string]string) (any, error)
 	GetComponentTraces(ctx context.Context, params opensearch.ComponentTracesRequestParams) (any, error)
 	GetComponentResourceMetrics(ctx context.Context, componentID, environmentID, projectID, startTime, endTime string) (any, error)
 	GetComponentHTTPMetrics(ctx context.Context, componentID, environmentID, projectID, startTime, endTime string) (any, error)
@@ -106,8 +107,8 @@ func registerTools(s *mcpsdk.Server, handler Handler) {
 	}) (*mcpsdk.CallToolResult, any, error) {
 		limit, sortOrder, logLevels := setDefaults(args.Limit, args.SortOrder, args.LogLevels)
 
-		params := opensearch.ComponentQueryParams{
-			QueryParams: opensearch.QueryParams{
+		params := logs.ComponentQueryParams{
+			QueryParams: logs.QueryParams{
 				StartTime:     args.StartTime,
 				EndTime:       args.EndTime,
 				EnvironmentID: args.EnvironmentID,
@@ -169,7 +170,7 @@ func registerTools(s *mcpsdk.Server, handler Handler) {
 	}) (*mcpsdk.CallToolResult, any, error) {
 		limit, sortOrder, logLevels := setDefaults(args.Limit, args.SortOrder, args.LogLevels)
 
-		params := opensearch.QueryParams{
+		params := logs.QueryParams{
 			StartTime:     args.StartTime,
 			EndTime:       args.EndTime,
 			EnvironmentID: args.EnvironmentID,
@@ -216,8 +217,8 @@ func registerTools(s *mcpsdk.Server, handler Handler) {
 	}) (*mcpsdk.CallToolResult, any, error) {
 		limit, sortOrder, _ := setDefaults(args.Limit, args.SortOrder, nil)
 
-		params := opensearch.GatewayQueryParams{
-			QueryParams: opensearch.QueryParams{
+		params := logs.GatewayQueryParams{
+			QueryParams: logs.QueryParams{
 				StartTime:      args.StartTime,
 				EndTime:        args.EndTime,
 				SearchPhrase:   args.SearchPhrase,
@@ -274,7 +275,7 @@ func registerTools(s *mcpsdk.Server, handler Handler) {
 	}) (*mcpsdk.CallToolResult, any, error) {
 		limit, sortOrder, logLevels := setDefaults(args.Limit, args.SortOrder, args.LogLevels)
 
-		params := opensearch.QueryParams{
+		params := logs.QueryParams{
 			StartTime:      args.StartTime,
 			EndTime:        args.EndTime,
 			EnvironmentID:  args.EnvironmentID,
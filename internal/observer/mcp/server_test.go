@@ -14,6 +14,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
 	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
 )
 
@@ -60,7 +61,7 @@ func (m *MockHandler) recordCall(method string, args ...interface{}) {
 	m.calls[method] = append(m.calls[method], args)
 }
 
-func (m *MockHandler) GetComponentLogs(ctx context.Context, params opensearch.ComponentQueryParams) (any, error) {
+func (m *MockHandler) GetComponentLogs(ctx context.Context, params logs.ComponentQueryParams) (any, error) {
 	m.recordCall("GetComponentLogs", params)
 	if m.componentLogsError != nil {
 		return nil, m.componentLogsError
@@ -73,7 +74,7 @@ func (m *MockHandler) GetComponentLogs(ctx context.Context, params opensearch.Co
 	return logsData, nil
 }
 
-func (m *MockHandler) GetProjectLogs(ctx context.Context, params opensearch.QueryParams, componentIDs []string) (any, error) {
+func (m *MockHandler) GetProjectLogs(ctx context.Context, params logs.QueryParams, componentIDs []string) (any, error) {
 	m.recordCall("GetProjectLogs", params, componentIDs)
 	if m.projectLogsError != nil {
 		return nil, m.projectLogsError
@@ -85,7 +86,7 @@ func (m *MockHandler) GetProjectLogs(ctx context.Context, params opensearch.Quer
 	return logsData, nil
 }
 
-func (m *MockHandler) GetGatewayLogs(ctx context.Context, params opensearch.GatewayQueryParams) (any, error) {
+func (m *MockHandler) GetGatewayLogs(ctx context.Context, params logs.GatewayQueryParams) (any, error) {
 	m.recordCall("GetGatewayLogs", params)
 	if m.gatewayLogsError != nil {
 		return nil, m.gatewayLogsError
@@ -97,7 +98,7 @@ func (m *MockHandler) GetGatewayLogs(ctx context.Context, params opensearch.Gate
 	return logsData, nil
 }
 
-func (m *MockHandler) GetOrganizationLogs(ctx context.Context, params opensearch.QueryParams, podLabels map[string]string) (any, error) {
+func (m *MockHandler) GetOrganizationLogs(ctx context.Context, params logs.QueryParams, podLabels map[string]string) (any, error) {
 	m.recordCall("GetOrganizationLogs", params, podLabels)
 	if m.organizationLogsError != nil {
 		return nil, m.organizationLogsError
@@ -224,7 +225,7 @@ var allToolSpecs = []toolTestSpec{
 			if len(args) == 0 {
 				t.Fatal("Expected at least one argument")
 			}
-			params, ok := args[0].(opensearch.ComponentQueryParams)
+			params, ok := args[0].(logs.ComponentQueryParams)
 			if !ok {
 				t.Fatalf("Expected ComponentQueryParams, got %T", args[0])
 			}
@@ -297,7 +298,7 @@ var allToolSpecs = []toolTestSpec{
 			if len(args) < 2 {
 				t.Fatal("Expected at least two arguments")
 			}
-			params, ok := args[0].(opensearch.QueryParams)
+			params, ok := args[0].(logs.QueryParams)
 			if !ok {
 				t.Fatalf("Expected QueryParams, got %T", args[0])
 			}
@@ -361,7 +362,7 @@ var allToolSpecs = []toolTestSpec{
 			if len(args) == 0 {
 				t.Fatal("Expected at least one argument")
 			}
-			params, ok := args[0].(opensearch.GatewayQueryParams)
+			params, ok := args[0].(logs.GatewayQueryParams)
 			if !ok {
 				t.Fatalf("Expected GatewayQueryParams, got %T", args[0])
 			}
@@ -425,7 +426,7 @@ var allToolSpecs = []toolTestSpec{
 			if len(args) < 2 {
 				t.Fatal("Expected at least two arguments")
 			}
-			params, ok := args[0].(opensearch.QueryParams)
+			params, ok := args[0].(logs.QueryParams)
 			if !ok {
 				t.Fatalf("Expected QueryParams, got %T", args[0])
 			}
@@ -1194,7 +1195,7 @@ func TestOptionalParametersDefaults(t *testing.T) {
 				"end_time":       testEndTime,
 			},
 			validateCall: func(t *testing.T, args []interface{}) {
-				params := args[0].(opensearch.ComponentQueryParams)
+				params := args[0].(logs.ComponentQueryParams)
 				// Verify optional params have default values
 				if params.SearchPhrase != "" {
 					t.Errorf("Expected empty search_phrase, got %q", params.SearchPhrase)
@@ -1233,7 +1234,7 @@ func TestOptionalParametersDefaults(t *testing.T) {
 				"end_time":        testEndTime,
 			},
 			validateCall: func(t *testing.T, args []interface{}) {
-				params := args[0].(opensearch.GatewayQueryParams)
+				params := args[0].(logs.GatewayQueryParams)
 				// Verify optional params are nil/empty
 				if len(params.APIIDToVersionMap) != 0 {
 					t.Errorf("Expected nil or empty api_id_to_version_map, got %v", params.APIIDToVersionMap)
@@ -1325,7 +1326,7 @@ func TestParameterMappingRegression(t *testing.T) {
 	}
 
 	args := calls[0].([]interface{})
-	params := args[0].(opensearch.ComponentQueryParams)
+	params := args[0].(logs.ComponentQueryParams)
 
 	// If someone changed the mapping in server.go (e.g., swapped component_id with environment_id),
 	// these assertions would fail, proving validateCall catches real bugs
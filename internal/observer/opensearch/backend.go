@@ -0,0 +1,100 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opensearch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
+)
+
+// Searcher executes searches against OpenSearch. It is implemented by Client.
+type Searcher interface {
+	Search(ctx context.Context, indices []string, query map[string]interface{}) (*SearchResponse, error)
+	HealthCheck(ctx context.Context) error
+}
+
+// LogBackend queries the logs stored in OpenSearch
+type LogBackend struct {
+	client       Searcher
+	queryBuilder *QueryBuilder
+}
+
+// NewLogBackend creates a log backend searching the daily log indices with the given prefix
+func NewLogBackend(client Searcher, indexPrefix string) *LogBackend {
+	return &LogBackend{
+		client:       client,
+		queryBuilder: NewQueryBuilder(indexPrefix),
+	}
+}
+
+// ComponentLogs retrieves the runtime or build logs of a component
+func (b *LogBackend) ComponentLogs(ctx context.Context, params logs.ComponentQueryParams) (*logs.LogResult, error) {
+	return b.searchLogs(ctx, params.StartTime, params.EndTime, b.queryBuilder.BuildComponentLogsQuery(params))
+}
+
+// ProjectLogs retrieves the logs of a project, limited to the given components if there are any
+func (b *LogBackend) ProjectLogs(ctx context.Context, params logs.QueryParams, componentIDs []string) (*logs.LogResult, error) {
+	return b.searchLogs(ctx, params.StartTime, params.EndTime, b.queryBuilder.BuildProjectLogsQuery(params, componentIDs))
+}
+
+// GatewayLogs retrieves the gateway logs of an organization
+func (b *LogBackend) GatewayLogs(ctx context.Context, params logs.GatewayQueryParams) (*logs.LogResult, error) {
+	return b.searchLogs(ctx, params.StartTime, params.EndTime, b.queryBuilder.BuildGatewayLogsQuery(params))
+}
+
+// OrganizationLogs retrieves the logs of an organization matching the given pod labels
+func (b *LogBackend) OrganizationLogs(ctx context.Context, params logs.QueryParams, podLabels map[string]string) (*logs.LogResult, error) {
+	return b.searchLogs(ctx, params.StartTime, params.EndTime, b.queryBuilder.BuildOrganizationLogsQuery(params, podLabels))
+}
+
+// CountComponentLogs returns the number of log entries of a component matching the query parameters
+func (b *LogBackend) CountComponentLogs(ctx context.Context, params logs.ComponentQueryParams) (int, error) {
+	indices, err := b.queryBuilder.GenerateIndices(params.StartTime, params.EndTime)
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	query := b.queryBuilder.BuildComponentLogsQuery(params)
+	query["track_total_hits"] = true
+
+	response, err := b.client.Search(ctx, indices, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute search: %w", err)
+	}
+	return response.Hits.Total.Value, nil
+}
+
+// HealthCheck checks that OpenSearch is reachable
+func (b *LogBackend) HealthCheck(ctx context.Context) error {
+	if err := b.client.HealthCheck(ctx); err != nil {
+		return fmt.Errorf("opensearch health check failed: %w", err)
+	}
+	return nil
+}
+
+// searchLogs executes a log query against the indices of the time range
+func (b *LogBackend) searchLogs(ctx context.Context, startTime, endTime string, query map[string]interface{}) (*logs.LogResult, error) {
+	indices, err := b.queryBuilder.GenerateIndices(startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	response, err := b.client.Search(ctx, indices, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute search: %w", err)
+	}
+
+	entries := make([]logs.LogEntry, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		entries = append(entries, ParseLogEntry(hit))
+	}
+
+	return &logs.LogResult{
+		Logs:       entries,
+		TotalCount: response.Hits.Total.Value,
+		Took:       response.Took,
+	}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
 )
 
 // QueryBuilder provides methods to build OpenSearch queries
@@ -80,7 +81,7 @@ func addLogLevelFilter(mustConditions []map[string]interface{}, logLevels []stri
 }
 
 // BuildComponentLogsQuery builds a query for component logs with wildcard search
-func (qb *QueryBuilder) BuildComponentLogsQuery(params ComponentQueryParams) map[string]interface{} {
+func (qb *QueryBuilder) BuildComponentLogsQuery(params logs.ComponentQueryParams) map[string]interface{} {
 	mustConditions := []map[string]interface{}{
 		{
 			"term": map[string]interface{}{
@@ -194,7 +195,7 @@ func (qb *QueryBuilder) BuildComponentLogsQuery(params ComponentQueryParams) map
 }
 
 // BuildProjectLogsQuery builds a query for project logs with wildcard search
-func (qb *QueryBuilder) BuildProjectLogsQuery(params QueryParams, componentIDs []string) map[string]interface{} {
+func (qb *QueryBuilder) BuildProjectLogsQuery(params logs.QueryParams, componentIDs []string) map[string]interface{} {
 	mustConditions := []map[string]interface{}{
 		{
 			"term": map[string]interface{}{
@@ -249,7 +250,7 @@ func (qb *QueryBuilder) BuildProjectLogsQuery(params QueryParams, componentIDs [
 }
 
 // BuildGatewayLogsQuery builds a query for gateway logs with wildcard search
-func (qb *QueryBuilder) BuildGatewayLogsQuery(params GatewayQueryParams) map[string]interface{} {
+func (qb *QueryBuilder) BuildGatewayLogsQuery(params logs.GatewayQueryParams) map[string]interface{} {
 	mustConditions := []map[string]interface{}{}
 
 	// Add common filters
@@ -383,7 +384,7 @@ func (qb *QueryBuilder) GenerateIndices(startTime, endTime string) ([]string, er
 }
 
 // BuildOrganizationLogsQuery builds a query for organization logs with wildcard search
-func (qb *QueryBuilder) BuildOrganizationLogsQuery(params QueryParams, podLabels map[string]string) map[string]interface{} {
+func (qb *QueryBuilder) BuildOrganizationLogsQuery(params logs.QueryParams, podLabels map[string]string) map[string]interface{} {
 	mustConditions := []map[string]interface{}{}
 
 	// Add organization filter - this is the key fix!
@@ -7,13 +7,14 @@ import (
 	"testing"
 
 	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
 )
 
 func TestQueryBuilder_BuildComponentLogsQuery(t *testing.T) {
 	qb := NewQueryBuilder("container-logs-")
 
-	params := ComponentQueryParams{
-		QueryParams: QueryParams{
+	params := logs.ComponentQueryParams{
+		QueryParams: logs.QueryParams{
 			StartTime:     "2024-01-01T00:00:00Z",
 			EndTime:       "2024-01-01T23:59:59Z",
 			SearchPhrase:  "error",
@@ -76,7 +77,7 @@ func TestQueryBuilder_BuildComponentLogsQuery(t *testing.T) {
 func TestQueryBuilder_BuildProjectLogsQuery(t *testing.T) {
 	qb := NewQueryBuilder("container-logs-")
 
-	params := QueryParams{
+	params := logs.QueryParams{
 		StartTime:     "2024-01-01T00:00:00Z",
 		EndTime:       "2024-01-01T23:59:59Z",
 		SearchPhrase:  "info",
@@ -115,8 +116,8 @@ func TestQueryBuilder_BuildProjectLogsQuery(t *testing.T) {
 func TestQueryBuilder_BuildGatewayLogsQuery(t *testing.T) {
 	qb := NewQueryBuilder("container-logs-")
 
-	params := GatewayQueryParams{
-		QueryParams: QueryParams{
+	params := logs.GatewayQueryParams{
+		QueryParams: logs.QueryParams{
 			StartTime:    "2024-01-01T00:00:00Z",
 			EndTime:      "2024-01-01T23:59:59Z",
 			SearchPhrase: "gateway",
@@ -119,6 +119,33 @@ func (c *Client) QueryRangeTimeSeries(ctx context.Context, query string, start,
 	return tsResp, nil
 }
 
+// QueryScalar executes a PromQL instant query that is expected to produce a single value.
+// Both scalar results and vectors with exactly one sample are accepted.
+func (c *Client) QueryScalar(ctx context.Context, query string, at time.Time) (float64, error) {
+	c.logger.Debug("Executing Prometheus instant query", "time", at)
+
+	result, warnings, err := c.api.Query(ctx, query, at)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	if len(warnings) > 0 {
+		c.logger.Warn("Prometheus instant query returned warnings", "warnings", warnings)
+	}
+
+	switch v := result.(type) {
+	case *model.Scalar:
+		return float64(v.Value), nil
+	case model.Vector:
+		if len(v) != 1 {
+			return 0, fmt.Errorf("query returned %d samples, expected exactly one", len(v))
+		}
+		return float64(v[0].Value), nil
+	default:
+		return 0, fmt.Errorf("query returned unsupported result type %s", result.Type())
+	}
+}
+
 // Converts Prometheus model.Value to TimeSeriesResponse format. This properly handles Matrix results with all
 // data points
 func convertToTimeSeriesResponse(result model.Value) *TimeSeriesResponse {
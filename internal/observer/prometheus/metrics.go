@@ -51,6 +51,11 @@ func (s *MetricsService) QueryRangeTimeSeries(ctx context.Context, query string,
 	return s.client.QueryRangeTimeSeries(ctx, query, start, end, step)
 }
 
+// QueryScalar executes a Prometheus instant query and returns its single value
+func (s *MetricsService) QueryScalar(ctx context.Context, query string, at time.Time) (float64, error) {
+	return s.client.QueryScalar(ctx, query, at)
+}
+
 // Converts Kubernetes label names to Prometheus metric label names
 // e.g., "component-name" becomes "label_component_name"
 func prometheusLabelName(kubernetesLabel string) string {
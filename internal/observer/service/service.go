@@ -5,20 +5,27 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sync"
 	"time"
 
 	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
 	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
 	"github.com/openchoreo/openchoreo/internal/observer/prometheus"
 )
 
 const (
-	logLevelDebug = "debug"
+	logLevelDebug    = "debug"
+	defaultSortOrder = "desc"
 )
 
+// ErrTracesUnavailable is returned for trace queries when the observer is not connected to OpenSearch
+var ErrTracesUnavailable = errors.New("traces are only available with the OpenSearch log backend")
+
 // OpenSearchClient interface for testing
 type OpenSearchClient interface {
 	Search(ctx context.Context, indices []string, query map[string]interface{}) (*opensearch.SearchResponse, error)
@@ -26,9 +33,21 @@ type OpenSearchClient interface {
 	HealthCheck(ctx context.Context) error
 }
 
+// LogBackend is the store the logs are queried from, such as OpenSearch or Grafana Loki.
+// Queries return the entries sorted by timestamp in the sort order of the query parameters.
+type LogBackend interface {
+	ComponentLogs(ctx context.Context, params logs.ComponentQueryParams) (*logs.LogResult, error)
+	ProjectLogs(ctx context.Context, params logs.QueryParams, componentIDs []string) (*logs.LogResult, error)
+	GatewayLogs(ctx context.Context, params logs.GatewayQueryParams) (*logs.LogResult, error)
+	OrganizationLogs(ctx context.Context, params logs.QueryParams, podLabels map[string]string) (*logs.LogResult, error)
+	CountComponentLogs(ctx context.Context, params logs.ComponentQueryParams) (int, error)
+	HealthCheck(ctx context.Context) error
+}
+
 // LoggingService provides logging and metrics functionality
 type LoggingService struct {
-	osClient       OpenSearchClient
+	logBackend     LogBackend
+	osClient       OpenSearchClient // Queries the traces. Nil when the logs are not stored in OpenSearch.
 	queryBuilder   *opensearch.QueryBuilder
 	metricsService *prometheus.MetricsService
 	config         *config.Config
@@ -37,9 +56,9 @@ type LoggingService struct {
 
 // LogResponse represents the response structure for log queries
 type LogResponse struct {
-	Logs       []opensearch.LogEntry `json:"logs"`
-	TotalCount int                   `json:"totalCount"`
-	Took       int                   `json:"tookMs"`
+	Logs       []logs.LogEntry `json:"logs"`
+	TotalCount int             `json:"totalCount"`
+	Took       int             `json:"tookMs"`
 }
 
 // HTTPMetricsTimeSeries represents HTTP metrics as time series data. This is what will be returned by the
@@ -54,9 +73,11 @@ type HTTPMetricsTimeSeries struct {
 	UnsuccessfulRequestCount []prometheus.TimeValuePoint `json:"unsuccessfulRequestCount"`
 }
 
-// NewLoggingService creates a new logging service instance
-func NewLoggingService(osClient OpenSearchClient, metricsService *prometheus.MetricsService, cfg *config.Config, logger *slog.Logger) *LoggingService {
+// NewLoggingService creates a new logging service instance querying the logs from logBackend.
+// osClient queries the traces, and may be nil when the observer is not connected to OpenSearch.
+func NewLoggingService(logBackend LogBackend, osClient OpenSearchClient, metricsService *prometheus.MetricsService, cfg *config.Config, logger *slog.Logger) *LoggingService {
 	return &LoggingService{
+		logBackend:     logBackend,
 		osClient:       osClient,
 		queryBuilder:   opensearch.NewQueryBuilder(cfg.OpenSearch.IndexPrefix),
 		metricsService: metricsService,
@@ -66,179 +87,122 @@ func NewLoggingService(osClient OpenSearchClient, metricsService *prometheus.Met
 }
 
 // GetComponentLogs retrieves logs for a specific component using V2 wildcard search
-func (s *LoggingService) GetComponentLogs(ctx context.Context, params opensearch.ComponentQueryParams) (*LogResponse, error) {
+func (s *LoggingService) GetComponentLogs(ctx context.Context, params logs.ComponentQueryParams) (*LogResponse, error) {
 	s.logger.Info("Getting component logs",
 		"component_id", params.ComponentID,
 		"environment_id", params.EnvironmentID,
 		"search_phrase", params.SearchPhrase)
 
-	// Generate indices based on time range
-	indices, err := s.queryBuilder.GenerateIndices(params.StartTime, params.EndTime)
+	params.CountTotal = true
+	result, err := s.logBackend.ComponentLogs(ctx, params)
 	if err != nil {
-		s.logger.Error("Failed to generate indices", "error", err)
-		return nil, fmt.Errorf("failed to generate indices: %w", err)
+		s.logger.Error("Failed to query component logs", "error", err)
+		return nil, err
 	}
 
-	// Build query with wildcard search
-	query := s.queryBuilder.BuildComponentLogsQuery(params)
-
-	// Execute search
-	response, err := s.osClient.Search(ctx, indices, query)
-	if err != nil {
-		s.logger.Error("Failed to execute component logs search", "error", err)
-		return nil, fmt.Errorf("failed to execute search: %w", err)
-	}
+	s.logger.Info("Component logs retrieved",
+		"count", len(result.Logs),
+		"total", result.TotalCount)
 
-	// Parse log entries
-	logs := make([]opensearch.LogEntry, 0, len(response.Hits.Hits))
-	for _, hit := range response.Hits.Hits {
-		entry := opensearch.ParseLogEntry(hit)
-		logs = append(logs, entry)
-	}
+	return newLogResponse(result), nil
+}
 
-	s.logger.Info("Component logs retrieved",
-		"count", len(logs),
-		"total", response.Hits.Total.Value)
+// CountComponentLogs returns the number of runtime log entries of a component matching the query parameters
+func (s *LoggingService) CountComponentLogs(ctx context.Context, params logs.ComponentQueryParams) (int, error) {
+	params.LogType = labels.QueryParamLogTypeRuntime
+	params.Limit = 0
+	params.SortOrder = defaultSortOrder
 
-	return &LogResponse{
-		Logs:       logs,
-		TotalCount: response.Hits.Total.Value,
-		Took:       response.Took,
-	}, nil
+	count, err := s.logBackend.CountComponentLogs(ctx, params)
+	if err != nil {
+		s.logger.Error("Failed to count component logs", "error", err)
+		return 0, err
+	}
+	return count, nil
 }
 
 // GetProjectLogs retrieves logs for a specific project using V2 wildcard search
-func (s *LoggingService) GetProjectLogs(ctx context.Context, params opensearch.QueryParams, componentIDs []string) (*LogResponse, error) {
+func (s *LoggingService) GetProjectLogs(ctx context.Context, params logs.QueryParams, componentIDs []string) (*LogResponse, error) {
 	s.logger.Info("Getting project logs",
 		"project_id", params.ProjectID,
 		"environment_id", params.EnvironmentID,
 		"component_ids", componentIDs,
 		"search_phrase", params.SearchPhrase)
 
-	// Generate indices based on time range
-	indices, err := s.queryBuilder.GenerateIndices(params.StartTime, params.EndTime)
-	if err != nil {
-		s.logger.Error("Failed to generate indices", "error", err)
-		return nil, fmt.Errorf("failed to generate indices: %w", err)
-	}
-
-	// Build query with wildcard search
-	query := s.queryBuilder.BuildProjectLogsQuery(params, componentIDs)
-
-	// Execute search
-	response, err := s.osClient.Search(ctx, indices, query)
+	params.CountTotal = true
+	result, err := s.logBackend.ProjectLogs(ctx, params, componentIDs)
 	if err != nil {
-		s.logger.Error("Failed to execute project logs search", "error", err)
-		return nil, fmt.Errorf("failed to execute search: %w", err)
-	}
-
-	// Parse log entries
-	logs := make([]opensearch.LogEntry, 0, len(response.Hits.Hits))
-	for _, hit := range response.Hits.Hits {
-		entry := opensearch.ParseLogEntry(hit)
-		logs = append(logs, entry)
+		s.logger.Error("Failed to query project logs", "error", err)
+		return nil, err
 	}
 
 	s.logger.Info("Project logs retrieved",
-		"count", len(logs),
-		"total", response.Hits.Total.Value)
+		"count", len(result.Logs),
+		"total", result.TotalCount)
 
-	return &LogResponse{
-		Logs:       logs,
-		TotalCount: response.Hits.Total.Value,
-		Took:       response.Took,
-	}, nil
+	return newLogResponse(result), nil
 }
 
 // GetGatewayLogs retrieves gateway logs using V2 wildcard search
-func (s *LoggingService) GetGatewayLogs(ctx context.Context, params opensearch.GatewayQueryParams) (*LogResponse, error) {
+func (s *LoggingService) GetGatewayLogs(ctx context.Context, params logs.GatewayQueryParams) (*LogResponse, error) {
 	s.logger.Info("Getting gateway logs",
 		"organization_id", params.OrganizationID,
 		"gateway_vhosts", params.GatewayVHosts,
 		"search_phrase", params.SearchPhrase)
 
-	// Generate indices based on time range
-	indices, err := s.queryBuilder.GenerateIndices(params.StartTime, params.EndTime)
+	params.CountTotal = true
+	result, err := s.logBackend.GatewayLogs(ctx, params)
 	if err != nil {
-		s.logger.Error("Failed to generate indices", "error", err)
-		return nil, fmt.Errorf("failed to generate indices: %w", err)
-	}
-
-	// Build query with wildcard search
-	query := s.queryBuilder.BuildGatewayLogsQuery(params)
-
-	// Execute search
-	response, err := s.osClient.Search(ctx, indices, query)
-	if err != nil {
-		s.logger.Error("Failed to execute gateway logs search", "error", err)
-		return nil, fmt.Errorf("failed to execute search: %w", err)
-	}
-
-	// Parse log entries
-	logs := make([]opensearch.LogEntry, 0, len(response.Hits.Hits))
-	for _, hit := range response.Hits.Hits {
-		entry := opensearch.ParseLogEntry(hit)
-		logs = append(logs, entry)
+		s.logger.Error("Failed to query gateway logs", "error", err)
+		return nil, err
 	}
 
 	s.logger.Info("Gateway logs retrieved",
-		"count", len(logs),
-		"total", response.Hits.Total.Value)
+		"count", len(result.Logs),
+		"total", result.TotalCount)
 
-	return &LogResponse{
-		Logs:       logs,
-		TotalCount: response.Hits.Total.Value,
-		Took:       response.Took,
-	}, nil
+	return newLogResponse(result), nil
 }
 
 // GetOrganizationLogs retrieves logs for an organization with custom filters
-func (s *LoggingService) GetOrganizationLogs(ctx context.Context, params opensearch.QueryParams, podLabels map[string]string) (*LogResponse, error) {
+func (s *LoggingService) GetOrganizationLogs(ctx context.Context, params logs.QueryParams, podLabels map[string]string) (*LogResponse, error) {
 	s.logger.Info("Getting organization logs",
 		"organization_id", params.OrganizationID,
 		"environment_id", params.EnvironmentID,
 		"pod_labels", podLabels,
 		"search_phrase", params.SearchPhrase)
 
-	// Generate indices based on time range
-	indices, err := s.queryBuilder.GenerateIndices(params.StartTime, params.EndTime)
+	params.CountTotal = true
+	result, err := s.logBackend.OrganizationLogs(ctx, params, podLabels)
 	if err != nil {
-		s.logger.Error("Failed to generate indices", "error", err)
-		return nil, fmt.Errorf("failed to generate indices: %w", err)
-	}
-
-	// Build organization-specific query
-	query := s.queryBuilder.BuildOrganizationLogsQuery(params, podLabels)
-
-	// Execute search
-	response, err := s.osClient.Search(ctx, indices, query)
-	if err != nil {
-		s.logger.Error("Failed to execute organization logs search", "error", err)
-		return nil, fmt.Errorf("failed to execute search: %w", err)
-	}
-
-	// Parse log entries
-	logs := make([]opensearch.LogEntry, 0, len(response.Hits.Hits))
-	for _, hit := range response.Hits.Hits {
-		entry := opensearch.ParseLogEntry(hit)
-		logs = append(logs, entry)
+		s.logger.Error("Failed to query organization logs", "error", err)
+		return nil, err
 	}
 
 	s.logger.Info("Organization logs retrieved",
-		"count", len(logs),
-		"total", response.Hits.Total.Value)
+		"count", len(result.Logs),
+		"total", result.TotalCount)
 
+	return newLogResponse(result), nil
+}
+
+// newLogResponse converts the result of a log backend query to the response of the log APIs
+func newLogResponse(result *logs.LogResult) *LogResponse {
 	return &LogResponse{
-		Logs:       logs,
-		TotalCount: response.Hits.Total.Value,
-		Took:       response.Took,
-	}, nil
+		Logs:       result.Logs,
+		TotalCount: result.TotalCount,
+		Took:       result.Took,
+	}
 }
 
 func (s *LoggingService) GetComponentTraces(ctx context.Context, params opensearch.ComponentTracesRequestParams) (*opensearch.TraceResponse, error) {
 	s.logger.Info("Getting component traces",
 		"serviceName", params.ServiceName)
 
+	if s.osClient == nil {
+		return nil, ErrTracesUnavailable
+	}
+
 	// Build component traces query
 	query := s.queryBuilder.BuildComponentTracesQuery(params)
 
@@ -272,9 +236,9 @@ func (s *LoggingService) HealthCheck(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	if err := s.osClient.HealthCheck(ctx); err != nil {
+	if err := s.logBackend.HealthCheck(ctx); err != nil {
 		s.logger.Error("Health check failed", "error", err)
-		return fmt.Errorf("opensearch health check failed: %w", err)
+		return err
 	}
 
 	s.logger.Debug("Health check passed")
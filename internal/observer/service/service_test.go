@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/openchoreo/openchoreo/internal/observer/config"
 	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
 	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
 )
 
@@ -114,10 +116,10 @@ func TestLoggingService_GetComponentLogs(t *testing.T) {
 	mockClient := &MockOpenSearchClient{
 		searchResponse: mockResponse,
 	}
-	service.osClient = mockClient
+	service.logBackend = opensearch.NewLogBackend(mockClient, service.config.OpenSearch.IndexPrefix)
 
-	params := opensearch.ComponentQueryParams{
-		QueryParams: opensearch.QueryParams{
+	params := logs.ComponentQueryParams{
+		QueryParams: logs.QueryParams{
 			StartTime:     "2024-01-01T00:00:00Z",
 			EndTime:       "2024-01-01T23:59:59Z",
 			SearchPhrase:  "error",
@@ -172,6 +174,30 @@ func TestLoggingService_GetComponentLogs(t *testing.T) {
 	}
 }
 
+// recordingLogBackend records the query parameters of organization log queries
+type recordingLogBackend struct {
+	LogBackend
+	params []logs.QueryParams
+}
+
+func (b *recordingLogBackend) OrganizationLogs(_ context.Context, params logs.QueryParams, _ map[string]string) (*logs.LogResult, error) {
+	b.params = append(b.params, params)
+	return &logs.LogResult{}, nil
+}
+
+func TestLoggingService_GetOrganizationLogsCountsTotal(t *testing.T) {
+	service := newMockLoggingService()
+	backend := &recordingLogBackend{}
+	service.logBackend = backend
+
+	if _, err := service.GetOrganizationLogs(context.Background(), logs.QueryParams{OrganizationID: "org-1"}, nil); err != nil {
+		t.Fatalf("GetOrganizationLogs() error = %v", err)
+	}
+	if len(backend.params) != 1 || !backend.params[0].CountTotal {
+		t.Errorf("GetOrganizationLogs() queried %+v, want one query counting the total", backend.params)
+	}
+}
+
 func TestLoggingService_GetProjectLogs(t *testing.T) {
 	service := newMockLoggingService()
 
@@ -213,9 +239,9 @@ func TestLoggingService_GetProjectLogs(t *testing.T) {
 	mockClient := &MockOpenSearchClient{
 		searchResponse: mockResponse,
 	}
-	service.osClient = mockClient
+	service.logBackend = opensearch.NewLogBackend(mockClient, service.config.OpenSearch.IndexPrefix)
 
-	params := opensearch.QueryParams{
+	params := logs.QueryParams{
 		StartTime:     "2024-01-01T00:00:00Z",
 		EndTime:       "2024-01-01T23:59:59Z",
 		ProjectID:     "proj-123",
@@ -272,7 +298,7 @@ func TestLoggingService_HealthCheck(t *testing.T) {
 			mockClient := &MockOpenSearchClient{
 				healthError: tt.healthError,
 			}
-			service.osClient = mockClient
+			service.logBackend = opensearch.NewLogBackend(mockClient, service.config.OpenSearch.IndexPrefix)
 
 			ctx := context.Background()
 			err := service.HealthCheck(ctx)
@@ -698,6 +724,15 @@ func TestLoggingService_GetComponentTraces_QueryBuilding(t *testing.T) {
 	// 3. Response was parsed without issues
 }
 
+func TestLoggingService_GetComponentTraces_WithoutOpenSearch(t *testing.T) {
+	service := newMockLoggingService()
+
+	_, err := service.GetComponentTraces(context.Background(), opensearch.ComponentTracesRequestParams{ServiceName: "my-test-service"})
+	if !errors.Is(err, ErrTracesUnavailable) {
+		t.Errorf("Expected ErrTracesUnavailable, got %v", err)
+	}
+}
+
 // Helper function to parse time strings for test data
 func mustParseTime(timeStr string) time.Time {
 	parsed, err := time.Parse(time.RFC3339Nano, timeStr)
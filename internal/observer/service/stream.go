@@ -0,0 +1,215 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/labels"
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
+)
+
+const (
+	// defaultStreamPollInterval is used when the configuration does not set a poll interval
+	defaultStreamPollInterval = 2 * time.Second
+
+	// defaultStreamIngestDelay is used when the configuration does not set an ingest delay
+	defaultStreamIngestDelay = 5 * time.Second
+
+	// streamBatchSize is the maximum number of entries fetched by a single stream query
+	streamBatchSize = 500
+)
+
+// LogBatchFunc receives the new entries of a log stream after every poll, in timestamp order.
+// It is called with an empty batch when a poll found no new entries. Returning an error ends the stream.
+type LogBatchFunc func(logs []logs.LogEntry) error
+
+// logQueryFunc queries the log backend for the entries of a log stream within a time range
+type logQueryFunc func(ctx context.Context, startTime, endTime string) (*logs.LogResult, error)
+
+// StreamComponentLogs follows the runtime logs of a component from params.StartTime, or from now if it is
+// not set, and passes new entries to send until the context is cancelled. Entries at params.StartTime
+// whose IDs are in sentIDs were already received by the client and are skipped.
+func (s *LoggingService) StreamComponentLogs(ctx context.Context, params logs.ComponentQueryParams, sentIDs []string,
+	send LogBatchFunc) error {
+	s.logger.Info("Streaming component logs",
+		"component_id", params.ComponentID,
+		"environment_id", params.EnvironmentID)
+
+	// Build logs are not filtered by time, so only runtime logs can be followed
+	params.LogType = labels.QueryParamLogTypeRuntime
+	return s.streamLogs(ctx, params.StartTime, sentIDs, func(ctx context.Context, startTime, endTime string) (*logs.LogResult, error) {
+		p := params
+		p.QueryParams = streamQueryParams(p.QueryParams, startTime, endTime)
+		return s.logBackend.ComponentLogs(ctx, p)
+	}, send)
+}
+
+// StreamProjectLogs follows the logs of a project, optionally limited to the given components
+func (s *LoggingService) StreamProjectLogs(ctx context.Context, params logs.QueryParams, componentIDs []string,
+	sentIDs []string, send LogBatchFunc) error {
+	s.logger.Info("Streaming project logs",
+		"project_id", params.ProjectID,
+		"environment_id", params.EnvironmentID,
+		"component_ids", componentIDs)
+
+	return s.streamLogs(ctx, params.StartTime, sentIDs, func(ctx context.Context, startTime, endTime string) (*logs.LogResult, error) {
+		return s.logBackend.ProjectLogs(ctx, streamQueryParams(params, startTime, endTime), componentIDs)
+	}, send)
+}
+
+// StreamGatewayLogs follows the gateway logs of an organization
+func (s *LoggingService) StreamGatewayLogs(ctx context.Context, params logs.GatewayQueryParams, sentIDs []string,
+	send LogBatchFunc) error {
+	s.logger.Info("Streaming gateway logs",
+		"organization_id", params.OrganizationID,
+		"gateway_vhosts", params.GatewayVHosts)
+
+	return s.streamLogs(ctx, params.StartTime, sentIDs, func(ctx context.Context, startTime, endTime string) (*logs.LogResult, error) {
+		p := params
+		p.QueryParams = streamQueryParams(p.QueryParams, startTime, endTime)
+		return s.logBackend.GatewayLogs(ctx, p)
+	}, send)
+}
+
+// StreamOrganizationLogs follows the logs of an organization with custom pod label filters
+func (s *LoggingService) StreamOrganizationLogs(ctx context.Context, params logs.QueryParams, podLabels map[string]string,
+	sentIDs []string, send LogBatchFunc) error {
+	s.logger.Info("Streaming organization logs",
+		"organization_id", params.OrganizationID,
+		"environment_id", params.EnvironmentID,
+		"pod_labels", podLabels)
+
+	return s.streamLogs(ctx, params.StartTime, sentIDs, func(ctx context.Context, startTime, endTime string) (*logs.LogResult, error) {
+		return s.logBackend.OrganizationLogs(ctx, streamQueryParams(params, startTime, endTime), podLabels)
+	}, send)
+}
+
+// streamQueryParams returns the query parameters of a single stream poll. Streams send no total count, so
+// polls do not request one.
+func streamQueryParams(params logs.QueryParams, startTime, endTime string) logs.QueryParams {
+	params.StartTime = startTime
+	params.EndTime = endTime
+	params.Limit = streamBatchSize
+	params.SortOrder = "asc"
+	params.CountTotal = false
+	return params
+}
+
+// streamLogs polls the log backend for entries newer than the stream cursor until the context is cancelled
+func (s *LoggingService) streamLogs(ctx context.Context, since string, sentIDs []string, query logQueryFunc,
+	send LogBatchFunc) error {
+	start := time.Now().UTC()
+	if since != "" {
+		parsed, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return fmt.Errorf("invalid start time format: %w", err)
+		}
+		start = parsed
+	}
+	cursor := newLogCursor(start, sentIDs...)
+
+	interval := s.config.Logging.StreamPollInterval
+	if interval <= 0 {
+		interval = defaultStreamPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		logs, err := s.pollLogs(ctx, cursor, query)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if err := send(logs); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollLogs fetches the entries after the cursor, in as many batches as needed to catch up.
+// Entries become searchable some time after their timestamp, so the range ends the configured ingest
+// delay before now. Entries newer than that are fetched by a later poll once they are searchable,
+// instead of being skipped because the cursor already moved past their timestamp.
+func (s *LoggingService) pollLogs(ctx context.Context, cursor *logCursor, query logQueryFunc) ([]logs.LogEntry, error) {
+	delay := s.config.Logging.StreamIngestDelay
+	if delay <= 0 {
+		delay = defaultStreamIngestDelay
+	}
+	end := time.Now().UTC().Add(-delay)
+
+	var entries []logs.LogEntry
+	for cursor.time.Before(end) {
+		// The time range filter is exclusive, so the range starts just before the cursor. Entries
+		// at the cursor that were already sent are skipped by the cursor.
+		startTime := cursor.time.Add(-time.Millisecond).Format(time.RFC3339Nano)
+		endTime := end.Format(time.RFC3339Nano)
+
+		result, err := query(ctx, startTime, endTime)
+		if err != nil {
+			s.logger.Error("Failed to query log stream entries", "error", err)
+			return nil, err
+		}
+
+		batch := cursor.advance(result.Logs)
+		entries = append(entries, batch...)
+
+		// A full batch means there may be more entries. Stop if the batch did not move the
+		// cursor, which happens when more entries than a batch share one timestamp.
+		if len(result.Logs) < streamBatchSize || len(batch) == 0 {
+			break
+		}
+	}
+	return entries, nil
+}
+
+// logCursor is the position of a log stream: the timestamp of the newest entry sent and the IDs
+// of the sent entries with that timestamp, which the next query returns again
+type logCursor struct {
+	time time.Time
+	seen map[string]struct{}
+}
+
+// newLogCursor creates a cursor that accepts entries from the given time on, except for the entries
+// at that time with one of the given IDs, which were already sent
+func newLogCursor(start time.Time, sentIDs ...string) *logCursor {
+	seen := make(map[string]struct{}, len(sentIDs))
+	for _, id := range sentIDs {
+		seen[id] = struct{}{}
+	}
+	return &logCursor{time: start, seen: seen}
+}
+
+// advance returns the entries that were not sent yet and moves the cursor past them.
+// The entries must be sorted by timestamp in ascending order.
+func (c *logCursor) advance(entries []logs.LogEntry) []logs.LogEntry {
+	unsent := make([]logs.LogEntry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Timestamp.Before(c.time) {
+			continue
+		}
+		if entry.Timestamp.Equal(c.time) {
+			if _, sent := c.seen[entry.ID]; sent && entry.ID != "" {
+				continue
+			}
+		} else {
+			c.time = entry.Timestamp
+			c.seen = make(map[string]struct{})
+		}
+		c.seen[entry.ID] = struct{}{}
+		unsent = append(unsent, entry)
+	}
+	return unsent
+}
@@ -0,0 +1,157 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/observer/logs"
+	"github.com/openchoreo/openchoreo/internal/observer/opensearch"
+)
+
+func logHit(id, timestamp, log string) opensearch.Hit {
+	return opensearch.Hit{
+		ID: id,
+		Source: map[string]interface{}{
+			"@timestamp": timestamp,
+			"log":        log,
+		},
+	}
+}
+
+func logEntries(hits ...opensearch.Hit) []logs.LogEntry {
+	entries := make([]logs.LogEntry, 0, len(hits))
+	for _, hit := range hits {
+		entries = append(entries, opensearch.ParseLogEntry(hit))
+	}
+	return entries
+}
+
+func TestLogCursorAdvance(t *testing.T) {
+	start := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	cursor := newLogCursor(start)
+
+	logs := cursor.advance(logEntries(
+		logHit("a", "2025-06-01T09:59:59.999Z", "before the stream started"),
+		logHit("b", "2025-06-01T10:00:00Z", "first"),
+		logHit("c", "2025-06-01T10:00:01Z", "second"),
+		logHit("d", "2025-06-01T10:00:01Z", "third"),
+	))
+	if got := logMessages(logs); !slices.Equal(got, []string{"first", "second", "third"}) {
+		t.Errorf("advance() = %v, want [first second third]", got)
+	}
+
+	// The next query starts at the cursor and returns the entries at the cursor again
+	logs = cursor.advance(logEntries(
+		logHit("c", "2025-06-01T10:00:01Z", "second"),
+		logHit("d", "2025-06-01T10:00:01Z", "third"),
+		logHit("e", "2025-06-01T10:00:01Z", "late"),
+		logHit("f", "2025-06-01T10:00:02Z", "fourth"),
+	))
+	if got := logMessages(logs); !slices.Equal(got, []string{"late", "fourth"}) {
+		t.Errorf("advance() = %v, want [late fourth]", got)
+	}
+}
+
+func TestLogCursorResume(t *testing.T) {
+	// A reconnecting client resumes at the timestamp of the last event it received
+	cursor := newLogCursor(time.Date(2025, 6, 1, 10, 0, 1, 0, time.UTC), "c")
+
+	logs := cursor.advance(logEntries(
+		logHit("b", "2025-06-01T10:00:00Z", "first"),
+		logHit("c", "2025-06-01T10:00:01Z", "second"),
+		logHit("d", "2025-06-01T10:00:01Z", "third"),
+	))
+	if got := logMessages(logs); !slices.Equal(got, []string{"third"}) {
+		t.Errorf("advance() = %v, want [third]", got)
+	}
+}
+
+func TestLoggingService_PollLogs(t *testing.T) {
+	service := newMockLoggingService()
+	response := &opensearch.SearchResponse{}
+	response.Hits.Hits = []opensearch.Hit{
+		logHit("a", time.Now().UTC().Format(time.RFC3339Nano), "INFO started"),
+	}
+	service.logBackend = opensearch.NewLogBackend(&MockOpenSearchClient{searchResponse: response}, service.config.OpenSearch.IndexPrefix)
+
+	cursor := newLogCursor(time.Now().Add(-time.Minute))
+	query := func(ctx context.Context, startTime, endTime string) (*logs.LogResult, error) {
+		return service.logBackend.OrganizationLogs(ctx, logs.QueryParams{StartTime: startTime, EndTime: endTime}, nil)
+	}
+
+	logs, err := service.pollLogs(context.Background(), cursor, query)
+	if err != nil {
+		t.Fatalf("pollLogs() error = %v", err)
+	}
+	if len(logs) != 1 || logs[0].LogLevel != "INFO" {
+		t.Errorf("pollLogs() = %+v, want one INFO entry", logs)
+	}
+
+	// The same entry is not sent twice
+	logs, err = service.pollLogs(context.Background(), cursor, query)
+	if err != nil {
+		t.Fatalf("pollLogs() error = %v", err)
+	}
+	if len(logs) != 0 {
+		t.Errorf("pollLogs() = %+v, want no new entries", logs)
+	}
+}
+
+func TestLoggingService_PollLogsIngestDelay(t *testing.T) {
+	service := newMockLoggingService()
+	service.config.Logging.StreamIngestDelay = time.Minute
+
+	var queried []string
+	query := func(_ context.Context, startTime, endTime string) (*logs.LogResult, error) {
+		queried = append(queried, endTime)
+		return &logs.LogResult{}, nil
+	}
+
+	// The range ends the ingest delay before now
+	before := time.Now().UTC()
+	if _, err := service.pollLogs(context.Background(), newLogCursor(before.Add(-time.Hour)), query); err != nil {
+		t.Fatalf("pollLogs() error = %v", err)
+	}
+	if len(queried) != 1 {
+		t.Fatalf("pollLogs() queried %d times, want 1", len(queried))
+	}
+	end, err := time.Parse(time.RFC3339Nano, queried[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if end.After(before.Add(-time.Minute + time.Second)) {
+		t.Errorf("pollLogs() end time = %v, want at least a minute before %v", end, before)
+	}
+
+	// Nothing is queried while the cursor is within the ingest delay
+	queried = nil
+	if _, err := service.pollLogs(context.Background(), newLogCursor(before.Add(-time.Second)), query); err != nil {
+		t.Fatalf("pollLogs() error = %v", err)
+	}
+	if len(queried) != 0 {
+		t.Errorf("pollLogs() queried %v, want no queries", queried)
+	}
+}
+
+func logMessages(logs []logs.LogEntry) []string {
+	messages := make([]string, 0, len(logs))
+	for _, entry := range logs {
+		messages = append(messages, entry.Log)
+	}
+	return messages
+}
+
+func TestStreamQueryParams(t *testing.T) {
+	params := streamQueryParams(logs.QueryParams{Limit: 500, SortOrder: "desc", CountTotal: true}, "start", "end")
+
+	want := logs.QueryParams{StartTime: "start", EndTime: "end", Limit: streamBatchSize, SortOrder: "asc"}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("streamQueryParams() = %+v, want %+v", params, want)
+	}
+}
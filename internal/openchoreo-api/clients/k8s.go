@@ -7,22 +7,41 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
 
 func NewK8sClient() (client.Client, error) {
+	config, scheme, err := newConfigAndScheme()
+	if err != nil {
+		return nil, err
+	}
+	return client.New(config, client.Options{Scheme: scheme})
+}
+
+// NewK8sCache creates a cache of shared informers of the OpenChoreo resources. Informers are created on first
+// use and only sync once the cache is started.
+func NewK8sCache() (cache.Cache, error) {
+	config, scheme, err := newConfigAndScheme()
+	if err != nil {
+		return nil, err
+	}
+	return cache.New(config, cache.Options{Scheme: scheme})
+}
+
+func newConfigAndScheme() (*rest.Config, *runtime.Scheme, error) {
 	config, err := ctrl.GetConfig()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create kubernetes config: %w", err)
+		return nil, nil, fmt.Errorf("failed to create kubernetes config: %w", err)
 	}
 
 	scheme := runtime.NewScheme()
 	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
-		return nil, fmt.Errorf("failed to add OpenChoreo scheme: %w", err)
+		return nil, nil, fmt.Errorf("failed to add OpenChoreo scheme: %w", err)
 	}
-
-	return client.New(config, client.Options{Scheme: scheme})
+	return config, scheme, nil
 }
@@ -25,6 +25,20 @@ const (
 
 	// EnvJWTDisabled is the flag to disable JWT authentication
 	EnvJWTDisabled = "JWT_DISABLED"
+
+	// EnvAuthzPolicyFile is the path of the authorization policy file (optional).
+	// Every authenticated user can perform every action when it is not set.
+	EnvAuthzPolicyFile = "AUTHZ_POLICY_FILE"
+
+	// EnvAuditSinks is the comma-separated list of sinks audit events are written to: log, file and webhook.
+	// Audit events are logged when it is not set.
+	EnvAuditSinks = "AUDIT_SINKS"
+
+	// EnvAuditFile is the file the file audit sink appends events to
+	EnvAuditFile = "AUDIT_FILE"
+
+	// EnvAuditWebhookURL is the URL the webhook audit sink posts events to
+	EnvAuditWebhookURL = "AUDIT_WEBHOOK_URL"
 )
 
 // Default values for configuration
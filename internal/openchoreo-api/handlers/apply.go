@@ -15,8 +15,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
 )
 
+// defaultResourceNamespace is the namespace of applied resources that do not set one
+const defaultResourceNamespace = "default"
+
 // ApplyResourceResponse represents the response for apply operations
 type ApplyResourceResponse struct {
 	APIVersion string `json:"apiVersion"`
@@ -32,7 +37,9 @@ func (h *Handler) ApplyResource(w http.ResponseWriter, r *http.Request) {
 
 	// Parse the raw resource payload
 	var resourceObj map[string]interface{}
-	if err := json.NewDecoder(r.Body).Decode(&resourceObj); err != nil {
+	err := json.NewDecoder(r.Body).Decode(&resourceObj)
+	setAuditResource(ctx, resourceObj)
+	if err != nil {
 		h.logger.Error("Failed to decode apply request", "error", err)
 		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", services.CodeInvalidInput)
 		return
@@ -57,6 +64,13 @@ func (h *Handler) ApplyResource(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Managing resources directly requires admin in the organization of the resource
+	if err := h.services.Authorizer.Authorize(ctx, rbac.ActionAdmin, rbac.Resource{Org: unstructuredObj.GetNamespace()}); err != nil {
+		h.logger.Warn("Permission denied", "kind", kind, "name", name, "error", err)
+		writeErrorResponse(w, http.StatusForbidden, err.Error(), rbac.CodePermissionDenied)
+		return
+	}
+
 	// Apply the resource to Kubernetes
 	operation, err := h.applyToKubernetes(ctx, unstructuredObj)
 	if err != nil {
@@ -131,7 +145,9 @@ func (h *Handler) DeleteResource(w http.ResponseWriter, r *http.Request) {
 
 	// Parse the JSON payload
 	var resourceObj map[string]interface{}
-	if err := json.NewDecoder(r.Body).Decode(&resourceObj); err != nil {
+	err := json.NewDecoder(r.Body).Decode(&resourceObj)
+	setAuditResource(ctx, resourceObj)
+	if err != nil {
 		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON payload", services.CodeInvalidInput)
 		return
 	}
@@ -155,6 +171,13 @@ func (h *Handler) DeleteResource(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Managing resources directly requires admin in the organization of the resource
+	if err := h.services.Authorizer.Authorize(ctx, rbac.ActionAdmin, rbac.Resource{Org: unstructuredObj.GetNamespace()}); err != nil {
+		h.logger.Warn("Permission denied", "kind", kind, "name", name, "error", err)
+		writeErrorResponse(w, http.StatusForbidden, err.Error(), rbac.CodePermissionDenied)
+		return
+	}
+
 	// Delete the resource from Kubernetes
 	operation, err := h.deleteFromKubernetes(ctx, unstructuredObj)
 	if err != nil {
@@ -201,6 +224,22 @@ func (h *Handler) deleteFromKubernetes(ctx context.Context, obj *unstructured.Un
 	return "deleted", nil
 }
 
+// setAuditResource sets the target of an apply or delete request on its audit event as soon as the body is decoded,
+// so that requests failing validation are recorded in an organization too. The organization is the namespace of
+// the resource, or the namespace resources without one are applied in; the organization of an Organization is
+// itself. Bodies that cannot be decoded are recorded in the default namespace.
+func setAuditResource(ctx context.Context, resourceObj map[string]interface{}) {
+	obj := &unstructured.Unstructured{Object: resourceObj}
+	org := obj.GetNamespace()
+	switch {
+	case obj.GetKind() == "Organization":
+		org = obj.GetName()
+	case org == "":
+		org = defaultResourceNamespace
+	}
+	audit.SetResource(ctx, audit.Resource{Org: org, Kind: obj.GetKind(), Name: obj.GetName()})
+}
+
 // handleResourceNamespace handles namespace logic for both cluster-scoped and namespaced resources
 func (h *Handler) handleResourceNamespace(obj *unstructured.Unstructured, apiVersion, kind string) error {
 	// Parse the GroupVersion from apiVersion
@@ -252,10 +291,9 @@ func (h *Handler) handleNamespacedResource(obj *unstructured.Unstructured, gvk s
 	}
 
 	// Apply default namespace based on resource type and context
-	defaultNamespace := "default"
-	obj.SetNamespace(defaultNamespace)
+	obj.SetNamespace(defaultResourceNamespace)
 	h.logger.Info("Applied default namespace to resource",
-		"kind", gvk.Kind, "name", obj.GetName(), "namespace", defaultNamespace)
+		"kind", gvk.Kind, "name", obj.GetName(), "namespace", defaultResourceNamespace)
 
 	return nil
 }
@@ -0,0 +1,59 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+)
+
+func TestSetAuditResource(t *testing.T) {
+	tests := []struct {
+		name        string
+		resourceObj map[string]interface{}
+		want        audit.Resource
+	}{
+		{
+			name:        "Undecodable body",
+			resourceObj: nil,
+			want:        audit.Resource{Org: defaultResourceNamespace},
+		},
+		{
+			name: "Resource with a namespace",
+			resourceObj: map[string]interface{}{
+				"kind":     "Component",
+				"metadata": map[string]interface{}{"name": "cart", "namespace": "acme"},
+			},
+			want: audit.Resource{Org: "acme", Kind: "Component", Name: "cart"},
+		},
+		{
+			name: "Resource without a namespace",
+			resourceObj: map[string]interface{}{
+				"kind":     "Project",
+				"metadata": map[string]interface{}{"name": "shop"},
+			},
+			want: audit.Resource{Org: defaultResourceNamespace, Kind: "Project", Name: "shop"},
+		},
+		{
+			name: "Organization",
+			resourceObj: map[string]interface{}{
+				"kind":     "Organization",
+				"metadata": map[string]interface{}{"name": "acme", "namespace": "ignored"},
+			},
+			want: audit.Resource{Org: "acme", Kind: "Organization", Name: "acme"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := &audit.Event{}
+			setAuditResource(audit.NewContext(context.Background(), event), tt.resourceObj)
+			if event.Resource != tt.want {
+				t.Errorf("setAuditResource() resource = %+v, want %+v", event.Resource, tt.want)
+			}
+		})
+	}
+}
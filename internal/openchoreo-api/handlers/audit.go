@@ -0,0 +1,103 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+)
+
+// defaultAuditEventLimit is the number of audit events returned when the request does not set a limit
+const defaultAuditEventLimit = 100
+
+// ListAuditEvents returns the audit events of an organization, most recent first
+func (h *Handler) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("ListAuditEvents handler called")
+
+	orgName := r.PathValue("orgName")
+	if orgName == "" {
+		logger.Warn("Organization name is required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name is required", "INVALID_ORG_NAME")
+		return
+	}
+
+	q, err := auditQueryFromRequest(r)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+	q.Org = orgName
+
+	events, err := h.services.Auditor.Query(ctx, q)
+	if err != nil {
+		logger.Error("Failed to query audit events", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	// A full page may be followed by older events, which are requested with the ID of its last event
+	response := &models.ListResponse[audit.Event]{
+		Items:      events,
+		TotalCount: len(events),
+		Page:       1,
+		PageSize:   q.Limit,
+	}
+	if len(events) == q.Limit {
+		response.NextCursor = events[len(events)-1].ID
+	}
+
+	logger.Debug("Listed audit events successfully", "org", orgName, "count", len(events))
+	writeSuccessResponse(w, http.StatusOK, response)
+}
+
+// auditQueryFromRequest reads the filters and the page of an audit event query from the query parameters of a request
+func auditQueryFromRequest(r *http.Request) (*audit.Query, error) {
+	query := r.URL.Query()
+	q := &audit.Query{
+		Project:   query.Get("project"),
+		Component: query.Get("component"),
+		Source:    query.Get("source"),
+		Subject:   query.Get("subject"),
+		Action:    query.Get("action"),
+		Outcome:   audit.Outcome(query.Get("outcome")),
+		Before:    query.Get("cursor"),
+		Limit:     defaultAuditEventLimit,
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 1 || n > models.MaxListLimit {
+			return nil, fmt.Errorf("limit must be between 1 and %d", models.MaxListLimit)
+		}
+		q.Limit = n
+	}
+
+	for name, t := range map[string]*time.Time{"since": &q.Since, "until": &q.Until} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an RFC 3339 timestamp", name)
+		}
+		*t = parsed
+	}
+
+	switch q.Outcome {
+	case "", audit.OutcomeSuccess, audit.OutcomeFailure, audit.OutcomeDenied:
+	default:
+		return nil, fmt.Errorf("outcome must be one of: %s, %s, %s", audit.OutcomeSuccess, audit.OutcomeFailure, audit.OutcomeDenied)
+	}
+	return q, nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/server/middleware"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
+)
+
+// requireAction returns a middleware that rejects requests whose user is not allowed to perform the action
+// on the resource identified by the path parameters of the route.
+// Operations whose environment is not part of the path, like deploying or promoting a component, are
+// authorized by the services instead.
+func (h *Handler) requireAction(action rbac.Action) middleware.Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := h.services.Authorizer.Authorize(r.Context(), action, resourceFromPath(r)); err != nil {
+				logger.GetLogger(r.Context()).Warn("Permission denied", "error", err)
+				writeErrorResponse(w, http.StatusForbidden, err.Error(), rbac.CodePermissionDenied)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// resourceFromPath returns the resource identified by the path parameters of a request
+func resourceFromPath(r *http.Request) rbac.Resource {
+	environment := r.PathValue("environmentName")
+	if environment == "" {
+		environment = r.PathValue("envName")
+	}
+	return rbac.Resource{
+		Org:         r.PathValue("orgName"),
+		Project:     r.PathValue("projectName"),
+		Component:   r.PathValue("componentName"),
+		Environment: environment,
+	}
+}
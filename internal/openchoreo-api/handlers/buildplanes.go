@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
 // There is only one buildplane per org
@@ -48,9 +49,18 @@ func (h *Handler) ListBuildPlanes(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	opts, err := listOptionsFromRequest(r)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
 	// Call service to list build planes
-	buildPlanes, err := h.services.BuildPlaneService.ListBuildPlanes(ctx, orgName)
+	buildPlanes, err := h.services.BuildPlaneService.ListBuildPlanes(ctx, orgName, opts)
 	if err != nil {
+		if writeListOptionsError(w, err) {
+			return
+		}
 		log.Error("Failed to list build planes", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Failed to list build planes", "INTERNAL_ERROR")
 		return
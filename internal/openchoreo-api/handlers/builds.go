@@ -7,6 +7,8 @@ import (
 	"net/http"
 
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
 func (h *Handler) ListBuildTemplates(w http.ResponseWriter, r *http.Request) {
@@ -21,16 +23,25 @@ func (h *Handler) ListBuildTemplates(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	opts, err := listOptionsFromRequest(r)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
 	// Call service to list build templates
-	templates, err := h.services.BuildService.ListBuildTemplates(ctx, orgName)
+	templates, err := h.services.BuildService.ListBuildTemplates(ctx, orgName, opts)
 	if err != nil {
+		if writeListOptionsError(w, err) {
+			return
+		}
 		log.Error("Failed to list build templates", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Failed to list build templates", "INTERNAL_ERROR")
 		return
 	}
 
 	// Success response
-	writeListResponse(w, templates, len(templates), 1, len(templates))
+	writeSuccessResponse(w, http.StatusOK, templates)
 }
 
 func (h *Handler) TriggerBuild(w http.ResponseWriter, r *http.Request) {
@@ -101,14 +112,23 @@ func (h *Handler) ListBuilds(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	opts, err := listOptionsFromRequest(r, models.FilterStatus)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
 	// Call service to list builds
-	builds, err := h.services.BuildService.ListBuilds(ctx, orgName, projectName, componentName)
+	builds, err := h.services.BuildService.ListBuilds(ctx, orgName, projectName, componentName, opts)
 	if err != nil {
+		if writeListOptionsError(w, err) {
+			return
+		}
 		log.Error("Failed to list builds", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Failed to list builds", "INTERNAL_ERROR")
 		return
 	}
 
 	// Success response
-	writeListResponse(w, builds, len(builds), 1, len(builds))
+	writeSuccessResponse(w, http.StatusOK, builds)
 }
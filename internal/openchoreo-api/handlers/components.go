@@ -12,6 +12,8 @@ import (
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
 )
 
 func (h *Handler) CreateComponent(w http.ResponseWriter, r *http.Request) {
@@ -74,9 +76,18 @@ func (h *Handler) ListComponents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	opts, err := listOptionsFromRequest(r, models.FilterType)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
 	// Call service to list components
-	components, err := h.services.ComponentService.ListComponents(ctx, orgName, projectName)
+	components, err := h.services.ComponentService.ListComponents(ctx, orgName, projectName, opts)
 	if err != nil {
+		if writeListOptionsError(w, err) {
+			return
+		}
 		if errors.Is(err, services.ErrProjectNotFound) {
 			logger.Warn("Project not found", "org", orgName, "project", projectName)
 			writeErrorResponse(w, http.StatusNotFound, "Project not found", services.CodeProjectNotFound)
@@ -87,13 +98,9 @@ func (h *Handler) ListComponents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Convert to slice of values for the list response
-	componentValues := make([]*models.ComponentResponse, len(components))
-	copy(componentValues, components)
-
-	// Success response with pagination info (simplified for now)
-	logger.Debug("Listed components successfully", "org", orgName, "project", projectName, "count", len(components))
-	writeListResponse(w, componentValues, len(components), 1, len(components))
+	// Success response
+	logger.Debug("Listed components successfully", "org", orgName, "project", projectName, "count", len(components.Items))
+	writeSuccessResponse(w, http.StatusOK, components)
 }
 
 func (h *Handler) GetComponent(w http.ResponseWriter, r *http.Request) {
@@ -337,6 +344,17 @@ func (h *Handler) PromoteComponent(w http.ResponseWriter, r *http.Request) {
 			writeErrorResponse(w, http.StatusNotFound, "Source release binding not found", services.CodeReleaseBindingNotFound)
 			return
 		}
+		if errors.Is(err, services.ErrPromotionApprovalRequired) {
+			logger.Warn("Promotion requires approval", "source", req.SourceEnvironment, "target", req.TargetEnvironment)
+			writeErrorResponse(w, http.StatusForbidden, "Promotion to the target environment requires approval, create a promotion request instead",
+				services.CodePromotionApprovalRequired)
+			return
+		}
+		if errors.Is(err, rbac.ErrPermissionDenied) {
+			logger.Warn("Permission denied", "error", err)
+			writeErrorResponse(w, http.StatusForbidden, err.Error(), rbac.CodePermissionDenied)
+			return
+		}
 		logger.Error("Failed to promote component", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
@@ -398,6 +416,11 @@ func (h *Handler) UpdateComponentBinding(w http.ResponseWriter, r *http.Request)
 			writeErrorResponse(w, http.StatusNotFound, "Binding not found", services.CodeBindingNotFound)
 			return
 		}
+		if errors.Is(err, rbac.ErrPermissionDenied) {
+			logger.Warn("Permission denied", "error", err)
+			writeErrorResponse(w, http.StatusForbidden, err.Error(), rbac.CodePermissionDenied)
+			return
+		}
 		logger.Error("Failed to update component binding", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
@@ -561,8 +584,17 @@ func (h *Handler) ListComponentReleases(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	releases, err := h.services.ComponentService.ListComponentReleases(ctx, orgName, projectName, componentName)
+	opts, err := listOptionsFromRequest(r)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	releases, err := h.services.ComponentService.ListComponentReleases(ctx, orgName, projectName, componentName, opts)
 	if err != nil {
+		if writeListOptionsError(w, err) {
+			return
+		}
 		if errors.Is(err, services.ErrProjectNotFound) {
 			logger.Warn("Project not found", "org", orgName, "project", projectName)
 			writeErrorResponse(w, http.StatusNotFound, "Project not found", services.CodeProjectNotFound)
@@ -578,8 +610,8 @@ func (h *Handler) ListComponentReleases(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	logger.Debug("Listed component releases successfully", "org", orgName, "project", projectName, "component", componentName, "count", len(releases))
-	writeListResponse(w, releases, len(releases), 1, len(releases))
+	logger.Debug("Listed component releases successfully", "org", orgName, "project", projectName, "component", componentName, "count", len(releases.Items))
+	writeSuccessResponse(w, http.StatusOK, releases)
 }
 
 func (h *Handler) GetComponentRelease(w http.ResponseWriter, r *http.Request) {
@@ -729,7 +761,8 @@ func (h *Handler) PatchReleaseBinding(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	binding, err := h.services.ComponentService.PatchReleaseBinding(ctx, orgName, projectName, componentName, bindingName, &req)
+	boundBy, _ := jwt.GetUserIdentity(ctx)
+	binding, err := h.services.ComponentService.PatchReleaseBinding(ctx, orgName, projectName, componentName, bindingName, &req, boundBy)
 	if err != nil {
 		if errors.Is(err, services.ErrProjectNotFound) {
 			logger.Warn("Project not found", "org", orgName, "project", projectName)
@@ -746,6 +779,22 @@ func (h *Handler) PatchReleaseBinding(w http.ResponseWriter, r *http.Request) {
 			writeErrorResponse(w, http.StatusNotFound, "Release binding not found", services.CodeReleaseBindingNotFound)
 			return
 		}
+		if errors.Is(err, services.ErrComponentReleaseNotFound) {
+			logger.Warn("Component release not found", "org", orgName, "binding", bindingName, "release", req.ReleaseName)
+			writeErrorResponse(w, http.StatusNotFound, "Component release not found", services.CodeComponentReleaseNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrPromotionApprovalRequired) {
+			logger.Warn("Binding a release requires approval", "org", orgName, "component", componentName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusForbidden, "Releases can only be bound to the environment through an approved promotion request",
+				services.CodePromotionApprovalRequired)
+			return
+		}
+		if errors.Is(err, rbac.ErrPermissionDenied) {
+			logger.Warn("Permission denied", "error", err)
+			writeErrorResponse(w, http.StatusForbidden, err.Error(), rbac.CodePermissionDenied)
+			return
+		}
 		logger.Error("Failed to patch release binding", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
@@ -755,6 +804,76 @@ func (h *Handler) PatchReleaseBinding(w http.ResponseWriter, r *http.Request) {
 	writeSuccessResponse(w, http.StatusOK, binding)
 }
 
+func (h *Handler) DryRunReleaseBinding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("DryRunReleaseBinding handler called")
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	bindingName := r.PathValue("bindingName")
+	if orgName == "" || projectName == "" || componentName == "" || bindingName == "" {
+		logger.Warn("Organization name, project name, component name, and binding name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, component name, and binding name are required", "INVALID_PARAMS")
+		return
+	}
+
+	defer r.Body.Close()
+	var req models.PatchReleaseBindingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+
+	result, err := h.services.ComponentService.DryRunReleaseBinding(ctx, orgName, projectName, componentName, bindingName, &req)
+	if err != nil {
+		if errors.Is(err, services.ErrProjectNotFound) {
+			logger.Warn("Project not found", "org", orgName, "project", projectName)
+			writeErrorResponse(w, http.StatusNotFound, "Project not found", services.CodeProjectNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrComponentNotFound) {
+			logger.Warn("Component not found", "org", orgName, "project", projectName, "component", componentName)
+			writeErrorResponse(w, http.StatusNotFound, "Component not found", services.CodeComponentNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrReleaseBindingNotFound) {
+			logger.Warn("Release binding not found", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusNotFound, "Release binding not found", services.CodeReleaseBindingNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrComponentReleaseNotFound) {
+			logger.Warn("Component release not found", "org", orgName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusNotFound, "Component release not found", services.CodeComponentReleaseNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrEnvironmentNotFound) {
+			logger.Warn("Environment not found", "org", orgName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusNotFound, "Environment not found", services.CodeEnvironmentNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrDataPlaneNotFound) {
+			logger.Warn("DataPlane not found", "org", orgName, "binding", bindingName)
+			writeErrorResponse(w, http.StatusNotFound, "DataPlane not found", services.CodeDataPlaneNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrReleaseRenderFailed) {
+			logger.Warn("Failed to render release binding", "binding", bindingName, "error", err)
+			writeErrorResponse(w, http.StatusUnprocessableEntity, err.Error(), services.CodeReleaseRenderFailed)
+			return
+		}
+		logger.Error("Failed to dry-run release binding", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+
+	logger.Debug("Dry-run of release binding completed", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
+	writeSuccessResponse(w, http.StatusOK, result)
+}
+
 func (h *Handler) ListReleaseBindings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger := logger.GetLogger(ctx)
@@ -771,8 +890,17 @@ func (h *Handler) ListReleaseBindings(w http.ResponseWriter, r *http.Request) {
 
 	environments := r.URL.Query()["environment"]
 
-	bindings, err := h.services.ComponentService.ListReleaseBindings(ctx, orgName, projectName, componentName, environments)
+	opts, err := listOptionsFromRequest(r, models.FilterStatus)
 	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	bindings, err := h.services.ComponentService.ListReleaseBindings(ctx, orgName, projectName, componentName, environments, opts)
+	if err != nil {
+		if writeListOptionsError(w, err) {
+			return
+		}
 		if errors.Is(err, services.ErrProjectNotFound) {
 			logger.Warn("Project not found", "org", orgName, "project", projectName)
 			writeErrorResponse(w, http.StatusNotFound, "Project not found", services.CodeProjectNotFound)
@@ -788,8 +916,8 @@ func (h *Handler) ListReleaseBindings(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	logger.Debug("Listed release bindings successfully", "org", orgName, "project", projectName, "component", componentName, "count", len(bindings))
-	writeListResponse(w, bindings, len(bindings), 1, len(bindings))
+	logger.Debug("Listed release bindings successfully", "org", orgName, "project", projectName, "component", componentName, "count", len(bindings.Items))
+	writeSuccessResponse(w, http.StatusOK, bindings)
 }
 
 func (h *Handler) DeployRelease(w http.ResponseWriter, r *http.Request) {
@@ -838,6 +966,17 @@ func (h *Handler) DeployRelease(w http.ResponseWriter, r *http.Request) {
 			writeErrorResponse(w, http.StatusNotFound, "Component release not found", services.CodeComponentReleaseNotFound)
 			return
 		}
+		if errors.Is(err, services.ErrPromotionApprovalRequired) {
+			logger.Warn("Binding a release requires approval", "org", orgName, "component", componentName, "release", req.ReleaseName)
+			writeErrorResponse(w, http.StatusForbidden, "Releases can only be bound to the environment through an approved promotion request",
+				services.CodePromotionApprovalRequired)
+			return
+		}
+		if errors.Is(err, rbac.ErrPermissionDenied) {
+			logger.Warn("Permission denied", "error", err)
+			writeErrorResponse(w, http.StatusForbidden, err.Error(), rbac.CodePermissionDenied)
+			return
+		}
 		logger.Error("Failed to deploy release", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
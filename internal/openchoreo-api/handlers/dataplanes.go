@@ -21,14 +21,23 @@ func (h *Handler) ListDataPlanes(w http.ResponseWriter, r *http.Request) {
 		writeErrorResponse(w, http.StatusBadRequest, "Organization name is required", services.CodeInvalidInput)
 		return
 	}
-	dataplanes, err := h.services.DataPlaneService.ListDataPlanes(ctx, orgName)
+	opts, err := listOptionsFromRequest(r)
 	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	dataplanes, err := h.services.DataPlaneService.ListDataPlanes(ctx, orgName, opts)
+	if err != nil {
+		if writeListOptionsError(w, err) {
+			return
+		}
 		h.logger.Error("Failed to list dataplanes", "error", err, "org", orgName)
 		writeErrorResponse(w, http.StatusInternalServerError, "Failed to list dataplanes", services.CodeInternalError)
 		return
 	}
 
-	writeListResponse(w, dataplanes, len(dataplanes), 1, len(dataplanes))
+	writeSuccessResponse(w, http.StatusOK, dataplanes)
 }
 
 // GetDataPlane handles GET /api/v1/orgs/{orgName}/dataplanes/{dpName}
@@ -22,14 +22,23 @@ func (h *Handler) ListEnvironments(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	environments, err := h.services.EnvironmentService.ListEnvironments(ctx, orgName)
+	opts, err := listOptionsFromRequest(r)
 	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	environments, err := h.services.EnvironmentService.ListEnvironments(ctx, orgName, opts)
+	if err != nil {
+		if writeListOptionsError(w, err) {
+			return
+		}
 		h.logger.Error("Failed to list environments", "error", err, "org", orgName)
 		writeErrorResponse(w, http.StatusInternalServerError, "Failed to list environments", services.CodeInternalError)
 		return
 	}
 
-	writeListResponse(w, environments, len(environments), 1, len(environments))
+	writeSuccessResponse(w, http.StatusOK, environments)
 }
 
 // GetEnvironment handles GET /api/v1/orgs/{orgName}/environments/{envName}
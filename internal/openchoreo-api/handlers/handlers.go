@@ -14,7 +14,9 @@ import (
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	"github.com/openchoreo/openchoreo/internal/server/middleware"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
 	mcpmiddleware "github.com/openchoreo/openchoreo/internal/server/middleware/mcp"
 	"github.com/openchoreo/openchoreo/pkg/mcp"
 	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
@@ -67,72 +69,91 @@ func (h *Handler) Routes() http.Handler {
 	// MCP middleware
 	mcpMiddleware := h.initMCPMiddleware()
 
-	// MCP endpoint with chained middleware (logger -> auth401 -> jwt -> handler)
-	mcpRoutes := routes.Group(mcpMiddleware, jwtAuth)
+	// MCP endpoint with chained middleware (logger -> auth401 -> audit -> jwt -> handler). The tool calls are
+	// audited by the tools, and the requests rejected by the JWT authentication by the audit middleware.
+	mcpRoutes := routes.Group(mcpMiddleware, h.services.Auditor.UnauthenticatedMiddleware(audit.SourceMCP), jwtAuth)
 	mcpRoutes.Handle("/mcp", mcp.NewHTTPServer(toolsets))
 
-	// Create protected route group with JWT auth
-	api := routes.With(jwtAuth)
+	// Create protected route group with JWT auth. Mutating requests are audited, including the ones rejected by
+	// the JWT authentication and the ones denied by the authorization of the route groups below.
+	api := routes.With(h.services.Auditor.Middleware, jwtAuth, audit.Authenticated)
 
-	// Organization operations
+	// Route groups authorizing an action on the resource in the path. Routes registered directly on api
+	// are authorized by the handlers or the services, as the resource is not known from the path alone.
+	viewer := api.Group(h.requireAction(rbac.ActionView))
+	editor := api.Group(h.requireAction(rbac.ActionEdit))
+	builder := api.Group(h.requireAction(rbac.ActionBuild))
+	promoter := api.Group(h.requireAction(rbac.ActionPromote))
+	admin := api.Group(h.requireAction(rbac.ActionAdmin))
+
+	// Organization operations. Every authenticated user can list the organizations.
 	api.HandleFunc("GET "+v1+"/orgs", h.ListOrganizations)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}", h.GetOrganization)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}", h.GetOrganization)
+
+	// Resource change events. The project and component filters are authorized by the handler.
+	api.HandleFunc("GET "+v1+"/orgs/{orgName}/watch/{kind}", h.WatchResources)
+
+	// Audit log of the mutating operations of the organization
+	admin.HandleFunc("GET "+v1+"/orgs/{orgName}/audit-events", h.ListAuditEvents)
 
 	// Apply/Delete operations (kubectl-like)
 	api.HandleFunc("POST "+v1+"/apply", h.ApplyResource)
 	api.HandleFunc("DELETE "+v1+"/delete", h.DeleteResource)
 
 	// DataPlane management
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/dataplanes", h.ListDataPlanes)
-	api.HandleFunc("POST "+v1+"/orgs/{orgName}/dataplanes", h.CreateDataPlane)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/dataplanes/{dpName}", h.GetDataPlane)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/dataplanes", h.ListDataPlanes)
+	admin.HandleFunc("POST "+v1+"/orgs/{orgName}/dataplanes", h.CreateDataPlane)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/dataplanes/{dpName}", h.GetDataPlane)
 
 	// Environment management
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/environments", h.ListEnvironments)
-	api.HandleFunc("POST "+v1+"/orgs/{orgName}/environments", h.CreateEnvironment)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/environments/{envName}", h.GetEnvironment)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/environments", h.ListEnvironments)
+	admin.HandleFunc("POST "+v1+"/orgs/{orgName}/environments", h.CreateEnvironment)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/environments/{envName}", h.GetEnvironment)
 
 	// BuildPlane & Build Templates
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/buildplanes", h.ListBuildPlanes)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/build-templates", h.ListBuildTemplates)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/buildplanes", h.ListBuildPlanes)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/build-templates", h.ListBuildTemplates)
 
 	// ComponentType endpoints
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/component-types", h.ListComponentTypes)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/component-types/{ctName}/schema", h.GetComponentTypeSchema)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/component-types", h.ListComponentTypes)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/component-types/{ctName}/schema", h.GetComponentTypeSchema)
 
 	// Workflow endpoints
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/workflows", h.ListWorkflows)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/workflows/{workflowName}/schema", h.GetWorkflowSchema)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/workflows", h.ListWorkflows)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/workflows/{workflowName}/schema", h.GetWorkflowSchema)
 
 	// Trait endpoints
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/traits", h.ListTraits)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/traits/{traitName}/schema", h.GetTraitSchema)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/traits", h.ListTraits)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/traits/{traitName}/schema", h.GetTraitSchema)
 
 	// Project management
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects", h.ListProjects)
-	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects", h.CreateProject)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}", h.GetProject)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/deployment-pipeline", h.GetProjectDeploymentPipeline)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects", h.ListProjects)
+	editor.HandleFunc("POST "+v1+"/orgs/{orgName}/projects", h.CreateProject)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}", h.GetProject)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/deployment-pipeline", h.GetProjectDeploymentPipeline)
 
 	// Component management
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components", h.ListComponents)
-	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components", h.CreateComponent)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}", h.GetComponent)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/schema", h.GetComponentSchema)
-	api.HandleFunc("PATCH "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/workflow-schema", h.UpdateComponentWorkflowSchema)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/environments/{environmentName}/release", h.GetEnvironmentRelease)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components", h.ListComponents)
+	editor.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components", h.CreateComponent)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}", h.GetComponent)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/schema", h.GetComponentSchema)
+	editor.HandleFunc("PATCH "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/workflow-schema", h.UpdateComponentWorkflowSchema)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/environments/{environmentName}/release", h.GetEnvironmentRelease)
 
 	// Component bindings
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/bindings", h.GetComponentBinding)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/bindings", h.GetComponentBinding)
 	api.HandleFunc("PATCH "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/bindings/{bindingName}", h.UpdateComponentBinding)
 
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/component-releases", h.ListComponentReleases)
-	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/component-releases", h.CreateComponentRelease)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/component-releases/{releaseName}", h.GetComponentRelease)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/component-releases/{releaseName}/schema", h.GetComponentReleaseSchema)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/component-releases", h.ListComponentReleases)
+	editor.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/component-releases", h.CreateComponentRelease)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/component-releases/{releaseName}", h.GetComponentRelease)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/component-releases/{releaseName}/schema", h.GetComponentReleaseSchema)
 
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/release-bindings", h.ListReleaseBindings)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/release-bindings", h.ListReleaseBindings)
 	api.HandleFunc("PATCH "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/release-bindings/{bindingName}", h.PatchReleaseBinding)
+	viewer.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/release-bindings/{bindingName}/dry-run", h.DryRunReleaseBinding)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/release-bindings/{bindingName}/history", h.GetReleaseBindingHistory)
+	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/release-bindings/{bindingName}/rollback", h.RollbackReleaseBinding)
 
 	// Deployment endpoint
 	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/deploy", h.DeployRelease)
@@ -140,17 +161,24 @@ func (h *Handler) Routes() http.Handler {
 	// Promotion endpoint
 	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/promote", h.PromoteComponent)
 
+	// Promotion requests for environments that require approval
+	promoter.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests", h.CreatePromotionRequest)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests", h.ListPromotionRequests)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests/{requestName}", h.GetPromotionRequest)
+	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests/{requestName}/approve", h.ApprovePromotionRequest)
+	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/promotion-requests/{requestName}/reject", h.RejectPromotionRequest)
+
 	// Build operations
-	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/builds", h.TriggerBuild)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/builds", h.ListBuilds)
+	builder.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/builds", h.TriggerBuild)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/builds", h.ListBuilds)
 
 	// Observer URL endpoints
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/environments/{environmentName}/observer-url", h.GetComponentObserverURL)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/observer-url", h.GetBuildObserverURL)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/environments/{environmentName}/observer-url", h.GetComponentObserverURL)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/observer-url", h.GetBuildObserverURL)
 
 	// Workload management
-	api.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/workloads", h.CreateWorkload)
-	api.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/workloads", h.GetWorkloads)
+	editor.HandleFunc("POST "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/workloads", h.CreateWorkload)
+	viewer.HandleFunc("GET "+v1+"/orgs/{orgName}/projects/{projectName}/components/{componentName}/workloads", h.GetWorkloads)
 
 	return mux
 }
@@ -228,7 +256,7 @@ func getMCPServerToolsets(h *Handler) *tools.Toolsets {
 	handler := &mcphandlers.MCPHandler{Services: h.services}
 
 	// Create toolsets struct and enable based on configuration
-	toolsets := &tools.Toolsets{}
+	toolsets := &tools.Toolsets{Authorizer: h.services.Authorizer, Auditor: h.services.Auditor}
 
 	for toolsetType := range toolsetsMap {
 		switch toolsetType {
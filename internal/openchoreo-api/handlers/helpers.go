@@ -5,9 +5,12 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
 // writeSuccessResponse writes a successful API response
@@ -36,3 +39,50 @@ func writeListResponse[T any](w http.ResponseWriter, items []T, total, page, pag
 	response := models.ListSuccessResponse(items, total, page, pageSize)
 	_ = json.NewEncoder(w).Encode(response) // Ignore encoding errors for response
 }
+
+// listOptionsFromRequest reads the pagination, filtering and sorting options of a list request from its query parameters.
+// filters names the resource specific filters supported by the endpoint, which are read from the query parameters of the same name.
+func listOptionsFromRequest(r *http.Request, filters ...string) (*models.ListOptions, error) {
+	query := r.URL.Query()
+	opts := &models.ListOptions{
+		Cursor:        query.Get("cursor"),
+		LabelSelector: query.Get("labelSelector"),
+		SortBy:        query.Get("sortBy"),
+		Order:         query.Get("order"),
+	}
+	if limit := query.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil {
+			return nil, errors.New("limit must be a number")
+		}
+		opts.Limit = n
+	}
+	for _, name := range filters {
+		if value := query.Get(name); value != "" {
+			if opts.Filters == nil {
+				opts.Filters = make(map[string]string)
+			}
+			opts.Filters[name] = value
+		}
+	}
+
+	opts.Sanitize()
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// writeListOptionsError writes a bad request response if err is caused by the list options of the request,
+// and reports whether it did
+func writeListOptionsError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, services.ErrInvalidCursor):
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidCursor)
+	case errors.Is(err, services.ErrInvalidListOptions):
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+	default:
+		return false
+	}
+	return true
+}
@@ -14,14 +14,23 @@ import (
 func (h *Handler) ListOrganizations(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	organizations, err := h.services.OrganizationService.ListOrganizations(ctx)
+	opts, err := listOptionsFromRequest(r)
 	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	organizations, err := h.services.OrganizationService.ListOrganizations(ctx, opts)
+	if err != nil {
+		if writeListOptionsError(w, err) {
+			return
+		}
 		h.logger.Error("Failed to list organizations", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Failed to list organizations", services.CodeInternalError)
 		return
 	}
 
-	writeListResponse(w, organizations, len(organizations), 1, len(organizations))
+	writeSuccessResponse(w, http.StatusOK, organizations)
 }
 
 // GetOrganization handles GET /api/v1/orgs/{orgName}
@@ -66,21 +66,26 @@ func (h *Handler) ListProjects(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	opts, err := listOptionsFromRequest(r)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
 	// Call service to list projects
-	projects, err := h.services.ProjectService.ListProjects(ctx, orgName)
+	projects, err := h.services.ProjectService.ListProjects(ctx, orgName, opts)
 	if err != nil {
+		if writeListOptionsError(w, err) {
+			return
+		}
 		logger.Error("Failed to list projects", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
 	}
 
-	// Convert to slice of values for the list response
-	projectValues := make([]*models.ProjectResponse, len(projects))
-	copy(projectValues, projects)
-
-	// Success response with pagination info (simplified for now)
-	logger.Debug("Listed projects successfully", "org", orgName, "count", len(projects))
-	writeListResponse(w, projectValues, len(projects), 1, len(projects))
+	// Success response
+	logger.Debug("Listed projects successfully", "org", orgName, "count", len(projects.Items))
+	writeSuccessResponse(w, http.StatusOK, projects)
 }
 
 func (h *Handler) GetProject(w http.ResponseWriter, r *http.Request) {
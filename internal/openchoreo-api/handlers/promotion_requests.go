@@ -0,0 +1,216 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
+)
+
+func (h *Handler) CreatePromotionRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("CreatePromotionRequest handler called")
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	if orgName == "" || projectName == "" || componentName == "" {
+		logger.Warn("Organization name, project name, and component name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, and component name are required", "INVALID_PARAMS")
+		return
+	}
+
+	// Parse request body
+	var req models.CreatePromotionRequestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	defer r.Body.Close()
+
+	// Sanitize input
+	req.Sanitize()
+	if req.SourceEnvironment == "" || req.TargetEnvironment == "" {
+		logger.Warn("Source and target environments are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Source and target environments are required", services.CodeInvalidInput)
+		return
+	}
+
+	requestedBy, _ := jwt.GetUserIdentity(ctx)
+	promotionRequest, err := h.services.ComponentService.RequestPromotion(ctx, &services.RequestPromotionPayload{
+		CreatePromotionRequestRequest: req,
+		ComponentName:                 componentName,
+		ProjectName:                   projectName,
+		OrgName:                       orgName,
+		RequestedBy:                   requestedBy,
+	})
+	if err != nil {
+		writePromotionRequestError(w, logger, err, "Failed to request promotion")
+		return
+	}
+
+	// Success response
+	logger.Debug("Promotion requested successfully", "org", orgName, "project", projectName, "component", componentName,
+		"name", promotionRequest.Name, "target", req.TargetEnvironment)
+	writeSuccessResponse(w, http.StatusCreated, promotionRequest)
+}
+
+func (h *Handler) ListPromotionRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("ListPromotionRequests handler called")
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	if orgName == "" || projectName == "" || componentName == "" {
+		logger.Warn("Organization name, project name, and component name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, and component name are required", "INVALID_PARAMS")
+		return
+	}
+
+	opts, err := listOptionsFromRequest(r, models.FilterStatus, models.FilterEnvironment)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	promotionRequests, err := h.services.ComponentService.ListPromotionRequests(ctx, orgName, projectName, componentName, opts)
+	if err != nil {
+		if writeListOptionsError(w, err) {
+			return
+		}
+		writePromotionRequestError(w, logger, err, "Failed to list promotion requests")
+		return
+	}
+
+	// Success response
+	logger.Debug("Listed promotion requests successfully", "org", orgName, "project", projectName, "component", componentName,
+		"count", len(promotionRequests.Items))
+	writeSuccessResponse(w, http.StatusOK, promotionRequests)
+}
+
+func (h *Handler) GetPromotionRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetPromotionRequest handler called")
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	requestName := r.PathValue("requestName")
+	if orgName == "" || projectName == "" || componentName == "" || requestName == "" {
+		logger.Warn("Organization name, project name, component name, and request name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, component name, and request name are required", "INVALID_PARAMS")
+		return
+	}
+
+	promotionRequest, err := h.services.ComponentService.GetPromotionRequest(ctx, orgName, projectName, componentName, requestName)
+	if err != nil {
+		writePromotionRequestError(w, logger, err, "Failed to get promotion request")
+		return
+	}
+
+	writeSuccessResponse(w, http.StatusOK, promotionRequest)
+}
+
+func (h *Handler) ApprovePromotionRequest(w http.ResponseWriter, r *http.Request) {
+	h.reviewPromotionRequest(w, r, true)
+}
+
+func (h *Handler) RejectPromotionRequest(w http.ResponseWriter, r *http.Request) {
+	h.reviewPromotionRequest(w, r, false)
+}
+
+// reviewPromotionRequest approves or rejects a promotion request on behalf of the authenticated user
+func (h *Handler) reviewPromotionRequest(w http.ResponseWriter, r *http.Request, approve bool) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("Review promotion request handler called", "approve", approve)
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	requestName := r.PathValue("requestName")
+	if orgName == "" || projectName == "" || componentName == "" || requestName == "" {
+		logger.Warn("Organization name, project name, component name, and request name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, component name, and request name are required", "INVALID_PARAMS")
+		return
+	}
+
+	// The review comment is optional, so is the body
+	var req models.ReviewPromotionRequestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	defer r.Body.Close()
+	req.Sanitize()
+
+	reviewer, _ := jwt.GetUserIdentity(ctx)
+
+	var (
+		promotionRequest *models.PromotionRequestResponse
+		err              error
+	)
+	if approve {
+		promotionRequest, err = h.services.ComponentService.ApprovePromotionRequest(ctx, orgName, projectName, componentName, requestName, reviewer, req.Comment)
+	} else {
+		promotionRequest, err = h.services.ComponentService.RejectPromotionRequest(ctx, orgName, projectName, componentName, requestName, reviewer, req.Comment)
+	}
+	if err != nil {
+		writePromotionRequestError(w, logger, err, "Failed to review promotion request")
+		return
+	}
+
+	// Success response
+	logger.Info("Promotion request reviewed", "org", orgName, "project", projectName, "component", componentName,
+		"name", requestName, "phase", promotionRequest.Phase, "reviewer", reviewer)
+	writeSuccessResponse(w, http.StatusOK, promotionRequest)
+}
+
+// writePromotionRequestError maps promotion request service errors to API responses
+func writePromotionRequestError(w http.ResponseWriter, logger *slog.Logger, err error, message string) {
+	switch {
+	case errors.Is(err, services.ErrProjectNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Project not found", services.CodeProjectNotFound)
+	case errors.Is(err, services.ErrDeploymentPipelineNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Deployment pipeline not found", services.CodeDeploymentPipelineNotFound)
+	case errors.Is(err, services.ErrInvalidPromotionPath):
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid promotion path", services.CodeInvalidPromotionPath)
+	case errors.Is(err, services.ErrReleaseBindingNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Source release binding not found", services.CodeReleaseBindingNotFound)
+	case errors.Is(err, services.ErrPromotionRequestNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Promotion request not found", services.CodePromotionRequestNotFound)
+	case errors.Is(err, services.ErrPromotionRequestExists):
+		writeErrorResponse(w, http.StatusConflict, "A pending promotion request already exists for the target environment", services.CodePromotionRequestExists)
+	case errors.Is(err, services.ErrPromotionRequestReviewed):
+		writeErrorResponse(w, http.StatusConflict, "Promotion request has already been reviewed", services.CodePromotionRequestReviewed)
+	case errors.Is(err, services.ErrPromotionSelfApproval):
+		writeErrorResponse(w, http.StatusForbidden, "Promotion request cannot be approved by its requester", services.CodePromotionSelfApproval)
+	case errors.Is(err, rbac.ErrPermissionDenied):
+		writeErrorResponse(w, http.StatusForbidden, err.Error(), rbac.CodePermissionDenied)
+	default:
+		logger.Error(message, "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+	logger.Warn(message, "error", err)
+}
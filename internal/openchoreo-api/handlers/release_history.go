@@ -0,0 +1,114 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
+)
+
+func (h *Handler) GetReleaseBindingHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("GetReleaseBindingHistory handler called")
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	bindingName := r.PathValue("bindingName")
+	if orgName == "" || projectName == "" || componentName == "" || bindingName == "" {
+		logger.Warn("Organization name, project name, component name, and binding name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, component name, and binding name are required", "INVALID_PARAMS")
+		return
+	}
+
+	history, err := h.services.ComponentService.GetReleaseBindingHistory(ctx, orgName, projectName, componentName, bindingName)
+	if err != nil {
+		writeReleaseHistoryError(w, logger, err, "Failed to get release binding history")
+		return
+	}
+
+	// Success response
+	logger.Debug("Retrieved release binding history successfully", "org", orgName, "project", projectName, "component", componentName,
+		"binding", bindingName, "count", len(history))
+	writeListResponse(w, history, len(history), 1, len(history))
+}
+
+func (h *Handler) RollbackReleaseBinding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("RollbackReleaseBinding handler called")
+
+	// Extract path parameters
+	orgName := r.PathValue("orgName")
+	projectName := r.PathValue("projectName")
+	componentName := r.PathValue("componentName")
+	bindingName := r.PathValue("bindingName")
+	if orgName == "" || projectName == "" || componentName == "" || bindingName == "" {
+		logger.Warn("Organization name, project name, component name, and binding name are required")
+		writeErrorResponse(w, http.StatusBadRequest, "Organization name, project name, component name, and binding name are required", "INVALID_PARAMS")
+		return
+	}
+
+	// Parse request body, an empty body rolls back to the previous release
+	defer r.Body.Close()
+	var req models.RollbackReleaseBindingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		logger.Warn("Invalid JSON body", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid request body", "INVALID_JSON")
+		return
+	}
+	req.Sanitize()
+
+	requestedBy, _ := jwt.GetUserIdentity(ctx)
+	binding, err := h.services.ComponentService.RollbackReleaseBinding(ctx, orgName, projectName, componentName, bindingName,
+		req.ReleaseName, requestedBy)
+	if err != nil {
+		writeReleaseHistoryError(w, logger, err, "Failed to roll back release binding")
+		return
+	}
+
+	// Success response
+	logger.Debug("Rolled back release binding successfully", "org", orgName, "project", projectName, "component", componentName,
+		"binding", bindingName, "release", binding.ReleaseName)
+	writeSuccessResponse(w, http.StatusOK, binding)
+}
+
+// writeReleaseHistoryError maps release history and rollback errors to API responses
+func writeReleaseHistoryError(w http.ResponseWriter, logger *slog.Logger, err error, message string) {
+	switch {
+	case errors.Is(err, services.ErrProjectNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Project not found", services.CodeProjectNotFound)
+	case errors.Is(err, services.ErrComponentNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Component not found", services.CodeComponentNotFound)
+	case errors.Is(err, services.ErrReleaseBindingNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Release binding not found", services.CodeReleaseBindingNotFound)
+	case errors.Is(err, services.ErrComponentReleaseNotFound):
+		writeErrorResponse(w, http.StatusNotFound, "Component release not found", services.CodeComponentReleaseNotFound)
+	case errors.Is(err, services.ErrReleaseNotInHistory):
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeReleaseNotInHistory)
+	case errors.Is(err, services.ErrReleaseAlreadyBound):
+		writeErrorResponse(w, http.StatusConflict, err.Error(), services.CodeReleaseAlreadyBound)
+	case errors.Is(err, services.ErrPromotionApprovalRequired):
+		writeErrorResponse(w, http.StatusForbidden, "Releases can only be bound to the environment through an approved promotion request",
+			services.CodePromotionApprovalRequired)
+	case errors.Is(err, rbac.ErrPermissionDenied):
+		writeErrorResponse(w, http.StatusForbidden, err.Error(), rbac.CodePermissionDenied)
+	default:
+		logger.Error(message, "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+	logger.Warn(message, "error", err)
+}
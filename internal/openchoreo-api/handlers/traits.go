@@ -8,7 +8,6 @@ import (
 	"net/http"
 
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
-	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
@@ -25,21 +24,26 @@ func (h *Handler) ListTraits(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	opts, err := listOptionsFromRequest(r)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
 	// Call service to list Traits
-	traits, err := h.services.TraitService.ListTraits(ctx, orgName)
+	traits, err := h.services.TraitService.ListTraits(ctx, orgName, opts)
 	if err != nil {
+		if writeListOptionsError(w, err) {
+			return
+		}
 		logger.Error("Failed to list Traits", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
 	}
 
-	// Convert to slice of values for the list response
-	traitValues := make([]*models.TraitResponse, len(traits))
-	copy(traitValues, traits)
-
-	// Success response with pagination info (simplified for now)
-	logger.Debug("Listed Traits successfully", "org", orgName, "count", len(traits))
-	writeListResponse(w, traitValues, len(traits), 1, len(traits))
+	// Success response
+	logger.Debug("Listed Traits successfully", "org", orgName, "count", len(traits.Items))
+	writeSuccessResponse(w, http.StatusOK, traits)
 }
 
 func (h *Handler) GetTraitSchema(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,89 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
+	"github.com/openchoreo/openchoreo/internal/server/sse"
+)
+
+// watchErrorEvent is the name of the event sent when a watch fails after the stream started
+const watchErrorEvent = "error"
+
+// WatchResources streams the changes of the resources of a kind in an organization as Server-Sent Events.
+// Each event is named after its type in lower case, such as "added", and its ID is the resource version a
+// reconnecting client sends back in the Last-Event-ID header to resume the watch.
+func (h *Handler) WatchResources(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.GetLogger(ctx)
+	logger.Debug("WatchResources handler called")
+
+	orgName := r.PathValue("orgName")
+	query := r.URL.Query()
+	opts := &models.WatchOptions{
+		Kind:            r.PathValue("kind"),
+		Project:         query.Get("project"),
+		Component:       query.Get("component"),
+		ResourceVersion: query.Get("resourceVersion"),
+	}
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		opts.ResourceVersion = lastEventID
+	}
+	opts.Sanitize()
+	if err := opts.Validate(); err != nil {
+		logger.Warn("Invalid watch options", "error", err)
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	resource := rbac.Resource{Org: orgName, Project: opts.Project, Component: opts.Component}
+	if err := h.services.Authorizer.Authorize(ctx, rbac.ActionView, resource); err != nil {
+		logger.Warn("Permission denied", "error", err)
+		writeErrorResponse(w, http.StatusForbidden, err.Error(), rbac.CodePermissionDenied)
+		return
+	}
+
+	writer, err := sse.NewWriter(w)
+	if err != nil {
+		logger.Error("Failed to start watch", "error", err)
+		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
+		return
+	}
+	if err := writer.Start(); err != nil {
+		logger.Error("Failed to start watch", "error", err)
+		return
+	}
+
+	err = h.services.WatchService.Watch(ctx, orgName, opts, func(event *models.WatchEvent) error {
+		// The watch service only reports a watch without events once it was idle for a while
+		if event == nil {
+			return writer.KeepAlive(0)
+		}
+		if err := writer.Event(event.ResourceVersion, strings.ToLower(event.Type), event); err != nil {
+			return err
+		}
+		return writer.Flush()
+	})
+	if err == nil || ctx.Err() != nil {
+		return
+	}
+
+	response := models.ErrorResponse("Internal server error", services.CodeInternalError)
+	if errors.Is(err, services.ErrWatchExpired) {
+		logger.Warn("Watch expired", "org", orgName, "kind", opts.Kind, "resourceVersion", opts.ResourceVersion)
+		response = models.ErrorResponse(err.Error(), services.CodeWatchExpired)
+	} else {
+		logger.Error("Watch failed", "org", orgName, "kind", opts.Kind, "error", err)
+	}
+	if err := writer.Event("", watchErrorEvent, response); err == nil {
+		_ = writer.Flush()
+	}
+}
@@ -23,15 +23,24 @@ func (h *Handler) ListWorkflows(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	wfs, err := h.services.WorkflowService.ListWorkflows(ctx, orgName)
+	opts, err := listOptionsFromRequest(r)
 	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error(), services.CodeInvalidInput)
+		return
+	}
+
+	wfs, err := h.services.WorkflowService.ListWorkflows(ctx, orgName, opts)
+	if err != nil {
+		if writeListOptionsError(w, err) {
+			return
+		}
 		logger.Error("Failed to list Workflows", "error", err)
 		writeErrorResponse(w, http.StatusInternalServerError, "Internal server error", services.CodeInternalError)
 		return
 	}
 
-	logger.Debug("Listed Workflows successfully", "org", orgName, "count", len(wfs))
-	writeListResponse(w, wfs, len(wfs), 1, len(wfs))
+	logger.Debug("Listed Workflows successfully", "org", orgName, "count", len(wfs.Items))
+	writeSuccessResponse(w, http.StatusOK, wfs)
 }
 
 func (h *Handler) GetWorkflowSchema(w http.ResponseWriter, r *http.Request) {
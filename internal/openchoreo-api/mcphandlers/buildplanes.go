@@ -5,18 +5,22 @@ package mcphandlers
 
 import (
 	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
 type ListBuildPlanesResponse struct {
 	BuildPlanes any `json:"build_planes"`
+	ListPage
 }
 
-func (h *MCPHandler) ListBuildPlanes(ctx context.Context, orgName string) (any, error) {
-	buildplanes, err := h.Services.BuildPlaneService.ListBuildPlanes(ctx, orgName)
+func (h *MCPHandler) ListBuildPlanes(ctx context.Context, orgName string, opts *models.ListOptions) (any, error) {
+	buildplanes, err := h.Services.BuildPlaneService.ListBuildPlanes(ctx, orgName, opts)
 	if err != nil {
 		return ListBuildPlanesResponse{}, err
 	}
 	return ListBuildPlanesResponse{
-		BuildPlanes: buildplanes,
+		BuildPlanes: buildplanes.Items,
+		ListPage:    listPage(buildplanes),
 	}, nil
 }
@@ -5,23 +5,28 @@ package mcphandlers
 
 import (
 	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
 type ListBuildTemplatesResponse struct {
 	Templates any `json:"templates"`
+	ListPage
 }
 
 type ListBuildsResponse struct {
 	Builds any `json:"builds"`
+	ListPage
 }
 
-func (h *MCPHandler) ListBuildTemplates(ctx context.Context, orgName string) (any, error) {
-	templates, err := h.Services.BuildService.ListBuildTemplates(ctx, orgName)
+func (h *MCPHandler) ListBuildTemplates(ctx context.Context, orgName string, opts *models.ListOptions) (any, error) {
+	templates, err := h.Services.BuildService.ListBuildTemplates(ctx, orgName, opts)
 	if err != nil {
 		return ListBuildTemplatesResponse{}, err
 	}
 	return ListBuildTemplatesResponse{
-		Templates: templates,
+		Templates: templates.Items,
+		ListPage:  listPage(templates),
 	}, nil
 }
 
@@ -29,12 +34,13 @@ func (h *MCPHandler) TriggerBuild(ctx context.Context, orgName, projectName, com
 	return h.Services.BuildService.TriggerBuild(ctx, orgName, projectName, componentName, commit)
 }
 
-func (h *MCPHandler) ListBuilds(ctx context.Context, orgName, projectName, componentName string) (any, error) {
-	builds, err := h.Services.BuildService.ListBuilds(ctx, orgName, projectName, componentName)
+func (h *MCPHandler) ListBuilds(ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions) (any, error) {
+	builds, err := h.Services.BuildService.ListBuilds(ctx, orgName, projectName, componentName, opts)
 	if err != nil {
 		return ListBuildsResponse{}, err
 	}
 	return ListBuildsResponse{
-		Builds: builds,
+		Builds:   builds.Items,
+		ListPage: listPage(builds),
 	}, nil
 }
@@ -10,31 +10,45 @@ import (
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
 )
 
 type ListComponentsResponse struct {
 	Components []*models.ComponentResponse `json:"components"`
+	ListPage
 }
 
 type ListComponentReleasesResponse struct {
 	Releases []*models.ComponentReleaseResponse `json:"releases"`
+	ListPage
 }
 
 type ListReleaseBindingsResponse struct {
 	Bindings []*models.ReleaseBindingResponse `json:"bindings"`
+	ListPage
+}
+
+type ReleaseBindingHistoryResponse struct {
+	History []*models.ReleaseHistoryEntryResponse `json:"history"`
+}
+
+type ListPromotionRequestsResponse struct {
+	PromotionRequests []*models.PromotionRequestResponse `json:"promotionRequests"`
+	ListPage
 }
 
 func (h *MCPHandler) CreateComponent(ctx context.Context, orgName, projectName string, req *models.CreateComponentRequest) (any, error) {
 	return h.Services.ComponentService.CreateComponent(ctx, orgName, projectName, req)
 }
 
-func (h *MCPHandler) ListComponents(ctx context.Context, orgName, projectName string) (any, error) {
-	components, err := h.Services.ComponentService.ListComponents(ctx, orgName, projectName)
+func (h *MCPHandler) ListComponents(ctx context.Context, orgName, projectName string, opts *models.ListOptions) (any, error) {
+	components, err := h.Services.ComponentService.ListComponents(ctx, orgName, projectName, opts)
 	if err != nil {
 		return ListComponentsResponse{}, err
 	}
 	return ListComponentsResponse{
-		Components: components,
+		Components: components.Items,
+		ListPage:   listPage(components),
 	}, nil
 }
 
@@ -62,13 +76,14 @@ func (h *MCPHandler) GetComponentWorkloads(ctx context.Context, orgName, project
 	return h.Services.ComponentService.GetComponentWorkloads(ctx, orgName, projectName, componentName)
 }
 
-func (h *MCPHandler) ListComponentReleases(ctx context.Context, orgName, projectName, componentName string) (any, error) {
-	releases, err := h.Services.ComponentService.ListComponentReleases(ctx, orgName, projectName, componentName)
+func (h *MCPHandler) ListComponentReleases(ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions) (any, error) {
+	releases, err := h.Services.ComponentService.ListComponentReleases(ctx, orgName, projectName, componentName, opts)
 	if err != nil {
 		return ListComponentReleasesResponse{}, err
 	}
 	return ListComponentReleasesResponse{
-		Releases: releases,
+		Releases: releases.Items,
+		ListPage: listPage(releases),
 	}, nil
 }
 
@@ -80,18 +95,40 @@ func (h *MCPHandler) GetComponentRelease(ctx context.Context, orgName, projectNa
 	return h.Services.ComponentService.GetComponentRelease(ctx, orgName, projectName, componentName, releaseName)
 }
 
-func (h *MCPHandler) ListReleaseBindings(ctx context.Context, orgName, projectName, componentName string, environments []string) (any, error) {
-	bindings, err := h.Services.ComponentService.ListReleaseBindings(ctx, orgName, projectName, componentName, environments)
+func (h *MCPHandler) ListReleaseBindings(ctx context.Context, orgName, projectName, componentName string, environments []string, opts *models.ListOptions) (any, error) {
+	bindings, err := h.Services.ComponentService.ListReleaseBindings(ctx, orgName, projectName, componentName, environments, opts)
 	if err != nil {
 		return ListReleaseBindingsResponse{}, err
 	}
 	return ListReleaseBindingsResponse{
-		Bindings: bindings,
+		Bindings: bindings.Items,
+		ListPage: listPage(bindings),
 	}, nil
 }
 
 func (h *MCPHandler) PatchReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName string, req *models.PatchReleaseBindingRequest) (any, error) {
-	return h.Services.ComponentService.PatchReleaseBinding(ctx, orgName, projectName, componentName, bindingName, req)
+	boundBy, _ := jwt.GetUserIdentity(ctx)
+	return h.Services.ComponentService.PatchReleaseBinding(ctx, orgName, projectName, componentName, bindingName, req, boundBy)
+}
+
+func (h *MCPHandler) GetReleaseBindingHistory(ctx context.Context, orgName, projectName, componentName, bindingName string) (any, error) {
+	history, err := h.Services.ComponentService.GetReleaseBindingHistory(ctx, orgName, projectName, componentName, bindingName)
+	if err != nil {
+		return ReleaseBindingHistoryResponse{}, err
+	}
+	return ReleaseBindingHistoryResponse{
+		History: history,
+	}, nil
+}
+
+func (h *MCPHandler) RollbackReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName string, req *models.RollbackReleaseBindingRequest) (any, error) {
+	req.Sanitize()
+	requestedBy, _ := jwt.GetUserIdentity(ctx)
+	return h.Services.ComponentService.RollbackReleaseBinding(ctx, orgName, projectName, componentName, bindingName, req.ReleaseName, requestedBy)
+}
+
+func (h *MCPHandler) DryRunReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName string, req *models.PatchReleaseBindingRequest) (any, error) {
+	return h.Services.ComponentService.DryRunReleaseBinding(ctx, orgName, projectName, componentName, bindingName, req)
 }
 
 func (h *MCPHandler) DeployRelease(ctx context.Context, orgName, projectName, componentName string, req *models.DeployReleaseRequest) (any, error) {
@@ -108,6 +145,41 @@ func (h *MCPHandler) PromoteComponent(ctx context.Context, orgName, projectName,
 	return binding, err
 }
 
+func (h *MCPHandler) RequestPromotion(ctx context.Context, orgName, projectName, componentName string, req *models.CreatePromotionRequestRequest) (any, error) {
+	req.Sanitize()
+	requestedBy, _ := jwt.GetUserIdentity(ctx)
+	return h.Services.ComponentService.RequestPromotion(ctx, &services.RequestPromotionPayload{
+		CreatePromotionRequestRequest: *req,
+		ComponentName:                 componentName,
+		ProjectName:                   projectName,
+		OrgName:                       orgName,
+		RequestedBy:                   requestedBy,
+	})
+}
+
+func (h *MCPHandler) ListPromotionRequests(ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions) (any, error) {
+	promotionRequests, err := h.Services.ComponentService.ListPromotionRequests(ctx, orgName, projectName, componentName, opts)
+	if err != nil {
+		return ListPromotionRequestsResponse{}, err
+	}
+	return ListPromotionRequestsResponse{
+		PromotionRequests: promotionRequests.Items,
+		ListPage:          listPage(promotionRequests),
+	}, nil
+}
+
+func (h *MCPHandler) ApprovePromotionRequest(ctx context.Context, orgName, projectName, componentName, requestName string, req *models.ReviewPromotionRequestRequest) (any, error) {
+	req.Sanitize()
+	reviewer, _ := jwt.GetUserIdentity(ctx)
+	return h.Services.ComponentService.ApprovePromotionRequest(ctx, orgName, projectName, componentName, requestName, reviewer, req.Comment)
+}
+
+func (h *MCPHandler) RejectPromotionRequest(ctx context.Context, orgName, projectName, componentName, requestName string, req *models.ReviewPromotionRequestRequest) (any, error) {
+	req.Sanitize()
+	reviewer, _ := jwt.GetUserIdentity(ctx)
+	return h.Services.ComponentService.RejectPromotionRequest(ctx, orgName, projectName, componentName, requestName, reviewer, req.Comment)
+}
+
 func (h *MCPHandler) CreateWorkload(ctx context.Context, orgName, projectName, componentName string, workloadSpec interface{}) (any, error) {
 	// Convert interface{} to WorkloadSpec
 	workloadSpecBytes, err := json.Marshal(workloadSpec)
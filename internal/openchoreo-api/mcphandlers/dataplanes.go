@@ -11,15 +11,17 @@ import (
 
 type ListDataPlanesResponse struct {
 	DataPlanes []*models.DataPlaneResponse `json:"data_planes"`
+	ListPage
 }
 
-func (h *MCPHandler) ListDataPlanes(ctx context.Context, orgName string) (any, error) {
-	dataplanes, err := h.Services.DataPlaneService.ListDataPlanes(ctx, orgName)
+func (h *MCPHandler) ListDataPlanes(ctx context.Context, orgName string, opts *models.ListOptions) (any, error) {
+	dataplanes, err := h.Services.DataPlaneService.ListDataPlanes(ctx, orgName, opts)
 	if err != nil {
 		return ListDataPlanesResponse{}, err
 	}
 	return ListDataPlanesResponse{
-		DataPlanes: dataplanes,
+		DataPlanes: dataplanes.Items,
+		ListPage:   listPage(dataplanes),
 	}, nil
 }
 
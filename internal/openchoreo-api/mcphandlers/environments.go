@@ -11,15 +11,17 @@ import (
 
 type ListEnvironmentsResponse struct {
 	Environments []*models.EnvironmentResponse `json:"environments"`
+	ListPage
 }
 
-func (h *MCPHandler) ListEnvironments(ctx context.Context, orgName string) (any, error) {
-	environments, err := h.Services.EnvironmentService.ListEnvironments(ctx, orgName)
+func (h *MCPHandler) ListEnvironments(ctx context.Context, orgName string, opts *models.ListOptions) (any, error) {
+	environments, err := h.Services.EnvironmentService.ListEnvironments(ctx, orgName, opts)
 	if err != nil {
 		return ListEnvironmentsResponse{}, err
 	}
 	return ListEnvironmentsResponse{
-		Environments: environments,
+		Environments: environments.Items,
+		ListPage:     listPage(environments),
 	}, nil
 }
 
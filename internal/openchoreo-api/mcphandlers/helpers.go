@@ -4,9 +4,23 @@
 package mcphandlers
 
 import (
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
 type MCPHandler struct {
 	Services *services.Services
 }
+
+// ListPage holds the pagination details embedded in list responses
+type ListPage struct {
+	TotalCount int    `json:"totalCount"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+func listPage[T any](list *models.ListResponse[T]) ListPage {
+	return ListPage{
+		TotalCount: list.TotalCount,
+		NextCursor: list.NextCursor,
+	}
+}
@@ -5,27 +5,33 @@ package mcphandlers
 
 import (
 	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
 type ListComponentTypesResponse struct {
 	ComponentTypes any `json:"component_types"`
+	ListPage
 }
 
 type ListWorkflowsResponse struct {
 	Workflows any `json:"workflows"`
+	ListPage
 }
 
 type ListTraitsResponse struct {
 	Traits any `json:"traits"`
+	ListPage
 }
 
-func (h *MCPHandler) ListComponentTypes(ctx context.Context, orgName string) (any, error) {
-	componentTypes, err := h.Services.ComponentTypeService.ListComponentTypes(ctx, orgName)
+func (h *MCPHandler) ListComponentTypes(ctx context.Context, orgName string, opts *models.ListOptions) (any, error) {
+	componentTypes, err := h.Services.ComponentTypeService.ListComponentTypes(ctx, orgName, opts)
 	if err != nil {
 		return ListComponentTypesResponse{}, err
 	}
 	return ListComponentTypesResponse{
-		ComponentTypes: componentTypes,
+		ComponentTypes: componentTypes.Items,
+		ListPage:       listPage(componentTypes),
 	}, nil
 }
 
@@ -33,13 +39,14 @@ func (h *MCPHandler) GetComponentTypeSchema(ctx context.Context, orgName, ctName
 	return h.Services.ComponentTypeService.GetComponentTypeSchema(ctx, orgName, ctName)
 }
 
-func (h *MCPHandler) ListWorkflows(ctx context.Context, orgName string) (any, error) {
-	workflows, err := h.Services.WorkflowService.ListWorkflows(ctx, orgName)
+func (h *MCPHandler) ListWorkflows(ctx context.Context, orgName string, opts *models.ListOptions) (any, error) {
+	workflows, err := h.Services.WorkflowService.ListWorkflows(ctx, orgName, opts)
 	if err != nil {
 		return ListWorkflowsResponse{}, err
 	}
 	return ListWorkflowsResponse{
-		Workflows: workflows,
+		Workflows: workflows.Items,
+		ListPage:  listPage(workflows),
 	}, nil
 }
 
@@ -47,13 +54,14 @@ func (h *MCPHandler) GetWorkflowSchema(ctx context.Context, orgName, workflowNam
 	return h.Services.WorkflowService.GetWorkflowSchema(ctx, orgName, workflowName)
 }
 
-func (h *MCPHandler) ListTraits(ctx context.Context, orgName string) (any, error) {
-	traits, err := h.Services.TraitService.ListTraits(ctx, orgName)
+func (h *MCPHandler) ListTraits(ctx context.Context, orgName string, opts *models.ListOptions) (any, error) {
+	traits, err := h.Services.TraitService.ListTraits(ctx, orgName, opts)
 	if err != nil {
 		return ListTraitsResponse{}, err
 	}
 	return ListTraitsResponse{
-		Traits: traits,
+		Traits:   traits.Items,
+		ListPage: listPage(traits),
 	}, nil
 }
 
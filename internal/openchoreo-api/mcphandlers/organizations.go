@@ -11,23 +11,25 @@ import (
 
 type ListOrganizationsResponse struct {
 	Organizations []*models.OrganizationResponse `json:"organizations"`
+	ListPage
 }
 
 func (h *MCPHandler) GetOrganization(ctx context.Context, name string) (any, error) {
 	return h.getOrganizationByName(ctx, name)
 }
 
-func (h *MCPHandler) ListOrganizations(ctx context.Context) (any, error) {
-	return h.listOrganizations(ctx)
+func (h *MCPHandler) ListOrganizations(ctx context.Context, opts *models.ListOptions) (any, error) {
+	return h.listOrganizations(ctx, opts)
 }
 
-func (h *MCPHandler) listOrganizations(ctx context.Context) (ListOrganizationsResponse, error) {
-	organizations, err := h.Services.OrganizationService.ListOrganizations(ctx)
+func (h *MCPHandler) listOrganizations(ctx context.Context, opts *models.ListOptions) (ListOrganizationsResponse, error) {
+	organizations, err := h.Services.OrganizationService.ListOrganizations(ctx, opts)
 	if err != nil {
 		return ListOrganizationsResponse{}, err
 	}
 	return ListOrganizationsResponse{
-		Organizations: organizations,
+		Organizations: organizations.Items,
+		ListPage:      listPage(organizations),
 	}, nil
 }
 
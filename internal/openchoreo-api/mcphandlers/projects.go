@@ -11,16 +11,18 @@ import (
 
 type ListProjectsResponse struct {
 	Projects []*models.ProjectResponse `json:"projects"`
+	ListPage
 }
 
-func (h *MCPHandler) ListProjects(ctx context.Context, orgName string) (any, error) {
-	projects, err := h.Services.ProjectService.ListProjects(ctx, orgName)
+func (h *MCPHandler) ListProjects(ctx context.Context, orgName string, opts *models.ListOptions) (any, error) {
+	projects, err := h.Services.ProjectService.ListProjects(ctx, orgName, opts)
 	if err != nil {
 		return ListProjectsResponse{}, err
 	}
 
 	return ListProjectsResponse{
-		Projects: projects,
+		Projects: projects.Items,
+		ListPage: listPage(projects),
 	}, nil
 }
 
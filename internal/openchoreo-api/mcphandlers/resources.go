@@ -10,6 +10,9 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
 )
 
 func (h *MCPHandler) ApplyResource(ctx context.Context, resource map[string]interface{}) (any, error) {
@@ -41,6 +44,13 @@ func (h *MCPHandler) ApplyResource(ctx context.Context, resource map[string]inte
 		return nil, fmt.Errorf("failed to handle resource namespace: %w", err)
 	}
 
+	audit.SetResource(ctx, audit.Resource{Org: unstructuredObj.GetNamespace(), Kind: kind, Name: name})
+
+	// Managing resources directly requires admin in the organization of the resource
+	if err := h.Services.Authorizer.Authorize(ctx, rbac.ActionAdmin, rbac.Resource{Org: unstructuredObj.GetNamespace()}); err != nil {
+		return nil, err
+	}
+
 	// Apply the resource
 	k8sClient := h.Services.GetKubernetesClient()
 	fieldManager := "mcp-server"
@@ -120,6 +130,13 @@ func (h *MCPHandler) DeleteResource(ctx context.Context, resource map[string]int
 		return nil, fmt.Errorf("failed to handle resource namespace: %w", err)
 	}
 
+	audit.SetResource(ctx, audit.Resource{Org: unstructuredObj.GetNamespace(), Kind: kind, Name: name})
+
+	// Managing resources directly requires admin in the organization of the resource
+	if err := h.Services.Authorizer.Authorize(ctx, rbac.ActionAdmin, rbac.Resource{Org: unstructuredObj.GetNamespace()}); err != nil {
+		return nil, err
+	}
+
 	// Delete the resource
 	k8sClient := h.Services.GetKubernetesClient()
 
@@ -0,0 +1,99 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Fields list items can be sorted by
+const (
+	SortByName      = "name"
+	SortByCreatedAt = "createdAt"
+)
+
+// Orders list items can be sorted in
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// Resource specific list filters
+const (
+	// FilterType filters components by their component type
+	FilterType = "type"
+	// FilterStatus filters builds, release bindings and promotion requests by their status
+	FilterStatus = "status"
+	// FilterEnvironment filters promotion requests by their target environment
+	FilterEnvironment = "environment"
+)
+
+// MaxListLimit is the largest page size that can be requested
+const MaxListLimit = 500
+
+// ListOptions represents the pagination, filtering and sorting options of a list request
+type ListOptions struct {
+	// Limit is the maximum number of items to return. All items are returned when it is zero.
+	Limit int `json:"limit,omitempty"`
+	// Cursor is the opaque cursor returned with the previous page
+	Cursor string `json:"cursor,omitempty"`
+	// LabelSelector filters the items by their labels, using the Kubernetes label selector syntax
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// Filters holds the resource specific filters, keyed by the filter name
+	Filters map[string]string `json:"filters,omitempty"`
+	// SortBy is the field to sort the items by. Items are sorted by name when it is empty.
+	SortBy string `json:"sortBy,omitempty"`
+	// Order is the sort order. Items are sorted in ascending order when it is empty.
+	Order string `json:"order,omitempty"`
+}
+
+// Filter returns the value of a resource specific filter, or an empty string if it is not set
+func (o *ListOptions) Filter(name string) string {
+	if o == nil {
+		return ""
+	}
+	return o.Filters[name]
+}
+
+// Sanitize sanitizes the ListOptions by trimming whitespace and dropping empty filters
+func (o *ListOptions) Sanitize() {
+	o.Cursor = strings.TrimSpace(o.Cursor)
+	o.LabelSelector = strings.TrimSpace(o.LabelSelector)
+	o.SortBy = strings.TrimSpace(o.SortBy)
+	o.Order = strings.ToLower(strings.TrimSpace(o.Order))
+	for name, value := range o.Filters {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			delete(o.Filters, name)
+			continue
+		}
+		o.Filters[name] = value
+	}
+}
+
+// Validate validates the ListOptions
+func (o *ListOptions) Validate() error {
+	if o.Limit < 0 || o.Limit > MaxListLimit {
+		return fmt.Errorf("limit must be between 0 and %d", MaxListLimit)
+	}
+	switch o.SortBy {
+	case "", SortByName, SortByCreatedAt:
+	default:
+		return fmt.Errorf("sortBy must be one of: %s, %s", SortByName, SortByCreatedAt)
+	}
+	switch o.Order {
+	case "", SortOrderAsc, SortOrderDesc:
+	default:
+		return fmt.Errorf("order must be one of: %s, %s", SortOrderAsc, SortOrderDesc)
+	}
+	if o.LabelSelector != "" {
+		if _, err := labels.Parse(o.LabelSelector); err != nil {
+			return fmt.Errorf("labelSelector is invalid: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestListOptions_Sanitize(t *testing.T) {
+	opts := &ListOptions{
+		Cursor:        " abc ",
+		LabelSelector: " app=shop ",
+		SortBy:        " createdAt ",
+		Order:         " DESC ",
+		Filters:       map[string]string{FilterType: " Service ", FilterStatus: "  "},
+	}
+	opts.Sanitize()
+
+	if opts.Cursor != "abc" || opts.LabelSelector != "app=shop" || opts.SortBy != SortByCreatedAt || opts.Order != SortOrderDesc {
+		t.Errorf("Sanitize() = %+v", opts)
+	}
+	if opts.Filter(FilterType) != "Service" {
+		t.Errorf("Filter(%q) = %q, want %q", FilterType, opts.Filter(FilterType), "Service")
+	}
+	if _, ok := opts.Filters[FilterStatus]; ok {
+		t.Errorf("Sanitize() kept the empty %q filter", FilterStatus)
+	}
+}
+
+func TestListOptions_Filter(t *testing.T) {
+	var opts *ListOptions
+	if got := opts.Filter(FilterType); got != "" {
+		t.Errorf("Filter() on nil options = %q, want empty", got)
+	}
+}
+
+func TestListOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    ListOptions
+		wantErr string
+	}{
+		{name: "Defaults", opts: ListOptions{}},
+		{name: "All options", opts: ListOptions{Limit: 20, LabelSelector: "app in (shop,cart)", SortBy: SortByCreatedAt, Order: SortOrderDesc}},
+		{name: "Negative limit", opts: ListOptions{Limit: -1}, wantErr: "limit must be between"},
+		{name: "Limit too large", opts: ListOptions{Limit: MaxListLimit + 1}, wantErr: "limit must be between"},
+		{name: "Unknown sort field", opts: ListOptions{SortBy: "status"}, wantErr: "sortBy must be one of"},
+		{name: "Unknown order", opts: ListOptions{Order: "up"}, wantErr: "order must be one of"},
+		{name: "Invalid label selector", opts: ListOptions{LabelSelector: "app in shop"}, wantErr: "labelSelector is invalid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
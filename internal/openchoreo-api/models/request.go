@@ -46,6 +46,24 @@ type PromoteComponentRequest struct {
 	// TODO Support overrides for the target environment
 }
 
+// CreatePromotionRequestRequest requests the promotion of a component to an environment that requires approval
+type CreatePromotionRequestRequest struct {
+	SourceEnvironment string `json:"sourceEnv"`
+	TargetEnvironment string `json:"targetEnv"`
+	Reason            string `json:"reason,omitempty"`
+}
+
+// ReviewPromotionRequestRequest approves or rejects a promotion request
+type ReviewPromotionRequestRequest struct {
+	Comment string `json:"comment,omitempty"`
+}
+
+// RollbackReleaseBindingRequest selects the release from the history of a ReleaseBinding to roll back to.
+// The release bound before the current one is used if ReleaseName is empty.
+type RollbackReleaseBindingRequest struct {
+	ReleaseName string `json:"releaseName,omitempty"`
+}
+
 type CreateComponentReleaseRequest struct {
 	ReleaseName string `json:"releaseName,omitempty"`
 }
@@ -179,6 +197,23 @@ func (req *PromoteComponentRequest) Sanitize() {
 	req.TargetEnvironment = strings.TrimSpace(req.TargetEnvironment)
 }
 
+// Sanitize sanitizes the CreatePromotionRequestRequest by trimming whitespace
+func (req *CreatePromotionRequestRequest) Sanitize() {
+	req.SourceEnvironment = strings.TrimSpace(req.SourceEnvironment)
+	req.TargetEnvironment = strings.TrimSpace(req.TargetEnvironment)
+	req.Reason = strings.TrimSpace(req.Reason)
+}
+
+// Sanitize sanitizes the ReviewPromotionRequestRequest by trimming whitespace
+func (req *ReviewPromotionRequestRequest) Sanitize() {
+	req.Comment = strings.TrimSpace(req.Comment)
+}
+
+// Sanitize sanitizes the RollbackReleaseBindingRequest by trimming whitespace
+func (req *RollbackReleaseBindingRequest) Sanitize() {
+	req.ReleaseName = strings.TrimSpace(req.ReleaseName)
+}
+
 type BindingReleaseState string
 
 const (
@@ -17,12 +17,14 @@ type APIResponse[T any] struct {
 	Code    string `json:"code,omitempty"`
 }
 
-// ListResponse represents a paginated list response
+// ListResponse represents a paginated list response.
+// NextCursor is set when more items follow, and is passed as the cursor of the request for the next page.
 type ListResponse[T any] struct {
-	Items      []T `json:"items"`
-	TotalCount int `json:"totalCount"`
-	Page       int `json:"page"`
-	PageSize   int `json:"pageSize"`
+	Items      []T    `json:"items"`
+	TotalCount int    `json:"totalCount"`
+	Page       int    `json:"page"`
+	PageSize   int    `json:"pageSize"`
+	NextCursor string `json:"nextCursor,omitempty"`
 }
 
 // ProjectResponse represents a project in API responses
@@ -304,8 +306,81 @@ type ReleaseBindingResponse struct {
 	Status                    string                 `json:"status,omitempty"`
 }
 
+// ReleaseHistoryEntryResponse represents a release bound to a ReleaseBinding in API responses
+type ReleaseHistoryEntryResponse struct {
+	ReleaseName string    `json:"releaseName"`
+	BoundAt     time.Time `json:"boundAt"`
+	BoundBy     string    `json:"boundBy,omitempty"`
+	Current     bool      `json:"current"`
+}
+
+// PromotionRequestResponse represents a PromotionRequest in API responses
+type PromotionRequestResponse struct {
+	Name               string     `json:"name"`
+	ComponentName      string     `json:"componentName"`
+	ProjectName        string     `json:"projectName"`
+	OrgName            string     `json:"orgName"`
+	SourceEnvironment  string     `json:"sourceEnv"`
+	TargetEnvironment  string     `json:"targetEnv"`
+	ReleaseName        string     `json:"releaseName"`
+	RequestedBy        string     `json:"requestedBy,omitempty"`
+	Reason             string     `json:"reason,omitempty"`
+	Phase              string     `json:"phase"`
+	ReviewedBy         string     `json:"reviewedBy,omitempty"`
+	ReviewedAt         *time.Time `json:"reviewedAt,omitempty"`
+	Comment            string     `json:"comment,omitempty"`
+	ReleaseBindingName string     `json:"releaseBindingName,omitempty"`
+	CreatedAt          time.Time  `json:"createdAt"`
+}
+
 // ReleaseResponse represents a Release in API responses
 type ReleaseResponse struct {
 	Spec   openchoreov1alpha1.ReleaseSpec   `json:"spec"`
 	Status openchoreov1alpha1.ReleaseStatus `json:"status"`
 }
+
+// ResourceChangeType describes how a rendered resource differs from the current Release
+type ResourceChangeType string
+
+const (
+	ResourceChangeAdded     ResourceChangeType = "added"
+	ResourceChangeModified  ResourceChangeType = "modified"
+	ResourceChangeRemoved   ResourceChangeType = "removed"
+	ResourceChangeUnchanged ResourceChangeType = "unchanged"
+)
+
+// FieldChange represents a single changed field within a resource.
+// Path uses dot notation with bracketed list indices, e.g. "spec.template.spec.containers[0].image".
+type FieldChange struct {
+	Path     string `json:"path"`
+	OldValue any    `json:"oldValue,omitempty"`
+	NewValue any    `json:"newValue,omitempty"`
+}
+
+// ResourceDiff represents the difference for a single resource between the current Release and the proposed render
+type ResourceDiff struct {
+	ID         string             `json:"id"`
+	APIVersion string             `json:"apiVersion,omitempty"`
+	Kind       string             `json:"kind,omitempty"`
+	Name       string             `json:"name,omitempty"`
+	Namespace  string             `json:"namespace,omitempty"`
+	Change     ResourceChangeType `json:"change"`
+	Fields     []FieldChange      `json:"fields,omitempty"`
+}
+
+// ReleaseDiffSummary counts resources by change type
+type ReleaseDiffSummary struct {
+	Added     int `json:"added"`
+	Modified  int `json:"modified"`
+	Removed   int `json:"removed"`
+	Unchanged int `json:"unchanged"`
+}
+
+// ReleaseBindingDryRunResponse represents the result of rendering a ReleaseBinding patch without applying it
+type ReleaseBindingDryRunResponse struct {
+	Binding     *ReleaseBindingResponse `json:"binding"`
+	ReleaseName string                  `json:"releaseName"`
+	Summary     ReleaseDiffSummary      `json:"summary"`
+	Resources   []ResourceDiff          `json:"resources"`
+	Warnings    []string                `json:"warnings,omitempty"`
+}
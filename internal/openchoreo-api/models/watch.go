@@ -0,0 +1,96 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kinds of resources whose changes can be watched
+const (
+	WatchKindProjects          = "projects"
+	WatchKindEnvironments      = "environments"
+	WatchKindComponents        = "components"
+	WatchKindComponentReleases = "component-releases"
+	WatchKindReleaseBindings   = "release-bindings"
+	WatchKindBuilds            = "builds"
+	WatchKindPromotionRequests = "promotion-requests"
+)
+
+// WatchKinds lists the kinds of resources whose changes can be watched
+var WatchKinds = []string{
+	WatchKindProjects,
+	WatchKindEnvironments,
+	WatchKindComponents,
+	WatchKindComponentReleases,
+	WatchKindReleaseBindings,
+	WatchKindBuilds,
+	WatchKindPromotionRequests,
+}
+
+// Types of watch events
+const (
+	// WatchEventAdded is sent for a resource that was created, and for every existing resource
+	// when a watch starts without a resource version
+	WatchEventAdded = "ADDED"
+	// WatchEventModified is sent for a resource that was updated
+	WatchEventModified = "MODIFIED"
+	// WatchEventDeleted is sent for a resource that was deleted, with the last state of the resource
+	WatchEventDeleted = "DELETED"
+	// WatchEventBookmark carries no resource and only advances the resource version a watch can resume from
+	WatchEventBookmark = "BOOKMARK"
+)
+
+// WatchOptions represents the options of a watch request
+type WatchOptions struct {
+	// Kind is the kind of resources to watch
+	Kind string `json:"kind"`
+	// Project only includes the resources of a project
+	Project string `json:"project,omitempty"`
+	// Component only includes the resources of a component
+	Component string `json:"component,omitempty"`
+	// ResourceVersion resumes a watch after the event with the resource version. The current resources
+	// are sent as added events first when it is empty.
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+// Sanitize sanitizes the WatchOptions by trimming whitespace
+func (o *WatchOptions) Sanitize() {
+	o.Kind = strings.ToLower(strings.TrimSpace(o.Kind))
+	o.Project = strings.TrimSpace(o.Project)
+	o.Component = strings.TrimSpace(o.Component)
+	o.ResourceVersion = strings.TrimSpace(o.ResourceVersion)
+}
+
+// Validate validates the WatchOptions
+func (o *WatchOptions) Validate() error {
+	switch o.Kind {
+	case WatchKindProjects:
+		if o.Component != "" {
+			return fmt.Errorf("%s cannot be filtered by component", o.Kind)
+		}
+	case WatchKindEnvironments:
+		if o.Project != "" || o.Component != "" {
+			return fmt.Errorf("%s cannot be filtered by project or component", o.Kind)
+		}
+	case WatchKindComponents, WatchKindComponentReleases, WatchKindReleaseBindings, WatchKindBuilds,
+		WatchKindPromotionRequests:
+	default:
+		return fmt.Errorf("kind must be one of: %s", strings.Join(WatchKinds, ", "))
+	}
+	return nil
+}
+
+// WatchEvent represents a change of a watched resource
+type WatchEvent struct {
+	Type string `json:"type"`
+	Kind string `json:"kind"`
+	// ResourceVersion is the version to resume the watch from after this event. It is empty for the added
+	// events of the current resources sent when a watch starts, which are followed by a bookmark. The bookmark
+	// is empty as well while no resource of the kind changed since the API server started.
+	ResourceVersion string `json:"resourceVersion"`
+	// Object is the API response of the resource, such as a ComponentResponse. It is empty for bookmarks.
+	Object any `json:"object,omitempty"`
+}
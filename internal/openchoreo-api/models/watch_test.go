@@ -0,0 +1,51 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWatchOptions_Sanitize(t *testing.T) {
+	opts := &WatchOptions{Kind: " Components ", Project: " shop ", Component: " cart ", ResourceVersion: " 42 "}
+	opts.Sanitize()
+
+	want := WatchOptions{Kind: WatchKindComponents, Project: "shop", Component: "cart", ResourceVersion: "42"}
+	if *opts != want {
+		t.Errorf("Sanitize() = %+v, want %+v", *opts, want)
+	}
+}
+
+func TestWatchOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    WatchOptions
+		wantErr string
+	}{
+		{name: "Projects", opts: WatchOptions{Kind: WatchKindProjects, Project: "shop"}},
+		{name: "Environments", opts: WatchOptions{Kind: WatchKindEnvironments}},
+		{name: "Builds of a component", opts: WatchOptions{Kind: WatchKindBuilds, Project: "shop", Component: "cart"}},
+		{name: "Release bindings from a resource version", opts: WatchOptions{Kind: WatchKindReleaseBindings, ResourceVersion: "42"}},
+		{name: "Missing kind", opts: WatchOptions{}, wantErr: "kind must be one of"},
+		{name: "Unknown kind", opts: WatchOptions{Kind: "secrets"}, wantErr: "kind must be one of"},
+		{name: "Projects by component", opts: WatchOptions{Kind: WatchKindProjects, Component: "cart"}, wantErr: "cannot be filtered by component"},
+		{name: "Environments by project", opts: WatchOptions{Kind: WatchKindEnvironments, Project: "shop"}, wantErr: "cannot be filtered by project"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
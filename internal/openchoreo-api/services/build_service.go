@@ -10,6 +10,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -39,8 +41,8 @@ func NewBuildService(k8sClient client.Client, buildPlaneService *BuildPlaneServi
 	}
 }
 
-// ListBuildTemplates retrieves cluster workflow templates (argo) available for an organization in the buildplane
-func (s *BuildService) ListBuildTemplates(ctx context.Context, orgName string) ([]models.BuildTemplateResponse, error) {
+// ListBuildTemplates retrieves a page of the cluster workflow templates (argo) available for an organization in the buildplane
+func (s *BuildService) ListBuildTemplates(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListResponse[models.BuildTemplateResponse], error) {
 	s.logger.Debug("Listing build templates", "org", orgName)
 
 	// Get the build plane Kubernetes client
@@ -50,42 +52,56 @@ func (s *BuildService) ListBuildTemplates(ctx context.Context, orgName string) (
 	}
 
 	// List ClusterWorkflowTemplates using the build plane client
-	var clusterWorkflowTemplates argo.ClusterWorkflowTemplateList
-	err = buildPlaneClient.List(ctx, &clusterWorkflowTemplates)
+	templates, err := listItems(ctx, opts, listQuery[models.BuildTemplateResponse]{
+		fetch: func(ctx context.Context, listOpts ...client.ListOption) ([]models.BuildTemplateResponse, metav1.ListMeta, error) {
+			var clusterWorkflowTemplates argo.ClusterWorkflowTemplateList
+			if err := buildPlaneClient.List(ctx, &clusterWorkflowTemplates, listOpts...); err != nil {
+				return nil, metav1.ListMeta{}, err
+			}
+			items := make([]models.BuildTemplateResponse, 0, len(clusterWorkflowTemplates.Items))
+			for i := range clusterWorkflowTemplates.Items {
+				items = append(items, toBuildTemplateResponse(&clusterWorkflowTemplates.Items[i]))
+			}
+			return items, clusterWorkflowTemplates.ListMeta, nil
+		},
+		sortKey: func(template models.BuildTemplateResponse) (string, time.Time) {
+			return template.Name, template.CreatedAt
+		},
+	})
 	if err != nil {
+		if isListOptionsError(err) {
+			return nil, err
+		}
 		s.logger.Error("Failed to list ClusterWorkflowTemplates", "error", err)
 		return nil, fmt.Errorf("failed to list ClusterWorkflowTemplates: %w", err)
 	}
 
-	s.logger.Debug("Found build templates", "count", len(clusterWorkflowTemplates.Items), "org", orgName)
-
-	templateResponses := make([]models.BuildTemplateResponse, 0, len(clusterWorkflowTemplates.Items))
-	for _, template := range clusterWorkflowTemplates.Items {
-		parameters := make([]models.BuildTemplateParameter, 0, len(template.Spec.Arguments.Parameters))
-		if template.Spec.Arguments.Parameters != nil {
-			for _, param := range template.Spec.Arguments.Parameters {
-				templateParam := models.BuildTemplateParameter{
-					Name: param.Name,
-				}
+	s.logger.Debug("Found build templates", "count", len(templates.Items), "org", orgName)
+	return templates, nil
+}
 
-				if param.Default != nil {
-					templateParam.Default = string(*param.Default)
-				}
+// toBuildTemplateResponse converts a ClusterWorkflowTemplate to a BuildTemplateResponse
+func toBuildTemplateResponse(template *argo.ClusterWorkflowTemplate) models.BuildTemplateResponse {
+	parameters := make([]models.BuildTemplateParameter, 0, len(template.Spec.Arguments.Parameters))
+	if template.Spec.Arguments.Parameters != nil {
+		for _, param := range template.Spec.Arguments.Parameters {
+			templateParam := models.BuildTemplateParameter{
+				Name: param.Name,
+			}
 
-				parameters = append(parameters, templateParam)
+			if param.Default != nil {
+				templateParam.Default = string(*param.Default)
 			}
-		}
 
-		templateResponse := models.BuildTemplateResponse{
-			Name:       template.Name,
-			Parameters: parameters,
-			CreatedAt:  template.CreationTimestamp.Time,
+			parameters = append(parameters, templateParam)
 		}
-
-		templateResponses = append(templateResponses, templateResponse)
 	}
 
-	return templateResponses, nil
+	return models.BuildTemplateResponse{
+		Name:       template.Name,
+		Parameters: parameters,
+		CreatedAt:  template.CreationTimestamp.Time,
+	}
 }
 
 // TriggerBuild creates a new workflow from a component's build configuration
@@ -194,44 +210,43 @@ func (s *BuildService) TriggerBuild(ctx context.Context, orgName, projectName, c
 	}, nil
 }
 
-// ListBuilds retrieves workflows for a component using spec.owner fields
-func (s *BuildService) ListBuilds(ctx context.Context, orgName, projectName, componentName string) ([]models.BuildResponse, error) {
+// ListBuilds retrieves a page of the workflows of a component using spec.owner fields
+func (s *BuildService) ListBuilds(ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions) (*models.ListResponse[models.BuildResponse], error) {
 	s.logger.Debug("Listing builds", "org", orgName, "project", projectName, "component", componentName)
 
-	var workflowRuns openchoreov1alpha1.WorkflowRunList
-	err := s.k8sClient.List(ctx, &workflowRuns, client.InNamespace(orgName))
+	status := opts.Filter(models.FilterStatus)
+	builds, err := listItems(ctx, opts, listQuery[models.BuildResponse]{
+		fetch: func(ctx context.Context, listOpts ...client.ListOption) ([]models.BuildResponse, metav1.ListMeta, error) {
+			var workflowRuns openchoreov1alpha1.WorkflowRunList
+			if err := s.k8sClient.List(ctx, &workflowRuns, append(listOpts, client.InNamespace(orgName))...); err != nil {
+				return nil, metav1.ListMeta{}, err
+			}
+			items := make([]models.BuildResponse, 0, len(workflowRuns.Items))
+			for i := range workflowRuns.Items {
+				items = append(items, toBuildResponse(&workflowRuns.Items[i], orgName))
+			}
+			return items, workflowRuns.ListMeta, nil
+		},
+		keep: func(build models.BuildResponse) bool {
+			// Filter by spec.owner fields
+			if build.ProjectName != projectName || build.ComponentName != componentName {
+				return false
+			}
+			return status == "" || strings.EqualFold(build.Status, status)
+		},
+		sortKey: func(build models.BuildResponse) (string, time.Time) {
+			return build.Name, build.CreatedAt
+		},
+	})
 	if err != nil {
+		if isListOptionsError(err) {
+			return nil, err
+		}
 		s.logger.Error("Failed to list workflows", "error", err)
 		return nil, fmt.Errorf("failed to list workflows: %w", err)
 	}
 
-	buildResponses := make([]models.BuildResponse, 0, len(workflowRuns.Items))
-	for _, workflowRun := range workflowRuns.Items {
-		// Filter by spec.owner fields
-		if workflowRun.Spec.Owner.ProjectName != projectName || workflowRun.Spec.Owner.ComponentName != componentName {
-			continue
-		}
-
-		// Extract commit from the workflow schema
-		commit := extractCommitFromSchema(workflowRun.Spec.Workflow.Schema)
-		if commit == "" {
-			commit = "latest"
-		}
-
-		buildResponses = append(buildResponses, models.BuildResponse{
-			Name:          workflowRun.Name,
-			UUID:          string(workflowRun.UID),
-			ComponentName: componentName,
-			ProjectName:   projectName,
-			OrgName:       orgName,
-			Commit:        commit,
-			Status:        GetLatestWorkflowStatus(workflowRun.Status.Conditions),
-			CreatedAt:     workflowRun.CreationTimestamp.Time,
-			Image:         workflowRun.Status.ImageStatus.Image,
-		})
-	}
-
-	return buildResponses, nil
+	return builds, nil
 }
 
 // extractCommitFromSchema extracts the commit hash from the workflow schema
@@ -257,6 +272,27 @@ func extractCommitFromSchema(schema *runtime.RawExtension) string {
 	return ""
 }
 
+// toBuildResponse converts a WorkflowRun to a BuildResponse
+func toBuildResponse(workflowRun *openchoreov1alpha1.WorkflowRun, orgName string) models.BuildResponse {
+	// Extract commit from the workflow schema
+	commit := extractCommitFromSchema(workflowRun.Spec.Workflow.Schema)
+	if commit == "" {
+		commit = "latest"
+	}
+
+	return models.BuildResponse{
+		Name:          workflowRun.Name,
+		UUID:          string(workflowRun.UID),
+		ComponentName: workflowRun.Spec.Owner.ComponentName,
+		ProjectName:   workflowRun.Spec.Owner.ProjectName,
+		OrgName:       orgName,
+		Commit:        commit,
+		Status:        GetLatestWorkflowStatus(workflowRun.Status.Conditions),
+		CreatedAt:     workflowRun.CreationTimestamp.Time,
+		Image:         workflowRun.Status.ImageStatus.Image,
+	}
+}
+
 // GetLatestWorkflowStatus determines the user-friendly status from workflow conditions
 func GetLatestWorkflowStatus(workflowConditions []metav1.Condition) string {
 	if len(workflowConditions) == 0 {
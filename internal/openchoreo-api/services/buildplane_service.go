@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
@@ -68,7 +70,10 @@ func (s *BuildPlaneService) GetBuildPlaneClient(ctx context.Context, orgName str
 	}
 
 	buildPlaneClient, err := kubernetesClient.GetK8sClient(
+		ctx,
 		s.bpClientMgr,
+		s.k8sClient,
+		kubernetesClient.PlaneKindBuildPlane,
 		orgName,
 		buildPlane.Name,
 		buildPlane.Spec.KubernetesCluster,
@@ -82,52 +87,65 @@ func (s *BuildPlaneService) GetBuildPlaneClient(ctx context.Context, orgName str
 	return buildPlaneClient, nil
 }
 
-// ListBuildPlanes retrieves all build planes for an organization
-func (s *BuildPlaneService) ListBuildPlanes(ctx context.Context, orgName string) ([]models.BuildPlaneResponse, error) {
+// ListBuildPlanes retrieves a page of the build planes of an organization
+func (s *BuildPlaneService) ListBuildPlanes(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListResponse[models.BuildPlaneResponse], error) {
 	s.logger.Debug("Listing build planes", "org", orgName)
 
-	// List all build planes in the organization namespace
-	var buildPlanes openchoreov1alpha1.BuildPlaneList
-	err := s.k8sClient.List(ctx, &buildPlanes, client.InNamespace(orgName))
+	// List the build planes in the organization namespace
+	buildPlanes, err := listItems(ctx, opts, listQuery[models.BuildPlaneResponse]{
+		fetch: func(ctx context.Context, listOpts ...client.ListOption) ([]models.BuildPlaneResponse, metav1.ListMeta, error) {
+			var buildPlaneList openchoreov1alpha1.BuildPlaneList
+			if err := s.k8sClient.List(ctx, &buildPlaneList, append(listOpts, client.InNamespace(orgName))...); err != nil {
+				return nil, metav1.ListMeta{}, err
+			}
+			items := make([]models.BuildPlaneResponse, 0, len(buildPlaneList.Items))
+			for i := range buildPlaneList.Items {
+				items = append(items, toBuildPlaneResponse(&buildPlaneList.Items[i]))
+			}
+			return items, buildPlaneList.ListMeta, nil
+		},
+		sortKey: func(buildPlane models.BuildPlaneResponse) (string, time.Time) {
+			return buildPlane.Name, buildPlane.CreatedAt
+		},
+	})
 	if err != nil {
+		if isListOptionsError(err) {
+			return nil, err
+		}
 		s.logger.Error("Failed to list build planes", "error", err, "org", orgName)
 		return nil, fmt.Errorf("failed to list build planes: %w", err)
 	}
 
 	s.logger.Debug("Found build planes", "count", len(buildPlanes.Items), "org", orgName)
+	return buildPlanes, nil
+}
 
-	// Convert to response format
-	buildPlaneResponses := make([]models.BuildPlaneResponse, 0, len(buildPlanes.Items))
-	for _, buildPlane := range buildPlanes.Items {
-		displayName := buildPlane.Annotations[controller.AnnotationKeyDisplayName]
-		description := buildPlane.Annotations[controller.AnnotationKeyDescription]
-
-		// Determine status from conditions
-		status := ""
-
-		// Extract observer information if available
-		observerURL := ""
-		observerUsername := ""
-		if buildPlane.Spec.Observer.URL != "" {
-			observerURL = buildPlane.Spec.Observer.URL
-			observerUsername = buildPlane.Spec.Observer.Authentication.BasicAuth.Username
-		}
+// toBuildPlaneResponse converts a BuildPlane to a BuildPlaneResponse
+func toBuildPlaneResponse(buildPlane *openchoreov1alpha1.BuildPlane) models.BuildPlaneResponse {
+	displayName := buildPlane.Annotations[controller.AnnotationKeyDisplayName]
+	description := buildPlane.Annotations[controller.AnnotationKeyDescription]
 
-		buildPlaneResponse := models.BuildPlaneResponse{
-			Name:                  buildPlane.Name,
-			Namespace:             buildPlane.Namespace,
-			DisplayName:           displayName,
-			Description:           description,
-			KubernetesClusterName: buildPlane.Name,
-			APIServerURL:          buildPlane.Spec.KubernetesCluster.Server,
-			ObserverURL:           observerURL,
-			ObserverUsername:      observerUsername,
-			CreatedAt:             buildPlane.CreationTimestamp.Time,
-			Status:                status,
-		}
+	// Determine status from conditions
+	status := ""
 
-		buildPlaneResponses = append(buildPlaneResponses, buildPlaneResponse)
+	// Extract observer information if available
+	observerURL := ""
+	observerUsername := ""
+	if buildPlane.Spec.Observer.URL != "" {
+		observerURL = buildPlane.Spec.Observer.URL
+		observerUsername = buildPlane.Spec.Observer.Authentication.BasicAuth.Username
 	}
 
-	return buildPlaneResponses, nil
+	return models.BuildPlaneResponse{
+		Name:                  buildPlane.Name,
+		Namespace:             buildPlane.Namespace,
+		DisplayName:           displayName,
+		Description:           description,
+		KubernetesClusterName: buildPlane.Name,
+		APIServerURL:          buildPlane.Spec.KubernetesCluster.Server,
+		ObserverURL:           observerURL,
+		ObserverUsername:      observerUsername,
+		CreatedAt:             buildPlane.CreationTimestamp.Time,
+		Status:                status,
+	}
 }
@@ -10,7 +10,9 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
+	"time"
 
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
@@ -24,7 +26,9 @@ import (
 	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
 	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
 	openchoreoschema "github.com/openchoreo/openchoreo/internal/schema"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
 )
 
 const (
@@ -40,6 +44,8 @@ type ComponentService struct {
 	k8sClient           client.Client
 	projectService      *ProjectService
 	specFetcherRegistry *ComponentSpecFetcherRegistry
+	pipeline            *componentpipeline.Pipeline
+	authorizer          *rbac.Authorizer
 	logger              *slog.Logger
 }
 
@@ -96,15 +102,35 @@ type PromoteComponentPayload struct {
 }
 
 // NewComponentService creates a new component service
-func NewComponentService(k8sClient client.Client, projectService *ProjectService, logger *slog.Logger) *ComponentService {
+func NewComponentService(k8sClient client.Client, projectService *ProjectService, authorizer *rbac.Authorizer, logger *slog.Logger) *ComponentService {
 	return &ComponentService{
 		k8sClient:           k8sClient,
 		projectService:      projectService,
 		specFetcherRegistry: NewComponentSpecFetcherRegistry(),
+		pipeline:            componentpipeline.NewPipeline(),
+		authorizer:          authorizer,
 		logger:              logger,
 	}
 }
 
+// authorizeInEnvironment checks that the user of the request may perform the action on the component in
+// the given environment. Operations whose environment is only known after looking up the pipeline or the
+// release binding are authorized here rather than by the API routes and MCP tools.
+func (s *ComponentService) authorizeInEnvironment(ctx context.Context, action rbac.Action, orgName, projectName, componentName, environment string) error {
+	err := s.authorizer.Authorize(ctx, action, rbac.Resource{
+		Org:         orgName,
+		Project:     projectName,
+		Component:   componentName,
+		Environment: environment,
+	})
+	if err != nil {
+		s.logger.Warn("Action denied", "action", action, "org", orgName, "project", projectName,
+			"component", componentName, "environment", environment, "error", err)
+		return err
+	}
+	return nil
+}
+
 func (s *ComponentService) CreateComponentRelease(ctx context.Context, orgName, projectName, componentName, releaseName string) (*models.ComponentReleaseResponse, error) {
 	s.logger.Debug("Creating component release", "org", orgName, "project", projectName, "component", componentName, "release", releaseName)
 
@@ -307,8 +333,8 @@ func (s *ComponentService) generateReleaseName(ctx context.Context, orgName, pro
 	return releaseName, nil
 }
 
-// ListComponentReleases lists all component releases for a specific component
-func (s *ComponentService) ListComponentReleases(ctx context.Context, orgName, projectName, componentName string) ([]*models.ComponentReleaseResponse, error) {
+// ListComponentReleases lists a page of the component releases of a specific component
+func (s *ComponentService) ListComponentReleases(ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions) (*models.ListResponse[*models.ComponentReleaseResponse], error) {
 	s.logger.Debug("Listing component releases", "org", orgName, "project", projectName, "component", componentName)
 
 	componentKey := client.ObjectKey{
@@ -330,35 +356,49 @@ func (s *ComponentService) ListComponentReleases(ctx context.Context, orgName, p
 		return nil, ErrComponentNotFound
 	}
 
-	var releaseList openchoreov1alpha1.ComponentReleaseList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &releaseList, listOpts...); err != nil {
+	releases, err := listItems(ctx, opts, listQuery[*models.ComponentReleaseResponse]{
+		fetch: func(ctx context.Context, listOpts ...client.ListOption) ([]*models.ComponentReleaseResponse, metav1.ListMeta, error) {
+			var releaseList openchoreov1alpha1.ComponentReleaseList
+			if err := s.k8sClient.List(ctx, &releaseList, append(listOpts, client.InNamespace(orgName))...); err != nil {
+				return nil, metav1.ListMeta{}, err
+			}
+			items := make([]*models.ComponentReleaseResponse, 0, len(releaseList.Items))
+			for i := range releaseList.Items {
+				items = append(items, toComponentReleaseResponse(&releaseList.Items[i], orgName))
+			}
+			return items, releaseList.ListMeta, nil
+		},
+		keep: func(release *models.ComponentReleaseResponse) bool {
+			return release.ComponentName == componentName && release.ProjectName == projectName
+		},
+		sortKey: func(release *models.ComponentReleaseResponse) (string, time.Time) {
+			return release.Name, release.CreatedAt
+		},
+	})
+	if err != nil {
+		if isListOptionsError(err) {
+			return nil, err
+		}
 		s.logger.Error("Failed to list component releases", "error", err)
 		return nil, fmt.Errorf("failed to list component releases: %w", err)
 	}
 
-	releases := make([]*models.ComponentReleaseResponse, 0, len(releaseList.Items))
-	for _, item := range releaseList.Items {
-		if item.Spec.Owner.ComponentName != componentName || item.Spec.Owner.ProjectName != projectName {
-			continue
-		}
-		releases = append(releases, &models.ComponentReleaseResponse{
-			Name:          item.Name,
-			ComponentName: componentName,
-			ProjectName:   projectName,
-			OrgName:       orgName,
-			CreatedAt:     item.CreationTimestamp.Time,
-			Status:        statusReady,
-		})
-	}
-
-	s.logger.Debug("Listed component releases", "org", orgName, "project", projectName, "component", componentName, "count", len(releases))
+	s.logger.Debug("Listed component releases", "org", orgName, "project", projectName, "component", componentName, "count", len(releases.Items))
 	return releases, nil
 }
 
+// toComponentReleaseResponse converts a ComponentRelease to a ComponentReleaseResponse
+func toComponentReleaseResponse(release *openchoreov1alpha1.ComponentRelease, orgName string) *models.ComponentReleaseResponse {
+	return &models.ComponentReleaseResponse{
+		Name:          release.Name,
+		ComponentName: release.Spec.Owner.ComponentName,
+		ProjectName:   release.Spec.Owner.ProjectName,
+		OrgName:       orgName,
+		CreatedAt:     release.CreationTimestamp.Time,
+		Status:        statusReady,
+	}
+}
+
 // GetComponentRelease retrieves a specific component release by its name
 func (s *ComponentService) GetComponentRelease(ctx context.Context, orgName, projectName, componentName, releaseName string) (*models.ComponentReleaseResponse, error) {
 	s.logger.Debug("Getting component release", "org", orgName, "project", projectName, "component", componentName, "release", releaseName)
@@ -701,16 +741,15 @@ func (s *ComponentService) GetEnvironmentRelease(ctx context.Context, orgName, p
 	}, nil
 }
 
-// PatchReleaseBinding patches a ReleaseBinding with environment-specific overrides
-func (s *ComponentService) PatchReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName string, req *models.PatchReleaseBindingRequest) (*models.ReleaseBindingResponse, error) {
-	s.logger.Debug("Patching release binding", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
-
+// getReleaseBindingForPatch fetches the ReleaseBinding targeted by a patch request, or initializes a new one
+// from the request if it does not exist yet. The returned bool reports whether the binding already exists.
+func (s *ComponentService) getReleaseBindingForPatch(ctx context.Context, orgName, projectName, componentName, bindingName string, req *models.PatchReleaseBindingRequest) (*openchoreov1alpha1.ReleaseBinding, bool, error) {
 	_, err := s.projectService.GetProject(ctx, orgName, projectName)
 	if err != nil {
 		if errors.Is(err, ErrProjectNotFound) {
-			return nil, ErrProjectNotFound
+			return nil, false, ErrProjectNotFound
 		}
-		return nil, fmt.Errorf("failed to verify project: %w", err)
+		return nil, false, fmt.Errorf("failed to verify project: %w", err)
 	}
 
 	componentKey := client.ObjectKey{
@@ -721,15 +760,15 @@ func (s *ComponentService) PatchReleaseBinding(ctx context.Context, orgName, pro
 	if err := s.k8sClient.Get(ctx, componentKey, &component); err != nil {
 		if client.IgnoreNotFound(err) == nil {
 			s.logger.Warn("Component not found", "org", orgName, "project", projectName, "component", componentName)
-			return nil, ErrComponentNotFound
+			return nil, false, ErrComponentNotFound
 		}
 		s.logger.Error("Failed to get component", "error", err)
-		return nil, fmt.Errorf("failed to get component: %w", err)
+		return nil, false, fmt.Errorf("failed to get component: %w", err)
 	}
 
 	if component.Spec.Owner.ProjectName != projectName {
 		s.logger.Warn("Component does not belong to project", "org", orgName, "project", projectName, "component", componentName)
-		return nil, ErrComponentNotFound
+		return nil, false, ErrComponentNotFound
 	}
 
 	bindingKey := client.ObjectKey{
@@ -746,7 +785,7 @@ func (s *ComponentService) PatchReleaseBinding(ctx context.Context, orgName, pro
 
 			if req.Environment == "" {
 				s.logger.Warn("Environment is required when creating a new release binding")
-				return nil, fmt.Errorf("environment is required when creating a new release binding")
+				return nil, false, fmt.Errorf("environment is required when creating a new release binding")
 			}
 
 			binding = openchoreov1alpha1.ReleaseBinding{
@@ -772,21 +811,34 @@ func (s *ComponentService) PatchReleaseBinding(ctx context.Context, orgName, pro
 			}
 		} else {
 			s.logger.Error("Failed to get release binding", "error", err)
-			return nil, fmt.Errorf("failed to get release binding: %w", err)
+			return nil, false, fmt.Errorf("failed to get release binding: %w", err)
 		}
 	}
 
 	// Verify the binding belongs to the correct component (only if it already exists)
 	if bindingExists && binding.Spec.Owner.ComponentName != componentName {
 		s.logger.Warn("Release binding does not belong to component", "org", orgName, "component", componentName, "binding", bindingName)
-		return nil, ErrReleaseBindingNotFound
+		return nil, false, ErrReleaseBindingNotFound
+	}
+
+	return &binding, bindingExists, nil
+}
+
+// applyReleaseBindingPatch applies the release and the overrides from a patch request to the given ReleaseBinding.
+// The requested ComponentRelease must exist and belong to the component of the binding.
+func (s *ComponentService) applyReleaseBindingPatch(ctx context.Context, binding *openchoreov1alpha1.ReleaseBinding, req *models.PatchReleaseBindingRequest) error {
+	if req.ReleaseName != "" {
+		if err := s.checkComponentRelease(ctx, binding, req.ReleaseName); err != nil {
+			return err
+		}
+		binding.Spec.ReleaseName = req.ReleaseName
 	}
 
 	if req.ComponentTypeEnvOverrides != nil {
 		overridesJSON, err := json.Marshal(req.ComponentTypeEnvOverrides)
 		if err != nil {
 			s.logger.Error("Failed to marshal component type env overrides", "error", err)
-			return nil, fmt.Errorf("failed to marshal component type env overrides: %w", err)
+			return fmt.Errorf("failed to marshal component type env overrides: %w", err)
 		}
 		binding.Spec.ComponentTypeEnvOverrides = &runtime.RawExtension{Raw: overridesJSON}
 	}
@@ -797,7 +849,7 @@ func (s *ComponentService) PatchReleaseBinding(ctx context.Context, orgName, pro
 			overridesJSON, err := json.Marshal(overrides)
 			if err != nil {
 				s.logger.Error("Failed to marshal trait overrides", "error", err, "instanceName", instanceName)
-				return nil, fmt.Errorf("failed to marshal trait overrides for %s: %w", instanceName, err)
+				return fmt.Errorf("failed to marshal trait overrides for %s: %w", instanceName, err)
 			}
 			binding.Spec.TraitOverrides[instanceName] = runtime.RawExtension{Raw: overridesJSON}
 		}
@@ -872,22 +924,213 @@ func (s *ComponentService) PatchReleaseBinding(ctx context.Context, orgName, pro
 		}
 	}
 
+	return nil
+}
+
+// checkComponentRelease verifies that a ComponentRelease exists and belongs to the component of the binding
+func (s *ComponentService) checkComponentRelease(ctx context.Context, binding *openchoreov1alpha1.ReleaseBinding, releaseName string) error {
+	var componentRelease openchoreov1alpha1.ComponentRelease
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: binding.Namespace, Name: releaseName}, &componentRelease); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Component release not found", "org", binding.Namespace, "release", releaseName)
+			return ErrComponentReleaseNotFound
+		}
+		s.logger.Error("Failed to get component release", "error", err)
+		return fmt.Errorf("failed to get component release: %w", err)
+	}
+
+	if componentRelease.Spec.Owner.ProjectName != binding.Spec.Owner.ProjectName ||
+		componentRelease.Spec.Owner.ComponentName != binding.Spec.Owner.ComponentName {
+		s.logger.Warn("Component release does not belong to component", "org", binding.Namespace,
+			"component", binding.Spec.Owner.ComponentName, "release", releaseName)
+		return ErrComponentReleaseNotFound
+	}
+	return nil
+}
+
+// PatchReleaseBinding patches a ReleaseBinding with a release and environment-specific overrides.
+// boundBy identifies the user making the change and is recorded in the release history of the binding
+// when the bound release changes.
+func (s *ComponentService) PatchReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName string, req *models.PatchReleaseBindingRequest, boundBy string) (*models.ReleaseBindingResponse, error) {
+	s.logger.Debug("Patching release binding", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
+
+	binding, bindingExists, err := s.getReleaseBindingForPatch(ctx, orgName, projectName, componentName, bindingName, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeInEnvironment(ctx, rbac.ActionDeploy, orgName, projectName, componentName, binding.Spec.Environment); err != nil {
+		return nil, err
+	}
+
+	previousRelease := binding.Spec.ReleaseName
+	if bindingExists && req.ReleaseName != "" && req.ReleaseName != previousRelease {
+		s.logger.Info("Changing the release of release binding", "org", orgName, "binding", bindingName,
+			"from", previousRelease, "to", req.ReleaseName, "boundBy", boundBy)
+	}
+
+	if err := s.applyReleaseBindingPatch(ctx, binding, req); err != nil {
+		return nil, err
+	}
+
+	releaseChanged := (!bindingExists || binding.Spec.ReleaseName != previousRelease) && binding.Spec.ReleaseName != ""
+	if releaseChanged {
+		if err := s.checkReleaseApproval(ctx, orgName, projectName, binding.Spec.Environment); err != nil {
+			return nil, err
+		}
+	}
+
 	// Create or update the binding
 	if bindingExists {
-		if err := s.k8sClient.Update(ctx, &binding); err != nil {
+		if err := s.k8sClient.Update(ctx, binding); err != nil {
 			s.logger.Error("Failed to update release binding", "error", err)
 			return nil, fmt.Errorf("failed to update release binding: %w", err)
 		}
 		s.logger.Debug("Release binding updated successfully", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
 	} else {
-		if err := s.k8sClient.Create(ctx, &binding); err != nil {
+		if err := s.k8sClient.Create(ctx, binding); err != nil {
 			s.logger.Error("Failed to create release binding", "error", err)
 			return nil, fmt.Errorf("failed to create release binding: %w", err)
 		}
 		s.logger.Debug("Release binding created successfully", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
 	}
 
-	return s.toReleaseBindingResponse(&binding, orgName, projectName, componentName), nil
+	if releaseChanged && boundBy != "" {
+		// The binding is already updated, so failing to attribute the release only leaves the user out of the history
+		if err := s.recordBoundBy(ctx, binding, boundBy); err != nil {
+			s.logger.Warn("Failed to record the user who bound the release", "org", orgName, "binding", bindingName, "error", err)
+		}
+	}
+
+	return s.toReleaseBindingResponse(binding, orgName, projectName, componentName), nil
+}
+
+// DryRunReleaseBinding renders a ReleaseBinding with the proposed patch applied and returns a per-resource
+// diff against the resources of the current Release. Nothing is persisted.
+func (s *ComponentService) DryRunReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName string, req *models.PatchReleaseBindingRequest) (*models.ReleaseBindingDryRunResponse, error) {
+	s.logger.Debug("Dry-running release binding patch", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
+
+	binding, _, err := s.getReleaseBindingForPatch(ctx, orgName, projectName, componentName, bindingName, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyReleaseBindingPatch(ctx, binding, req); err != nil {
+		return nil, err
+	}
+
+	if binding.Spec.ReleaseName == "" {
+		return nil, fmt.Errorf("%w: release binding %q has no release name", ErrComponentReleaseNotFound, bindingName)
+	}
+
+	var componentRelease openchoreov1alpha1.ComponentRelease
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: binding.Spec.ReleaseName}, &componentRelease); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Component release not found", "org", orgName, "release", binding.Spec.ReleaseName)
+			return nil, ErrComponentReleaseNotFound
+		}
+		s.logger.Error("Failed to get component release", "error", err)
+		return nil, fmt.Errorf("failed to get component release: %w", err)
+	}
+
+	var environment openchoreov1alpha1.Environment
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: binding.Spec.Environment}, &environment); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Environment not found", "org", orgName, "environment", binding.Spec.Environment)
+			return nil, ErrEnvironmentNotFound
+		}
+		s.logger.Error("Failed to get environment", "error", err)
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	if environment.Spec.DataPlaneRef == "" {
+		s.logger.Warn("Environment has no data plane configured", "org", orgName, "environment", environment.Name)
+		return nil, ErrDataPlaneNotFound
+	}
+	var dataPlane openchoreov1alpha1.DataPlane
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: environment.Spec.DataPlaneRef}, &dataPlane); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("DataPlane not found", "org", orgName, "dataPlane", environment.Spec.DataPlaneRef)
+			return nil, ErrDataPlaneNotFound
+		}
+		s.logger.Error("Failed to get dataplane", "error", err)
+		return nil, fmt.Errorf("failed to get dataplane: %w", err)
+	}
+
+	var component openchoreov1alpha1.Component
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: componentName}, &component); err != nil {
+		s.logger.Error("Failed to get component", "error", err)
+		return nil, fmt.Errorf("failed to get component: %w", err)
+	}
+
+	var project openchoreov1alpha1.Project
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: projectName}, &project); err != nil {
+		s.logger.Error("Failed to get project", "error", err)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	proposed, warnings, err := s.renderReleaseBinding(ctx, &releasebinding.RenderSources{
+		ReleaseBinding:   binding,
+		ComponentRelease: &componentRelease,
+		Environment:      &environment,
+		DataPlane:        &dataPlane,
+		Component:        &component,
+		Project:          &project,
+	})
+	if err != nil {
+		s.logger.Warn("Failed to render release binding", "binding", bindingName, "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrReleaseRenderFailed, err)
+	}
+
+	// A missing Release means every rendered resource is new
+	releaseName := releasebinding.MakeReleaseName(componentName, binding.Spec.Environment)
+	var current []openchoreov1alpha1.Resource
+	var release openchoreov1alpha1.Release
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: releaseName}, &release); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			s.logger.Error("Failed to get release", "error", err)
+			return nil, fmt.Errorf("failed to get release: %w", err)
+		}
+	} else {
+		current = release.Spec.Resources
+	}
+
+	diffs, summary, err := diffReleaseResources(current, proposed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff release resources: %w", err)
+	}
+
+	s.logger.Debug("Dry-run of release binding completed", "org", orgName, "binding", bindingName,
+		"added", summary.Added, "modified", summary.Modified, "removed", summary.Removed)
+
+	return &models.ReleaseBindingDryRunResponse{
+		Binding:     s.toReleaseBindingResponse(binding, orgName, projectName, componentName),
+		ReleaseName: releaseName,
+		Summary:     summary,
+		Resources:   diffs,
+		Warnings:    warnings,
+	}, nil
+}
+
+// renderReleaseBinding renders the Release resources of a ReleaseBinding as its controller would. Bindings with a
+// rollout strategy are rendered with the stable and canary tracks of the rollout step they move to.
+func (s *ComponentService) renderReleaseBinding(ctx context.Context, src *releasebinding.RenderSources) ([]openchoreov1alpha1.Resource, []string, error) {
+	if src.ReleaseBinding.Spec.Rollout != nil {
+		return releasebinding.RenderRollout(ctx, s.k8sClient, s.pipeline, src)
+	}
+
+	renderInput, err := releasebinding.BuildRenderInput(ctx, s.k8sClient, src)
+	if err != nil {
+		return nil, nil, err
+	}
+	renderOutput, err := s.pipeline.Render(renderInput)
+	if err != nil {
+		return nil, nil, err
+	}
+	resources, err := releasebinding.ConvertToReleaseResources(renderOutput.Resources)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resources, renderOutput.Metadata.Warnings, nil
 }
 
 // toReleaseBindingResponse converts a ReleaseBinding CR to a ReleaseBindingResponse
@@ -1021,9 +1264,9 @@ func (s *ComponentService) determineReleaseBindingStatus(binding *openchoreov1al
 	return statusReady
 }
 
-// ListReleaseBindings lists all release bindings for a specific component
+// ListReleaseBindings lists a page of the release bindings of a specific component
 // If environments is provided, only returns bindings for those environments
-func (s *ComponentService) ListReleaseBindings(ctx context.Context, orgName, projectName, componentName string, environments []string) ([]*models.ReleaseBindingResponse, error) {
+func (s *ComponentService) ListReleaseBindings(ctx context.Context, orgName, projectName, componentName string, environments []string, opts *models.ListOptions) (*models.ListResponse[*models.ReleaseBindingResponse], error) {
 	s.logger.Debug("Listing release bindings", "org", orgName, "project", projectName, "component", componentName, "environments", environments)
 
 	_, err := s.projectService.GetProject(ctx, orgName, projectName)
@@ -1053,40 +1296,42 @@ func (s *ComponentService) ListReleaseBindings(ctx context.Context, orgName, pro
 		return nil, ErrComponentNotFound
 	}
 
-	var bindingList openchoreov1alpha1.ReleaseBindingList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &bindingList, listOpts...); err != nil {
-		s.logger.Error("Failed to list release bindings", "error", err)
-		return nil, fmt.Errorf("failed to list release bindings: %w", err)
-	}
-
-	bindings := make([]*models.ReleaseBindingResponse, 0, len(bindingList.Items))
-	for i := range bindingList.Items {
-		if bindingList.Items[i].Spec.Owner.ComponentName != componentName || bindingList.Items[i].Spec.Owner.ProjectName != projectName {
-			continue
-		}
-		binding := &bindingList.Items[i]
-
-		if len(environments) > 0 {
-			matchesEnv := false
-			for _, env := range environments {
-				if binding.Spec.Environment == env {
-					matchesEnv = true
-					break
-				}
+	status := opts.Filter(models.FilterStatus)
+	bindings, err := listItems(ctx, opts, listQuery[*models.ReleaseBindingResponse]{
+		fetch: func(ctx context.Context, listOpts ...client.ListOption) ([]*models.ReleaseBindingResponse, metav1.ListMeta, error) {
+			var bindingList openchoreov1alpha1.ReleaseBindingList
+			if err := s.k8sClient.List(ctx, &bindingList, append(listOpts, client.InNamespace(orgName))...); err != nil {
+				return nil, metav1.ListMeta{}, err
 			}
-			if !matchesEnv {
-				continue
+			items := make([]*models.ReleaseBindingResponse, 0, len(bindingList.Items))
+			for i := range bindingList.Items {
+				binding := &bindingList.Items[i]
+				items = append(items, s.toReleaseBindingResponse(binding, orgName, binding.Spec.Owner.ProjectName, binding.Spec.Owner.ComponentName))
+			}
+			return items, bindingList.ListMeta, nil
+		},
+		keep: func(binding *models.ReleaseBindingResponse) bool {
+			if binding.ComponentName != componentName || binding.ProjectName != projectName {
+				return false
 			}
+			if len(environments) > 0 && !slices.Contains(environments, binding.Environment) {
+				return false
+			}
+			return status == "" || strings.EqualFold(binding.Status, status)
+		},
+		sortKey: func(binding *models.ReleaseBindingResponse) (string, time.Time) {
+			return binding.Name, binding.CreatedAt
+		},
+	})
+	if err != nil {
+		if isListOptionsError(err) {
+			return nil, err
 		}
-
-		bindings = append(bindings, s.toReleaseBindingResponse(binding, orgName, projectName, componentName))
+		s.logger.Error("Failed to list release bindings", "error", err)
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
 	}
 
-	s.logger.Debug("Listed release bindings", "org", orgName, "project", projectName, "component", componentName, "count", len(bindings))
+	s.logger.Debug("Listed release bindings", "org", orgName, "project", projectName, "component", componentName, "count", len(bindings.Items))
 	return bindings, nil
 }
 
@@ -1127,6 +1372,10 @@ func (s *ComponentService) DeployRelease(ctx context.Context, orgName, projectNa
 
 	s.logger.Debug("Found lowest environment", "environment", lowestEnv)
 
+	if err := s.authorizeInEnvironment(ctx, rbac.ActionDeploy, orgName, projectName, componentName, lowestEnv); err != nil {
+		return nil, err
+	}
+
 	// Verify component exists
 	componentKey := client.ObjectKey{
 		Namespace: orgName,
@@ -1183,6 +1432,12 @@ func (s *ComponentService) DeployRelease(ctx context.Context, orgName, projectNa
 		}
 	}
 
+	if !bindingExists || binding.Spec.ReleaseName != req.ReleaseName {
+		if err := s.checkReleaseApproval(ctx, orgName, projectName, lowestEnv); err != nil {
+			return nil, err
+		}
+	}
+
 	if bindingExists {
 		s.logger.Debug("Updating existing release binding", "binding", bindingName)
 		binding.Spec.ReleaseName = req.ReleaseName
@@ -1301,8 +1556,8 @@ func (s *ComponentService) CreateComponent(ctx context.Context, orgName, project
 	}, nil
 }
 
-// ListComponents lists all components in the given project
-func (s *ComponentService) ListComponents(ctx context.Context, orgName, projectName string) ([]*models.ComponentResponse, error) {
+// ListComponents lists a page of the components in the given project
+func (s *ComponentService) ListComponents(ctx context.Context, orgName, projectName string, opts *models.ListOptions) (*models.ListResponse[*models.ComponentResponse], error) {
 	s.logger.Debug("Listing components", "org", orgName, "project", projectName)
 
 	// Verify project exists
@@ -1314,25 +1569,39 @@ func (s *ComponentService) ListComponents(ctx context.Context, orgName, projectN
 		return nil, fmt.Errorf("failed to verify project: %w", err)
 	}
 
-	var componentList openchoreov1alpha1.ComponentList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &componentList, listOpts...); err != nil {
+	componentType := opts.Filter(models.FilterType)
+	components, err := listItems(ctx, opts, listQuery[*models.ComponentResponse]{
+		fetch: func(ctx context.Context, listOpts ...client.ListOption) ([]*models.ComponentResponse, metav1.ListMeta, error) {
+			var componentList openchoreov1alpha1.ComponentList
+			if err := s.k8sClient.List(ctx, &componentList, append(listOpts, client.InNamespace(orgName))...); err != nil {
+				return nil, metav1.ListMeta{}, err
+			}
+			items := make([]*models.ComponentResponse, 0, len(componentList.Items))
+			for _, item := range componentList.Items {
+				items = append(items, s.toComponentResponse(&item, make(map[string]interface{}), false))
+			}
+			return items, componentList.ListMeta, nil
+		},
+		keep: func(component *models.ComponentResponse) bool {
+			// Only include components that belong to the specified project
+			if component.ProjectName != projectName {
+				return false
+			}
+			return componentType == "" || component.Type == componentType
+		},
+		sortKey: func(component *models.ComponentResponse) (string, time.Time) {
+			return component.Name, component.CreatedAt
+		},
+	})
+	if err != nil {
+		if isListOptionsError(err) {
+			return nil, err
+		}
 		s.logger.Error("Failed to list components", "error", err)
 		return nil, fmt.Errorf("failed to list components: %w", err)
 	}
 
-	components := make([]*models.ComponentResponse, 0, len(componentList.Items))
-	for _, item := range componentList.Items {
-		// Only include components that belong to the specified project
-		if item.Spec.Owner.ProjectName == projectName {
-			components = append(components, s.toComponentResponse(&item, make(map[string]interface{}), false))
-		}
-	}
-
-	s.logger.Debug("Listed components", "org", orgName, "project", projectName, "count", len(components))
+	s.logger.Debug("Listed components", "org", orgName, "project", projectName, "count", len(components.Items))
 	return components, nil
 }
 
@@ -1844,7 +2113,14 @@ func (s *ComponentService) PromoteComponent(ctx context.Context, req *PromoteCom
 	s.logger.Debug("Promoting component", "org", req.OrgName, "project", req.ProjectName, "component", req.ComponentName,
 		"source", req.SourceEnvironment, "target", req.TargetEnvironment)
 
-	if err := s.validatePromotionPath(ctx, req.OrgName, req.ProjectName, req.SourceEnvironment, req.TargetEnvironment); err != nil {
+	if err := s.authorizeInEnvironment(ctx, rbac.ActionPromote, req.OrgName, req.ProjectName, req.ComponentName, req.TargetEnvironment); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.validatePromotionPath(ctx, req.OrgName, req.ProjectName, req.SourceEnvironment, req.TargetEnvironment); err != nil {
+		return nil, err
+	}
+	if err := s.checkReleaseApproval(ctx, req.OrgName, req.ProjectName, req.TargetEnvironment); err != nil {
 		return nil, err
 	}
 
@@ -1853,7 +2129,7 @@ func (s *ComponentService) PromoteComponent(ctx context.Context, req *PromoteCom
 		return nil, fmt.Errorf("failed to get source release binding: %w", err)
 	}
 
-	if err := s.createOrUpdateReleaseBinding(ctx, req, sourceReleaseBinding); err != nil {
+	if err := s.createOrUpdateReleaseBinding(ctx, req, sourceReleaseBinding.Spec.ReleaseName); err != nil {
 		return nil, fmt.Errorf("failed to create/update target release binding: %w", err)
 	}
 
@@ -1907,11 +2183,12 @@ func (s *ComponentService) mapConditionToBindingStatus(condition metav1.Conditio
 }
 
 // validatePromotionPath validates that the promotion path is allowed by the deployment pipeline
-func (s *ComponentService) validatePromotionPath(ctx context.Context, orgName, projectName, sourceEnv, targetEnv string) error {
+// and returns the target environment reference of the path
+func (s *ComponentService) validatePromotionPath(ctx context.Context, orgName, projectName, sourceEnv, targetEnv string) (*openchoreov1alpha1.TargetEnvironmentRef, error) {
 	// Get the project to determine the deployment pipeline reference
 	project, err := s.projectService.GetProject(ctx, orgName, projectName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var pipelineName string
@@ -1930,9 +2207,9 @@ func (s *ComponentService) validatePromotionPath(ctx context.Context, orgName, p
 
 	if err := s.k8sClient.Get(ctx, key, pipeline); err != nil {
 		if client.IgnoreNotFound(err) == nil {
-			return ErrDeploymentPipelineNotFound
+			return nil, ErrDeploymentPipelineNotFound
 		}
-		return fmt.Errorf("failed to get deployment pipeline: %w", err)
+		return nil, fmt.Errorf("failed to get deployment pipeline: %w", err)
 	}
 
 	s.logger.Info("Promotion paths", "promotionPaths", pipeline.Spec.PromotionPaths)
@@ -1941,19 +2218,20 @@ func (s *ComponentService) validatePromotionPath(ctx context.Context, orgName, p
 	for _, path := range pipeline.Spec.PromotionPaths {
 		if path.SourceEnvironmentRef == sourceEnv {
 			s.logger.Info("Source environment", "source", sourceEnv)
-			for _, target := range path.TargetEnvironmentRefs {
+			for i := range path.TargetEnvironmentRefs {
+				target := &path.TargetEnvironmentRefs[i]
 				s.logger.Info("Target environment", "target", target.Name)
 				if target.Name == targetEnv {
 					s.logger.Info("Valid promotion path found", "source", sourceEnv, "target", targetEnv)
 					s.logger.Debug("Valid promotion path found", "source", sourceEnv, "target", targetEnv)
-					return nil
+					return target, nil
 				}
 			}
 		}
 	}
 
 	s.logger.Warn("Invalid promotion path", "source", sourceEnv, "target", targetEnv, "pipeline", pipelineName)
-	return ErrInvalidPromotionPath
+	return nil, ErrInvalidPromotionPath
 }
 
 // getReleaseBinding retrieves a ReleaseBinding for a component in a specific environment
@@ -1984,8 +2262,8 @@ func (s *ComponentService) getReleaseBinding(ctx context.Context, orgName, proje
 	return nil, ErrReleaseBindingNotFound
 }
 
-// createOrUpdateReleaseBinding creates or updates a ReleaseBinding in the target environment
-func (s *ComponentService) createOrUpdateReleaseBinding(ctx context.Context, req *PromoteComponentPayload, sourceBinding *openchoreov1alpha1.ReleaseBinding) error {
+// createOrUpdateReleaseBinding binds the given release to the target environment by creating or updating its ReleaseBinding
+func (s *ComponentService) createOrUpdateReleaseBinding(ctx context.Context, req *PromoteComponentPayload, releaseName string) error {
 	// Check if there's already a binding for this component in the target environment
 	existingTargetBinding, err := s.getReleaseBinding(ctx, req.OrgName, req.ProjectName, req.ComponentName, req.TargetEnvironment)
 	var targetBindingName string
@@ -2020,12 +2298,12 @@ func (s *ComponentService) createOrUpdateReleaseBinding(ctx context.Context, req
 					ComponentName: req.ComponentName,
 				},
 				Environment: req.TargetEnvironment,
-				ReleaseName: sourceBinding.Spec.ReleaseName,
+				ReleaseName: releaseName,
 			},
 		}
 	} else {
 		targetBinding = existingTargetBinding
-		targetBinding.Spec.ReleaseName = sourceBinding.Spec.ReleaseName
+		targetBinding.Spec.ReleaseName = releaseName
 	}
 
 	if existingTargetBinding == nil {
@@ -2102,7 +2380,20 @@ func (s *ComponentService) getScheduledTaskBindingCR(ctx context.Context, orgNam
 	return nil, ErrBindingNotFound
 }
 
-// UpdateComponentBinding updates a component binding
+// authorizeBindingUpdate checks that a binding belongs to the component and that the user of the request
+// may deploy the component to the environment of the binding
+func (s *ComponentService) authorizeBindingUpdate(ctx context.Context, orgName, projectName, componentName, bindingName,
+	ownerProject, ownerComponent, environment string) error {
+	if ownerProject != projectName || ownerComponent != componentName {
+		s.logger.Warn("Binding does not belong to component", "org", orgName, "project", projectName,
+			"component", componentName, "binding", bindingName)
+		return ErrBindingNotFound
+	}
+	return s.authorizeInEnvironment(ctx, rbac.ActionDeploy, orgName, projectName, componentName, environment)
+}
+
+// UpdateComponentBinding updates a component binding. The user must be allowed to deploy the component to the
+// environment of the binding.
 func (s *ComponentService) UpdateComponentBinding(ctx context.Context, orgName, projectName, componentName, bindingName string, req *models.UpdateBindingRequest) (*models.BindingResponse, error) {
 	s.logger.Debug("Updating component binding", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
 
@@ -2151,6 +2442,10 @@ func (s *ComponentService) UpdateComponentBinding(ctx context.Context, orgName,
 			s.logger.Warn("Service binding not found", "binding", bindingName)
 			return nil, ErrBindingNotFound
 		}
+		if err := s.authorizeBindingUpdate(ctx, orgName, projectName, componentName, bindingName,
+			binding.Spec.Owner.ProjectName, binding.Spec.Owner.ComponentName, binding.Spec.Environment); err != nil {
+			return nil, err
+		}
 
 		// Update the releaseState
 		binding.Spec.ReleaseState = openchoreov1alpha1.ReleaseState(req.ReleaseState)
@@ -2186,6 +2481,10 @@ func (s *ComponentService) UpdateComponentBinding(ctx context.Context, orgName,
 			s.logger.Warn("Web application binding not found", "binding", bindingName)
 			return nil, ErrBindingNotFound
 		}
+		if err := s.authorizeBindingUpdate(ctx, orgName, projectName, componentName, bindingName,
+			binding.Spec.Owner.ProjectName, binding.Spec.Owner.ComponentName, binding.Spec.Environment); err != nil {
+			return nil, err
+		}
 
 		// Update the releaseState
 		binding.Spec.ReleaseState = openchoreov1alpha1.ReleaseState(req.ReleaseState)
@@ -2221,6 +2520,10 @@ func (s *ComponentService) UpdateComponentBinding(ctx context.Context, orgName,
 			s.logger.Warn("Scheduled task binding not found", "binding", bindingName)
 			return nil, ErrBindingNotFound
 		}
+		if err := s.authorizeBindingUpdate(ctx, orgName, projectName, componentName, bindingName,
+			binding.Spec.Owner.ProjectName, binding.Spec.Owner.ComponentName, binding.Spec.Environment); err != nil {
+			return nil, err
+		}
 
 		// Update the releaseState
 		binding.Spec.ReleaseState = openchoreov1alpha1.ReleaseState(req.ReleaseState)
@@ -4,12 +4,18 @@
 package services
 
 import (
+	"context"
+	"errors"
+	"log/slog"
 	"testing"
 
+	gojwt "github.com/golang-jwt/jwt/v5"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
 )
 
 // TestFindLowestEnvironment tests the findLowestEnvironment helper method
@@ -606,3 +612,47 @@ func TestDetermineReleaseBindingStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthorizeBindingUpdate(t *testing.T) {
+	policy, err := rbac.ParsePolicy([]byte(`
+protectedEnvironments: [production]
+roles:
+  - name: developer
+    actions: [view, deploy]
+bindings:
+  - role: developer
+    groups: [shop-devs]
+    scope:
+      org: acme
+      project: shop
+`))
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+	service := &ComponentService{authorizer: rbac.NewAuthorizer(policy), logger: slog.New(slog.DiscardHandler)}
+	ctx := jwt.NewContextWithClaims(context.Background(), gojwt.MapClaims{"sub": "dev", "groups": "shop-devs"})
+
+	tests := []struct {
+		name           string
+		ownerComponent string
+		environment    string
+		wantErr        error
+	}{
+		{name: "Deploy to development", ownerComponent: "cart", environment: "development"},
+		{name: "Deploy to protected environment", ownerComponent: "cart", environment: "production", wantErr: rbac.ErrPermissionDenied},
+		{name: "Binding of another component", ownerComponent: "checkout", environment: "development", wantErr: ErrBindingNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.authorizeBindingUpdate(ctx, "acme", "shop", "cart", "cart-binding",
+				"shop", tt.ownerComponent, tt.environment)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("authorizeBindingUpdate() error = %v, want allowed", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("authorizeBindingUpdate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -8,8 +8,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"time"
 
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
@@ -33,26 +35,35 @@ func NewComponentTypeService(k8sClient client.Client, logger *slog.Logger) *Comp
 	}
 }
 
-// ListComponentTypes lists all ComponentTypes in the given organization
-func (s *ComponentTypeService) ListComponentTypes(ctx context.Context, orgName string) ([]*models.ComponentTypeResponse, error) {
+// ListComponentTypes lists a page of the ComponentTypes in the given organization
+func (s *ComponentTypeService) ListComponentTypes(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListResponse[*models.ComponentTypeResponse], error) {
 	s.logger.Debug("Listing ComponentTypes", "org", orgName)
 
-	var ctList openchoreov1alpha1.ComponentTypeList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &ctList, listOpts...); err != nil {
+	cts, err := listItems(ctx, opts, listQuery[*models.ComponentTypeResponse]{
+		fetch: func(ctx context.Context, listOpts ...client.ListOption) ([]*models.ComponentTypeResponse, metav1.ListMeta, error) {
+			var ctList openchoreov1alpha1.ComponentTypeList
+			if err := s.k8sClient.List(ctx, &ctList, append(listOpts, client.InNamespace(orgName))...); err != nil {
+				return nil, metav1.ListMeta{}, err
+			}
+			items := make([]*models.ComponentTypeResponse, 0, len(ctList.Items))
+			for i := range ctList.Items {
+				items = append(items, s.toComponentTypeResponse(&ctList.Items[i]))
+			}
+			return items, ctList.ListMeta, nil
+		},
+		sortKey: func(componentType *models.ComponentTypeResponse) (string, time.Time) {
+			return componentType.Name, componentType.CreatedAt
+		},
+	})
+	if err != nil {
+		if isListOptionsError(err) {
+			return nil, err
+		}
 		s.logger.Error("Failed to list ComponentTypes", "error", err)
 		return nil, fmt.Errorf("failed to list ComponentTypes: %w", err)
 	}
 
-	cts := make([]*models.ComponentTypeResponse, 0, len(ctList.Items))
-	for i := range ctList.Items {
-		cts = append(cts, s.toComponentTypeResponse(&ctList.Items[i]))
-	}
-
-	s.logger.Debug("Listed ComponentTypes", "org", orgName, "count", len(cts))
+	s.logger.Debug("Listed ComponentTypes", "org", orgName, "count", len(cts.Items))
 	return cts, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,26 +32,35 @@ func NewDataPlaneService(k8sClient client.Client, logger *slog.Logger) *DataPlan
 	}
 }
 
-// ListDataPlanes lists all dataplanes in the specified organization
-func (s *DataPlaneService) ListDataPlanes(ctx context.Context, orgName string) ([]*models.DataPlaneResponse, error) {
+// ListDataPlanes lists a page of the dataplanes in the specified organization
+func (s *DataPlaneService) ListDataPlanes(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListResponse[*models.DataPlaneResponse], error) {
 	s.logger.Debug("Listing dataplanes", "org", orgName)
 
-	var dpList openchoreov1alpha1.DataPlaneList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &dpList, listOpts...); err != nil {
+	dataplanes, err := listItems(ctx, opts, listQuery[*models.DataPlaneResponse]{
+		fetch: func(ctx context.Context, listOpts ...client.ListOption) ([]*models.DataPlaneResponse, metav1.ListMeta, error) {
+			var dpList openchoreov1alpha1.DataPlaneList
+			if err := s.k8sClient.List(ctx, &dpList, append(listOpts, client.InNamespace(orgName))...); err != nil {
+				return nil, metav1.ListMeta{}, err
+			}
+			items := make([]*models.DataPlaneResponse, 0, len(dpList.Items))
+			for i := range dpList.Items {
+				items = append(items, s.toDataPlaneResponse(&dpList.Items[i]))
+			}
+			return items, dpList.ListMeta, nil
+		},
+		sortKey: func(dataplane *models.DataPlaneResponse) (string, time.Time) {
+			return dataplane.Name, dataplane.CreatedAt
+		},
+	})
+	if err != nil {
+		if isListOptionsError(err) {
+			return nil, err
+		}
 		s.logger.Error("Failed to list dataplanes", "error", err, "org", orgName)
 		return nil, fmt.Errorf("failed to list dataplanes: %w", err)
 	}
 
-	dataplanes := make([]*models.DataPlaneResponse, 0, len(dpList.Items))
-	for _, item := range dpList.Items {
-		dataplanes = append(dataplanes, s.toDataPlaneResponse(&item))
-	}
-
-	s.logger.Debug("Listed dataplanes", "count", len(dataplanes), "org", orgName)
+	s.logger.Debug("Listed dataplanes", "count", len(dataplanes.Items), "org", orgName)
 	return dataplanes, nil
 }
 
@@ -137,7 +147,7 @@ func (s *DataPlaneService) buildDataPlaneCR(orgName string, req *models.CreateDa
 	}
 
 	spec := openchoreov1alpha1.DataPlaneSpec{
-		KubernetesCluster: openchoreov1alpha1.KubernetesClusterSpec{
+		KubernetesCluster: &openchoreov1alpha1.KubernetesClusterSpec{
 			Server: req.APIServerURL,
 			TLS: openchoreov1alpha1.KubernetesTLS{
 				CA: openchoreov1alpha1.ValueFrom{
@@ -219,6 +229,12 @@ func (s *DataPlaneService) toDataPlaneResponse(dp *openchoreov1alpha1.DataPlane)
 		secretStoreRef = dp.Spec.SecretStoreRef.Name
 	}
 
+	// Data planes managed by an agent do not expose their API server to the control plane
+	var apiServerURL string
+	if dp.Spec.KubernetesCluster != nil {
+		apiServerURL = dp.Spec.KubernetesCluster.Server
+	}
+
 	response := &models.DataPlaneResponse{
 		Name:                    dp.Name,
 		Namespace:               dp.Namespace,
@@ -227,7 +243,7 @@ func (s *DataPlaneService) toDataPlaneResponse(dp *openchoreov1alpha1.DataPlane)
 		ImagePullSecretRefs:     dp.Spec.ImagePullSecretRefs,
 		SecretStoreRef:          secretStoreRef,
 		KubernetesClusterName:   dp.Name,
-		APIServerURL:            dp.Spec.KubernetesCluster.Server,
+		APIServerURL:            apiServerURL,
 		PublicVirtualHost:       dp.Spec.Gateway.PublicVirtualHost,
 		OrganizationVirtualHost: dp.Spec.Gateway.OrganizationVirtualHost,
 		CreatedAt:               dp.CreationTimestamp.Time,
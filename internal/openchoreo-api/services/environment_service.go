@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,26 +32,35 @@ func NewEnvironmentService(k8sClient client.Client, logger *slog.Logger) *Enviro
 	}
 }
 
-// ListEnvironments lists all environments in the specified organization
-func (s *EnvironmentService) ListEnvironments(ctx context.Context, orgName string) ([]*models.EnvironmentResponse, error) {
+// ListEnvironments lists a page of the environments in the specified organization
+func (s *EnvironmentService) ListEnvironments(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListResponse[*models.EnvironmentResponse], error) {
 	s.logger.Debug("Listing environments", "org", orgName)
 
-	var envList openchoreov1alpha1.EnvironmentList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &envList, listOpts...); err != nil {
+	environments, err := listItems(ctx, opts, listQuery[*models.EnvironmentResponse]{
+		fetch: func(ctx context.Context, listOpts ...client.ListOption) ([]*models.EnvironmentResponse, metav1.ListMeta, error) {
+			var envList openchoreov1alpha1.EnvironmentList
+			if err := s.k8sClient.List(ctx, &envList, append(listOpts, client.InNamespace(orgName))...); err != nil {
+				return nil, metav1.ListMeta{}, err
+			}
+			items := make([]*models.EnvironmentResponse, 0, len(envList.Items))
+			for i := range envList.Items {
+				items = append(items, s.toEnvironmentResponse(&envList.Items[i]))
+			}
+			return items, envList.ListMeta, nil
+		},
+		sortKey: func(environment *models.EnvironmentResponse) (string, time.Time) {
+			return environment.Name, environment.CreatedAt
+		},
+	})
+	if err != nil {
+		if isListOptionsError(err) {
+			return nil, err
+		}
 		s.logger.Error("Failed to list environments", "error", err, "org", orgName)
 		return nil, fmt.Errorf("failed to list environments: %w", err)
 	}
 
-	environments := make([]*models.EnvironmentResponse, 0, len(envList.Items))
-	for _, item := range envList.Items {
-		environments = append(environments, s.toEnvironmentResponse(&item))
-	}
-
-	s.logger.Debug("Listed environments", "count", len(environments), "org", orgName)
+	s.logger.Debug("Listed environments", "count", len(environments.Items), "org", orgName)
 	return environments, nil
 }
 
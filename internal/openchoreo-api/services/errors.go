@@ -29,6 +29,18 @@ var (
 	ErrReleaseBindingNotFound     = errors.New("release binding not found")
 	ErrWorkflowSchemaInvalid      = errors.New("workflow schema is invalid")
 	ErrReleaseNotFound            = errors.New("release not found")
+	ErrReleaseRenderFailed        = errors.New("failed to render release")
+	ErrPromotionApprovalRequired  = errors.New("promotion requires approval")
+	ErrPromotionRequestNotFound   = errors.New("promotion request not found")
+	ErrPromotionRequestExists     = errors.New("a pending promotion request already exists")
+	ErrPromotionRequestReviewed   = errors.New("promotion request has already been reviewed")
+	ErrPromotionSelfApproval      = errors.New("promotion request cannot be approved by its requester")
+	ErrReleaseNotInHistory        = errors.New("release is not in the release binding history")
+	ErrReleaseAlreadyBound        = errors.New("release is already bound")
+	ErrInvalidListOptions         = errors.New("invalid list options")
+	ErrInvalidCursor              = errors.New("list cursor is invalid or has expired")
+	ErrInvalidWatchOptions        = errors.New("invalid watch options")
+	ErrWatchExpired               = errors.New("resource version is too old to resume the watch from")
 )
 
 // Error codes for API responses
@@ -54,7 +66,17 @@ const (
 	CodeComponentReleaseNotFound   = "COMPONENT_RELEASE_NOT_FOUND"
 	CodeReleaseBindingNotFound     = "RELEASE_BINDING_NOT_FOUND"
 	CodeReleaseNotFound            = "RELEASE_NOT_FOUND"
+	CodeReleaseRenderFailed        = "RELEASE_RENDER_FAILED"
+	CodePromotionApprovalRequired  = "PROMOTION_APPROVAL_REQUIRED"
+	CodePromotionRequestNotFound   = "PROMOTION_REQUEST_NOT_FOUND"
+	CodePromotionRequestExists     = "PROMOTION_REQUEST_EXISTS"
+	CodePromotionRequestReviewed   = "PROMOTION_REQUEST_REVIEWED"
+	CodePromotionSelfApproval      = "PROMOTION_SELF_APPROVAL"
+	CodeReleaseNotInHistory        = "RELEASE_NOT_IN_HISTORY"
+	CodeReleaseAlreadyBound        = "RELEASE_ALREADY_BOUND"
 	CodeInvalidInput               = "INVALID_INPUT"
+	CodeInvalidCursor              = "INVALID_CURSOR"
+	CodeWatchExpired               = "WATCH_EXPIRED"
 	CodeInternalError              = "INTERNAL_ERROR"
 	CodeWorkflowSchemaInvalid      = "WORKFLOW_SCHEMA_INVALID"
 )
@@ -0,0 +1,255 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// listCursor is the position of a page in a list, handed to clients as an opaque base64 encoded cursor.
+// Pages sorted by name in ascending order, the order Kubernetes lists resources in, resume from the continue
+// token of the Kubernetes list. Pages in any other order are cut out of the sorted list at the offset.
+type listCursor struct {
+	Continue string `json:"continue,omitempty"`
+	// Skip is the number of items of the chunk at the continue token that earlier pages went through
+	Skip   int    `json:"skip,omitempty"`
+	Offset int    `json:"offset,omitempty"`
+	SortBy string `json:"sortBy"`
+	Order  string `json:"order"`
+}
+
+func (c *listCursor) encode() string {
+	data, _ := json.Marshal(c) // A struct of strings and ints always marshals
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeListCursor(cursor string) (*listCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	var c listCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	if c.Offset < 0 || c.Skip < 0 {
+		return nil, fmt.Errorf("negative offset %d or skip %d", c.Offset, c.Skip)
+	}
+	return &c, nil
+}
+
+// listFetcher lists a resource with the given list options and converts all listed items to their API responses
+type listFetcher[T any] func(ctx context.Context, opts ...client.ListOption) ([]T, metav1.ListMeta, error)
+
+// listQuery describes how to list the items of a list endpoint
+type listQuery[T any] struct {
+	fetch listFetcher[T]
+	// keep reports whether an item matches the filters Kubernetes cannot apply, such as the owner of the item.
+	// All items are kept when it is nil, and only then is the total counted from the remaining items of Kubernetes.
+	keep func(T) bool
+	// sortKey returns the name and the creation time of an item
+	sortKey func(T) (string, time.Time)
+}
+
+// listItems lists a page of items with the pagination, filtering and sorting options of a list request.
+// Items are sorted by name in ascending order unless the options say otherwise, and all items are returned
+// when the options do not set a limit.
+func listItems[T any](ctx context.Context, opts *models.ListOptions, query listQuery[T]) (*models.ListResponse[T], error) {
+	var o models.ListOptions
+	if opts != nil {
+		o = *opts
+	}
+	if err := o.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidListOptions, err)
+	}
+	if o.SortBy == "" {
+		o.SortBy = models.SortByName
+	}
+	if o.Order == "" {
+		o.Order = models.SortOrderAsc
+	}
+
+	cursor := &listCursor{SortBy: o.SortBy, Order: o.Order}
+	if o.Cursor != "" {
+		decoded, err := decodeListCursor(o.Cursor)
+		if err != nil || decoded.SortBy != o.SortBy || decoded.Order != o.Order {
+			return nil, ErrInvalidCursor
+		}
+		cursor = decoded
+	}
+
+	var listOpts []client.ListOption
+	if o.LabelSelector != "" {
+		selector, err := labels.Parse(o.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidListOptions, err)
+		}
+		listOpts = append(listOpts, client.MatchingLabelsSelector{Selector: selector})
+	}
+
+	if o.Limit > 0 && o.SortBy == models.SortByName && o.Order == models.SortOrderAsc {
+		return listChunks(ctx, o.Limit, cursor, listOpts, query)
+	}
+	return listSorted(ctx, o.Limit, cursor, listOpts, query)
+}
+
+// listChunks lists a page in the order of Kubernetes using the limit and continue token of Kubernetes lists.
+// Chunks of the size of the page are fetched until the page is full, so that items dropped by the filters do
+// not cut the page short. A page that fills up before the end of a chunk resumes inside that chunk by skipping
+// the items of the chunk the earlier pages went through.
+func listChunks[T any](ctx context.Context, limit int, cursor *listCursor, listOpts []client.ListOption, query listQuery[T]) (*models.ListResponse[T], error) {
+	if cursor.Offset > 0 && cursor.Continue == "" && cursor.Skip == 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	items := make([]T, 0, limit)
+	token := cursor.Continue
+	skip := cursor.Skip
+	var next *listCursor
+	var remaining *int64
+	// Items of the last chunk left for the next page
+	left := 0
+	for {
+		chunkToken := token
+		chunkOpts := append(slices.Clone(listOpts), client.Limit(int64(limit)), client.Continue(chunkToken))
+		chunk, meta, err := query.fetch(ctx, chunkOpts...)
+		if err != nil {
+			if chunkToken != "" && (apierrors.IsResourceExpired(err) || apierrors.IsGone(err) || apierrors.IsBadRequest(err)) {
+				return nil, ErrInvalidCursor
+			}
+			return nil, err
+		}
+		token = meta.Continue
+		remaining = meta.RemainingItemCount
+
+		for i := min(skip, len(chunk)); i < len(chunk); i++ {
+			if query.keep != nil && !query.keep(chunk[i]) {
+				continue
+			}
+			if len(items) == limit {
+				next = &listCursor{Continue: chunkToken, Skip: i}
+				left = len(chunk) - i
+				break
+			}
+			items = append(items, chunk[i])
+		}
+		skip = max(skip-len(chunk), 0)
+		if next != nil || token == "" {
+			break
+		}
+		if len(items) == limit {
+			next = &listCursor{Continue: token, Skip: skip}
+			break
+		}
+	}
+
+	// The total is only known up to this page unless Kubernetes counted the remaining items and none are filtered out
+	total := cursor.Offset + len(items)
+	if next != nil && query.keep == nil {
+		total += left
+		if remaining != nil {
+			total += int(*remaining)
+		}
+	}
+	response := &models.ListResponse[T]{
+		Items:      items,
+		TotalCount: total,
+		Page:       cursor.Offset/limit + 1,
+		PageSize:   limit,
+	}
+	if next != nil {
+		next.Offset = cursor.Offset + len(items)
+		next.SortBy = cursor.SortBy
+		next.Order = cursor.Order
+		response.NextCursor = next.encode()
+	}
+	return response, nil
+}
+
+// listSorted lists all items, sorts them and cuts the page out of the sorted list at the offset of the cursor
+func listSorted[T any](ctx context.Context, limit int, cursor *listCursor, listOpts []client.ListOption, query listQuery[T]) (*models.ListResponse[T], error) {
+	if cursor.Continue != "" {
+		return nil, ErrInvalidCursor
+	}
+
+	fetched, _, err := query.fetch(ctx, listOpts...)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]T, 0, len(fetched))
+	for _, item := range fetched {
+		if query.keep == nil || query.keep(item) {
+			items = append(items, item)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		iName, iCreated := query.sortKey(items[i])
+		jName, jCreated := query.sortKey(items[j])
+		if cursor.Order == models.SortOrderDesc {
+			iName, jName = jName, iName
+			iCreated, jCreated = jCreated, iCreated
+		}
+		if cursor.SortBy == models.SortByCreatedAt && !iCreated.Equal(jCreated) {
+			return iCreated.Before(jCreated)
+		}
+		return iName < jName
+	})
+
+	total := len(items)
+	start := min(cursor.Offset, total)
+	end := total
+	if limit > 0 {
+		end = min(start+limit, total)
+	}
+	response := &models.ListResponse[T]{
+		Items:      items[start:end],
+		TotalCount: total,
+		Page:       1,
+		PageSize:   end - start,
+	}
+	if limit > 0 {
+		response.Page = start/limit + 1
+		response.PageSize = limit
+	}
+	if end < total {
+		next := &listCursor{Offset: end, SortBy: cursor.SortBy, Order: cursor.Order}
+		response.NextCursor = next.encode()
+	}
+	return response, nil
+}
+
+// withDefaultSort returns a copy of the list options that sorts by the given field and order unless the options set a sort field
+func withDefaultSort(opts *models.ListOptions, sortBy, order string) *models.ListOptions {
+	o := models.ListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.SortBy == "" {
+		o.SortBy = sortBy
+		if o.Order == "" {
+			o.Order = order
+		}
+	}
+	return &o
+}
+
+// isListOptionsError reports whether err is caused by the list options of the request rather than by listing the items
+func isListOptionsError(err error) bool {
+	return errors.Is(err, ErrInvalidListOptions) || errors.Is(err, ErrInvalidCursor)
+}
@@ -0,0 +1,249 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+type testListItem struct {
+	name      string
+	createdAt time.Time
+}
+
+// chunkedFetcher serves the items in chunks the way the Kubernetes API server does, using the position of
+// the next item as the continue token
+func chunkedFetcher(items []testListItem, calls *int) listFetcher[testListItem] {
+	return func(ctx context.Context, opts ...client.ListOption) ([]testListItem, metav1.ListMeta, error) {
+		*calls++
+		listOpts := &client.ListOptions{}
+		listOpts.ApplyOptions(opts)
+
+		start := 0
+		if listOpts.Continue != "" {
+			start, _ = strconv.Atoi(listOpts.Continue)
+		}
+		end := len(items)
+		if listOpts.Limit > 0 {
+			end = min(start+int(listOpts.Limit), len(items))
+		}
+
+		meta := metav1.ListMeta{}
+		if end < len(items) {
+			meta.Continue = strconv.Itoa(end)
+			remaining := int64(len(items) - end)
+			meta.RemainingItemCount = &remaining
+		}
+		return items[start:end], meta, nil
+	}
+}
+
+func testListQuery(items []testListItem, calls *int, keep func(testListItem) bool) listQuery[testListItem] {
+	return listQuery[testListItem]{
+		fetch: chunkedFetcher(items, calls),
+		keep:  keep,
+		sortKey: func(item testListItem) (string, time.Time) {
+			return item.name, item.createdAt
+		},
+	}
+}
+
+func itemNames(items []testListItem) []string {
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		names = append(names, item.name)
+	}
+	return names
+}
+
+// listAllPages follows the cursors of a list until the last page, returning the names of the items of each page
+func listAllPages(t *testing.T, opts models.ListOptions, query listQuery[testListItem]) [][]string {
+	t.Helper()
+	var pages [][]string
+	for {
+		page, err := listItems(context.Background(), &opts, query)
+		if err != nil {
+			t.Fatalf("listItems() error = %v", err)
+		}
+		pages = append(pages, itemNames(page.Items))
+		if page.NextCursor == "" {
+			return pages
+		}
+		if len(pages) > 10 {
+			t.Fatalf("listItems() did not reach the last page")
+		}
+		opts.Cursor = page.NextCursor
+	}
+}
+
+func TestListItems(t *testing.T) {
+	base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	// Kubernetes lists items in the order of their names
+	items := []testListItem{
+		{name: "a", createdAt: base.Add(3 * time.Hour)},
+		{name: "b", createdAt: base.Add(1 * time.Hour)},
+		{name: "c", createdAt: base.Add(4 * time.Hour)},
+		{name: "d", createdAt: base.Add(2 * time.Hour)},
+		{name: "e", createdAt: base},
+	}
+	notC := func(item testListItem) bool { return item.name != "c" }
+
+	tests := []struct {
+		name      string
+		opts      models.ListOptions
+		keep      func(testListItem) bool
+		wantPages [][]string
+	}{
+		{
+			name:      "All items without a limit",
+			wantPages: [][]string{{"a", "b", "c", "d", "e"}},
+		},
+		{
+			name:      "Pages of Kubernetes chunks",
+			opts:      models.ListOptions{Limit: 2},
+			wantPages: [][]string{{"a", "b"}, {"c", "d"}, {"e"}},
+		},
+		{
+			name:      "Filtered items do not shorten the pages",
+			opts:      models.ListOptions{Limit: 2},
+			keep:      notC,
+			wantPages: [][]string{{"a", "b"}, {"d", "e"}},
+		},
+		{
+			name:      "Pages resume inside a chunk",
+			opts:      models.ListOptions{Limit: 2},
+			keep:      func(item testListItem) bool { return item.name != "a" },
+			wantPages: [][]string{{"b", "c"}, {"d", "e"}},
+		},
+		{
+			name:      "Sorted by creation time",
+			opts:      models.ListOptions{Limit: 2, SortBy: models.SortByCreatedAt},
+			wantPages: [][]string{{"e", "b"}, {"d", "a"}, {"c"}},
+		},
+		{
+			name:      "Sorted by name in descending order",
+			opts:      models.ListOptions{Limit: 3, Order: models.SortOrderDesc},
+			keep:      notC,
+			wantPages: [][]string{{"e", "d", "b"}, {"a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			pages := listAllPages(t, tt.opts, testListQuery(items, &calls, tt.keep))
+			if diff := cmp.Diff(tt.wantPages, pages); diff != "" {
+				t.Errorf("pages mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListItemsTotalCount(t *testing.T) {
+	items := []testListItem{{name: "a"}, {name: "b"}, {name: "c"}}
+	calls := 0
+
+	page, err := listItems(context.Background(), &models.ListOptions{Limit: 1}, testListQuery(items, &calls, nil))
+	if err != nil {
+		t.Fatalf("listItems() error = %v", err)
+	}
+	if page.TotalCount != 3 || page.Page != 1 || page.PageSize != 1 {
+		t.Errorf("listItems() = total %d, page %d, page size %d, want 3, 1, 1", page.TotalCount, page.Page, page.PageSize)
+	}
+	if calls != 1 {
+		t.Errorf("fetch called %d times, want 1", calls)
+	}
+}
+
+func TestListItemsChunkSize(t *testing.T) {
+	items := []testListItem{{name: "a"}, {name: "b"}, {name: "c"}, {name: "d"}, {name: "e"}, {name: "f"}}
+	calls := 0
+	query := testListQuery(items, &calls, func(item testListItem) bool { return item.name != "b" })
+	fetch := query.fetch
+	var limits []int64
+	query.fetch = func(ctx context.Context, opts ...client.ListOption) ([]testListItem, metav1.ListMeta, error) {
+		listOpts := &client.ListOptions{}
+		listOpts.ApplyOptions(opts)
+		limits = append(limits, listOpts.Limit)
+		return fetch(ctx, opts...)
+	}
+
+	pages := listAllPages(t, models.ListOptions{Limit: 3}, query)
+	if diff := cmp.Diff([][]string{{"a", "c", "d"}, {"e", "f"}}, pages); diff != "" {
+		t.Errorf("pages mismatch (-want +got):\n%s", diff)
+	}
+	for _, limit := range limits {
+		if limit != 3 {
+			t.Errorf("chunk limits = %v, want the page limit of every chunk", limits)
+			break
+		}
+	}
+}
+
+func TestListItemsInvalidOptions(t *testing.T) {
+	items := []testListItem{{name: "a"}, {name: "b"}, {name: "c"}}
+	calls := 0
+	query := testListQuery(items, &calls, nil)
+
+	page, err := listItems(context.Background(), &models.ListOptions{Limit: 1}, query)
+	if err != nil {
+		t.Fatalf("listItems() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		opts    models.ListOptions
+		wantErr error
+	}{
+		{name: "Invalid limit", opts: models.ListOptions{Limit: -1}, wantErr: ErrInvalidListOptions},
+		{name: "Malformed cursor", opts: models.ListOptions{Limit: 1, Cursor: "not-a-cursor"}, wantErr: ErrInvalidCursor},
+		{
+			name:    "Cursor of another sort order",
+			opts:    models.ListOptions{Limit: 1, Cursor: page.NextCursor, SortBy: models.SortByCreatedAt},
+			wantErr: ErrInvalidCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := listItems(context.Background(), &tt.opts, query)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("listItems() error = %v, want %v", err, tt.wantErr)
+			}
+			if !isListOptionsError(err) {
+				t.Errorf("isListOptionsError(%v) = false, want true", err)
+			}
+			if tt.wantErr == ErrInvalidListOptions && !strings.Contains(err.Error(), "limit") {
+				t.Errorf("listItems() error = %v, want the invalid option named", err)
+			}
+		})
+	}
+}
+
+func TestWithDefaultSort(t *testing.T) {
+	opts := withDefaultSort(nil, models.SortByCreatedAt, models.SortOrderDesc)
+	if opts.SortBy != models.SortByCreatedAt || opts.Order != models.SortOrderDesc {
+		t.Errorf("withDefaultSort(nil) = %+v", opts)
+	}
+
+	requested := &models.ListOptions{SortBy: models.SortByName}
+	opts = withDefaultSort(requested, models.SortByCreatedAt, models.SortOrderDesc)
+	if opts.SortBy != models.SortByName || opts.Order != "" {
+		t.Errorf("withDefaultSort() = %+v, want the requested sort order", opts)
+	}
+	if opts == requested {
+		t.Errorf("withDefaultSort() returned the options instead of a copy")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -30,22 +31,33 @@ func NewOrganizationService(k8sClient client.Client, logger *slog.Logger) *Organ
 	}
 }
 
-// ListOrganizations lists all organizations
-func (s *OrganizationService) ListOrganizations(ctx context.Context) ([]*models.OrganizationResponse, error) {
+// ListOrganizations lists a page of organizations
+func (s *OrganizationService) ListOrganizations(ctx context.Context, opts *models.ListOptions) (*models.ListResponse[*models.OrganizationResponse], error) {
 	s.logger.Debug("Listing organizations")
 
-	var orgList openchoreov1alpha1.OrganizationList
-	if err := s.k8sClient.List(ctx, &orgList); err != nil {
+	organizations, err := listItems(ctx, opts, listQuery[*models.OrganizationResponse]{
+		fetch: func(ctx context.Context, listOpts ...client.ListOption) ([]*models.OrganizationResponse, metav1.ListMeta, error) {
+			var orgList openchoreov1alpha1.OrganizationList
+			if err := s.k8sClient.List(ctx, &orgList, listOpts...); err != nil {
+				return nil, metav1.ListMeta{}, err
+			}
+			items := make([]*models.OrganizationResponse, 0, len(orgList.Items))
+			for _, item := range orgList.Items {
+				items = append(items, s.toOrganizationResponse(&item))
+			}
+			return items, orgList.ListMeta, nil
+		},
+		sortKey: func(org *models.OrganizationResponse) (string, time.Time) { return org.Name, org.CreatedAt },
+	})
+	if err != nil {
+		if isListOptionsError(err) {
+			return nil, err
+		}
 		s.logger.Error("Failed to list organizations", "error", err)
 		return nil, fmt.Errorf("failed to list organizations: %w", err)
 	}
 
-	organizations := make([]*models.OrganizationResponse, 0, len(orgList.Items))
-	for _, item := range orgList.Items {
-		organizations = append(organizations, s.toOrganizationResponse(&item))
-	}
-
-	s.logger.Debug("Listed organizations", "count", len(organizations))
+	s.logger.Debug("Listed organizations", "count", len(organizations.Items))
 	return organizations, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -60,26 +61,33 @@ func (s *ProjectService) CreateProject(ctx context.Context, orgName string, req
 	return s.toProjectResponse(projectCR), nil
 }
 
-// ListProjects lists all projects in the given organization
-func (s *ProjectService) ListProjects(ctx context.Context, orgName string) ([]*models.ProjectResponse, error) {
+// ListProjects lists a page of projects in the given organization
+func (s *ProjectService) ListProjects(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListResponse[*models.ProjectResponse], error) {
 	s.logger.Debug("Listing projects", "org", orgName)
 
-	var projectList openchoreov1alpha1.ProjectList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &projectList, listOpts...); err != nil {
+	projects, err := listItems(ctx, opts, listQuery[*models.ProjectResponse]{
+		fetch: func(ctx context.Context, listOpts ...client.ListOption) ([]*models.ProjectResponse, metav1.ListMeta, error) {
+			var projectList openchoreov1alpha1.ProjectList
+			if err := s.k8sClient.List(ctx, &projectList, append(listOpts, client.InNamespace(orgName))...); err != nil {
+				return nil, metav1.ListMeta{}, err
+			}
+			items := make([]*models.ProjectResponse, 0, len(projectList.Items))
+			for _, item := range projectList.Items {
+				items = append(items, s.toProjectResponse(&item))
+			}
+			return items, projectList.ListMeta, nil
+		},
+		sortKey: func(project *models.ProjectResponse) (string, time.Time) { return project.Name, project.CreatedAt },
+	})
+	if err != nil {
+		if isListOptionsError(err) {
+			return nil, err
+		}
 		s.logger.Error("Failed to list projects", "error", err)
 		return nil, fmt.Errorf("failed to list projects: %w", err)
 	}
 
-	projects := make([]*models.ProjectResponse, 0, len(projectList.Items))
-	for _, item := range projectList.Items {
-		projects = append(projects, s.toProjectResponse(&item))
-	}
-
-	s.logger.Debug("Listed projects", "org", orgName, "count", len(projects))
+	s.logger.Debug("Listed projects", "org", orgName, "count", len(projects.Items))
 	return projects, nil
 }
 
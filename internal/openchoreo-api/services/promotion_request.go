@@ -0,0 +1,347 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
+)
+
+// RequestPromotionPayload combines the promotion request body with the path parameters and the requester identity
+type RequestPromotionPayload struct {
+	models.CreatePromotionRequestRequest
+	ComponentName string `json:"componentName"`
+	ProjectName   string `json:"projectName"`
+	OrgName       string `json:"orgName"`
+	RequestedBy   string `json:"requestedBy"`
+}
+
+// requiresApproval reports whether promotions to the target environment must be approved before they are applied
+func requiresApproval(target *openchoreov1alpha1.TargetEnvironmentRef) bool {
+	return target.RequiresApproval || target.IsManualApprovalRequired
+}
+
+// checkReleaseApproval returns ErrPromotionApprovalRequired if the deployment pipeline of the project requires
+// promotions to the environment to be approved. Every change of the release bound to an environment goes through
+// this check, except binding the release of an approved promotion request.
+func (s *ComponentService) checkReleaseApproval(ctx context.Context, orgName, projectName, environment string) error {
+	project, err := s.projectService.GetProject(ctx, orgName, projectName)
+	if err != nil {
+		return err
+	}
+
+	pipelineName := project.DeploymentPipeline
+	if pipelineName == "" {
+		pipelineName = defaultPipeline
+	}
+
+	pipeline := &openchoreov1alpha1.DeploymentPipeline{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: pipelineName}, pipeline); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			// Without a pipeline there is no promotion path that could require approval
+			return nil
+		}
+		return fmt.Errorf("failed to get deployment pipeline: %w", err)
+	}
+
+	for _, path := range pipeline.Spec.PromotionPaths {
+		for i := range path.TargetEnvironmentRefs {
+			target := &path.TargetEnvironmentRefs[i]
+			if target.Name == environment && requiresApproval(target) {
+				s.logger.Warn("Binding a release to the environment requires approval", "org", orgName,
+					"project", projectName, "environment", environment, "pipeline", pipelineName)
+				return ErrPromotionApprovalRequired
+			}
+		}
+	}
+	return nil
+}
+
+// RequestPromotion records a request to promote the release currently bound to the source environment.
+// The release is captured at request time so that the approved release is exactly the one that was reviewed.
+func (s *ComponentService) RequestPromotion(ctx context.Context, req *RequestPromotionPayload) (*models.PromotionRequestResponse, error) {
+	s.logger.Debug("Requesting promotion", "org", req.OrgName, "project", req.ProjectName, "component", req.ComponentName,
+		"source", req.SourceEnvironment, "target", req.TargetEnvironment, "requestedBy", req.RequestedBy)
+
+	if _, err := s.validatePromotionPath(ctx, req.OrgName, req.ProjectName, req.SourceEnvironment, req.TargetEnvironment); err != nil {
+		return nil, err
+	}
+
+	sourceReleaseBinding, err := s.getReleaseBinding(ctx, req.OrgName, req.ProjectName, req.ComponentName, req.SourceEnvironment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source release binding: %w", err)
+	}
+
+	existing, err := s.listPromotionRequests(ctx, req.OrgName, req.ProjectName, req.ComponentName)
+	if err != nil {
+		return nil, err
+	}
+	for i := range existing {
+		if existing[i].Spec.TargetEnvironment == req.TargetEnvironment && promotionRequestPhase(&existing[i]) == openchoreov1alpha1.PromotionRequestPhasePending {
+			s.logger.Warn("Pending promotion request already exists", "name", existing[i].Name, "target", req.TargetEnvironment)
+			return nil, ErrPromotionRequestExists
+		}
+	}
+
+	promotionRequest := &openchoreov1alpha1.PromotionRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-%s-", req.ComponentName, req.TargetEnvironment),
+			Namespace:    req.OrgName,
+			Labels: map[string]string{
+				labels.LabelKeyProjectName:     req.ProjectName,
+				labels.LabelKeyComponentName:   req.ComponentName,
+				labels.LabelKeyEnvironmentName: req.TargetEnvironment,
+			},
+		},
+		Spec: openchoreov1alpha1.PromotionRequestSpec{
+			Owner: openchoreov1alpha1.PromotionRequestOwner{
+				ProjectName:   req.ProjectName,
+				ComponentName: req.ComponentName,
+			},
+			SourceEnvironment: req.SourceEnvironment,
+			TargetEnvironment: req.TargetEnvironment,
+			ReleaseName:       sourceReleaseBinding.Spec.ReleaseName,
+			RequestedBy:       req.RequestedBy,
+			Reason:            req.Reason,
+		},
+	}
+	if err := s.k8sClient.Create(ctx, promotionRequest); err != nil {
+		return nil, fmt.Errorf("failed to create promotion request: %w", err)
+	}
+
+	promotionRequest.Status.Phase = openchoreov1alpha1.PromotionRequestPhasePending
+	if err := s.k8sClient.Status().Update(ctx, promotionRequest); err != nil {
+		return nil, fmt.Errorf("failed to update promotion request status: %w", err)
+	}
+
+	s.logger.Info("Promotion requested", "name", promotionRequest.Name, "org", req.OrgName, "component", req.ComponentName,
+		"release", promotionRequest.Spec.ReleaseName, "target", req.TargetEnvironment, "requestedBy", req.RequestedBy)
+	return toPromotionRequestResponse(promotionRequest, req.OrgName), nil
+}
+
+// ListPromotionRequests lists a page of the promotion requests of a component, newest first unless the options set a sort order
+func (s *ComponentService) ListPromotionRequests(ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions) (*models.ListResponse[*models.PromotionRequestResponse], error) {
+	s.logger.Debug("Listing promotion requests", "org", orgName, "project", projectName, "component", componentName)
+
+	phase := opts.Filter(models.FilterStatus)
+	targetEnvironment := opts.Filter(models.FilterEnvironment)
+	promotionRequests, err := listItems(ctx, withDefaultSort(opts, models.SortByCreatedAt, models.SortOrderDesc), listQuery[*models.PromotionRequestResponse]{
+		fetch: func(ctx context.Context, listOpts ...client.ListOption) ([]*models.PromotionRequestResponse, metav1.ListMeta, error) {
+			list := &openchoreov1alpha1.PromotionRequestList{}
+			if err := s.k8sClient.List(ctx, list, append(listOpts, client.InNamespace(orgName))...); err != nil {
+				return nil, metav1.ListMeta{}, err
+			}
+			items := make([]*models.PromotionRequestResponse, 0, len(list.Items))
+			for i := range list.Items {
+				items = append(items, toPromotionRequestResponse(&list.Items[i], orgName))
+			}
+			return items, list.ListMeta, nil
+		},
+		keep: func(promotionRequest *models.PromotionRequestResponse) bool {
+			if promotionRequest.ProjectName != projectName || promotionRequest.ComponentName != componentName {
+				return false
+			}
+			if targetEnvironment != "" && promotionRequest.TargetEnvironment != targetEnvironment {
+				return false
+			}
+			return phase == "" || strings.EqualFold(promotionRequest.Phase, phase)
+		},
+		sortKey: func(promotionRequest *models.PromotionRequestResponse) (string, time.Time) {
+			return promotionRequest.Name, promotionRequest.CreatedAt
+		},
+	})
+	if err != nil {
+		if isListOptionsError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to list promotion requests: %w", err)
+	}
+	return promotionRequests, nil
+}
+
+// GetPromotionRequest retrieves a promotion request of a component
+func (s *ComponentService) GetPromotionRequest(ctx context.Context, orgName, projectName, componentName, name string) (*models.PromotionRequestResponse, error) {
+	promotionRequest, err := s.getPromotionRequest(ctx, orgName, projectName, componentName, name)
+	if err != nil {
+		return nil, err
+	}
+	return toPromotionRequestResponse(promotionRequest, orgName), nil
+}
+
+// ApprovePromotionRequest approves a pending promotion request and binds the requested release to the target environment.
+// An approved request whose release could not be bound is retried when it is approved again.
+func (s *ComponentService) ApprovePromotionRequest(ctx context.Context, orgName, projectName, componentName, name, reviewer, comment string) (*models.PromotionRequestResponse, error) {
+	s.logger.Debug("Approving promotion request", "org", orgName, "project", projectName, "component", componentName,
+		"name", name, "reviewer", reviewer)
+
+	promotionRequest, err := s.getPromotionRequest(ctx, orgName, projectName, componentName, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeInEnvironment(ctx, rbac.ActionApprove, orgName, projectName, componentName, promotionRequest.Spec.TargetEnvironment); err != nil {
+		return nil, err
+	}
+
+	if promotionRequestPhase(promotionRequest) != openchoreov1alpha1.PromotionRequestPhaseApproved {
+		if err := reviewPromotionRequest(promotionRequest, openchoreov1alpha1.PromotionRequestPhaseApproved, reviewer, comment, time.Now()); err != nil {
+			return nil, err
+		}
+		// The update fails with a conflict if the request was reviewed concurrently
+		if err := s.k8sClient.Status().Update(ctx, promotionRequest); err != nil {
+			return nil, fmt.Errorf("failed to update promotion request status: %w", err)
+		}
+		s.logger.Info("Promotion request approved", "name", name, "org", orgName, "component", componentName, "reviewer", reviewer)
+	}
+
+	// The pipeline may have changed since the promotion was requested
+	if _, err := s.validatePromotionPath(ctx, orgName, projectName, promotionRequest.Spec.SourceEnvironment, promotionRequest.Spec.TargetEnvironment); err != nil {
+		return nil, err
+	}
+
+	promoteReq := &PromoteComponentPayload{
+		PromoteComponentRequest: models.PromoteComponentRequest{
+			SourceEnvironment: promotionRequest.Spec.SourceEnvironment,
+			TargetEnvironment: promotionRequest.Spec.TargetEnvironment,
+		},
+		ComponentName: componentName,
+		ProjectName:   projectName,
+		OrgName:       orgName,
+	}
+	if err := s.createOrUpdateReleaseBinding(ctx, promoteReq, promotionRequest.Spec.ReleaseName); err != nil {
+		return nil, fmt.Errorf("failed to create/update target release binding: %w", err)
+	}
+
+	targetReleaseBinding, err := s.getReleaseBinding(ctx, orgName, projectName, componentName, promotionRequest.Spec.TargetEnvironment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get release binding: %w", err)
+	}
+
+	promotionRequest.Status.Phase = openchoreov1alpha1.PromotionRequestPhasePromoted
+	promotionRequest.Status.ReleaseBindingName = targetReleaseBinding.Name
+	if err := s.k8sClient.Status().Update(ctx, promotionRequest); err != nil {
+		return nil, fmt.Errorf("failed to update promotion request status: %w", err)
+	}
+
+	s.logger.Info("Promotion request applied", "name", name, "org", orgName, "component", componentName,
+		"release", promotionRequest.Spec.ReleaseName, "releaseBinding", targetReleaseBinding.Name)
+	return toPromotionRequestResponse(promotionRequest, orgName), nil
+}
+
+// RejectPromotionRequest rejects a pending promotion request
+func (s *ComponentService) RejectPromotionRequest(ctx context.Context, orgName, projectName, componentName, name, reviewer, comment string) (*models.PromotionRequestResponse, error) {
+	s.logger.Debug("Rejecting promotion request", "org", orgName, "project", projectName, "component", componentName,
+		"name", name, "reviewer", reviewer)
+
+	promotionRequest, err := s.getPromotionRequest(ctx, orgName, projectName, componentName, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeInEnvironment(ctx, rbac.ActionApprove, orgName, projectName, componentName, promotionRequest.Spec.TargetEnvironment); err != nil {
+		return nil, err
+	}
+
+	if err := reviewPromotionRequest(promotionRequest, openchoreov1alpha1.PromotionRequestPhaseRejected, reviewer, comment, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.k8sClient.Status().Update(ctx, promotionRequest); err != nil {
+		return nil, fmt.Errorf("failed to update promotion request status: %w", err)
+	}
+
+	s.logger.Info("Promotion request rejected", "name", name, "org", orgName, "component", componentName, "reviewer", reviewer)
+	return toPromotionRequestResponse(promotionRequest, orgName), nil
+}
+
+// listPromotionRequests lists the PromotionRequests owned by a component
+func (s *ComponentService) listPromotionRequests(ctx context.Context, orgName, projectName, componentName string) ([]openchoreov1alpha1.PromotionRequest, error) {
+	list := &openchoreov1alpha1.PromotionRequestList{}
+	if err := s.k8sClient.List(ctx, list, client.InNamespace(orgName)); err != nil {
+		return nil, fmt.Errorf("failed to list promotion requests: %w", err)
+	}
+
+	items := make([]openchoreov1alpha1.PromotionRequest, 0, len(list.Items))
+	for i := range list.Items {
+		if list.Items[i].Spec.Owner.ProjectName == projectName && list.Items[i].Spec.Owner.ComponentName == componentName {
+			items = append(items, list.Items[i])
+		}
+	}
+	return items, nil
+}
+
+// getPromotionRequest retrieves a PromotionRequest and verifies that it belongs to the component
+func (s *ComponentService) getPromotionRequest(ctx context.Context, orgName, projectName, componentName, name string) (*openchoreov1alpha1.PromotionRequest, error) {
+	promotionRequest := &openchoreov1alpha1.PromotionRequest{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: name}, promotionRequest); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrPromotionRequestNotFound
+		}
+		return nil, fmt.Errorf("failed to get promotion request: %w", err)
+	}
+
+	if promotionRequest.Spec.Owner.ProjectName != projectName || promotionRequest.Spec.Owner.ComponentName != componentName {
+		return nil, ErrPromotionRequestNotFound
+	}
+	return promotionRequest, nil
+}
+
+// reviewPromotionRequest records the decision of a reviewer on a pending promotion request
+func reviewPromotionRequest(promotionRequest *openchoreov1alpha1.PromotionRequest, decision openchoreov1alpha1.PromotionRequestPhase,
+	reviewer, comment string, now time.Time) error {
+	if promotionRequestPhase(promotionRequest) != openchoreov1alpha1.PromotionRequestPhasePending {
+		return ErrPromotionRequestReviewed
+	}
+	// Requests made without an authenticated identity cannot be checked for self-approval
+	if decision == openchoreov1alpha1.PromotionRequestPhaseApproved && reviewer != "" && reviewer == promotionRequest.Spec.RequestedBy {
+		return ErrPromotionSelfApproval
+	}
+
+	reviewedAt := metav1.NewTime(now)
+	promotionRequest.Status.Phase = decision
+	promotionRequest.Status.ReviewedBy = reviewer
+	promotionRequest.Status.ReviewedAt = &reviewedAt
+	promotionRequest.Status.Comment = comment
+	return nil
+}
+
+// promotionRequestPhase returns the phase of a promotion request.
+// A request without a phase has not been reviewed yet.
+func promotionRequestPhase(promotionRequest *openchoreov1alpha1.PromotionRequest) openchoreov1alpha1.PromotionRequestPhase {
+	if promotionRequest.Status.Phase == "" {
+		return openchoreov1alpha1.PromotionRequestPhasePending
+	}
+	return promotionRequest.Status.Phase
+}
+
+func toPromotionRequestResponse(promotionRequest *openchoreov1alpha1.PromotionRequest, orgName string) *models.PromotionRequestResponse {
+	response := &models.PromotionRequestResponse{
+		Name:               promotionRequest.Name,
+		ComponentName:      promotionRequest.Spec.Owner.ComponentName,
+		ProjectName:        promotionRequest.Spec.Owner.ProjectName,
+		OrgName:            orgName,
+		SourceEnvironment:  promotionRequest.Spec.SourceEnvironment,
+		TargetEnvironment:  promotionRequest.Spec.TargetEnvironment,
+		ReleaseName:        promotionRequest.Spec.ReleaseName,
+		RequestedBy:        promotionRequest.Spec.RequestedBy,
+		Reason:             promotionRequest.Spec.Reason,
+		Phase:              string(promotionRequestPhase(promotionRequest)),
+		ReviewedBy:         promotionRequest.Status.ReviewedBy,
+		Comment:            promotionRequest.Status.Comment,
+		ReleaseBindingName: promotionRequest.Status.ReleaseBindingName,
+		CreatedAt:          promotionRequest.CreationTimestamp.Time,
+	}
+	if promotionRequest.Status.ReviewedAt != nil {
+		reviewedAt := promotionRequest.Status.ReviewedAt.Time
+		response.ReviewedAt = &reviewedAt
+	}
+	return response
+}
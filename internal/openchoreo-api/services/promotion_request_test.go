@@ -0,0 +1,135 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestRequiresApproval(t *testing.T) {
+	tests := []struct {
+		name   string
+		target v1alpha1.TargetEnvironmentRef
+		want   bool
+	}{
+		{name: "No approval", target: v1alpha1.TargetEnvironmentRef{Name: "staging"}, want: false},
+		{name: "Requires approval", target: v1alpha1.TargetEnvironmentRef{Name: "prod", RequiresApproval: true}, want: true},
+		{name: "Manual approval", target: v1alpha1.TargetEnvironmentRef{Name: "prod", IsManualApprovalRequired: true}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := requiresApproval(&tt.target); got != tt.want {
+				t.Errorf("requiresApproval() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReviewPromotionRequest(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		phase     v1alpha1.PromotionRequestPhase
+		requester string
+		decision  v1alpha1.PromotionRequestPhase
+		reviewer  string
+		wantErr   error
+	}{
+		{
+			name:      "Approve pending request",
+			phase:     v1alpha1.PromotionRequestPhasePending,
+			requester: "alice@example.com",
+			decision:  v1alpha1.PromotionRequestPhaseApproved,
+			reviewer:  "bob@example.com",
+		},
+		{
+			name:      "Approve request without a phase",
+			requester: "alice@example.com",
+			decision:  v1alpha1.PromotionRequestPhaseApproved,
+			reviewer:  "bob@example.com",
+		},
+		{
+			name:      "Reject pending request",
+			phase:     v1alpha1.PromotionRequestPhasePending,
+			requester: "alice@example.com",
+			decision:  v1alpha1.PromotionRequestPhaseRejected,
+			reviewer:  "bob@example.com",
+		},
+		{
+			name:      "Requester cannot approve",
+			phase:     v1alpha1.PromotionRequestPhasePending,
+			requester: "alice@example.com",
+			decision:  v1alpha1.PromotionRequestPhaseApproved,
+			reviewer:  "alice@example.com",
+			wantErr:   ErrPromotionSelfApproval,
+		},
+		{
+			name:      "Requester can withdraw by rejecting",
+			phase:     v1alpha1.PromotionRequestPhasePending,
+			requester: "alice@example.com",
+			decision:  v1alpha1.PromotionRequestPhaseRejected,
+			reviewer:  "alice@example.com",
+		},
+		{
+			name:     "Approve without identities",
+			phase:    v1alpha1.PromotionRequestPhasePending,
+			decision: v1alpha1.PromotionRequestPhaseApproved,
+		},
+		{
+			name:      "Rejected request cannot be approved",
+			phase:     v1alpha1.PromotionRequestPhaseRejected,
+			requester: "alice@example.com",
+			decision:  v1alpha1.PromotionRequestPhaseApproved,
+			reviewer:  "bob@example.com",
+			wantErr:   ErrPromotionRequestReviewed,
+		},
+		{
+			name:      "Promoted request cannot be rejected",
+			phase:     v1alpha1.PromotionRequestPhasePromoted,
+			requester: "alice@example.com",
+			decision:  v1alpha1.PromotionRequestPhaseRejected,
+			reviewer:  "bob@example.com",
+			wantErr:   ErrPromotionRequestReviewed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := &v1alpha1.PromotionRequest{
+				Spec:   v1alpha1.PromotionRequestSpec{RequestedBy: tt.requester},
+				Status: v1alpha1.PromotionRequestStatus{Phase: tt.phase},
+			}
+
+			err := reviewPromotionRequest(pr, tt.decision, tt.reviewer, "looks good", now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("reviewPromotionRequest() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if pr.Status.Phase != tt.phase || pr.Status.ReviewedAt != nil {
+					t.Errorf("status must not change on error, got %+v", pr.Status)
+				}
+				return
+			}
+
+			if pr.Status.Phase != tt.decision {
+				t.Errorf("Phase = %v, want %v", pr.Status.Phase, tt.decision)
+			}
+			if pr.Status.ReviewedBy != tt.reviewer {
+				t.Errorf("ReviewedBy = %v, want %v", pr.Status.ReviewedBy, tt.reviewer)
+			}
+			if pr.Status.ReviewedAt == nil || !pr.Status.ReviewedAt.Time.Equal(now) {
+				t.Errorf("ReviewedAt = %v, want %v", pr.Status.ReviewedAt, now)
+			}
+			if pr.Status.Comment != "looks good" {
+				t.Errorf("Comment = %v, want %v", pr.Status.Comment, "looks good")
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// diffReleaseResources compares the resources of the current Release against a proposed set of
+// resources and returns a per-resource diff. Resources are matched by their Release resource ID.
+// Proposed resources are reported in render order, followed by resources that would be removed.
+func diffReleaseResources(current, proposed []openchoreov1alpha1.Resource) ([]models.ResourceDiff, models.ReleaseDiffSummary, error) {
+	var summary models.ReleaseDiffSummary
+
+	currentByID := make(map[string]map[string]any, len(current))
+	for _, res := range current {
+		obj, err := decodeReleaseResource(res)
+		if err != nil {
+			return nil, summary, err
+		}
+		currentByID[res.ID] = obj
+	}
+
+	diffs := make([]models.ResourceDiff, 0, len(proposed)+len(current))
+	seen := make(map[string]bool, len(proposed))
+
+	for _, res := range proposed {
+		obj, err := decodeReleaseResource(res)
+		if err != nil {
+			return nil, summary, err
+		}
+		seen[res.ID] = true
+
+		diff := newResourceDiff(res.ID, obj)
+		old, exists := currentByID[res.ID]
+		if !exists {
+			diff.Change = models.ResourceChangeAdded
+			summary.Added++
+			diffs = append(diffs, diff)
+			continue
+		}
+
+		diffValues("", old, obj, &diff.Fields)
+		if len(diff.Fields) > 0 {
+			diff.Change = models.ResourceChangeModified
+			summary.Modified++
+		} else {
+			diff.Change = models.ResourceChangeUnchanged
+			summary.Unchanged++
+		}
+		diffs = append(diffs, diff)
+	}
+
+	for _, res := range current {
+		if seen[res.ID] {
+			continue
+		}
+		diff := newResourceDiff(res.ID, currentByID[res.ID])
+		diff.Change = models.ResourceChangeRemoved
+		summary.Removed++
+		diffs = append(diffs, diff)
+	}
+
+	return diffs, summary, nil
+}
+
+// decodeReleaseResource unmarshals the raw object of a Release resource
+func decodeReleaseResource(res openchoreov1alpha1.Resource) (map[string]any, error) {
+	if res.Object == nil || len(res.Object.Raw) == 0 {
+		return map[string]any{}, nil
+	}
+	var obj map[string]any
+	if err := json.Unmarshal(res.Object.Raw, &obj); err != nil {
+		return nil, fmt.Errorf("failed to decode resource %s: %w", res.ID, err)
+	}
+	return obj, nil
+}
+
+func newResourceDiff(id string, obj map[string]any) models.ResourceDiff {
+	diff := models.ResourceDiff{ID: id}
+	diff.APIVersion, _ = obj["apiVersion"].(string)
+	diff.Kind, _ = obj["kind"].(string)
+	if metadata, ok := obj["metadata"].(map[string]any); ok {
+		diff.Name, _ = metadata["name"].(string)
+		diff.Namespace, _ = metadata["namespace"].(string)
+	}
+	return diff
+}
+
+// diffValues recursively compares two decoded JSON values and appends the leaf-level
+// differences to changes. Lists of equal length are compared element by element;
+// lists whose length differs are reported as a single change.
+func diffValues(path string, oldVal, newVal any, changes *[]models.FieldChange) {
+	oldMap, oldIsMap := oldVal.(map[string]any)
+	newMap, newIsMap := newVal.(map[string]any)
+	if oldIsMap && newIsMap {
+		keys := make([]string, 0, len(oldMap)+len(newMap))
+		for k := range oldMap {
+			keys = append(keys, k)
+		}
+		for k := range newMap {
+			if _, ok := oldMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			diffValues(joinFieldPath(path, k), oldMap[k], newMap[k], changes)
+		}
+		return
+	}
+
+	oldList, oldIsList := oldVal.([]any)
+	newList, newIsList := newVal.([]any)
+	if oldIsList && newIsList && len(oldList) == len(newList) {
+		for i := range oldList {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), oldList[i], newList[i], changes)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(oldVal, newVal) {
+		*changes = append(*changes, models.FieldChange{
+			Path:     path,
+			OldValue: oldVal,
+			NewValue: newVal,
+		})
+	}
+}
+
+// joinFieldPath appends a map key to a field path. Keys that contain dots or slashes
+// (e.g. label keys) are written in bracket notation to keep the path unambiguous.
+func joinFieldPath(path, key string) string {
+	if strings.ContainsAny(key, "./") {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
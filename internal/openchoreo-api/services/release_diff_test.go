@@ -0,0 +1,164 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+func releaseResource(t *testing.T, id string, obj map[string]any) v1alpha1.Resource {
+	t.Helper()
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("failed to marshal resource: %v", err)
+	}
+	return v1alpha1.Resource{ID: id, Object: &runtime.RawExtension{Raw: raw}}
+}
+
+func deploymentObject(replicas int, image string) map[string]any {
+	return map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":      "api",
+			"namespace": "dp-ns",
+			"labels": map[string]any{
+				"openchoreo.dev/component": "api",
+			},
+		},
+		"spec": map[string]any{
+			"replicas": replicas,
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{"name": "main", "image": image},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestDiffReleaseResources(t *testing.T) {
+	service := map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]any{"name": "api", "namespace": "dp-ns"},
+	}
+	configMap := map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "api-config", "namespace": "dp-ns"},
+	}
+	hpa := map[string]any{
+		"apiVersion": "autoscaling/v2",
+		"kind":       "HorizontalPodAutoscaler",
+		"metadata":   map[string]any{"name": "api", "namespace": "dp-ns"},
+	}
+
+	current := []v1alpha1.Resource{
+		releaseResource(t, "deployment-api", deploymentObject(1, "api:v1")),
+		releaseResource(t, "service-api", service),
+		releaseResource(t, "configmap-api-config", configMap),
+	}
+	proposed := []v1alpha1.Resource{
+		releaseResource(t, "deployment-api", deploymentObject(3, "api:v2")),
+		releaseResource(t, "service-api", service),
+		releaseResource(t, "horizontalpodautoscaler-api", hpa),
+	}
+
+	diffs, summary, err := diffReleaseResources(current, proposed)
+	if err != nil {
+		t.Fatalf("diffReleaseResources() error = %v", err)
+	}
+
+	wantSummary := models.ReleaseDiffSummary{Added: 1, Modified: 1, Removed: 1, Unchanged: 1}
+	if diff := cmp.Diff(wantSummary, summary); diff != "" {
+		t.Errorf("summary mismatch (-want +got):\n%s", diff)
+	}
+
+	want := []models.ResourceDiff{
+		{
+			ID: "deployment-api", APIVersion: "apps/v1", Kind: "Deployment", Name: "api", Namespace: "dp-ns",
+			Change: models.ResourceChangeModified,
+			Fields: []models.FieldChange{
+				{Path: "spec.replicas", OldValue: float64(1), NewValue: float64(3)},
+				{Path: "spec.template.spec.containers[0].image", OldValue: "api:v1", NewValue: "api:v2"},
+			},
+		},
+		{
+			ID: "service-api", APIVersion: "v1", Kind: "Service", Name: "api", Namespace: "dp-ns",
+			Change: models.ResourceChangeUnchanged,
+		},
+		{
+			ID: "horizontalpodautoscaler-api", APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler",
+			Name: "api", Namespace: "dp-ns", Change: models.ResourceChangeAdded,
+		},
+		{
+			ID: "configmap-api-config", APIVersion: "v1", Kind: "ConfigMap", Name: "api-config", Namespace: "dp-ns",
+			Change: models.ResourceChangeRemoved,
+		},
+	}
+	if diff := cmp.Diff(want, diffs); diff != "" {
+		t.Errorf("diffs mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiffValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		oldVal any
+		newVal any
+		want   []models.FieldChange
+	}{
+		{
+			name:   "equal values produce no changes",
+			oldVal: map[string]any{"a": "x", "b": []any{"1", "2"}},
+			newVal: map[string]any{"a": "x", "b": []any{"1", "2"}},
+			want:   nil,
+		},
+		{
+			name:   "added and removed keys",
+			oldVal: map[string]any{"a": "x"},
+			newVal: map[string]any{"b": "y"},
+			want: []models.FieldChange{
+				{Path: "a", OldValue: "x"},
+				{Path: "b", NewValue: "y"},
+			},
+		},
+		{
+			name:   "list length change is reported as a whole",
+			oldVal: map[string]any{"args": []any{"--a"}},
+			newVal: map[string]any{"args": []any{"--a", "--b"}},
+			want: []models.FieldChange{
+				{Path: "args", OldValue: []any{"--a"}, NewValue: []any{"--a", "--b"}},
+			},
+		},
+		{
+			name:   "keys with dots use bracket notation",
+			oldVal: map[string]any{"labels": map[string]any{"openchoreo.dev/env": "dev"}},
+			newVal: map[string]any{"labels": map[string]any{"openchoreo.dev/env": "prod"}},
+			want: []models.FieldChange{
+				{Path: `labels["openchoreo.dev/env"]`, OldValue: "dev", NewValue: "prod"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []models.FieldChange
+			diffValues("", tt.oldVal, tt.newVal, &got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("diffValues() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
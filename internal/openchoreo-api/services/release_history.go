@@ -0,0 +1,133 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// GetReleaseBindingHistory returns the ComponentReleases bound to a ReleaseBinding, most recent first.
+// The history is recorded in the ReleaseBinding status by the ReleaseBinding controller.
+func (s *ComponentService) GetReleaseBindingHistory(ctx context.Context, orgName, projectName, componentName, bindingName string) ([]*models.ReleaseHistoryEntryResponse, error) {
+	s.logger.Debug("Getting release binding history", "org", orgName, "project", projectName, "component", componentName, "binding", bindingName)
+
+	binding, err := s.getComponentReleaseBinding(ctx, orgName, projectName, componentName, bindingName)
+	if err != nil {
+		return nil, err
+	}
+
+	return toReleaseHistoryResponse(binding), nil
+}
+
+// RollbackReleaseBinding binds an earlier ComponentRelease from the history of a ReleaseBinding.
+// If releaseName is empty, the binding is rolled back to the release bound before the current one.
+// The binding is updated through PatchReleaseBinding, so a rollback is authorized, gated by the promotion
+// approval of the environment and recorded in the history like any other change of release.
+func (s *ComponentService) RollbackReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName, releaseName, requestedBy string) (*models.ReleaseBindingResponse, error) {
+	s.logger.Debug("Rolling back release binding", "org", orgName, "project", projectName, "component", componentName,
+		"binding", bindingName, "release", releaseName)
+
+	binding, err := s.getComponentReleaseBinding(ctx, orgName, projectName, componentName, bindingName)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := rollbackTarget(binding, releaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	// PatchReleaseBinding checks that the ComponentRelease still exists and belongs to the component,
+	// and that binding it to the environment does not require an approved promotion request
+	response, err := s.PatchReleaseBinding(ctx, orgName, projectName, componentName, bindingName,
+		&models.PatchReleaseBindingRequest{ReleaseName: target}, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Release binding rolled back", "org", orgName, "component", componentName, "binding", bindingName,
+		"from", binding.Spec.ReleaseName, "to", target, "requestedBy", requestedBy)
+	return response, nil
+}
+
+// getComponentReleaseBinding fetches an existing ReleaseBinding and verifies it belongs to the component
+func (s *ComponentService) getComponentReleaseBinding(ctx context.Context, orgName, projectName, componentName, bindingName string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	var binding openchoreov1alpha1.ReleaseBinding
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: orgName, Name: bindingName}, &binding); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Release binding not found", "org", orgName, "binding", bindingName)
+			return nil, ErrReleaseBindingNotFound
+		}
+		s.logger.Error("Failed to get release binding", "error", err)
+		return nil, fmt.Errorf("failed to get release binding: %w", err)
+	}
+
+	if binding.Spec.Owner.ProjectName != projectName || binding.Spec.Owner.ComponentName != componentName {
+		s.logger.Warn("Release binding does not belong to component", "org", orgName, "component", componentName, "binding", bindingName)
+		return nil, ErrReleaseBindingNotFound
+	}
+	return &binding, nil
+}
+
+// rollbackTarget returns the release from the history of the binding to roll back to.
+// An empty releaseName selects the most recent release other than the current one.
+func rollbackTarget(binding *openchoreov1alpha1.ReleaseBinding, releaseName string) (string, error) {
+	current := binding.Spec.ReleaseName
+	if releaseName == current {
+		return "", fmt.Errorf("%w: %q", ErrReleaseAlreadyBound, releaseName)
+	}
+
+	for _, entry := range binding.Status.History {
+		if entry.ReleaseName == current {
+			continue
+		}
+		if releaseName == "" || entry.ReleaseName == releaseName {
+			return entry.ReleaseName, nil
+		}
+	}
+
+	if releaseName == "" {
+		return "", fmt.Errorf("%w: no release was bound before %q", ErrReleaseNotInHistory, current)
+	}
+	return "", fmt.Errorf("%w: %q", ErrReleaseNotInHistory, releaseName)
+}
+
+// recordBoundBy records the user who bound the current release of a binding in its history. The history
+// is written through the status subresource, so the attribution cannot be forged by editing the binding.
+// The update is skipped if the release of the binding has changed again in the meantime.
+func (s *ComponentService) recordBoundBy(ctx context.Context, binding *openchoreov1alpha1.ReleaseBinding, boundBy string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var current openchoreov1alpha1.ReleaseBinding
+		if err := s.k8sClient.Get(ctx, client.ObjectKeyFromObject(binding), &current); err != nil {
+			return err
+		}
+		if current.Spec.ReleaseName != binding.Spec.ReleaseName {
+			return nil
+		}
+		releasebinding.RecordReleaseHistory(&current, boundBy, metav1.Now())
+		return s.k8sClient.Status().Update(ctx, &current)
+	})
+}
+
+func toReleaseHistoryResponse(binding *openchoreov1alpha1.ReleaseBinding) []*models.ReleaseHistoryEntryResponse {
+	history := make([]*models.ReleaseHistoryEntryResponse, 0, len(binding.Status.History))
+	for _, entry := range binding.Status.History {
+		history = append(history, &models.ReleaseHistoryEntryResponse{
+			ReleaseName: entry.ReleaseName,
+			BoundAt:     entry.BoundAt.Time,
+			BoundBy:     entry.BoundBy,
+			Current:     entry.ReleaseName == binding.Spec.ReleaseName,
+		})
+	}
+	return history
+}
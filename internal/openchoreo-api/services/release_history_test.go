@@ -0,0 +1,161 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestRollbackTarget(t *testing.T) {
+	history := []v1alpha1.ReleaseHistoryEntry{
+		{ReleaseName: "greeter-v3"},
+		{ReleaseName: "greeter-v2"},
+		{ReleaseName: "greeter-v1"},
+	}
+
+	tests := []struct {
+		name           string
+		currentRelease string
+		history        []v1alpha1.ReleaseHistoryEntry
+		releaseName    string
+		want           string
+		wantErr        error
+	}{
+		{name: "Previous release", currentRelease: "greeter-v3", history: history, want: "greeter-v2"},
+		{name: "Chosen release", currentRelease: "greeter-v3", history: history, releaseName: "greeter-v1", want: "greeter-v1"},
+		{
+			name:           "Current release not recorded yet",
+			currentRelease: "greeter-v4",
+			history:        history,
+			want:           "greeter-v3",
+		},
+		{
+			name:           "Release bound again after a rollback",
+			currentRelease: "greeter-v2",
+			history:        []v1alpha1.ReleaseHistoryEntry{{ReleaseName: "greeter-v2"}, {ReleaseName: "greeter-v3"}, {ReleaseName: "greeter-v2"}},
+			want:           "greeter-v3",
+		},
+		{name: "Current release", currentRelease: "greeter-v3", history: history, releaseName: "greeter-v3", wantErr: ErrReleaseAlreadyBound},
+		{name: "Unknown release", currentRelease: "greeter-v3", history: history, releaseName: "greeter-v0", wantErr: ErrReleaseNotInHistory},
+		{
+			name:           "No previous release",
+			currentRelease: "greeter-v1",
+			history:        []v1alpha1.ReleaseHistoryEntry{{ReleaseName: "greeter-v1"}},
+			wantErr:        ErrReleaseNotInHistory,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			binding := &v1alpha1.ReleaseBinding{
+				Spec:   v1alpha1.ReleaseBindingSpec{ReleaseName: tt.currentRelease},
+				Status: v1alpha1.ReleaseBindingStatus{History: tt.history},
+			}
+			got, err := rollbackTarget(binding, tt.releaseName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("rollbackTarget() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("rollbackTarget() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func newReleaseHistoryTestService(t *testing.T, objects ...client.Object) *ComponentService {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).
+		WithStatusSubresource(&v1alpha1.ReleaseBinding{}).Build()
+	return &ComponentService{k8sClient: k8sClient, logger: slog.New(slog.DiscardHandler)}
+}
+
+func TestCheckComponentRelease(t *testing.T) {
+	newRelease := func(name, project, component string) *v1alpha1.ComponentRelease {
+		release := &v1alpha1.ComponentRelease{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "acme"}}
+		release.Spec.Owner.ProjectName = project
+		release.Spec.Owner.ComponentName = component
+		return release
+	}
+	service := newReleaseHistoryTestService(t,
+		newRelease("greeter-v1", "shop", "greeter"),
+		newRelease("checkout-v1", "shop", "checkout"),
+		newRelease("greeter-other-v1", "other", "greeter"))
+
+	binding := &v1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "greeter-dev", Namespace: "acme"},
+		Spec: v1alpha1.ReleaseBindingSpec{
+			Owner: v1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "greeter"},
+		},
+	}
+
+	tests := []struct {
+		releaseName string
+		wantErr     error
+	}{
+		{releaseName: "greeter-v1"},
+		{releaseName: "greeter-v0", wantErr: ErrComponentReleaseNotFound},
+		{releaseName: "checkout-v1", wantErr: ErrComponentReleaseNotFound},
+		{releaseName: "greeter-other-v1", wantErr: ErrComponentReleaseNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.releaseName, func(t *testing.T) {
+			err := service.checkComponentRelease(context.Background(), binding, tt.releaseName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkComponentRelease() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRecordBoundBy(t *testing.T) {
+	binding := &v1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "greeter-dev", Namespace: "acme"},
+		Spec:       v1alpha1.ReleaseBindingSpec{ReleaseName: "greeter-v2"},
+		Status: v1alpha1.ReleaseBindingStatus{History: []v1alpha1.ReleaseHistoryEntry{
+			{ReleaseName: "greeter-v1", BoundBy: "alice@example.com"},
+		}},
+	}
+	service := newReleaseHistoryTestService(t, binding)
+	ctx := context.Background()
+
+	if err := service.recordBoundBy(ctx, binding, "bob@example.com"); err != nil {
+		t.Fatalf("recordBoundBy() error = %v", err)
+	}
+	var got v1alpha1.ReleaseBinding
+	if err := service.k8sClient.Get(ctx, client.ObjectKeyFromObject(binding), &got); err != nil {
+		t.Fatalf("failed to get binding: %v", err)
+	}
+	if len(got.Status.History) != 2 || got.Status.History[0].ReleaseName != "greeter-v2" ||
+		got.Status.History[0].BoundBy != "bob@example.com" {
+		t.Errorf("history = %+v, want greeter-v2 bound by bob@example.com first", got.Status.History)
+	}
+
+	// A release changed again in the meantime is not attributed to the user
+	stale := binding.DeepCopy()
+	stale.Spec.ReleaseName = "greeter-v3"
+	if err := service.recordBoundBy(ctx, stale, "carol@example.com"); err != nil {
+		t.Fatalf("recordBoundBy() error = %v", err)
+	}
+	if err := service.k8sClient.Get(ctx, client.ObjectKeyFromObject(binding), &got); err != nil {
+		t.Fatalf("failed to get binding: %v", err)
+	}
+	if len(got.Status.History) != 2 {
+		t.Errorf("history = %+v, want no entry for a release that is not bound", got.Status.History)
+	}
+}
@@ -6,9 +6,12 @@ package services
 import (
 	"log/slog"
 
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
 )
 
 type Services struct {
@@ -24,16 +27,21 @@ type Services struct {
 	BuildPlaneService         *BuildPlaneService
 	DeploymentPipelineService *DeploymentPipelineService
 	SchemaService             *SchemaService
-	k8sClient                 client.Client // Direct access to K8s client for apply operations
+	WatchService              *WatchService
+	Authorizer                *rbac.Authorizer // Nil when no authorization policy is configured
+	Auditor                   *audit.Auditor   // Records the mutating operations of the API and MCP tools
+	k8sClient                 client.Client    // Direct access to K8s client for apply operations
 }
 
-// NewServices creates and initializes all services
-func NewServices(k8sClient client.Client, k8sBPClientMgr *kubernetesClient.KubeMultiClientManager, logger *slog.Logger) *Services {
+// NewServices creates and initializes all services. The watches of the API are served from the shared informers of
+// the informer cache. A nil authorizer allows every action, and a nil auditor records nothing.
+func NewServices(k8sClient client.Client, informers cache.Informers, k8sBPClientMgr *kubernetesClient.KubeMultiClientManager, authorizer *rbac.Authorizer,
+	auditor *audit.Auditor, logger *slog.Logger) *Services {
 	// Create project service
 	projectService := NewProjectService(k8sClient, logger.With("service", "project"))
 
 	// Create component service (depends on project service)
-	componentService := NewComponentService(k8sClient, projectService, logger.With("service", "component"))
+	componentService := NewComponentService(k8sClient, projectService, authorizer, logger.With("service", "component"))
 
 	// Create organization service
 	organizationService := NewOrganizationService(k8sClient, logger.With("service", "organization"))
@@ -65,6 +73,9 @@ func NewServices(k8sClient client.Client, k8sBPClientMgr *kubernetesClient.KubeM
 	// Create Schema service
 	schemaService := NewSchemaService(k8sClient, logger.With("service", "schema"))
 
+	// Create Watch service
+	watchService := NewWatchService(informers, projectService, componentService, environmentService, logger.With("service", "watch"))
+
 	return &Services{
 		ProjectService:            projectService,
 		ComponentService:          componentService,
@@ -78,6 +89,9 @@ func NewServices(k8sClient client.Client, k8sBPClientMgr *kubernetesClient.KubeM
 		BuildPlaneService:         buildPlaneService,
 		DeploymentPipelineService: deploymentPipelineService,
 		SchemaService:             schemaService,
+		WatchService:              watchService,
+		Authorizer:                authorizer,
+		Auditor:                   auditor,
 		k8sClient:                 k8sClient,
 	}
 }
@@ -8,8 +8,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"time"
 
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
@@ -33,26 +35,35 @@ func NewTraitService(k8sClient client.Client, logger *slog.Logger) *TraitService
 	}
 }
 
-// ListTraits lists all Traits in the given organization
-func (s *TraitService) ListTraits(ctx context.Context, orgName string) ([]*models.TraitResponse, error) {
+// ListTraits lists a page of the Traits in the given organization
+func (s *TraitService) ListTraits(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListResponse[*models.TraitResponse], error) {
 	s.logger.Debug("Listing Traits", "org", orgName)
 
-	var traitList openchoreov1alpha1.TraitList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &traitList, listOpts...); err != nil {
+	traits, err := listItems(ctx, opts, listQuery[*models.TraitResponse]{
+		fetch: func(ctx context.Context, listOpts ...client.ListOption) ([]*models.TraitResponse, metav1.ListMeta, error) {
+			var traitList openchoreov1alpha1.TraitList
+			if err := s.k8sClient.List(ctx, &traitList, append(listOpts, client.InNamespace(orgName))...); err != nil {
+				return nil, metav1.ListMeta{}, err
+			}
+			items := make([]*models.TraitResponse, 0, len(traitList.Items))
+			for i := range traitList.Items {
+				items = append(items, s.toTraitResponse(&traitList.Items[i]))
+			}
+			return items, traitList.ListMeta, nil
+		},
+		sortKey: func(trait *models.TraitResponse) (string, time.Time) {
+			return trait.Name, trait.CreatedAt
+		},
+	})
+	if err != nil {
+		if isListOptionsError(err) {
+			return nil, err
+		}
 		s.logger.Error("Failed to list Traits", "error", err)
 		return nil, fmt.Errorf("failed to list Traits: %w", err)
 	}
 
-	traits := make([]*models.TraitResponse, 0, len(traitList.Items))
-	for i := range traitList.Items {
-		traits = append(traits, s.toTraitResponse(&traitList.Items[i]))
-	}
-
-	s.logger.Debug("Listed Traits", "org", orgName, "count", len(traits))
+	s.logger.Debug("Listed Traits", "org", orgName, "count", len(traits.Items))
 	return traits, nil
 }
 
@@ -0,0 +1,429 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// watchIdleInterval is how often send is called without an event while a watch is idle
+const watchIdleInterval = 15 * time.Second
+
+// watchHistorySize is how many of the latest events of a kind are kept for watches resuming after one of them
+const watchHistorySize = 1000
+
+// watchBufferSize is how many events a watch may fall behind the shared informer before it is resumed
+const watchBufferSize = 100
+
+// WatchEventFunc is called for every event of a watch. It is also called with a nil event every
+// watchIdleInterval, so that the caller can keep its connection alive. Returning an error stops the watch.
+type WatchEventFunc func(event *models.WatchEvent) error
+
+// informerGetter returns the shared informer of a type of objects, such as a controller-runtime cache
+type informerGetter interface {
+	GetInformer(ctx context.Context, obj client.Object, opts ...cache.InformerGetOption) (cache.Informer, error)
+}
+
+// watchedKind describes how to watch a kind of resources
+type watchedKind struct {
+	newObject func() client.Object
+	// convert returns the API response of a resource and the project and component the resource belongs to
+	convert func(obj client.Object) (response any, project, component string, ok bool)
+}
+
+// newWatchedKind creates a watchedKind for resources of type T
+func newWatchedKind[T client.Object](newObject func() client.Object, convert func(T) (any, string, string)) watchedKind {
+	return watchedKind{
+		newObject: newObject,
+		convert: func(obj client.Object) (any, string, string, bool) {
+			typed, ok := obj.(T)
+			if !ok {
+				return nil, "", "", false
+			}
+			response, project, component := convert(typed)
+			return response, project, component, true
+		},
+	}
+}
+
+// WatchService relays the changes of the OpenChoreo resources of an organization. All watches of a kind
+// are served from one shared informer, so that the number of watches does not add load to the API server.
+type WatchService struct {
+	informers informerGetter
+	kinds     map[string]watchedKind
+	logger    *slog.Logger
+
+	mu   sync.Mutex
+	hubs map[string]*watchHub
+}
+
+// NewWatchService creates a new watch service
+func NewWatchService(informers informerGetter, projectService *ProjectService, componentService *ComponentService,
+	environmentService *EnvironmentService, logger *slog.Logger) *WatchService {
+	kinds := map[string]watchedKind{
+		models.WatchKindProjects: newWatchedKind(
+			func() client.Object { return &openchoreov1alpha1.Project{} },
+			func(project *openchoreov1alpha1.Project) (any, string, string) {
+				return projectService.toProjectResponse(project), project.Name, ""
+			},
+		),
+		models.WatchKindEnvironments: newWatchedKind(
+			func() client.Object { return &openchoreov1alpha1.Environment{} },
+			func(env *openchoreov1alpha1.Environment) (any, string, string) {
+				return environmentService.toEnvironmentResponse(env), "", ""
+			},
+		),
+		models.WatchKindComponents: newWatchedKind(
+			func() client.Object { return &openchoreov1alpha1.Component{} },
+			func(component *openchoreov1alpha1.Component) (any, string, string) {
+				response := componentService.toComponentResponse(component, make(map[string]interface{}), false)
+				return response, component.Spec.Owner.ProjectName, component.Name
+			},
+		),
+		models.WatchKindComponentReleases: newWatchedKind(
+			func() client.Object { return &openchoreov1alpha1.ComponentRelease{} },
+			func(release *openchoreov1alpha1.ComponentRelease) (any, string, string) {
+				return toComponentReleaseResponse(release, release.Namespace),
+					release.Spec.Owner.ProjectName, release.Spec.Owner.ComponentName
+			},
+		),
+		models.WatchKindReleaseBindings: newWatchedKind(
+			func() client.Object { return &openchoreov1alpha1.ReleaseBinding{} },
+			func(binding *openchoreov1alpha1.ReleaseBinding) (any, string, string) {
+				owner := binding.Spec.Owner
+				return componentService.toReleaseBindingResponse(binding, binding.Namespace, owner.ProjectName, owner.ComponentName),
+					owner.ProjectName, owner.ComponentName
+			},
+		),
+		models.WatchKindBuilds: newWatchedKind(
+			func() client.Object { return &openchoreov1alpha1.WorkflowRun{} },
+			func(workflowRun *openchoreov1alpha1.WorkflowRun) (any, string, string) {
+				return toBuildResponse(workflowRun, workflowRun.Namespace),
+					workflowRun.Spec.Owner.ProjectName, workflowRun.Spec.Owner.ComponentName
+			},
+		),
+		models.WatchKindPromotionRequests: newWatchedKind(
+			func() client.Object { return &openchoreov1alpha1.PromotionRequest{} },
+			func(promotionRequest *openchoreov1alpha1.PromotionRequest) (any, string, string) {
+				return toPromotionRequestResponse(promotionRequest, promotionRequest.Namespace),
+					promotionRequest.Spec.Owner.ProjectName, promotionRequest.Spec.Owner.ComponentName
+			},
+		),
+	}
+
+	return &WatchService{
+		informers: informers,
+		kinds:     kinds,
+		logger:    logger,
+		hubs:      make(map[string]*watchHub),
+	}
+}
+
+// Watch sends the changes of the resources of a kind in an organization to send until the context is done.
+// A watch that falls behind the shared informer is resumed from the last event it received.
+func (s *WatchService) Watch(ctx context.Context, orgName string, opts *models.WatchOptions, send WatchEventFunc) error {
+	if err := opts.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidWatchOptions, err)
+	}
+	s.logger.Debug("Watching resources", "org", orgName, "kind", opts.Kind, "project", opts.Project,
+		"component", opts.Component, "resourceVersion", opts.ResourceVersion)
+
+	hub, err := s.hub(ctx, opts.Kind)
+	if err != nil {
+		return err
+	}
+	resourceVersion := opts.ResourceVersion
+	for {
+		replay, events, err := hub.subscribe(resourceVersion)
+		if err != nil {
+			return err
+		}
+		err = s.relay(ctx, orgName, opts, replay, events, &resourceVersion, send)
+		hub.unsubscribe(events)
+		if err != nil || ctx.Err() != nil {
+			return err
+		}
+		s.logger.Debug("Watch fell behind, resuming", "org", orgName, "kind", opts.Kind,
+			"resourceVersion", resourceVersion)
+	}
+}
+
+// hub returns the hub of a kind once it received the current resources, registering it with the shared informer
+// of the kind on the first watch of the kind. The informer syncs without holding the lock of the service, so that
+// watches of other kinds are not held up by it.
+func (s *WatchService) hub(ctx context.Context, kind string) (*watchHub, error) {
+	hub, err := s.registerHub(ctx, kind)
+	if err != nil {
+		return nil, err
+	}
+	if !toolscache.WaitForCacheSync(ctx.Done(), hub.hasSynced) {
+		return nil, fmt.Errorf("failed to watch %s: %w", kind, ctx.Err())
+	}
+	return hub, nil
+}
+
+// registerHub returns the hub of a kind, registering a new hub with the shared informer of the kind if there is none
+func (s *WatchService) registerHub(ctx context.Context, kind string) (*watchHub, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if hub, ok := s.hubs[kind]; ok {
+		return hub, nil
+	}
+
+	informer, err := s.informers.GetInformer(ctx, s.kinds[kind].newObject(), cache.BlockUntilSynced(false))
+	if err != nil {
+		s.logger.Error("Failed to get informer", "kind", kind, "error", err)
+		return nil, fmt.Errorf("failed to watch %s: %w", kind, err)
+	}
+	hub := newWatchHub()
+	registration, err := informer.AddEventHandler(hub)
+	if err != nil {
+		s.logger.Error("Failed to add informer event handler", "kind", kind, "error", err)
+		return nil, fmt.Errorf("failed to watch %s: %w", kind, err)
+	}
+	hub.hasSynced = registration.HasSynced
+	s.hubs[kind] = hub
+	return hub, nil
+}
+
+// relay sends the replayed events and then the events of the hub until the context is done or the hub drops the
+// watch for falling behind, updating resourceVersion with every event. Idle watches are sent a bookmark when the
+// events of other organizations or owners advanced resourceVersion, so that they can resume from it.
+func (s *WatchService) relay(ctx context.Context, orgName string, opts *models.WatchOptions, replay []hubEvent,
+	events <-chan hubEvent, resourceVersion *string, send WatchEventFunc) error {
+	kind := s.kinds[opts.Kind]
+	idle := time.NewTicker(watchIdleInterval)
+	defer idle.Stop()
+
+	// sent is the resource version of the last event sent
+	sent := *resourceVersion
+	for {
+		var event hubEvent
+		if len(replay) > 0 {
+			event, replay = replay[0], replay[1:]
+		} else {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-idle.C:
+				var bookmark *models.WatchEvent
+				if sent != *resourceVersion {
+					bookmark = &models.WatchEvent{Type: models.WatchEventBookmark, Kind: opts.Kind, ResourceVersion: *resourceVersion}
+					sent = *resourceVersion
+				}
+				if err := send(bookmark); err != nil {
+					return err
+				}
+				continue
+			case next, ok := <-events:
+				if !ok {
+					return nil
+				}
+				event = next
+			}
+		}
+
+		if event.resourceVersion != "" {
+			*resourceVersion = event.resourceVersion
+		}
+		watchEvent := toWatchEvent(event, orgName, opts, kind)
+		if watchEvent == nil {
+			continue
+		}
+		if err := send(watchEvent); err != nil {
+			return err
+		}
+		sent = *resourceVersion
+	}
+}
+
+// hubEvent is an event of the shared informer of a kind
+type hubEvent struct {
+	eventType string
+	// obj is nil for bookmarks
+	obj             client.Object
+	resourceVersion string
+}
+
+// watchHub fans the events of the shared informer of a kind out to the watches of the kind. It keeps the current
+// resources, which are sent to watches starting without a resource version, and the latest events, which are
+// replayed to watches resuming after one of them. Resource versions are not ordered across resources, so a watch
+// can only resume after an event the history still holds.
+type watchHub struct {
+	// hasSynced reports whether the hub received the initial list of the informer
+	hasSynced func() bool
+
+	mu          sync.Mutex
+	objects     map[client.ObjectKey]client.Object
+	history     []hubEvent
+	subscribers map[chan hubEvent]struct{}
+}
+
+func newWatchHub() *watchHub {
+	return &watchHub{
+		objects:     make(map[client.ObjectKey]client.Object),
+		subscribers: make(map[chan hubEvent]struct{}),
+	}
+}
+
+// OnAdd implements toolscache.ResourceEventHandler. The resources of the initial list of the informer are
+// current resources rather than events.
+func (h *watchHub) OnAdd(obj interface{}, isInInitialList bool) {
+	o, ok := obj.(client.Object)
+	if !ok {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.objects[client.ObjectKeyFromObject(o)] = o
+	if isInInitialList {
+		return
+	}
+	h.record(hubEvent{eventType: models.WatchEventAdded, obj: o, resourceVersion: o.GetResourceVersion()})
+}
+
+// OnUpdate implements toolscache.ResourceEventHandler. Resyncs of unchanged resources are ignored.
+func (h *watchHub) OnUpdate(oldObj, newObj interface{}) {
+	oldO, ok := oldObj.(client.Object)
+	if !ok {
+		return
+	}
+	o, ok := newObj.(client.Object)
+	if !ok || o.GetResourceVersion() == oldO.GetResourceVersion() {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.objects[client.ObjectKeyFromObject(o)] = o
+	h.record(hubEvent{eventType: models.WatchEventModified, obj: o, resourceVersion: o.GetResourceVersion()})
+}
+
+// OnDelete implements toolscache.ResourceEventHandler
+func (h *watchHub) OnDelete(obj interface{}) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	o, ok := obj.(client.Object)
+	if !ok {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.objects, client.ObjectKeyFromObject(o))
+	h.record(hubEvent{eventType: models.WatchEventDeleted, obj: o, resourceVersion: o.GetResourceVersion()})
+}
+
+// record adds an event to the history and sends it to the subscribers. Subscribers that fell behind are
+// dropped by closing their channel. The caller must hold the lock of the hub.
+func (h *watchHub) record(event hubEvent) {
+	h.history = append(h.history, event)
+	if len(h.history) > watchHistorySize {
+		h.history = h.history[1:]
+	}
+	for events := range h.subscribers {
+		select {
+		case events <- event:
+		default:
+			close(events)
+			delete(h.subscribers, events)
+		}
+	}
+}
+
+// subscribe returns the events a watch resuming from the resource version missed and a channel receiving the
+// events that follow. The current resources are returned as added events followed by a bookmark when the resource
+// version is empty, and ErrWatchExpired when the history does not hold the event of the resource version, such as
+// when it was dropped from the history or the resource version was not sent by this hub.
+func (h *watchHub) subscribe(resourceVersion string) ([]hubEvent, chan hubEvent, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var replay []hubEvent
+	switch {
+	case resourceVersion == "":
+		replay = h.snapshot()
+	default:
+		i := slices.IndexFunc(h.history, func(event hubEvent) bool { return event.resourceVersion == resourceVersion })
+		if i < 0 {
+			return nil, nil, ErrWatchExpired
+		}
+		replay = slices.Clone(h.history[i+1:])
+	}
+	events := make(chan hubEvent, watchBufferSize)
+	h.subscribers[events] = struct{}{}
+	return replay, events, nil
+}
+
+// unsubscribe stops sending events to a subscriber
+func (h *watchHub) unsubscribe(events chan hubEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subscribers, events)
+}
+
+// snapshot returns the current resources in the order of their keys as added events. The added events have no
+// resource version, since a watch cannot resume in the middle of them, and are followed by a bookmark with the
+// resource version of the latest event. The bookmark has no resource version either if there was no event since
+// the initial list of the informer. The caller must hold the lock of the hub.
+func (h *watchHub) snapshot() []hubEvent {
+	keys := make([]client.ObjectKey, 0, len(h.objects))
+	for key := range h.objects {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+
+	events := make([]hubEvent, 0, len(keys)+1)
+	for _, key := range keys {
+		events = append(events, hubEvent{eventType: models.WatchEventAdded, obj: h.objects[key]})
+	}
+	var latest string
+	if len(h.history) > 0 {
+		latest = h.history[len(h.history)-1].resourceVersion
+	}
+	return append(events, hubEvent{eventType: models.WatchEventBookmark, resourceVersion: latest})
+}
+
+// toWatchEvent converts an event of the hub for a watch. It returns nil for events of resources outside the
+// organization, project and component of the watch.
+func toWatchEvent(event hubEvent, orgName string, opts *models.WatchOptions, kind watchedKind) *models.WatchEvent {
+	watchEvent := &models.WatchEvent{
+		Type:            event.eventType,
+		Kind:            opts.Kind,
+		ResourceVersion: event.resourceVersion,
+	}
+	if event.obj == nil {
+		return watchEvent
+	}
+	if event.obj.GetNamespace() != orgName {
+		return nil
+	}
+
+	response, project, component, ok := kind.convert(event.obj)
+	if !ok || !matchesWatchOptions(project, component, opts) {
+		return nil
+	}
+	watchEvent.Object = response
+	return watchEvent
+}
+
+// matchesWatchOptions reports whether a resource belongs to the project and component of the watch
+func matchesWatchOptions(project, component string, opts *models.WatchOptions) bool {
+	if opts.Project != "" && project != opts.Project {
+		return false
+	}
+	return opts.Component == "" || component == opts.Component
+}
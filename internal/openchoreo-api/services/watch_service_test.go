@@ -0,0 +1,282 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
+
+// fakeInformer is a shared informer that passes its initial objects to every handler added. It is synced
+// unless unsynced is set.
+type fakeInformer struct {
+	initial  []client.Object
+	unsynced bool
+	handlers []toolscache.ResourceEventHandler
+}
+
+type fakeRegistration struct {
+	synced bool
+}
+
+func (r fakeRegistration) HasSynced() bool { return r.synced }
+
+func (f *fakeInformer) AddEventHandler(handler toolscache.ResourceEventHandler) (toolscache.ResourceEventHandlerRegistration, error) {
+	for _, obj := range f.initial {
+		handler.OnAdd(obj, true)
+	}
+	f.handlers = append(f.handlers, handler)
+	return fakeRegistration{synced: !f.unsynced}, nil
+}
+
+func (f *fakeInformer) AddEventHandlerWithResyncPeriod(handler toolscache.ResourceEventHandler,
+	_ time.Duration) (toolscache.ResourceEventHandlerRegistration, error) {
+	return f.AddEventHandler(handler)
+}
+
+func (f *fakeInformer) RemoveEventHandler(toolscache.ResourceEventHandlerRegistration) error {
+	return nil
+}
+
+func (f *fakeInformer) AddIndexers(toolscache.Indexers) error {
+	return nil
+}
+
+func (f *fakeInformer) HasSynced() bool {
+	return !f.unsynced
+}
+
+func (f *fakeInformer) IsStopped() bool {
+	return false
+}
+
+// fakeInformers returns the informer of byType for the type of an object, or else informer, counting the calls
+type fakeInformers struct {
+	mu       sync.Mutex
+	informer *fakeInformer
+	byType   map[string]*fakeInformer
+	calls    int
+}
+
+func (f *fakeInformers) GetInformer(_ context.Context, obj client.Object, _ ...cache.InformerGetOption) (cache.Informer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if informer, ok := f.byType[fmt.Sprintf("%T", obj)]; ok {
+		return informer, nil
+	}
+	return f.informer, nil
+}
+
+func testWorkflowRun(name, namespace, component, resourceVersion string) *v1alpha1.WorkflowRun {
+	run := &v1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, ResourceVersion: resourceVersion},
+	}
+	run.Spec.Owner.ProjectName = "shop"
+	run.Spec.Owner.ComponentName = component
+	return run
+}
+
+func newTestWatchService(informers informerGetter) *WatchService {
+	logger := slog.New(slog.DiscardHandler)
+	return NewWatchService(informers, &ProjectService{logger: logger}, &ComponentService{logger: logger},
+		&EnvironmentService{logger: logger}, logger)
+}
+
+func TestWatch(t *testing.T) {
+	informer := &fakeInformer{initial: []client.Object{
+		testWorkflowRun("cart-build-1", "acme", "cart", "1"),
+		testWorkflowRun("checkout-build-1", "acme", "checkout", "2"),
+		testWorkflowRun("cart-build-1", "other", "cart", "3"),
+	}}
+	informers := &fakeInformers{informer: informer}
+	service := newTestWatchService(informers)
+	opts := &models.WatchOptions{Kind: models.WatchKindBuilds, Project: "shop", Component: "cart"}
+
+	errDone := errors.New("done")
+	var events []string
+	err := service.Watch(context.Background(), "acme", opts, func(event *models.WatchEvent) error {
+		if event == nil {
+			return nil
+		}
+		name := ""
+		if build, ok := event.Object.(models.BuildResponse); ok {
+			name = build.Name
+		}
+		events = append(events, event.Type+" "+event.ResourceVersion+" "+name)
+
+		switch event.Type {
+		case models.WatchEventBookmark:
+			// Changes of the shared informer once the current resources were sent
+			informer.handlers[0].OnAdd(testWorkflowRun("cart-build-2", "acme", "cart", "4"), false)
+			informer.handlers[0].OnUpdate(testWorkflowRun("checkout-build-1", "acme", "checkout", "2"),
+				testWorkflowRun("checkout-build-1", "acme", "checkout", "5"))
+			informer.handlers[0].OnDelete(toolscache.DeletedFinalStateUnknown{
+				Obj: testWorkflowRun("cart-build-1", "acme", "cart", "6"),
+			})
+		case models.WatchEventDeleted:
+			return errDone
+		}
+		return nil
+	})
+
+	if !errors.Is(err, errDone) {
+		t.Fatalf("Watch() error = %v, want %v", err, errDone)
+	}
+	wantEvents := []string{"ADDED  cart-build-1", "BOOKMARK  ", "ADDED 4 cart-build-2", "DELETED 6 cart-build-1"}
+	if diff := cmp.Diff(wantEvents, events); diff != "" {
+		t.Errorf("events mismatch (-want +got):\n%s", diff)
+	}
+
+	// A watch resuming after an event replays the events that followed it from the same informer
+	events = nil
+	opts.ResourceVersion = "4"
+	err = service.Watch(context.Background(), "acme", opts, func(event *models.WatchEvent) error {
+		events = append(events, event.Type+" "+event.ResourceVersion)
+		return errDone
+	})
+	if !errors.Is(err, errDone) {
+		t.Fatalf("Watch() error = %v, want %v", err, errDone)
+	}
+	if diff := cmp.Diff([]string{"DELETED 6"}, events); diff != "" {
+		t.Errorf("resumed events mismatch (-want +got):\n%s", diff)
+	}
+	if informers.calls != 1 || len(informer.handlers) != 1 {
+		t.Errorf("watches got the informer %d times and added %d handlers, want 1 and 1", informers.calls, len(informer.handlers))
+	}
+}
+
+func TestWatchHubSubscribe(t *testing.T) {
+	hub := newWatchHub()
+	hub.OnAdd(testWorkflowRun("cart-build-1", "acme", "cart", "1"), true)
+	for i := 2; i <= watchHistorySize+2; i++ {
+		hub.OnAdd(testWorkflowRun("cart-build-"+strconv.Itoa(i), "acme", "cart", strconv.Itoa(i)), false)
+	}
+
+	tests := []struct {
+		name            string
+		resourceVersion string
+		wantFirst       string
+		wantLen         int
+		wantErr         error
+	}{
+		{name: "Current resources", resourceVersion: "", wantFirst: "ADDED ", wantLen: watchHistorySize + 3},
+		{name: "Resume after an event", resourceVersion: "1000", wantFirst: "ADDED 1001", wantLen: 2},
+		{name: "Resume after the latest event", resourceVersion: strconv.Itoa(watchHistorySize + 2)},
+		{name: "Resume after the first event of the history", resourceVersion: "3", wantFirst: "ADDED 4", wantLen: watchHistorySize - 1},
+		{name: "Resume after an event no longer in the history", resourceVersion: "2", wantErr: ErrWatchExpired},
+		{name: "Resume after a resource of the initial list", resourceVersion: "1", wantErr: ErrWatchExpired},
+		{name: "Resume after an unknown event", resourceVersion: "unknown", wantErr: ErrWatchExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replay, events, err := hub.subscribe(tt.resourceVersion)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("subscribe() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			hub.unsubscribe(events)
+			if len(replay) != tt.wantLen {
+				t.Fatalf("subscribe() replayed %d events, want %d", len(replay), tt.wantLen)
+			}
+			if len(replay) > 0 && replay[0].eventType+" "+replay[0].resourceVersion != tt.wantFirst {
+				t.Errorf("first replayed event = %s %s, want %s", replay[0].eventType, replay[0].resourceVersion, tt.wantFirst)
+			}
+		})
+	}
+}
+
+func TestWatchHubDropsSlowSubscribers(t *testing.T) {
+	hub := newWatchHub()
+	_, events, err := hub.subscribe("")
+	if err != nil {
+		t.Fatalf("subscribe() error = %v", err)
+	}
+	for i := 1; i <= watchBufferSize+1; i++ {
+		hub.OnAdd(testWorkflowRun("cart-build-"+strconv.Itoa(i), "acme", "cart", strconv.Itoa(i)), false)
+	}
+
+	received := 0
+	for range events {
+		received++
+	}
+	if received != watchBufferSize {
+		t.Errorf("subscriber received %d events before it was dropped, want %d", received, watchBufferSize)
+	}
+	if len(hub.subscribers) != 0 {
+		t.Errorf("hub has %d subscribers, want 0", len(hub.subscribers))
+	}
+}
+
+func TestWatchSyncDoesNotBlockOtherKinds(t *testing.T) {
+	informers := &fakeInformers{
+		informer: &fakeInformer{},
+		byType:   map[string]*fakeInformer{"*v1alpha1.WorkflowRun": {unsynced: true}},
+	}
+	service := newTestWatchService(informers)
+
+	// The first watch of builds waits for the informer of builds to sync until it is cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	buildsDone := make(chan error, 1)
+	go func() {
+		buildsDone <- service.Watch(ctx, "acme", &models.WatchOptions{Kind: models.WatchKindBuilds}, func(*models.WatchEvent) error {
+			return nil
+		})
+	}()
+	for registered := false; !registered; time.Sleep(time.Millisecond) {
+		informers.mu.Lock()
+		registered = informers.calls > 0
+		informers.mu.Unlock()
+	}
+
+	errDone := errors.New("done")
+	err := service.Watch(context.Background(), "acme", &models.WatchOptions{Kind: models.WatchKindProjects}, func(event *models.WatchEvent) error {
+		if event != nil && event.Type == models.WatchEventBookmark {
+			return errDone
+		}
+		return nil
+	})
+	if !errors.Is(err, errDone) {
+		t.Fatalf("Watch() of projects error = %v, want %v", err, errDone)
+	}
+
+	cancel()
+	if err := <-buildsDone; !errors.Is(err, context.Canceled) {
+		t.Errorf("Watch() of builds error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestWatchInvalidOptions(t *testing.T) {
+	informers := &fakeInformers{informer: &fakeInformer{}}
+	opts := &models.WatchOptions{Kind: models.WatchKindEnvironments, Project: "shop"}
+	err := newTestWatchService(informers).Watch(context.Background(), "acme", opts, func(*models.WatchEvent) error {
+		return nil
+	})
+
+	if !errors.Is(err, ErrInvalidWatchOptions) {
+		t.Errorf("Watch() error = %v, want %v", err, ErrInvalidWatchOptions)
+	}
+	if informers.calls != 0 {
+		t.Errorf("Watch() got an informer %d times with invalid options", informers.calls)
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
@@ -32,26 +34,35 @@ func NewWorkflowService(k8sClient client.Client, logger *slog.Logger) *WorkflowS
 	}
 }
 
-// ListWorkflows lists all Workflows in the given organization
-func (s *WorkflowService) ListWorkflows(ctx context.Context, orgName string) ([]*models.WorkflowResponse, error) {
+// ListWorkflows lists a page of the Workflows in the given organization
+func (s *WorkflowService) ListWorkflows(ctx context.Context, orgName string, opts *models.ListOptions) (*models.ListResponse[*models.WorkflowResponse], error) {
 	s.logger.Debug("Listing Workflows", "org", orgName)
 
-	var wfList openchoreov1alpha1.WorkflowList
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-	}
-
-	if err := s.k8sClient.List(ctx, &wfList, listOpts...); err != nil {
+	wfs, err := listItems(ctx, opts, listQuery[*models.WorkflowResponse]{
+		fetch: func(ctx context.Context, listOpts ...client.ListOption) ([]*models.WorkflowResponse, metav1.ListMeta, error) {
+			var wfList openchoreov1alpha1.WorkflowList
+			if err := s.k8sClient.List(ctx, &wfList, append(listOpts, client.InNamespace(orgName))...); err != nil {
+				return nil, metav1.ListMeta{}, err
+			}
+			items := make([]*models.WorkflowResponse, 0, len(wfList.Items))
+			for i := range wfList.Items {
+				items = append(items, s.toWorkflowResponse(&wfList.Items[i]))
+			}
+			return items, wfList.ListMeta, nil
+		},
+		sortKey: func(workflow *models.WorkflowResponse) (string, time.Time) {
+			return workflow.Name, workflow.CreatedAt
+		},
+	})
+	if err != nil {
+		if isListOptionsError(err) {
+			return nil, err
+		}
 		s.logger.Error("Failed to list Workflows", "error", err)
 		return nil, fmt.Errorf("failed to list Workflows: %w", err)
 	}
 
-	wfs := make([]*models.WorkflowResponse, 0, len(wfList.Items))
-	for i := range wfList.Items {
-		wfs = append(wfs, s.toWorkflowResponse(&wfList.Items[i]))
-	}
-
-	s.logger.Debug("Listed Workflows", "org", orgName, "count", len(wfs))
+	s.logger.Debug("Listed Workflows", "org", orgName, "count", len(wfs.Items))
 	return wfs, nil
 }
 
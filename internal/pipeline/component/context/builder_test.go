@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	apiextschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
 	"sigs.k8s.io/yaml"
 
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
@@ -53,7 +54,7 @@ spec:
 			environment: "dev",
 			want: map[string]any{
 				"parameters": map[string]any{
-					"replicas": float64(3), // JSON numbers are float64
+					"replicas": int64(3), // Integer typed in the schema
 					"image":    "myapp:v1",
 				},
 				"component": map[string]any{
@@ -115,8 +116,8 @@ spec:
 			environment: "prod",
 			want: map[string]any{
 				"parameters": map[string]any{
-					"replicas": float64(5), // Override applied
-					"cpu":      "100m",     // Base value preserved
+					"replicas": int64(5), // Override applied
+					"cpu":      "100m",   // Base value preserved
 				},
 				"component": map[string]any{
 					"name": "test-component",
@@ -140,6 +141,57 @@ spec:
 			},
 			wantErr: false,
 		},
+		{
+			name: "schema defaults have the numeric types of set values",
+			componentYAML: `
+apiVersion: choreo.dev/v1alpha1
+kind: Component
+metadata:
+  name: test-component
+spec:
+  type: service
+  parameters:
+    image: myapp:v1
+`,
+			componentTypeYAML: `
+apiVersion: choreo.dev/v1alpha1
+kind: ComponentType
+metadata:
+  name: service
+spec:
+  schema:
+    parameters:
+      replicas: "integer | default=2"
+      image: "string"
+`,
+			environment: "dev",
+			want: map[string]any{
+				"parameters": map[string]any{
+					"replicas": int64(2), // Same type as a replicas value set on the component
+					"image":    "myapp:v1",
+				},
+				"component": map[string]any{
+					"name": "test-component",
+				},
+				"environment": map[string]any{
+					"name":  "dev",
+					"vhost": "api.example.com",
+				},
+				"metadata": map[string]any{
+					"name":            "test-component-dev-12345678",
+					"namespace":       "test-namespace",
+					"componentName":   "test-component",
+					"componentUID":    "a1b2c3d4-5678-90ab-cdef-1234567890ab",
+					"projectName":     "test-project",
+					"projectUID":      "b2c3d4e5-6789-01bc-def0-234567890abc",
+					"dataPlaneName":   "test-dataplane",
+					"dataPlaneUID":    "c3d4e5f6-7890-12cd-ef01-34567890abcd",
+					"environmentName": "dev",
+					"environmentUID":  "d4e5f6a7-8901-23de-f012-4567890abcde",
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "component with workload",
 			componentYAML: `
@@ -589,4 +641,55 @@ func TestDeepMerge(t *testing.T) {
 	}
 }
 
+func TestNormalizeNumbers(t *testing.T) {
+	integer := apiextschema.Structural{Generic: apiextschema.Generic{Type: "integer"}}
+	number := apiextschema.Structural{Generic: apiextschema.Generic{Type: "number"}}
+	structural := &apiextschema.Structural{
+		Generic: apiextschema.Generic{Type: "object"},
+		Properties: map[string]apiextschema.Structural{
+			"replicas": integer,
+			"ratio":    number,
+			"port": {
+				Generic:    apiextschema.Generic{Type: "integer"},
+				Extensions: apiextschema.Extensions{XIntOrString: true},
+			},
+			"ports": {
+				Generic: apiextschema.Generic{Type: "array"},
+				Items:   &integer,
+			},
+			"limits": {
+				Generic:              apiextschema.Generic{Type: "object"},
+				AdditionalProperties: &apiextschema.StructuralOrBool{Structural: &integer},
+			},
+		},
+	}
+
+	params := map[string]any{
+		"replicas":   int64(2), // Inserted by schema defaulting
+		"ratio":      1.5,
+		"port":       float64(8080),
+		"ports":      []any{float64(80), float64(443)},
+		"limits":     map[string]any{"cpu": float64(2)},
+		"fractional": 2.5,
+		"undeclared": float64(3),
+	}
+	got, err := normalizeNumbers(params, structural)
+	if err != nil {
+		t.Fatalf("normalizeNumbers() unexpected error: %v", err)
+	}
+
+	want := map[string]any{
+		"replicas":   int64(2),
+		"ratio":      1.5,
+		"port":       float64(8080), // Int-or-string values are dynamically typed
+		"ports":      []any{int64(80), int64(443)},
+		"limits":     map[string]any{"cpu": int64(2)},
+		"fractional": 2.5,
+		"undeclared": float64(3),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("normalizeNumbers() mismatch (-want +got):\n%s", diff)
+	}
+}
+
 // Helper functions
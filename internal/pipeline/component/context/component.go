@@ -6,6 +6,7 @@ package context
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 
 	apiextschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -16,11 +17,25 @@ import (
 	"github.com/openchoreo/openchoreo/internal/schema"
 )
 
+// ComponentContextVariables lists every top-level variable that BuildComponentContext can expose to
+// ComponentType templates. Some of them (configurations, connections, dataplane) are only set when the data exists.
+var ComponentContextVariables = []string{
+	"parameters",
+	"workload",
+	"configurations",
+	"connections",
+	"component",
+	"environment",
+	"metadata",
+	"dataplane",
+}
+
 // BuildComponentContext builds a CEL evaluation context for rendering component resources.
 //
 // The context includes:
 //   - parameters: Component parameters with environment overrides and schema defaults applied
 //   - workload: Workload specification (image, resources, etc.)
+//   - connections: Endpoints resolved for the workload connections (host, port, url, etc.)
 //   - component: Component metadata (name, etc.)
 //   - environment: Environment name
 //   - metadata: Additional metadata
@@ -73,6 +88,10 @@ func BuildComponentContext(input *ComponentContextInput) (map[string]any, error)
 	}
 
 	parameters = schema.ApplyDefaults(parameters, structural)
+	parameters, err = normalizeNumbers(parameters, structural)
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize component parameters: %w", err)
+	}
 	ctx["parameters"] = parameters
 
 	workload := input.Workload
@@ -80,6 +99,12 @@ func BuildComponentContext(input *ComponentContextInput) (map[string]any, error)
 		workload = mergeWorkloadOverrides(input.Workload, input.ReleaseBinding.Spec.WorkloadOverrides)
 	}
 
+	// Inject the env vars of resolved connections, so they are rendered like any other container env var
+	workload, err = injectConnectionEnv(workload, input.Connections)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inject connection env vars: %w", err)
+	}
+
 	if workload != nil {
 		workloadData, err := extractWorkloadData(workload)
 		if err != nil {
@@ -95,6 +120,10 @@ func BuildComponentContext(input *ComponentContextInput) (map[string]any, error)
 		}
 	}
 
+	if len(input.Connections) > 0 {
+		ctx["connections"] = buildConnectionsContext(input.Connections)
+	}
+
 	componentMeta := map[string]any{
 		"name": input.Component.Name,
 	}
@@ -170,6 +199,52 @@ func extractParameters(raw *runtime.RawExtension) (map[string]any, error) {
 	return params, nil
 }
 
+// normalizeNumbers converts the numbers of the parameters to the types their schema declares: integers
+// to int64 and all other numbers to float64. Values decoded from JSON are float64 while schema defaulting
+// inserts int64 values, so expressions see the same numeric types regardless of whether a parameter was
+// set or defaulted, and the types match the CEL types the template checker derives from the schema.
+func normalizeNumbers(params map[string]any, structural *apiextschema.Structural) (map[string]any, error) {
+	// The JSON round trip turns every number into a float64
+	normalized, err := structToMap(params)
+	if err != nil {
+		return nil, err
+	}
+	result, ok := normalized.(map[string]any)
+	if !ok {
+		return make(map[string]any), nil
+	}
+	normalizeIntegers(result, structural)
+	return result, nil
+}
+
+// normalizeIntegers converts the whole float64 values of integer typed schema fields to int64 and
+// returns the converted value. Maps and lists are converted in place.
+func normalizeIntegers(value any, s *apiextschema.Structural) any {
+	if s == nil {
+		return value
+	}
+
+	switch v := value.(type) {
+	case float64:
+		if s.Type == "integer" && !s.XIntOrString && v == math.Trunc(v) && v >= math.MinInt64 && v < math.MaxInt64 {
+			return int64(v)
+		}
+	case map[string]any:
+		for key, item := range v {
+			if prop, ok := s.Properties[key]; ok {
+				v[key] = normalizeIntegers(item, &prop)
+			} else if s.AdditionalProperties != nil {
+				v[key] = normalizeIntegers(item, s.AdditionalProperties.Structural)
+			}
+		}
+	case []any:
+		for i := range v {
+			v[i] = normalizeIntegers(v[i], s.Items)
+		}
+	}
+	return value
+}
+
 // extractWorkloadData extracts relevant workload information for the rendering context.
 func extractWorkloadData(workload *v1alpha1.Workload) (map[string]any, error) {
 	data := make(map[string]any)
@@ -0,0 +1,97 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"sort"
+	"text/template"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// connectionValues returns the properties of a resolved connection, keyed by the names used in
+// CEL expressions (${connections.<name>.host}) and in inject templates ({{ .host }}).
+func connectionValues(conn ConnectionContext) map[string]any {
+	return map[string]any{
+		"host":       conn.Host,
+		"port":       conn.Port,
+		"scheme":     conn.Scheme,
+		"basePath":   conn.BasePath,
+		"url":        conn.URL,
+		"visibility": conn.Visibility,
+	}
+}
+
+// buildConnectionsContext converts the resolved connections into the connections context variable.
+func buildConnectionsContext(connections map[string]ConnectionContext) map[string]any {
+	result := make(map[string]any, len(connections))
+	for name, conn := range connections {
+		values := connectionValues(conn)
+		// Integers of the context are int64, the same as integer typed parameters
+		values["port"] = int64(conn.Port)
+		result[name] = values
+	}
+	return result
+}
+
+// injectConnectionEnv adds the env vars declared in the inject section of each resolved connection
+// to every container of the workload. Values are Go templates over the connection properties,
+// e.g. "{{ .host }}:{{ .port }}". Env vars defined by the container itself take precedence.
+// Connections without a resolved endpoint are skipped.
+func injectConnectionEnv(workload *openchoreov1alpha1.Workload,
+	connections map[string]ConnectionContext) (*openchoreov1alpha1.Workload, error) {
+	if workload == nil || len(workload.Spec.Connections) == 0 || len(connections) == 0 {
+		return workload, nil
+	}
+
+	// Sort connection names for a deterministic env var order
+	names := make([]string, 0, len(workload.Spec.Connections))
+	for name := range workload.Spec.Connections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var envs []openchoreov1alpha1.EnvVar
+	for _, name := range names {
+		resolved, ok := connections[name]
+		if !ok {
+			continue
+		}
+		values := connectionValues(resolved)
+		values["uri"] = resolved.URL // Alias kept for workloads written for the binding controllers
+
+		for _, env := range workload.Spec.Connections[name].Inject.Env {
+			value, err := renderConnectionTemplate(env.Value, values)
+			if err != nil {
+				return nil, fmt.Errorf("failed to render env var %s of connection %s: %w", env.Name, name, err)
+			}
+			envs = append(envs, openchoreov1alpha1.EnvVar{Key: env.Name, Value: value})
+		}
+	}
+	if len(envs) == 0 {
+		return workload, nil
+	}
+
+	injected := workload.DeepCopy()
+	for containerName, container := range injected.Spec.Containers {
+		container.Env = mergeEnvConfigs(slices.Clone(envs), container.Env)
+		injected.Spec.Containers[containerName] = container
+	}
+	return injected, nil
+}
+
+func renderConnectionTemplate(text string, values map[string]any) (string, error) {
+	tmpl, err := template.New("connection").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, values); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestInjectConnectionEnv(t *testing.T) {
+	catalog := ConnectionContext{
+		Host:       "catalog.dp-acme-shop-dev-12345678.svc.cluster.local",
+		Port:       8080,
+		Scheme:     "http",
+		URL:        "http://catalog.dp-acme-shop-dev-12345678.svc.cluster.local:8080",
+		Visibility: "Project",
+	}
+	newWorkload := func(env []v1alpha1.EnvVar, injected ...v1alpha1.WorkloadConnectionEnvVar) *v1alpha1.Workload {
+		return &v1alpha1.Workload{
+			Spec: v1alpha1.WorkloadSpec{
+				WorkloadTemplateSpec: v1alpha1.WorkloadTemplateSpec{
+					Containers: map[string]v1alpha1.Container{
+						"main": {Image: "shop:v1", Env: env},
+					},
+					Connections: map[string]v1alpha1.WorkloadConnection{
+						"catalog": {
+							Type:   v1alpha1.ConnectionTypeAPI,
+							Inject: v1alpha1.WorkloadConnectionInject{Env: injected},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		workload    *v1alpha1.Workload
+		connections map[string]ConnectionContext
+		want        []v1alpha1.EnvVar
+		wantErr     bool
+	}{
+		{
+			name: "templated env vars are added to the containers",
+			workload: newWorkload([]v1alpha1.EnvVar{{Key: "LOG_LEVEL", Value: "info"}},
+				v1alpha1.WorkloadConnectionEnvVar{Name: "CATALOG_ADDR", Value: "{{ .host }}:{{ .port }}"},
+				v1alpha1.WorkloadConnectionEnvVar{Name: "CATALOG_URL", Value: "{{ .url }}/v1"},
+			),
+			connections: map[string]ConnectionContext{"catalog": catalog},
+			want: []v1alpha1.EnvVar{
+				{Key: "CATALOG_ADDR", Value: "catalog.dp-acme-shop-dev-12345678.svc.cluster.local:8080"},
+				{Key: "CATALOG_URL", Value: "http://catalog.dp-acme-shop-dev-12345678.svc.cluster.local:8080/v1"},
+				{Key: "LOG_LEVEL", Value: "info"},
+			},
+		},
+		{
+			name: "container env vars take precedence",
+			workload: newWorkload([]v1alpha1.EnvVar{{Key: "CATALOG_URL", Value: "http://localhost:8080"}},
+				v1alpha1.WorkloadConnectionEnvVar{Name: "CATALOG_URL", Value: "{{ .uri }}"},
+			),
+			connections: map[string]ConnectionContext{"catalog": catalog},
+			want:        []v1alpha1.EnvVar{{Key: "CATALOG_URL", Value: "http://localhost:8080"}},
+		},
+		{
+			name: "unresolved connections are skipped",
+			workload: newWorkload(nil,
+				v1alpha1.WorkloadConnectionEnvVar{Name: "CATALOG_URL", Value: "{{ .url }}"},
+			),
+			want: nil,
+		},
+		{
+			name: "unknown template keys are rejected",
+			workload: newWorkload(nil,
+				v1alpha1.WorkloadConnectionEnvVar{Name: "CATALOG_URL", Value: "{{ .address }}"},
+			),
+			connections: map[string]ConnectionContext{"catalog": catalog},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := injectConnectionEnv(tt.workload, tt.connections)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("injectConnectionEnv() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got.Spec.Containers["main"].Env); diff != "" {
+				t.Errorf("container env mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildConnectionsContext(t *testing.T) {
+	got := buildConnectionsContext(map[string]ConnectionContext{
+		"catalog": {Host: "catalog.shop.svc.cluster.local", Port: 50051, Scheme: "grpc",
+			URL: "grpc://catalog.shop.svc.cluster.local:50051", Visibility: "Organization"},
+	})
+	want := map[string]any{
+		"catalog": map[string]any{
+			"host":       "catalog.shop.svc.cluster.local",
+			"port":       int64(50051),
+			"scheme":     "grpc",
+			"basePath":   "",
+			"url":        "grpc://catalog.shop.svc.cluster.local:50051",
+			"visibility": "Organization",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("buildConnectionsContext() mismatch (-want +got):\n%s", diff)
+	}
+}
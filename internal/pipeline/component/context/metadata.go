@@ -0,0 +1,83 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// MetadataContextInput contains the identifiers needed to compute a MetadataContext.
+type MetadataContextInput struct {
+	// OrganizationName is the organization (control plane namespace) that owns the component.
+	OrganizationName string
+
+	// ProjectName is the name of the project that owns the component.
+	ProjectName string
+
+	// ProjectUID is the unique identifier of the project.
+	ProjectUID string
+
+	// ComponentName is the name of the component being rendered.
+	ComponentName string
+
+	// ComponentUID is the unique identifier of the component.
+	ComponentUID string
+
+	// EnvironmentName is the name of the environment being rendered for.
+	EnvironmentName string
+
+	// EnvironmentUID is the unique identifier of the environment.
+	EnvironmentUID string
+
+	// DataPlaneName is the name of the data plane the environment is bound to.
+	DataPlaneName string
+
+	// DataPlaneUID is the unique identifier of the data plane.
+	DataPlaneUID string
+}
+
+// BuildMetadataContext computes the resource names, namespace, labels and pod selectors
+// using the platform naming conventions.
+//
+// Generated names:
+//   - Name: {component}-{env}-{hash}
+//   - Namespace: dp-{org}-{project}-{env}-{hash}
+func BuildMetadataContext(input *MetadataContextInput) MetadataContext {
+	baseName := dpkubernetes.GenerateK8sName(input.ComponentName, input.EnvironmentName)
+
+	namespace := dpkubernetes.GenerateK8sNameWithLengthLimit(
+		dpkubernetes.MaxNamespaceNameLength,
+		"dp", input.OrganizationName, input.ProjectName, input.EnvironmentName,
+	)
+
+	standardLabels := map[string]string{
+		labels.LabelKeyOrganizationName: input.OrganizationName,
+		labels.LabelKeyProjectName:      input.ProjectName,
+		labels.LabelKeyComponentName:    input.ComponentName,
+		labels.LabelKeyEnvironmentName:  input.EnvironmentName,
+	}
+
+	podSelectors := map[string]string{
+		labels.LabelKeyComponentUID:   input.ComponentUID,
+		labels.LabelKeyEnvironmentUID: input.EnvironmentUID,
+		labels.LabelKeyProjectUID:     input.ProjectUID,
+	}
+
+	return MetadataContext{
+		Name:            baseName,
+		Namespace:       namespace,
+		Labels:          standardLabels,
+		Annotations:     map[string]string{},
+		PodSelectors:    podSelectors,
+		ComponentName:   input.ComponentName,
+		ComponentUID:    input.ComponentUID,
+		ProjectName:     input.ProjectName,
+		ProjectUID:      input.ProjectUID,
+		DataPlaneName:   input.DataPlaneName,
+		DataPlaneUID:    input.DataPlaneUID,
+		EnvironmentName: input.EnvironmentName,
+		EnvironmentUID:  input.EnvironmentUID,
+	}
+}
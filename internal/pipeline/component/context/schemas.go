@@ -0,0 +1,141 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	apiextschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+// ComponentContextSchemas describes the variables built by BuildComponentContext for type checking
+// ComponentType templates with template.Engine.Check. parameters is the structural schema of the
+// ComponentType; a nil schema declares parameters with a dynamic type.
+func ComponentContextSchemas(parameters *apiextschema.Structural) template.Variables {
+	return template.Variables{
+		"parameters":     parameters,
+		"workload":       workloadSchema(),
+		"configurations": nil,
+		"connections":    connectionsSchema(),
+		"component":      componentSchema(),
+		"environment":    environmentSchema(),
+		"metadata":       componentMetadataSchema(),
+		"dataplane":      objectSchema(map[string]apiextschema.Structural{"secretStore": *stringSchema()}),
+	}
+}
+
+// TraitContextSchemas describes the variables built by BuildTraitContext for type checking Trait
+// templates with template.Engine.Check. parameters is the structural schema of the Trait; a nil
+// schema declares parameters with a dynamic type.
+func TraitContextSchemas(parameters *apiextschema.Structural) template.Variables {
+	return template.Variables{
+		"parameters": parameters,
+		"trait": objectSchema(map[string]apiextschema.Structural{
+			"name":         *stringSchema(),
+			"instanceName": *stringSchema(),
+		}),
+		"component":   componentSchema(),
+		"environment": environmentSchema(),
+		"metadata": objectSchema(map[string]apiextschema.Structural{
+			"name":         *stringSchema(),
+			"namespace":    *stringSchema(),
+			"labels":       *stringMapSchema(),
+			"annotations":  *stringMapSchema(),
+			"podSelectors": *stringMapSchema(),
+		}),
+	}
+}
+
+func componentSchema() *apiextschema.Structural {
+	return objectSchema(map[string]apiextschema.Structural{
+		"name":      *stringSchema(),
+		"namespace": *stringSchema(),
+	})
+}
+
+func environmentSchema() *apiextschema.Structural {
+	return objectSchema(map[string]apiextschema.Structural{
+		"name":  *stringSchema(),
+		"vhost": *stringSchema(),
+	})
+}
+
+func componentMetadataSchema() *apiextschema.Structural {
+	return objectSchema(map[string]apiextschema.Structural{
+		"name":            *stringSchema(),
+		"namespace":       *stringSchema(),
+		"componentName":   *stringSchema(),
+		"componentUID":    *stringSchema(),
+		"projectName":     *stringSchema(),
+		"projectUID":      *stringSchema(),
+		"dataPlaneName":   *stringSchema(),
+		"dataPlaneUID":    *stringSchema(),
+		"environmentName": *stringSchema(),
+		"environmentUID":  *stringSchema(),
+		"labels":          *stringMapSchema(),
+		"annotations":     *stringMapSchema(),
+		"podSelectors":    *stringMapSchema(),
+	})
+}
+
+// workloadSchema mirrors extractWorkloadData. Endpoints and connections are keyed by name and
+// keep the shape of the Workload API, so their values are left untyped.
+func workloadSchema() *apiextschema.Structural {
+	stringList := apiextschema.Structural{
+		Generic: apiextschema.Generic{Type: "array"},
+		Items:   stringSchema(),
+	}
+	container := objectSchema(map[string]apiextschema.Structural{
+		"image":   *stringSchema(),
+		"command": stringList,
+		"args":    stringList,
+	})
+	untypedMap := apiextschema.Structural{
+		Generic:    apiextschema.Generic{Type: "object"},
+		Extensions: apiextschema.Extensions{XPreserveUnknownFields: true},
+	}
+	return objectSchema(map[string]apiextschema.Structural{
+		"name": *stringSchema(),
+		"containers": {
+			Generic:              apiextschema.Generic{Type: "object"},
+			AdditionalProperties: &apiextschema.StructuralOrBool{Structural: container},
+		},
+		"endpoints":   untypedMap,
+		"connections": untypedMap,
+	})
+}
+
+// connectionsSchema mirrors buildConnectionsContext, keyed by connection name.
+func connectionsSchema() *apiextschema.Structural {
+	connection := objectSchema(map[string]apiextschema.Structural{
+		"host":       *stringSchema(),
+		"port":       {Generic: apiextschema.Generic{Type: "integer"}},
+		"scheme":     *stringSchema(),
+		"basePath":   *stringSchema(),
+		"url":        *stringSchema(),
+		"visibility": *stringSchema(),
+	})
+	return &apiextschema.Structural{
+		Generic:              apiextschema.Generic{Type: "object"},
+		AdditionalProperties: &apiextschema.StructuralOrBool{Structural: connection},
+	}
+}
+
+func objectSchema(properties map[string]apiextschema.Structural) *apiextschema.Structural {
+	return &apiextschema.Structural{
+		Generic:    apiextschema.Generic{Type: "object"},
+		Properties: properties,
+	}
+}
+
+func stringSchema() *apiextschema.Structural {
+	return &apiextschema.Structural{Generic: apiextschema.Generic{Type: "string"}}
+}
+
+func stringMapSchema() *apiextschema.Structural {
+	return &apiextschema.Structural{
+		Generic:              apiextschema.Generic{Type: "object"},
+		AdditionalProperties: &apiextschema.StructuralOrBool{Structural: stringSchema()},
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+func TestContextSchemasDeclareContextVariables(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables template.Variables
+		want      []string
+	}{
+		{name: "component", variables: ComponentContextSchemas(nil), want: ComponentContextVariables},
+		{name: "trait", variables: TraitContextSchemas(nil), want: TraitContextVariables},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]string, 0, len(tt.variables))
+			for name := range tt.variables {
+				got = append(got, name)
+			}
+			want := append([]string(nil), tt.want...)
+			sort.Strings(got)
+			sort.Strings(want)
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("declared variables mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestComponentContextSchemasCheck(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    bool
+	}{
+		{name: "metadata field", expression: "${metadata.componentName}"},
+		{name: "metadata labels", expression: `${oc_merge(metadata.labels, {"tier": "backend"})}`},
+		{name: "container image", expression: "${workload.containers['main'].image}"},
+		{name: "endpoint port", expression: "${workload.endpoints['http'].port}"},
+		{name: "environment vhost", expression: "${environment.vhost}"},
+		{name: "unknown metadata field", expression: "${metadata.projectId}", wantErr: true},
+		{name: "unknown container field", expression: "${workload.containers['main'].tag}", wantErr: true},
+		{name: "string arithmetic", expression: "${component.name + 1}", wantErr: true},
+		{name: "integer port arithmetic", expression: "${connections['catalog'].port + 1}"},
+		{name: "integer port comparison", expression: "${connections['catalog'].port > 1024}"},
+	}
+
+	engine := template.NewEngine()
+	variables := ComponentContextSchemas(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := engine.Check(tt.expression, variables)
+			if gotErr := len(errs) > 0; gotErr != tt.wantErr {
+				t.Errorf("Check(%q) errors = %v, wantErr %v", tt.expression, errs, tt.wantErr)
+			}
+		})
+	}
+}
@@ -13,6 +13,15 @@ import (
 	"github.com/openchoreo/openchoreo/internal/schema"
 )
 
+// TraitContextVariables lists every top-level variable that BuildTraitContext exposes to Trait templates.
+var TraitContextVariables = []string{
+	"parameters",
+	"trait",
+	"component",
+	"environment",
+	"metadata",
+}
+
 // BuildTraitContext builds a CEL evaluation context for rendering trait resources.
 //
 // The context includes:
@@ -102,6 +111,10 @@ func BuildTraitContext(input *TraitContextInput) (map[string]any, error) {
 
 	// 4. Apply schema defaults
 	parameters = schema.ApplyDefaults(parameters, structural)
+	parameters, err = normalizeNumbers(parameters, structural)
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize trait parameters: %w", err)
+	}
 	ctx["parameters"] = parameters
 
 	// 5. Add trait metadata
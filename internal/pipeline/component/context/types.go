@@ -105,6 +105,10 @@ type ComponentContextInput struct {
 	// Metadata provides structured naming and labeling information.
 	// Required - controller must provide this.
 	Metadata MetadataContext
+
+	// Connections are the endpoints resolved for the connections of the workload, keyed by connection name.
+	// Optional - connections without an entry are neither exposed to templates nor injected as env vars.
+	Connections map[string]ConnectionContext
 }
 
 // TraitContextInput contains all inputs needed to build a trait rendering context.
@@ -162,3 +166,28 @@ type EnvironmentContext struct {
 	// VirtualHost is the virtual host that is associated with the environment.
 	VirtualHost string
 }
+
+// ConnectionContext is the endpoint a workload connection resolves to in the environment being rendered.
+// This is resolved by the controller and passed to the renderer.
+type ConnectionContext struct {
+	// Host is the hostname of the target endpoint.
+	// Example: "greeter.dp-acme-shop-dev-x1y2z3w4.svc.cluster.local"
+	Host string
+
+	// Port is the port of the target endpoint.
+	Port int32
+
+	// Scheme is the connection scheme derived from the endpoint type (http, https, grpc, ws).
+	// Empty for TCP and UDP endpoints.
+	Scheme string
+
+	// BasePath is the base path of the target endpoint, if any.
+	BasePath string
+
+	// URL is the full address built from the scheme, host, port and base path.
+	// Example: "http://greeter.dp-acme-shop-dev-x1y2z3w4.svc.cluster.local:8080"
+	URL string
+
+	// Visibility is the scope in which the endpoint is reached (Project or Organization).
+	Visibility string
+}
@@ -9,6 +9,7 @@
 //  2. Rendering base resources from ComponentType
 //  3. Processing traits (creates and patches)
 //  4. Post-processing (validation, labels, annotations)
+//  5. Checking organization guardrail policies
 package component
 
 import (
@@ -21,6 +22,7 @@ import (
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/policy"
 	"github.com/openchoreo/openchoreo/internal/pipeline/component/renderer"
 	"github.com/openchoreo/openchoreo/internal/pipeline/component/trait"
 	"github.com/openchoreo/openchoreo/internal/template"
@@ -49,7 +51,8 @@ func NewPipeline(opts ...Option) *Pipeline {
 //  3. Render base resources from ComponentType
 //  4. Process traits (creates and patches)
 //  5. Post-process (validate, add labels/annotations, sort)
-//  6. Return output
+//  6. Check guardrail policies (warn or fail)
+//  7. Return output
 //
 // Returns an error if any step fails.
 func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
@@ -79,6 +82,7 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 		DataPlane:           input.DataPlane,
 		SecretReferences:    input.SecretReferences,
 		Metadata:            input.Metadata,
+		Connections:         input.Connections,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to build component context: %w", err)
@@ -158,6 +162,11 @@ func (p *Pipeline) Render(input *RenderInput) (*RenderOutput, error) {
 	// Sort resources for deterministic output
 	sortResources(resources)
 
+	// 7. Check guardrail policies
+	if err := p.checkPolicies(resources, input.Policies, componentContext, metadata); err != nil {
+		return nil, err
+	}
+
 	metadata.ResourceCount = len(resources)
 
 	return &RenderOutput{
@@ -198,6 +207,33 @@ func (p *Pipeline) validateInput(input *RenderInput) error {
 	return nil
 }
 
+// checkPolicies evaluates the guardrail policies against the final resources.
+// Violations of Warn rules are added to the render warnings. Violations of Enforce rules
+// fail the rendering with a *policy.ViolationError.
+func (p *Pipeline) checkPolicies(
+	resources []map[string]any,
+	policies []v1alpha1.GuardrailPolicy,
+	componentContext map[string]any,
+	metadata *RenderMetadata,
+) error {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	var enforced []policy.Violation
+	for _, violation := range policy.NewEvaluator(p.templateEngine).Evaluate(resources, policies, componentContext) {
+		if violation.Mode == v1alpha1.GuardrailModeWarn {
+			metadata.Warnings = append(metadata.Warnings, violation.String())
+			continue
+		}
+		enforced = append(enforced, violation)
+	}
+	if len(enforced) > 0 {
+		return &policy.ViolationError{Violations: enforced}
+	}
+	return nil
+}
+
 // postProcessResources adds labels, annotations, and performs cleanup.
 func (p *Pipeline) postProcessResources(resources []map[string]any, input *RenderInput) error {
 	// Build common labels/annotations
@@ -4,16 +4,19 @@
 package component
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"sort"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/policy"
 )
 
 // loadTestDataFile loads a file from the testdata directory
@@ -668,6 +671,140 @@ spec:
 	}
 }
 
+func TestPipeline_Policies(t *testing.T) {
+	snapshotYAML := `
+apiVersion: core.choreo.dev/v1alpha1
+kind: ComponentEnvSnapshot
+spec:
+  environment: dev
+  component:
+    metadata:
+      name: test-app
+    spec:
+      parameters: {}
+  componentType:
+    spec:
+      resources:
+        - id: deployment
+          template:
+            apiVersion: apps/v1
+            kind: Deployment
+            metadata:
+              name: app
+            spec:
+              replicas: 1
+              template:
+                spec:
+                  containers:
+                    - name: main
+                      image: docker.io/acme/app:v1
+  workload: {}
+`
+	snapshot := &v1alpha1.ComponentEnvSnapshot{}
+	if err := yaml.Unmarshal([]byte(snapshotYAML), snapshot); err != nil {
+		t.Fatalf("Failed to parse snapshot YAML: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		policyYAML     string
+		wantViolations []string
+		wantWarnings   []string
+	}{
+		{
+			name: "compliant resources",
+			policyYAML: `
+metadata:
+  name: baseline
+spec:
+  rules:
+    - name: min-replicas
+      target:
+        kind: Deployment
+      rule: ${environment.name != "production" || resource.spec.replicas >= 2}
+`,
+			wantWarnings: []string{},
+		},
+		{
+			name: "warn rule adds a warning",
+			policyYAML: `
+metadata:
+  name: baseline
+spec:
+  rules:
+    - name: trusted-registry
+      target:
+        kind: Deployment
+      rule: ${resource.spec.template.spec.containers.all(c, c.image.startsWith("registry.acme.io/"))}
+      message: Images must come from registry.acme.io
+      mode: Warn
+`,
+			wantWarnings: []string{"Deployment/app: baseline/trusted-registry: Images must come from registry.acme.io"},
+		},
+		{
+			name: "enforce rule fails the rendering",
+			policyYAML: `
+metadata:
+  name: baseline
+spec:
+  rules:
+    - name: labelled
+      rule: ${resource.metadata.labels["openchoreo.dev/project"] == "other-project"}
+      message: Resources must belong to other-project
+      mode: Enforce
+`,
+			wantViolations: []string{"Deployment/app: baseline/labelled: Resources must belong to other-project"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var guardrailPolicy v1alpha1.GuardrailPolicy
+			if err := yaml.Unmarshal([]byte(tt.policyYAML), &guardrailPolicy); err != nil {
+				t.Fatalf("Failed to parse policy YAML: %v", err)
+			}
+
+			input := &RenderInput{
+				ComponentType: &snapshot.Spec.ComponentType,
+				Component:     &snapshot.Spec.Component,
+				Workload:      &snapshot.Spec.Workload,
+				Environment:   &v1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "dev"}},
+				DataPlane:     &v1alpha1.DataPlane{},
+				Policies:      []v1alpha1.GuardrailPolicy{guardrailPolicy},
+				Metadata: context.MetadataContext{
+					Name:            "test-component-dev-12345678",
+					Namespace:       "test-namespace",
+					ComponentName:   "test-app",
+					EnvironmentName: "dev",
+					ProjectName:     "test-project",
+				},
+			}
+
+			output, err := NewPipeline().Render(input)
+			if len(tt.wantViolations) > 0 {
+				var violationErr *policy.ViolationError
+				if !errors.As(err, &violationErr) {
+					t.Fatalf("Render() error = %v, want *policy.ViolationError", err)
+				}
+				got := make([]string, len(violationErr.Violations))
+				for i, violation := range violationErr.Violations {
+					got[i] = violation.String()
+				}
+				if diff := cmp.Diff(tt.wantViolations, got); diff != "" {
+					t.Errorf("Violations mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.wantWarnings, output.Metadata.Warnings); diff != "" {
+				t.Errorf("Warnings mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestValidateResources(t *testing.T) {
 	tests := []struct {
 		name      string
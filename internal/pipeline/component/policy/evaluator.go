@@ -0,0 +1,158 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package policy evaluates organization guardrails against the resources rendered for a component.
+//
+// Guardrails are CEL rules defined in GuardrailPolicies. They run after trait processing,
+// so they see the final manifests regardless of which ComponentType or Trait produced them.
+package policy
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/pipeline/component/trait"
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+// Evaluator checks rendered resources against guardrail rules.
+type Evaluator struct {
+	templateEngine *template.Engine
+}
+
+// Violation describes a resource that does not comply with a guardrail rule.
+type Violation struct {
+	// Policy is the name of the GuardrailPolicy defining the rule.
+	Policy string
+
+	// Rule is the name of the violated rule.
+	Rule string
+
+	// Mode is the mode of the violated rule.
+	Mode v1alpha1.GuardrailMode
+
+	// Resource identifies the violating resource as "Kind/name".
+	Resource string
+
+	// Message describes the violation.
+	Message string
+}
+
+// String formats the violation for warnings and status conditions.
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s/%s: %s", v.Resource, v.Policy, v.Rule, v.Message)
+}
+
+// NewEvaluator creates a new guardrail evaluator.
+func NewEvaluator(templateEngine *template.Engine) *Evaluator {
+	return &Evaluator{
+		templateEngine: templateEngine,
+	}
+}
+
+// Evaluate checks each resource against the rules of the given policies.
+//
+// Each rule is evaluated with the rendered resource bound to "resource", on top of the
+// component context (environment, component, metadata, ...). A rule must evaluate to true
+// for the resource to comply. Rules that fail to evaluate, including rules referencing data
+// missing from the resource or the context, and rules returning a non-boolean value are reported
+// as violations, so that a broken rule never lets a resource through silently. Rules checking
+// optional fields guard them with has(), e.g. ${!has(resource.spec.replicas) || resource.spec.replicas <= 10}.
+//
+// Violations are returned in policy, rule and resource order.
+func (e *Evaluator) Evaluate(
+	resources []map[string]any,
+	policies []v1alpha1.GuardrailPolicy,
+	componentContext map[string]any,
+) []Violation {
+	// Bind "resource" in a copy so that the component context is left untouched
+	ruleContext := maps.Clone(componentContext)
+	if ruleContext == nil {
+		ruleContext = make(map[string]any)
+	}
+
+	var violations []Violation
+	for _, policy := range policies {
+		for _, rule := range policy.Spec.Rules {
+			targets := resources
+			if rule.Target != nil {
+				targets = trait.FindTargetResources(resources, trait.TargetSpec{
+					Kind:    rule.Target.Kind,
+					Group:   rule.Target.Group,
+					Version: rule.Target.Version,
+				})
+			}
+
+			for _, resource := range targets {
+				ruleContext["resource"] = resource
+				message, ok := e.evaluateRule(rule, ruleContext)
+				if ok {
+					continue
+				}
+				violations = append(violations, Violation{
+					Policy:   policy.Name,
+					Rule:     rule.Name,
+					Mode:     ruleMode(rule),
+					Resource: resourceID(resource),
+					Message:  message,
+				})
+			}
+		}
+	}
+	return violations
+}
+
+// evaluateRule evaluates a rule against the resource bound in the context. It returns false
+// and the violation message if the resource does not comply.
+func (e *Evaluator) evaluateRule(rule v1alpha1.GuardrailRule, context map[string]any) (string, bool) {
+	result, err := e.templateEngine.Render(rule.Rule, context)
+	if err != nil {
+		if template.IsMissingDataError(err) {
+			return "rule references data missing from the resource, guard optional fields with has()", false
+		}
+		return fmt.Sprintf("failed to evaluate rule: %v", err), false
+	}
+
+	compliant, ok := result.(bool)
+	if !ok {
+		return fmt.Sprintf("rule must evaluate to a boolean, got %T", result), false
+	}
+	if compliant {
+		return "", true
+	}
+	if rule.Message != "" {
+		return rule.Message, false
+	}
+	return fmt.Sprintf("rule %s is not satisfied", rule.Rule), false
+}
+
+// ruleMode returns the mode of a rule, defaulting to Enforce.
+func ruleMode(rule v1alpha1.GuardrailRule) v1alpha1.GuardrailMode {
+	if rule.Mode == "" {
+		return v1alpha1.GuardrailModeEnforce
+	}
+	return rule.Mode
+}
+
+// resourceID identifies a rendered resource as "Kind/name" in violation messages.
+func resourceID(resource map[string]any) string {
+	kind, _ := resource["kind"].(string)
+	metadata, _ := resource["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+	return fmt.Sprintf("%s/%s", kind, name)
+}
+
+// ViolationError is returned when rendered resources violate enforced guardrail rules.
+type ViolationError struct {
+	Violations []Violation
+}
+
+func (e *ViolationError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = v.String()
+	}
+	return fmt.Sprintf("guardrail policy violations: %s", strings.Join(messages, "; "))
+}
@@ -0,0 +1,202 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+func TestEvaluate(t *testing.T) {
+	resourcesYAML := `
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: api
+  spec:
+    replicas: 1
+    template:
+      spec:
+        containers:
+          - name: main
+            image: docker.io/acme/api:v1
+            securityContext:
+              privileged: true
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: api
+  spec:
+    ports:
+      - port: 80
+`
+	var resources []map[string]any
+	if err := yaml.Unmarshal([]byte(resourcesYAML), &resources); err != nil {
+		t.Fatalf("failed to parse resources: %v", err)
+	}
+
+	newContext := func(environment string) map[string]any {
+		return map[string]any{
+			"environment": map[string]any{"name": environment},
+			"metadata":    map[string]any{"name": "api-dev-12345678"},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		policyYAML  string
+		environment string
+		want        []Violation
+	}{
+		{
+			name: "compliant resources",
+			policyYAML: `
+metadata:
+  name: baseline
+spec:
+  rules:
+    - name: named
+      rule: ${resource.metadata.name != ""}
+`,
+			environment: "dev",
+		},
+		{
+			name: "rule applies only to targeted resources",
+			policyYAML: `
+metadata:
+  name: baseline
+spec:
+  rules:
+    - name: no-privileged
+      target:
+        group: apps
+        kind: Deployment
+      rule: ${!resource.spec.template.spec.containers.exists(c, c.securityContext.privileged)}
+      message: Containers must not run privileged
+`,
+			environment: "dev",
+			want: []Violation{
+				{Policy: "baseline", Rule: "no-privileged", Mode: v1alpha1.GuardrailModeEnforce,
+					Resource: "Deployment/api", Message: "Containers must not run privileged"},
+			},
+		},
+		{
+			name: "rule using the environment context",
+			policyYAML: `
+metadata:
+  name: production
+spec:
+  rules:
+    - name: min-replicas
+      target:
+        kind: Deployment
+      rule: ${environment.name != "production" || resource.spec.replicas >= 2}
+      mode: Warn
+`,
+			environment: "production",
+			want: []Violation{
+				{Policy: "production", Rule: "min-replicas", Mode: v1alpha1.GuardrailModeWarn, Resource: "Deployment/api",
+					Message: `rule ${environment.name != "production" || resource.spec.replicas >= 2} is not satisfied`},
+			},
+		},
+		{
+			name: "rule not violated in other environments",
+			policyYAML: `
+metadata:
+  name: production
+spec:
+  rules:
+    - name: min-replicas
+      target:
+        kind: Deployment
+      rule: ${environment.name != "production" || resource.spec.replicas >= 2}
+`,
+			environment: "dev",
+		},
+		{
+			name: "rule referencing missing fields is a violation",
+			policyYAML: `
+metadata:
+  name: baseline
+spec:
+  rules:
+    - name: min-replicas
+      rule: ${resource.spec.replicas >= 2}
+`,
+			environment: "dev",
+			want: []Violation{
+				{Policy: "baseline", Rule: "min-replicas", Mode: v1alpha1.GuardrailModeEnforce, Resource: "Deployment/api",
+					Message: "rule ${resource.spec.replicas >= 2} is not satisfied"},
+				{Policy: "baseline", Rule: "min-replicas", Mode: v1alpha1.GuardrailModeEnforce, Resource: "Service/api",
+					Message: "rule references data missing from the resource, guard optional fields with has()"},
+			},
+		},
+		{
+			name: "rule guarding optional fields with has",
+			policyYAML: `
+metadata:
+  name: baseline
+spec:
+  rules:
+    - name: min-replicas
+      rule: ${!has(resource.spec.replicas) || resource.spec.replicas >= 1}
+`,
+			environment: "dev",
+		},
+		{
+			name: "rule not returning a boolean is a violation",
+			policyYAML: `
+metadata:
+  name: baseline
+spec:
+  rules:
+    - name: broken
+      target:
+        kind: Service
+      rule: ${resource.metadata.name}
+`,
+			environment: "dev",
+			want: []Violation{
+				{Policy: "baseline", Rule: "broken", Mode: v1alpha1.GuardrailModeEnforce, Resource: "Service/api",
+					Message: "rule must evaluate to a boolean, got string"},
+			},
+		},
+	}
+
+	evaluator := NewEvaluator(template.NewEngine())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var policy v1alpha1.GuardrailPolicy
+			if err := yaml.Unmarshal([]byte(tt.policyYAML), &policy); err != nil {
+				t.Fatalf("failed to parse policy: %v", err)
+			}
+			componentContext := newContext(tt.environment)
+
+			got := evaluator.Evaluate(resources, []v1alpha1.GuardrailPolicy{policy}, componentContext)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Evaluate() mismatch (-want +got):\n%s", diff)
+			}
+			if _, ok := componentContext["resource"]; ok {
+				t.Errorf("Evaluate() modified the component context")
+			}
+		})
+	}
+}
+
+func TestViolationError(t *testing.T) {
+	err := &ViolationError{Violations: []Violation{
+		{Policy: "baseline", Rule: "no-privileged", Resource: "Deployment/api", Message: "Containers must not run privileged"},
+		{Policy: "production", Rule: "min-replicas", Resource: "Deployment/worker", Message: "At least 2 replicas"},
+	}}
+	want := "guardrail policy violations: Deployment/api: baseline/no-privileged: Containers must not run privileged; " +
+		"Deployment/worker: production/min-replicas: At least 2 replicas"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
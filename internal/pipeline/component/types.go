@@ -60,6 +60,14 @@ type RenderInput struct {
 	// Metadata provides structured naming information.
 	// Required - controller must compute and provide this.
 	Metadata pipelinecontext.MetadataContext
+
+	// Connections are the endpoints resolved for the workload connections, keyed by connection name.
+	// Optional - unresolved connections are not exposed to templates and inject no env vars.
+	Connections map[string]pipelinecontext.ConnectionContext
+
+	// Policies are the guardrail policies checked against the rendered resources.
+	// Optional - if nil or empty, no guardrails are checked.
+	Policies []v1alpha1.GuardrailPolicy
 }
 
 // RenderOutput contains the results of the rendering process.
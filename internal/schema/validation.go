@@ -0,0 +1,77 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"fmt"
+	"sort"
+
+	apiextschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	apiextvalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate checks values against a structural schema and returns every violation found.
+//
+// Two kinds of problems are reported:
+//  1. Fields that are not declared in the schema (unless the schema preserves unknown fields)
+//  2. Violations of the OpenAPI constraints (types, required fields, enums, patterns, bounds, ...),
+//     evaluated with the same validator the Kubernetes API server uses for custom resources
+//
+// Values are validated as given; callers that want schema defaults to satisfy required fields
+// should run ApplyDefaults first.
+func Validate(values map[string]any, structural *apiextschema.Structural, fldPath *field.Path) field.ErrorList {
+	if structural == nil {
+		return field.ErrorList{field.InternalError(fldPath, fmt.Errorf("schema is nil"))}
+	}
+	if values == nil {
+		values = map[string]any{}
+	}
+
+	allErrs := unknownFields(values, structural, fldPath)
+
+	validator := apiextvalidation.NewSchemaValidatorFromOpenAPI(structural.ToKubeOpenAPI())
+	allErrs = append(allErrs, apiextvalidation.ValidateCustomResource(fldPath, values, validator)...)
+	return allErrs
+}
+
+// unknownFields walks value alongside the structural schema and reports fields that the schema
+// does not declare. Map types (additionalProperties) accept any key but their values are still walked.
+func unknownFields(value any, structural *apiextschema.Structural, fldPath *field.Path) field.ErrorList {
+	if structural == nil || structural.XPreserveUnknownFields {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	switch typed := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if prop, ok := structural.Properties[key]; ok {
+				allErrs = append(allErrs, unknownFields(typed[key], &prop, fldPath.Child(key))...)
+				continue
+			}
+			if additional := structural.AdditionalProperties; additional != nil {
+				if additional.Structural != nil {
+					allErrs = append(allErrs, unknownFields(typed[key], additional.Structural, fldPath.Key(key))...)
+					continue
+				}
+				if additional.Bool {
+					continue
+				}
+			}
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(key), "field is not declared in the schema"))
+		}
+	case []any:
+		for i, item := range typed {
+			allErrs = append(allErrs, unknownFields(item, structural.Items, fldPath.Index(i))...)
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidate(t *testing.T) {
+	def := Definition{
+		Types: map[string]any{
+			"Port": map[string]any{
+				"name": "string",
+				"port": "integer | minimum=1 | maximum=65535",
+			},
+		},
+		Schemas: []map[string]any{
+			{
+				"replicas": "integer | default=1",
+				"tier":     "string | enum=small,large | default=small",
+				"ports":    "[]Port | default=[]",
+				"labels":   "map<string> | default={}",
+			},
+		},
+	}
+	structural, err := ToStructural(def)
+	if err != nil {
+		t.Fatalf("ToStructural returned error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		values map[string]any
+		// wantErrs lists the expected "<type> <field>" pairs in order
+		wantErrs []string
+	}{
+		{
+			name: "valid values",
+			values: map[string]any{
+				"replicas": int64(2),
+				"tier":     "large",
+				"ports":    []any{map[string]any{"name": "http", "port": int64(8080)}},
+				"labels":   map[string]any{"team": "payments"},
+			},
+		},
+		{
+			name:   "empty values",
+			values: nil,
+		},
+		{
+			name: "unknown fields at any depth",
+			values: map[string]any{
+				"replica": int64(2),
+				"ports":   []any{map[string]any{"name": "http", "port": int64(80), "protocol": "TCP"}},
+			},
+			wantErrs: []string{
+				"Forbidden spec.parameters.ports[0].protocol",
+				"Forbidden spec.parameters.replica",
+			},
+		},
+		{
+			name: "constraint violations",
+			values: map[string]any{
+				"replicas": "two",
+				"tier":     "medium",
+				"ports":    []any{map[string]any{"name": "http", "port": int64(70000)}},
+			},
+			wantErrs: []string{
+				"Invalid value spec.parameters.ports[0].port",
+				"Invalid value spec.parameters.replicas",
+				"Unsupported value spec.parameters.tier",
+			},
+		},
+		{
+			name: "missing required field in list item",
+			values: map[string]any{
+				"ports": []any{map[string]any{"port": int64(80)}},
+			},
+			wantErrs: []string{"Required value spec.parameters.ports[0].name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := Validate(tt.values, structural, field.NewPath("spec", "parameters"))
+
+			got := map[string]bool{}
+			for _, err := range errs {
+				got[string(err.Type)+" "+err.Field] = true
+			}
+			if len(got) != len(tt.wantErrs) {
+				t.Fatalf("Validate() returned %d distinct errors, want %d: %v", len(got), len(tt.wantErrs), errs)
+			}
+			for _, want := range tt.wantErrs {
+				if !got[want] {
+					t.Errorf("Validate() missing error %q, got %v", want, errs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"iter"
+	"log/slog"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+)
+
+// Outcome is the result of an audited operation
+type Outcome string
+
+const (
+	// OutcomeSuccess is recorded for operations that completed
+	OutcomeSuccess Outcome = "success"
+	// OutcomeFailure is recorded for operations that were rejected as invalid or failed
+	OutcomeFailure Outcome = "failure"
+	// OutcomeDenied is recorded for operations the user was not authenticated or authorized for
+	OutcomeDenied Outcome = "denied"
+)
+
+// Sources of audited operations
+const (
+	SourceAPI = "api"
+	SourceMCP = "mcp"
+)
+
+// anonymousSubject is recorded for operations without an authenticated user, e.g. when JWT authentication is disabled
+const anonymousSubject = "anonymous"
+
+// Resource identifies the target of an audited operation. Empty fields are not part of the resource.
+type Resource struct {
+	Org         string `json:"org,omitempty"`
+	Project     string `json:"project,omitempty"`
+	Component   string `json:"component,omitempty"`
+	Environment string `json:"environment,omitempty"`
+	Kind        string `json:"kind,omitempty"`
+	Name        string `json:"name,omitempty"`
+}
+
+// merge overwrites the fields of the resource with the non-empty fields of other
+func (r *Resource) merge(other Resource) {
+	if other.Org != "" {
+		r.Org = other.Org
+	}
+	if other.Project != "" {
+		r.Project = other.Project
+	}
+	if other.Component != "" {
+		r.Component = other.Component
+	}
+	if other.Environment != "" {
+		r.Environment = other.Environment
+	}
+	if other.Kind != "" {
+		r.Kind = other.Kind
+	}
+	if other.Name != "" {
+		r.Name = other.Name
+	}
+}
+
+// Event is the audit record of a mutating operation
+type Event struct {
+	ID        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	// Source is the interface the operation was performed through, SourceAPI or SourceMCP
+	Source string `json:"source"`
+	// Subject is the subject (sub) claim of the user who performed the operation
+	Subject string `json:"subject"`
+	// User is a human readable identity of the user, such as the email address
+	User string `json:"user,omitempty"`
+	// Action is the route of an API request, e.g. "POST /api/v1/orgs/{orgName}/projects", or the name of an MCP tool
+	Action   string   `json:"action"`
+	Resource Resource `json:"resource"`
+	// Request summarizes the request with its scalar parameters
+	Request map[string]any `json:"request,omitempty"`
+	Outcome Outcome        `json:"outcome"`
+	// Status is the HTTP status code of API requests
+	Status int    `json:"status,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Sink receives the events recorded by an Auditor
+type Sink interface {
+	Write(ctx context.Context, event *Event) error
+}
+
+// Querier is implemented by sinks whose events can be read back
+type Querier interface {
+	// Query returns the events matching the query, most recent first
+	Query(ctx context.Context, q *Query) ([]Event, error)
+}
+
+// Query selects audit events. Empty fields match every event.
+type Query struct {
+	Org       string
+	Project   string
+	Component string
+	Source    string
+	Subject   string
+	Action    string
+	Outcome   Outcome
+	Since     time.Time
+	Until     time.Time
+	// Before only selects the events recorded before the event with this ID, for paging through the events
+	Before string
+	// Limit is the maximum number of events returned
+	Limit int
+}
+
+// matches reports whether the event is selected by the query, ignoring Before and Limit
+func (q *Query) matches(e *Event) bool {
+	switch {
+	case q.Org != "" && e.Resource.Org != q.Org,
+		q.Project != "" && e.Resource.Project != q.Project,
+		q.Component != "" && e.Resource.Component != q.Component,
+		q.Source != "" && e.Source != q.Source,
+		q.Subject != "" && e.Subject != q.Subject,
+		q.Action != "" && e.Action != q.Action,
+		q.Outcome != "" && e.Outcome != q.Outcome,
+		!q.Since.IsZero() && e.Timestamp.Before(q.Since),
+		!q.Until.IsZero() && !e.Timestamp.Before(q.Until):
+		return false
+	}
+	return true
+}
+
+// selectEvents returns the events matching the query from events ordered from the most recent
+func selectEvents(q *Query, events iter.Seq[*Event]) []Event {
+	selected := []Event{}
+	skipping := q.Before != ""
+	for e := range events {
+		if skipping {
+			skipping = e.ID != q.Before
+			continue
+		}
+		if !q.matches(e) {
+			continue
+		}
+		selected = append(selected, *e)
+		if q.Limit > 0 && len(selected) >= q.Limit {
+			break
+		}
+	}
+	return selected
+}
+
+// Auditor records audit events to its sinks.
+// A nil Auditor records nothing, which is the behavior of servers without auditing.
+type Auditor struct {
+	sinks   []Sink
+	querier Querier
+	logger  *slog.Logger
+}
+
+// New creates an auditor writing to the given sinks. Events are queried from the first sink implementing
+// Querier; when there is none, the most recent events are also kept in memory so that they can be queried.
+func New(logger *slog.Logger, sinks ...Sink) *Auditor {
+	a := &Auditor{sinks: sinks, logger: logger}
+	for _, sink := range sinks {
+		if querier, ok := sink.(Querier); ok {
+			a.querier = querier
+			break
+		}
+	}
+	if a.querier == nil {
+		memory := NewMemorySink(DefaultMemoryCapacity)
+		a.sinks = append(a.sinks, memory)
+		a.querier = memory
+	}
+	return a
+}
+
+// Record completes the event with an ID, the time and the user of ctx, and writes it to every sink.
+// Failing sinks are logged rather than failing the audited operation.
+func (a *Auditor) Record(ctx context.Context, event *Event) {
+	if a == nil {
+		return
+	}
+	if event.ID == "" {
+		event.ID = newEventID()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now().UTC()
+	}
+	identify(ctx, event)
+	if event.Subject == "" {
+		event.Subject = anonymousSubject
+	}
+
+	// The operation may have ended with its context, which must not stop the event from being written
+	ctx = context.WithoutCancel(ctx)
+	for _, sink := range a.sinks {
+		if err := sink.Write(ctx, event); err != nil {
+			a.logger.Error("Failed to write audit event", "id", event.ID, "action", event.Action, "error", err)
+		}
+	}
+}
+
+// Query returns the recorded events matching the query, most recent first
+func (a *Auditor) Query(ctx context.Context, q *Query) ([]Event, error) {
+	if a == nil {
+		return []Event{}, nil
+	}
+	return a.querier.Query(ctx, q)
+}
+
+// Close closes the sinks that hold resources, such as files or pending webhook deliveries
+func (a *Auditor) Close() error {
+	if a == nil {
+		return nil
+	}
+	var errs []error
+	for _, sink := range a.sinks {
+		if closer, ok := sink.(io.Closer); ok {
+			errs = append(errs, closer.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// identify sets the subject and user of the authenticated user of ctx on the event, unless the event already names them
+func identify(ctx context.Context, event *Event) {
+	if event.Subject == "" {
+		if subject, ok := jwt.GetSubjectFromContext(ctx); ok {
+			event.Subject = subject
+		}
+	}
+	if event.User == "" {
+		event.User, _ = jwt.GetUserIdentity(ctx)
+	}
+}
+
+func newEventID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b) // crypto/rand.Read never returns an error
+	return hex.EncodeToString(b)
+}
+
+// recordingKey is the context key of the event being recorded for an operation
+type recordingKey struct{}
+
+// NewContext returns a copy of ctx carrying the event recorded for the operation of the context,
+// so that the handlers of the operation can add to it with SetResource
+func NewContext(ctx context.Context, event *Event) context.Context {
+	return context.WithValue(ctx, recordingKey{}, event)
+}
+
+// SetResource sets the non-empty fields of resource on the audit event of the operation of ctx.
+// Handlers use it for targets that are not part of the route, such as the resource of an apply request.
+func SetResource(ctx context.Context, resource Resource) {
+	if event, ok := ctx.Value(recordingKey{}).(*Event); ok {
+		event.Resource.merge(resource)
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+)
+
+func eventIDs(events []Event) []string {
+	ids := make([]string, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+// writeTestEvents writes events e1..e5, alternating between the orgs acme and globex
+func writeTestEvents(t *testing.T, sink Sink) {
+	t.Helper()
+	base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	for i, org := range []string{"acme", "globex", "acme", "globex", "acme"} {
+		event := &Event{
+			ID:        "e" + string(rune('1'+i)),
+			Timestamp: base.Add(time.Duration(i) * time.Hour),
+			Source:    SourceAPI,
+			Subject:   "alice",
+			Action:    "POST /api/v1/orgs/{orgName}/projects",
+			Resource:  Resource{Org: org},
+			Outcome:   OutcomeSuccess,
+		}
+		if i == 2 {
+			event.Subject = "bob"
+			event.Outcome = OutcomeDenied
+		}
+		if err := sink.Write(context.Background(), event); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+}
+
+func testQueries(t *testing.T, querier Querier) {
+	t.Helper()
+	base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		query Query
+		want  []string
+	}{
+		{name: "All events, most recent first", query: Query{}, want: []string{"e5", "e4", "e3", "e2", "e1"}},
+		{name: "Events of an org", query: Query{Org: "acme"}, want: []string{"e5", "e3", "e1"}},
+		{name: "Events of a subject", query: Query{Subject: "bob"}, want: []string{"e3"}},
+		{name: "Denied events", query: Query{Org: "acme", Outcome: OutcomeDenied}, want: []string{"e3"}},
+		{name: "Time range", query: Query{Since: base.Add(time.Hour), Until: base.Add(3 * time.Hour)}, want: []string{"e3", "e2"}},
+		{name: "Limit", query: Query{Limit: 2}, want: []string{"e5", "e4"}},
+		{name: "Page after an event", query: Query{Org: "acme", Before: "e5", Limit: 1}, want: []string{"e3"}},
+		{name: "No match", query: Query{Org: "initech"}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := querier.Query(context.Background(), &tt.query)
+			if err != nil {
+				t.Fatalf("Query() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, eventIDs(events)); diff != "" {
+				t.Errorf("events mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemorySink(t *testing.T) {
+	sink := NewMemorySink(10)
+	writeTestEvents(t, sink)
+	testQueries(t, sink)
+}
+
+func TestMemorySinkDropsOldestEvents(t *testing.T) {
+	sink := NewMemorySink(3)
+	writeTestEvents(t, sink)
+
+	events, err := sink.Query(context.Background(), &Query{})
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"e5", "e4", "e3"}, eventIDs(events)); diff != "" {
+		t.Errorf("events mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatalf("NewFileSink() error = %v", err)
+	}
+	writeTestEvents(t, sink)
+	testQueries(t, sink)
+
+	// Events written before a restart are queried from the same file
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	reopened, err := NewFileSink(path)
+	if err != nil {
+		t.Fatalf("NewFileSink() error = %v", err)
+	}
+	defer reopened.Close()
+	events, err := reopened.Query(context.Background(), &Query{Limit: 1})
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	if len(events) != 1 || events[0].ID != "e5" || events[0].Resource.Org != "acme" {
+		t.Errorf("Query() after reopening = %+v, want e5 of acme", events)
+	}
+}
+
+func TestFileSinkQueriesRecentEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := newFileSink(path, 3)
+	if err != nil {
+		t.Fatalf("newFileSink() error = %v", err)
+	}
+	writeTestEvents(t, sink)
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// The most recent events of the file are loaded once when it is opened and queried from memory
+	reopened, err := newFileSink(path, 3)
+	if err != nil {
+		t.Fatalf("newFileSink() error = %v", err)
+	}
+	defer reopened.Close()
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	events, err := reopened.Query(context.Background(), &Query{})
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"e5", "e4", "e3"}, eventIDs(events)); diff != "" {
+		t.Errorf("events mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	received := make(chan Event, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event Event
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			t.Errorf("Failed to decode webhook body: %v", err)
+		}
+		received <- event
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, slog.New(slog.DiscardHandler))
+	if err := sink.Write(context.Background(), &Event{ID: "e1", Action: "deploy_release"}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	select {
+	case event := <-received:
+		if event.ID != "e1" || event.Action != "deploy_release" {
+			t.Errorf("webhook received %+v", event)
+		}
+	default:
+		t.Errorf("Close() returned before the queued event was delivered")
+	}
+}
+
+func TestAuditorRecord(t *testing.T) {
+	memory := NewMemorySink(10)
+	auditor := New(slog.New(slog.DiscardHandler), memory)
+
+	ctx := jwt.NewContextWithClaims(context.Background(), gojwt.MapClaims{"sub": "user-123", "email": "alice@example.com"})
+	auditor.Record(ctx, &Event{Source: SourceMCP, Action: "create_project", Outcome: OutcomeSuccess})
+	auditor.Record(context.Background(), &Event{Source: SourceAPI, Action: "POST /api/v1/apply", Outcome: OutcomeFailure})
+
+	events, err := auditor.Query(context.Background(), &Query{})
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Query() returned %d events, want 2", len(events))
+	}
+	if events[0].Subject != anonymousSubject || events[0].User != "" {
+		t.Errorf("event without a user = %+v, want the anonymous subject", events[0])
+	}
+	if events[1].Subject != "user-123" || events[1].User != "alice@example.com" {
+		t.Errorf("event of an authenticated user = %+v", events[1])
+	}
+	if events[1].ID == "" || events[1].ID == events[0].ID || events[1].Timestamp.IsZero() {
+		t.Errorf("Record() did not assign a unique ID and a timestamp: %+v", events)
+	}
+}
+
+func TestNewFromConfig(t *testing.T) {
+	logger := slog.New(slog.DiscardHandler)
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr string
+	}{
+		{name: "Default log sink", cfg: Config{}},
+		{name: "All sinks", cfg: Config{Sinks: ParseSinks(" log, File ,webhook"), FilePath: filepath.Join(t.TempDir(), "audit.log"), WebhookURL: "http://audit.example.com"}},
+		{name: "File sink without a path", cfg: Config{Sinks: []string{SinkFile}}, wantErr: "requires a file path"},
+		{name: "Webhook sink without a URL", cfg: Config{Sinks: []string{SinkWebhook}}, wantErr: "requires a URL"},
+		{name: "Unknown sink", cfg: Config{Sinks: []string{"syslog"}}, wantErr: "unknown audit sink"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auditor, err := NewFromConfig(tt.cfg, logger)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("NewFromConfig() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewFromConfig() error = %v", err)
+			}
+			if err := auditor.Close(); err != nil {
+				t.Errorf("Close() error = %v", err)
+			}
+		})
+	}
+}
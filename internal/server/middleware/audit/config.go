@@ -0,0 +1,72 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// Names of the sinks that can be configured
+const (
+	SinkLog     = "log"
+	SinkFile    = "file"
+	SinkWebhook = "webhook"
+)
+
+// Config configures the sinks of an auditor
+type Config struct {
+	// Sinks names the sinks events are written to. Events are logged when it is empty.
+	Sinks []string
+	// FilePath is the file of the file sink
+	FilePath string
+	// WebhookURL is the URL the webhook sink posts events to
+	WebhookURL string
+}
+
+// ParseSinks parses a comma-separated list of sink names
+func ParseSinks(value string) []string {
+	var sinks []string
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			sinks = append(sinks, name)
+		}
+	}
+	return sinks
+}
+
+// NewFromConfig creates an auditor writing to the sinks of the configuration
+func NewFromConfig(cfg Config, logger *slog.Logger) (*Auditor, error) {
+	names := cfg.Sinks
+	if len(names) == 0 {
+		names = []string{SinkLog}
+	}
+
+	var sinks []Sink
+	for _, name := range names {
+		switch name {
+		case SinkLog:
+			sinks = append(sinks, NewLogSink(logger))
+		case SinkFile:
+			if cfg.FilePath == "" {
+				return nil, errors.New("the file audit sink requires a file path")
+			}
+			sink, err := NewFileSink(cfg.FilePath)
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, sink)
+		case SinkWebhook:
+			if cfg.WebhookURL == "" {
+				return nil, errors.New("the webhook audit sink requires a URL")
+			}
+			sinks = append(sinks, NewWebhookSink(cfg.WebhookURL, logger))
+		default:
+			return nil, fmt.Errorf("unknown audit sink %q, must be one of: %s, %s, %s", name, SinkLog, SinkFile, SinkWebhook)
+		}
+	}
+	return New(logger, sinks...), nil
+}
@@ -0,0 +1,268 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// maxErrorBody limits how much of an error response is kept to find its error message
+	maxErrorBody = 4096
+	// maxSummaryValue limits the length of the string values of a request summary
+	maxSummaryValue = 256
+	// maxRequestBody limits the size of the request bodies read for the summary, as they are read before the
+	// request is authenticated. It is the size limit of Kubernetes objects.
+	maxRequestBody = 3 << 20
+	// redactedValue replaces the values of the sensitive fields of a request summary
+	redactedValue = "[REDACTED]"
+)
+
+// sensitiveKeyParts are the parts of the names of request fields and query parameters whose values are credentials,
+// such as the clientKey and observerPassword of a data plane
+var sensitiveKeyParts = []string{"key", "password", "token", "secret", "cert", "credential"}
+
+// routeResourceParams are the path parameters of the routes naming the resource scope of a request.
+// The last other path parameter of a route names the target resource, e.g. {bindingName}.
+var routeResourceParams = map[string]func(r *Resource, value string){
+	"orgName":         func(r *Resource, value string) { r.Org = value },
+	"projectName":     func(r *Resource, value string) { r.Project = value },
+	"componentName":   func(r *Resource, value string) { r.Component = value },
+	"environmentName": func(r *Resource, value string) { r.Environment = value },
+	"envName":         func(r *Resource, value string) { r.Environment = value },
+}
+
+// Middleware records an audit event for every request that is not read-only once its handler completed.
+// It runs before the authentication middleware, so that the requests it rejects are audited as denied, and
+// Authenticated runs after it to add the user of the request to the event.
+func (a *Auditor) Middleware(next http.Handler) http.Handler {
+	return a.middleware(SourceAPI, next, func(int) bool { return true })
+}
+
+// UnauthenticatedMiddleware returns a middleware recording an audit event for the requests that are not read-only
+// and are rejected as unauthenticated. It wraps the authentication middleware of routes whose operations are
+// audited by their handlers, such as the MCP endpoint, which the requests rejected by it never reach.
+func (a *Auditor) UnauthenticatedMiddleware(source string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return a.middleware(source, next, func(status int) bool { return status == http.StatusUnauthorized })
+	}
+}
+
+// middleware records an audit event for the requests that are not read-only and completed with a status that
+// record reports true for
+func (a *Auditor) middleware(source string, next http.Handler, record func(status int) bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a == nil || isReadOnly(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// The body is read for the summary and handed to the handler unchanged
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBody))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, "failed to read request body", http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		event := &Event{
+			Source:   source,
+			Action:   actionOfRequest(r),
+			Resource: resourceFromRoute(r),
+			Request:  summarizeRequest(r.URL.Query(), body),
+		}
+
+		recorder := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(NewContext(r.Context(), event)))
+		if !record(recorder.status) {
+			return
+		}
+
+		event.Status = recorder.status
+		event.Outcome = outcomeOfStatus(recorder.status)
+		if recorder.status >= http.StatusBadRequest {
+			event.Error = errorMessage(recorder.errorBody.Bytes())
+		}
+		a.Record(r.Context(), event)
+	})
+}
+
+// Authenticated adds the user of an authenticated request to the audit event recorded for it. It runs after the
+// authentication middleware, which Middleware runs before.
+func Authenticated(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if event, ok := r.Context().Value(recordingKey{}).(*Event); ok {
+			identify(r.Context(), event)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// actionOfRequest returns the route of a request with its method, e.g. "POST /api/v1/orgs/{orgName}/projects"
+func actionOfRequest(r *http.Request) string {
+	switch {
+	case r.Pattern == "":
+		return r.Method + " " + r.URL.Path
+	case !strings.Contains(r.Pattern, " "):
+		return r.Method + " " + r.Pattern
+	default:
+		return r.Pattern
+	}
+}
+
+// isReadOnly reports whether requests of the method do not change resources
+func isReadOnly(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// resourceFromRoute returns the resource named by the path parameters of the route of a request
+func resourceFromRoute(r *http.Request) Resource {
+	var resource Resource
+	_, path, _ := strings.Cut(r.Pattern, " ")
+	for _, segment := range strings.Split(path, "/") {
+		if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") {
+			continue
+		}
+		name := strings.TrimSuffix(strings.Trim(segment, "{}"), "...")
+		value := r.PathValue(name)
+		if value == "" {
+			continue
+		}
+		if set, ok := routeResourceParams[name]; ok {
+			set(&resource, value)
+		} else {
+			resource.Name = value
+		}
+	}
+	return resource
+}
+
+// summarizeRequest returns the query parameters and the scalar fields of a JSON object body of a request
+func summarizeRequest(query url.Values, body []byte) map[string]any {
+	summary := SummarizeJSON(body)
+	for key := range query {
+		if summary == nil {
+			summary = make(map[string]any)
+		}
+		summary[key] = summaryValue(key, query.Get(key))
+	}
+	return summary
+}
+
+// SummarizeJSON returns the strings, numbers and booleans of a JSON object, such as the body of a request or
+// the arguments of a tool call. Nested objects and lists are left out to keep the events small, and the values
+// of fields named like credentials are redacted.
+func SummarizeJSON(data []byte) map[string]any {
+	var fields map[string]any
+	if json.Unmarshal(data, &fields) != nil {
+		return nil
+	}
+	summary := make(map[string]any)
+	for key, value := range fields {
+		switch v := value.(type) {
+		case string:
+			summary[key] = summaryValue(key, v)
+		case float64, bool:
+			summary[key] = v
+		}
+	}
+	if len(summary) == 0 {
+		return nil
+	}
+	return summary
+}
+
+// summaryValue returns the value of a field for a request summary, redacted if the field holds a credential
+func summaryValue(key, value string) string {
+	if isSensitiveKey(key) {
+		return redactedValue
+	}
+	return truncate(value)
+}
+
+// isSensitiveKey reports whether the name of a field or query parameter marks its value as a credential
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+func truncate(s string) string {
+	if len(s) <= maxSummaryValue {
+		return s
+	}
+	return s[:maxSummaryValue] + "..."
+}
+
+// outcomeOfStatus returns the outcome of a request completed with the HTTP status code
+func outcomeOfStatus(status int) Outcome {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return OutcomeDenied
+	case status >= http.StatusBadRequest:
+		return OutcomeFailure
+	default:
+		return OutcomeSuccess
+	}
+}
+
+// errorMessage returns the message of a JSON error response, which the API puts in the error field and
+// the authentication middleware in the message field
+func errorMessage(body []byte) string {
+	var resp struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return truncate(strings.TrimSpace(string(body)))
+	}
+	if resp.Message != "" {
+		return resp.Message
+	}
+	return resp.Error
+}
+
+// responseRecorder records the status code of a response, and the beginning of the body of error responses
+type responseRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	errorBody   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	if r.status >= http.StatusBadRequest && r.errorBody.Len() < maxErrorBody {
+		r.errorBody.Write(b[:min(len(b), maxErrorBody-r.errorBody.Len())])
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the wrapped writer for http.ResponseController
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,232 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+)
+
+func TestMiddleware(t *testing.T) {
+	memory := NewMemorySink(10)
+	auditor := New(slog.New(slog.DiscardHandler), memory)
+
+	mux := http.NewServeMux()
+	mux.Handle("POST /api/v1/orgs/{orgName}/projects/{projectName}/components/{componentName}/release-bindings/{bindingName}/rollback",
+		auditor.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if !strings.Contains(string(body), "release-2") {
+				t.Errorf("handler received body %q, want the original body", body)
+			}
+			w.WriteHeader(http.StatusOK)
+		})))
+	mux.Handle("POST /api/v1/apply", auditor.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		SetResource(r.Context(), Resource{Org: "acme", Kind: "Component", Name: "cart"})
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": false, "error": "permission denied", "code": "FORBIDDEN"})
+	})))
+	mux.Handle("GET /api/v1/orgs/{orgName}/projects", auditor.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	requests := []*http.Request{
+		httptest.NewRequest(http.MethodPost,
+			"/api/v1/orgs/acme/projects/shop/components/cart/release-bindings/cart-production/rollback?dryRun=true",
+			strings.NewReader(`{"releaseName": "release-2", "options": {"force": true}}`)),
+		httptest.NewRequest(http.MethodPost, "/api/v1/apply", strings.NewReader(`{"apiVersion": "openchoreo.dev/v1alpha1", "kind": "Component"}`)),
+		httptest.NewRequest(http.MethodGet, "/api/v1/orgs/acme/projects", nil),
+	}
+	for _, req := range requests {
+		mux.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	events, err := memory.Query(context.Background(), &Query{})
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	want := []Event{
+		{
+			Source:   SourceAPI,
+			Subject:  anonymousSubject,
+			Action:   "POST /api/v1/apply",
+			Resource: Resource{Org: "acme", Kind: "Component", Name: "cart"},
+			Request:  map[string]any{"apiVersion": "openchoreo.dev/v1alpha1", "kind": "Component"},
+			Outcome:  OutcomeDenied,
+			Status:   http.StatusForbidden,
+			Error:    "permission denied",
+		},
+		{
+			Source:   SourceAPI,
+			Subject:  anonymousSubject,
+			Action:   "POST /api/v1/orgs/{orgName}/projects/{projectName}/components/{componentName}/release-bindings/{bindingName}/rollback",
+			Resource: Resource{Org: "acme", Project: "shop", Component: "cart", Name: "cart-production"},
+			Request:  map[string]any{"releaseName": "release-2", "dryRun": "true"},
+			Outcome:  OutcomeSuccess,
+			Status:   http.StatusOK,
+		},
+	}
+	if diff := cmp.Diff(want, events, cmpopts.IgnoreFields(Event{}, "ID", "Timestamp")); diff != "" {
+		t.Errorf("events mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// testAuthentication rejects requests without a bearer token and authenticates the others as user-123
+func testAuthentication(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": "unauthorized", "message": "missing token"})
+			return
+		}
+		ctx := jwt.NewContextWithClaims(r.Context(), gojwt.MapClaims{"sub": "user-123", "email": "alice@example.com"})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func TestMiddlewareAuthentication(t *testing.T) {
+	memory := NewMemorySink(10)
+	auditor := New(slog.New(slog.DiscardHandler), memory)
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle("POST /api/v1/orgs/{orgName}/projects", auditor.Middleware(testAuthentication(Authenticated(ok))))
+	mux.Handle("/mcp", auditor.UnauthenticatedMiddleware(SourceMCP)(testAuthentication(ok)))
+
+	for _, path := range []string{"/api/v1/orgs/acme/projects", "/mcp"} {
+		mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, path, nil))
+		authenticated := httptest.NewRequest(http.MethodPost, path, nil)
+		authenticated.Header.Set("Authorization", "Bearer token")
+		mux.ServeHTTP(httptest.NewRecorder(), authenticated)
+	}
+
+	events, err := memory.Query(context.Background(), &Query{})
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	want := []Event{
+		{
+			Source:  SourceMCP,
+			Subject: anonymousSubject,
+			Action:  "POST /mcp",
+			Outcome: OutcomeDenied,
+			Status:  http.StatusUnauthorized,
+			Error:   "missing token",
+		},
+		{
+			Source:   SourceAPI,
+			Subject:  "user-123",
+			User:     "alice@example.com",
+			Action:   "POST /api/v1/orgs/{orgName}/projects",
+			Resource: Resource{Org: "acme"},
+			Outcome:  OutcomeSuccess,
+			Status:   http.StatusOK,
+		},
+		{
+			Source:   SourceAPI,
+			Subject:  anonymousSubject,
+			Action:   "POST /api/v1/orgs/{orgName}/projects",
+			Resource: Resource{Org: "acme"},
+			Outcome:  OutcomeDenied,
+			Status:   http.StatusUnauthorized,
+			Error:    "missing token",
+		},
+	}
+	if diff := cmp.Diff(want, events, cmpopts.IgnoreFields(Event{}, "ID", "Timestamp")); diff != "" {
+		t.Errorf("events mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestMiddlewareRedactsCredentials(t *testing.T) {
+	memory := NewMemorySink(10)
+	auditor := New(slog.New(slog.DiscardHandler), memory)
+
+	mux := http.NewServeMux()
+	mux.Handle("POST /api/v1/orgs/{orgName}/dataplanes", auditor.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})))
+
+	body := `{"name": "edge", "apiServerURL": "https://edge.example.com:6443", "caCert": "ca-data",
+		"clientCert": "cert-data", "clientKey": "key-data", "observerUsername": "observer", "observerPassword": "observer-pass"}`
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/api/v1/orgs/acme/dataplanes?token=query-token",
+		strings.NewReader(body)))
+
+	events, err := memory.Query(context.Background(), &Query{})
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("Query() returned %d events, want 1", len(events))
+	}
+	data, err := json.Marshal(events[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, credential := range []string{"ca-data", "cert-data", "key-data", "observer-pass", "query-token"} {
+		if strings.Contains(string(data), credential) {
+			t.Errorf("audit event %s contains the credential %q", data, credential)
+		}
+	}
+	want := map[string]any{
+		"name":             "edge",
+		"apiServerURL":     "https://edge.example.com:6443",
+		"caCert":           redactedValue,
+		"clientCert":       redactedValue,
+		"clientKey":        redactedValue,
+		"observerUsername": "observer",
+		"observerPassword": redactedValue,
+		"token":            redactedValue,
+	}
+	if diff := cmp.Diff(want, events[0].Request); diff != "" {
+		t.Errorf("request summary mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestMiddlewareBodyLimit(t *testing.T) {
+	memory := NewMemorySink(10)
+	auditor := New(slog.New(slog.DiscardHandler), memory)
+	handler := auditor.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("handler called for a request body over the limit")
+	}))
+
+	rec := httptest.NewRecorder()
+	body := strings.NewReader(`{"name": "` + strings.Repeat("a", maxRequestBody) + `"}`)
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/apply", body))
+
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusRequestEntityTooLarge)
+	}
+}
+
+func TestOutcomeOfStatus(t *testing.T) {
+	tests := []struct {
+		status int
+		want   Outcome
+	}{
+		{http.StatusCreated, OutcomeSuccess},
+		{http.StatusBadRequest, OutcomeFailure},
+		{http.StatusUnauthorized, OutcomeDenied},
+		{http.StatusForbidden, OutcomeDenied},
+		{http.StatusInternalServerError, OutcomeFailure},
+	}
+	for _, tt := range tests {
+		if got := outcomeOfStatus(tt.status); got != tt.want {
+			t.Errorf("outcomeOfStatus(%d) = %q, want %q", tt.status, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// DefaultMemoryCapacity is the number of events kept by the memory sink of an auditor without a queryable sink
+const DefaultMemoryCapacity = 10000
+
+// LogSink writes audit events as structured log records
+type LogSink struct {
+	logger *slog.Logger
+}
+
+// NewLogSink creates a sink logging every event at info level
+func NewLogSink(logger *slog.Logger) *LogSink {
+	return &LogSink{logger: logger}
+}
+
+// Write logs the event
+func (s *LogSink) Write(ctx context.Context, event *Event) error {
+	s.logger.LogAttrs(ctx, slog.LevelInfo, "Audit event",
+		slog.String("id", event.ID),
+		slog.Time("timestamp", event.Timestamp),
+		slog.String("source", event.Source),
+		slog.String("subject", event.Subject),
+		slog.String("user", event.User),
+		slog.String("action", event.Action),
+		slog.Any("resource", event.Resource),
+		slog.Any("request", event.Request),
+		slog.String("outcome", string(event.Outcome)),
+		slog.Int("status", event.Status),
+		slog.String("error", event.Error),
+	)
+	return nil
+}
+
+var _ Querier = &MemorySink{}
+
+// MemorySink keeps the most recent events in memory. The events are lost when the server restarts.
+type MemorySink struct {
+	mu       sync.RWMutex
+	events   []Event
+	next     int
+	capacity int
+}
+
+// NewMemorySink creates a sink keeping up to capacity events, dropping the oldest events first
+func NewMemorySink(capacity int) *MemorySink {
+	return &MemorySink{events: make([]Event, 0, capacity), capacity: capacity}
+}
+
+// Write stores the event, replacing the oldest event when the sink is full
+func (s *MemorySink) Write(_ context.Context, event *Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.events) < s.capacity {
+		s.events = append(s.events, *event)
+		return nil
+	}
+	s.events[s.next] = *event
+	s.next = (s.next + 1) % s.capacity
+	return nil
+}
+
+// Query returns the stored events matching the query, most recent first
+func (s *MemorySink) Query(_ context.Context, q *Query) ([]Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return selectEvents(q, func(yield func(*Event) bool) {
+		// The most recent event is the one before the position of the next write
+		for i := range len(s.events) {
+			j := (s.next - 1 - i + 2*len(s.events)) % len(s.events)
+			if !yield(&s.events[j]) {
+				return
+			}
+		}
+	}), nil
+}
+
+var _ Querier = &FileSink{}
+
+// FileSink appends audit events to a file as JSON lines. The most recent events are also kept in memory, where
+// they are queried from, so that queries do not read the file. They are loaded from the file when it is opened,
+// which keeps them across restarts when the file is on a persistent volume.
+type FileSink struct {
+	mu     sync.Mutex
+	file   *os.File
+	recent *MemorySink
+}
+
+// NewFileSink opens the file at path for appending events, creating it if it does not exist. The most recent
+// DefaultMemoryCapacity events of the file can be queried.
+func NewFileSink(path string) (*FileSink, error) {
+	return newFileSink(path, DefaultMemoryCapacity)
+}
+
+func newFileSink(path string, capacity int) (*FileSink, error) {
+	recent := NewMemorySink(capacity)
+	if err := loadEvents(path, recent); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	return &FileSink{file: file, recent: recent}, nil
+}
+
+// Write appends the event to the file
+func (s *FileSink) Write(ctx context.Context, event *Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return s.recent.Write(ctx, event)
+}
+
+// Query returns the most recent events of the file matching the query, most recent first
+func (s *FileSink) Query(ctx context.Context, q *Query) ([]Event, error) {
+	return s.recent.Query(ctx, q)
+}
+
+// Close closes the file
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// loadEvents writes the events of the file at path to sink in the order they were written. A missing file has no events.
+func loadEvents(path string, sink Sink) error {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var event Event
+		// A partially written last line is skipped rather than failing to open the file
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			continue
+		}
+		if err := sink.Write(context.Background(), &event); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read audit log file: %w", err)
+	}
+	return nil
+}
+
+const (
+	// webhookQueueSize is the number of events waiting for delivery before new events are dropped
+	webhookQueueSize = 1000
+	// webhookAttempts is how many times the delivery of an event is attempted
+	webhookAttempts = 3
+	// webhookRetryInterval is how long to wait before retrying a failed delivery
+	webhookRetryInterval = 2 * time.Second
+)
+
+// WebhookSink posts audit events as JSON to a URL. Events are delivered in the background so that a slow
+// receiver does not delay the audited operations.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+	logger *slog.Logger
+	queue  chan Event
+	done   chan struct{}
+	closed sync.Once
+}
+
+// NewWebhookSink creates a sink posting every event to url
+func NewWebhookSink(url string, logger *slog.Logger) *WebhookSink {
+	s := &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+		logger: logger,
+		queue:  make(chan Event, webhookQueueSize),
+		done:   make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Write queues the event for delivery. It fails when the queue is full.
+func (s *WebhookSink) Write(_ context.Context, event *Event) error {
+	select {
+	case s.queue <- *event:
+		return nil
+	default:
+		return errors.New("audit webhook queue is full, dropping event")
+	}
+}
+
+// Close delivers the queued events and stops the sink
+func (s *WebhookSink) Close() error {
+	s.closed.Do(func() { close(s.queue) })
+	<-s.done
+	return nil
+}
+
+func (s *WebhookSink) run() {
+	defer close(s.done)
+	for event := range s.queue {
+		var err error
+		for attempt := 1; attempt <= webhookAttempts; attempt++ {
+			if err = s.deliver(&event); err == nil {
+				break
+			}
+			if attempt < webhookAttempts {
+				time.Sleep(webhookRetryInterval)
+			}
+		}
+		if err != nil {
+			s.logger.Error("Failed to deliver audit event to webhook", "id", event.ID, "action", event.Action, "error", err)
+		}
+	}
+}
+
+func (s *WebhookSink) deliver(event *Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
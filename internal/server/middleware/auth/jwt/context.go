@@ -4,6 +4,7 @@
 package jwt
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -21,7 +22,17 @@ const (
 
 // GetClaims retrieves the JWT claims from the request context
 func GetClaims(r *http.Request) (jwt.MapClaims, bool) {
-	claims, ok := r.Context().Value(claimsContextKey).(jwt.MapClaims)
+	return GetClaimsFromContext(r.Context())
+}
+
+// NewContextWithClaims returns a copy of ctx carrying the JWT claims of an authenticated request
+func NewContextWithClaims(ctx context.Context, claims jwt.MapClaims) context.Context {
+	return context.WithValue(ctx, claimsContextKey, claims)
+}
+
+// GetClaimsFromContext retrieves the JWT claims from a context derived from the request context
+func GetClaimsFromContext(ctx context.Context) (jwt.MapClaims, bool) {
+	claims, ok := ctx.Value(claimsContextKey).(jwt.MapClaims)
 	return claims, ok
 }
 
@@ -43,10 +54,33 @@ func GetClaimValue(r *http.Request, key string) (interface{}, bool) {
 
 // GetSubject retrieves the subject (sub) claim from the request context
 func GetSubject(r *http.Request) (string, bool) {
-	value, ok := GetClaimValue(r, "sub")
+	return GetSubjectFromContext(r.Context())
+}
+
+// GetSubjectFromContext retrieves the subject (sub) claim from a context derived from the request context
+func GetSubjectFromContext(ctx context.Context) (string, bool) {
+	claims, ok := GetClaimsFromContext(ctx)
 	if !ok {
 		return "", false
 	}
-	sub, ok := value.(string)
+	sub, ok := claims["sub"].(string)
 	return sub, ok
 }
+
+// identityClaims are the claims checked, in order, to identify the user behind a request
+var identityClaims = []string{"email", "preferred_username", "sub"}
+
+// GetUserIdentity returns an identity of the authenticated user suitable for audit records.
+// The email and preferred_username claims are preferred over the subject as they are human readable.
+func GetUserIdentity(ctx context.Context) (string, bool) {
+	claims, ok := GetClaimsFromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, key := range identityClaims {
+		if value, ok := claims[key].(string); ok && value != "" {
+			return value, true
+		}
+	}
+	return "", false
+}
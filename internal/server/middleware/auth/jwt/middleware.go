@@ -176,7 +176,7 @@ func Middleware(config Config) func(http.Handler) http.Handler {
 			}
 
 			// Add claims and token to request context
-			ctx := context.WithValue(r.Context(), claimsContextKey, claims)
+			ctx := NewContextWithClaims(r.Context(), claims)
 			ctx = context.WithValue(ctx, tokenContextKey, tokenString)
 
 			config.Logger.Debug("JWT authentication successful",
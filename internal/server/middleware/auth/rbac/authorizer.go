@@ -0,0 +1,142 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+)
+
+// ErrPermissionDenied is returned when the user is not allowed to perform an action
+var ErrPermissionDenied = errors.New("permission denied")
+
+// CodePermissionDenied is the error code of API responses for denied requests
+const CodePermissionDenied = "FORBIDDEN"
+
+// Resource identifies what an action is performed on. Empty fields are not part of the resource,
+// e.g. a resource with only Org set is the organization itself.
+type Resource struct {
+	Org         string
+	Project     string
+	Component   string
+	Environment string
+}
+
+// String returns a readable path of the resource, e.g. "org/acme/project/shop/environment/production"
+func (r Resource) String() string {
+	var parts []string
+	for _, p := range [][2]string{
+		{"org", r.Org}, {"project", r.Project}, {"component", r.Component}, {"environment", r.Environment},
+	} {
+		if p[1] != "" {
+			parts = append(parts, p[0]+"/"+p[1])
+		}
+	}
+	if len(parts) == 0 {
+		return "all resources"
+	}
+	return strings.Join(parts, "/")
+}
+
+// Authorizer decides whether the user of a request may perform an action on a resource.
+// A nil Authorizer allows every action, which is the behavior when no policy is configured.
+type Authorizer struct {
+	policy *Policy
+	roles  map[string][]Action
+}
+
+// NewAuthorizer creates an authorizer enforcing the given policy
+func NewAuthorizer(policy *Policy) *Authorizer {
+	roles := make(map[string][]Action, len(policy.Roles))
+	for _, role := range policy.Roles {
+		roles[role.Name] = role.Actions
+	}
+	return &Authorizer{policy: policy, roles: roles}
+}
+
+// Authorize returns an error wrapping ErrPermissionDenied unless the user identified by the JWT claims
+// in ctx is bound to a role granting the action within a scope that covers the resource.
+func (a *Authorizer) Authorize(ctx context.Context, action Action, resource Resource) error {
+	if a == nil {
+		return nil
+	}
+
+	claims, ok := jwt.GetClaimsFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("%w: no authenticated user", ErrPermissionDenied)
+	}
+	user, _ := jwt.GetUserIdentity(ctx)
+	groups := claimStrings(claims[a.policy.GroupsClaim])
+
+	for _, binding := range a.policy.Bindings {
+		if !binding.hasSubject(user, groups) || !a.covers(binding.Scope, action, resource) {
+			continue
+		}
+		actions := a.roles[binding.Role]
+		if slices.Contains(actions, action) || slices.Contains(actions, ActionAdmin) {
+			return nil
+		}
+	}
+
+	if user == "" {
+		user = "user"
+	}
+	return fmt.Errorf("%w: %s is not allowed to %s %s", ErrPermissionDenied, user, action, resource)
+}
+
+// covers reports whether a binding scope covers the action on the resource. Actions other than view in
+// protected environments are only covered by scopes that name the environment.
+func (a *Authorizer) covers(scope Scope, action Action, resource Resource) bool {
+	if action != ActionView && resource.Environment != "" && scope.Environment != resource.Environment &&
+		slices.Contains(a.policy.ProtectedEnvironments, resource.Environment) {
+		return false
+	}
+	return scopeFieldCovers(scope.Org, resource.Org) &&
+		scopeFieldCovers(scope.Project, resource.Project) &&
+		scopeFieldCovers(scope.Component, resource.Component) &&
+		scopeFieldCovers(scope.Environment, resource.Environment)
+}
+
+// scopeFieldCovers reports whether a scope field matches a resource field. An empty scope field matches
+// anything, while a set scope field only matches the same value.
+func scopeFieldCovers(scope, value string) bool {
+	return scope == "" || scope == value
+}
+
+// hasSubject reports whether the binding applies to the user or one of the groups
+func (b *Binding) hasSubject(user string, groups []string) bool {
+	if user != "" && slices.Contains(b.Users, user) {
+		return true
+	}
+	for _, group := range groups {
+		if slices.Contains(b.Groups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+// claimStrings returns the values of a claim that is either a string or a list of strings
+func claimStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rbac
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+)
+
+const testPolicy = `
+protectedEnvironments: [production]
+roles:
+  - name: viewer
+    actions: [view]
+  - name: developer
+    actions: [view, edit, build, deploy, promote]
+  - name: release-manager
+    actions: [approve, promote, deploy]
+  - name: platform-admin
+    actions: [admin]
+bindings:
+  - role: viewer
+    groups: [everyone]
+    scope:
+      org: acme
+  - role: developer
+    groups: [shop-devs]
+    scope:
+      org: acme
+      project: shop
+  - role: release-manager
+    groups: [release-managers]
+    scope:
+      org: acme
+      environment: production
+  - role: platform-admin
+    users: [root@example.com]
+`
+
+func TestAuthorize(t *testing.T) {
+	policy, err := ParsePolicy([]byte(testPolicy))
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+	authorizer := NewAuthorizer(policy)
+
+	shopDev := gojwt.MapClaims{"sub": "u1", "email": "dev@example.com", "groups": []interface{}{"everyone", "shop-devs"}}
+	releaseManager := gojwt.MapClaims{"sub": "u2", "groups": "release-managers"}
+	root := gojwt.MapClaims{"sub": "u3", "email": "root@example.com"}
+
+	component := Resource{Org: "acme", Project: "shop", Component: "cart"}
+	inEnv := func(env string) Resource {
+		r := component
+		r.Environment = env
+		return r
+	}
+
+	tests := []struct {
+		name     string
+		claims   gojwt.MapClaims
+		action   Action
+		resource Resource
+		allowed  bool
+	}{
+		{"view in org", shopDev, ActionView, Resource{Org: "acme"}, true},
+		{"view in other org", shopDev, ActionView, Resource{Org: "other"}, false},
+		{"deploy in own project", shopDev, ActionDeploy, inEnv("dev"), true},
+		{"deploy in other project", shopDev, ActionDeploy, Resource{Org: "acme", Project: "billing", Environment: "dev"}, false},
+		{"view in protected environment", shopDev, ActionView, inEnv("production"), true},
+		{"promote to protected environment", shopDev, ActionPromote, inEnv("production"), false},
+		{"approve without role", shopDev, ActionApprove, inEnv("staging"), false},
+		{"release manager approves production", releaseManager, ActionApprove, inEnv("production"), true},
+		{"release manager approves staging", releaseManager, ActionApprove, inEnv("staging"), false},
+		{"admin implies every action", root, ActionBuild, component, true},
+		{"admin is subject to protected environments", root, ActionDeploy, inEnv("production"), false},
+		{"no claims", nil, ActionView, Resource{Org: "acme"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.claims != nil {
+				ctx = jwt.NewContextWithClaims(ctx, tt.claims)
+			}
+			err := authorizer.Authorize(ctx, tt.action, tt.resource)
+			if tt.allowed && err != nil {
+				t.Errorf("Authorize() error = %v, want allowed", err)
+			}
+			if !tt.allowed && !errors.Is(err, ErrPermissionDenied) {
+				t.Errorf("Authorize() error = %v, want %v", err, ErrPermissionDenied)
+			}
+		})
+	}
+}
+
+func TestNilAuthorizerAllows(t *testing.T) {
+	var authorizer *Authorizer
+	if err := authorizer.Authorize(context.Background(), ActionAdmin, Resource{}); err != nil {
+		t.Errorf("Authorize() error = %v, want allowed", err)
+	}
+}
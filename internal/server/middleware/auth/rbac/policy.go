@@ -0,0 +1,133 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rbac
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Action is an operation that can be granted by a role
+type Action string
+
+const (
+	// ActionView allows reading resources
+	ActionView Action = "view"
+	// ActionEdit allows creating and updating projects, components, releases and workloads
+	ActionEdit Action = "edit"
+	// ActionBuild allows triggering builds
+	ActionBuild Action = "build"
+	// ActionDeploy allows deploying releases to environments and changing release bindings
+	ActionDeploy Action = "deploy"
+	// ActionPromote allows promoting components between environments and requesting promotions
+	ActionPromote Action = "promote"
+	// ActionApprove allows approving and rejecting promotion requests
+	ActionApprove Action = "approve"
+	// ActionAdmin allows every action, including managing platform resources
+	ActionAdmin Action = "admin"
+)
+
+// validActions are the actions that can be used in roles
+var validActions = []Action{ActionView, ActionEdit, ActionBuild, ActionDeploy, ActionPromote, ActionApprove, ActionAdmin}
+
+// defaultGroupsClaim is the JWT claim holding the groups of the user
+const defaultGroupsClaim = "groups"
+
+// Policy is the authorization policy of the API server
+type Policy struct {
+	// GroupsClaim is the JWT claim holding the groups of the user. Defaults to "groups".
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+	// ProtectedEnvironments are environments, e.g. production, where only bindings naming the environment
+	// grant actions other than view. Bindings without an environment scope only grant view in them.
+	ProtectedEnvironments []string  `json:"protectedEnvironments,omitempty"`
+	Roles                 []Role    `json:"roles"`
+	Bindings              []Binding `json:"bindings"`
+}
+
+// Role is a named set of actions
+type Role struct {
+	Name    string   `json:"name"`
+	Actions []Action `json:"actions"`
+}
+
+// Binding grants a role to users and groups within a scope
+type Binding struct {
+	Role   string   `json:"role"`
+	Users  []string `json:"users,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+	Scope  Scope    `json:"scope,omitempty"`
+}
+
+// Scope limits a binding to an organization, project, component or environment.
+// Empty fields match any value.
+type Scope struct {
+	Org         string `json:"org,omitempty"`
+	Project     string `json:"project,omitempty"`
+	Component   string `json:"component,omitempty"`
+	Environment string `json:"environment,omitempty"`
+}
+
+// LoadPolicy reads and validates the policy file at path
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read authorization policy: %w", err)
+	}
+	return ParsePolicy(data)
+}
+
+// ParsePolicy parses and validates a YAML authorization policy
+func ParsePolicy(data []byte) (*Policy, error) {
+	var policy Policy
+	if err := yaml.UnmarshalStrict(data, &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse authorization policy: %w", err)
+	}
+	if err := policy.validate(); err != nil {
+		return nil, fmt.Errorf("invalid authorization policy: %w", err)
+	}
+	if policy.GroupsClaim == "" {
+		policy.GroupsClaim = defaultGroupsClaim
+	}
+	return &policy, nil
+}
+
+func (p *Policy) validate() error {
+	roles := make(map[string]bool, len(p.Roles))
+	for _, role := range p.Roles {
+		if role.Name == "" {
+			return fmt.Errorf("role name is required")
+		}
+		if roles[role.Name] {
+			return fmt.Errorf("duplicate role %q", role.Name)
+		}
+		roles[role.Name] = true
+		if len(role.Actions) == 0 {
+			return fmt.Errorf("role %q: at least one action is required", role.Name)
+		}
+		for _, action := range role.Actions {
+			if !slices.Contains(validActions, action) {
+				return fmt.Errorf("role %q: unsupported action %q", role.Name, action)
+			}
+		}
+	}
+
+	for i, binding := range p.Bindings {
+		if !roles[binding.Role] {
+			return fmt.Errorf("binding %d: unknown role %q", i, binding.Role)
+		}
+		if len(binding.Users) == 0 && len(binding.Groups) == 0 {
+			return fmt.Errorf("binding %d: at least one user or group is required", i)
+		}
+		if binding.Scope.Org == "" && (binding.Scope.Project != "" || binding.Scope.Component != "") {
+			return fmt.Errorf("binding %d: a project or component scope requires an org", i)
+		}
+		if binding.Scope.Component != "" && binding.Scope.Project == "" {
+			return fmt.Errorf("binding %d: a component scope requires a project", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rbac
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParsePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr string
+	}{
+		{
+			name: "valid policy",
+			policy: `
+protectedEnvironments: [production]
+roles:
+  - name: developer
+    actions: [view, edit, build, deploy, promote]
+  - name: release-manager
+    actions: [approve, promote, deploy]
+bindings:
+  - role: developer
+    groups: [shop-devs]
+    scope:
+      org: acme
+      project: shop
+  - role: release-manager
+    groups: [release-managers]
+    scope:
+      org: acme
+      environment: production
+`,
+		},
+		{
+			name: "unknown action",
+			policy: `
+roles:
+  - name: r
+    actions: [delete]
+`,
+			wantErr: `unsupported action "delete"`,
+		},
+		{
+			name: "unknown role",
+			policy: `
+roles:
+  - name: r
+    actions: [view]
+bindings:
+  - role: admin
+    users: [alice@example.com]
+`,
+			wantErr: `unknown role "admin"`,
+		},
+		{
+			name: "binding without subjects",
+			policy: `
+roles:
+  - name: r
+    actions: [view]
+bindings:
+  - role: r
+`,
+			wantErr: "at least one user or group is required",
+		},
+		{
+			name: "project scope without org",
+			policy: `
+roles:
+  - name: r
+    actions: [view]
+bindings:
+  - role: r
+    groups: [devs]
+    scope:
+      project: shop
+`,
+			wantErr: "a project or component scope requires an org",
+		},
+		{
+			name: "unknown field",
+			policy: `
+roles:
+  - name: r
+    verbs: [view]
+`,
+			wantErr: "failed to parse authorization policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := ParsePolicy([]byte(tt.policy))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParsePolicy() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePolicy() error = %v", err)
+			}
+			if policy.GroupsClaim != defaultGroupsClaim {
+				t.Errorf("groupsClaim = %q, want %q", policy.GroupsClaim, defaultGroupsClaim)
+			}
+		})
+	}
+}
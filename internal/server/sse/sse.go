@@ -0,0 +1,75 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sse writes Server-Sent Events to HTTP responses
+package sse
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Writer writes Server-Sent Events to a response
+type Writer struct {
+	w        http.ResponseWriter
+	rc       *http.ResponseController
+	lastSent time.Time
+}
+
+// NewWriter prepares an event stream response. The write deadline of the server is lifted,
+// since a stream stays open until the client disconnects.
+func NewWriter(w http.ResponseWriter) (*Writer, error) {
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return nil, err
+	}
+	return &Writer{w: w, rc: rc}, nil
+}
+
+// Start writes the response headers of the event stream
+func (s *Writer) Start() error {
+	s.w.Header().Set("Content-Type", "text/event-stream")
+	s.w.Header().Set("Cache-Control", "no-cache")
+	s.w.Header().Set("Connection", "keep-alive")
+	s.w.Header().Set("X-Accel-Buffering", "no")
+	s.w.WriteHeader(http.StatusOK)
+	return s.Flush()
+}
+
+// Event writes an event with a JSON payload. The event reaches the client with the next Flush.
+func (s *Writer) Event(id, name string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(s.w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", name, data); err != nil {
+		return err
+	}
+	return nil
+}
+
+// KeepAlive writes a comment if nothing was flushed for the idle duration, so that proxies do not
+// close an idle stream
+func (s *Writer) KeepAlive(idle time.Duration) error {
+	if time.Since(s.lastSent) < idle {
+		return nil
+	}
+	if _, err := fmt.Fprint(s.w, ": keep-alive\n\n"); err != nil {
+		return err
+	}
+	return s.Flush()
+}
+
+// Flush sends the events written so far to the client
+func (s *Writer) Flush() error {
+	s.lastSent = time.Now()
+	return s.rc.Flush()
+}
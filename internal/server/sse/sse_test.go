@@ -0,0 +1,47 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sse
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWriter(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	writer, err := NewWriter(recorder)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+	if err := writer.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if err := writer.Event("7", "added", map[string]string{"name": "cart"}); err != nil {
+		t.Fatalf("Event() error = %v", err)
+	}
+	if err := writer.Event("", "error", map[string]string{"error": "failed"}); err != nil {
+		t.Fatalf("Event() error = %v", err)
+	}
+	if err := writer.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	// Nothing is written while events were sent within the idle duration
+	if err := writer.KeepAlive(time.Hour); err != nil {
+		t.Fatalf("KeepAlive() error = %v", err)
+	}
+	if err := writer.KeepAlive(0); err != nil {
+		t.Fatalf("KeepAlive() error = %v", err)
+	}
+
+	if got := recorder.Header().Get("Content-Type"); got != "text/event-stream" {
+		t.Errorf("Content-Type = %q, want text/event-stream", got)
+	}
+	want := "id: 7\nevent: added\ndata: {\"name\":\"cart\"}\n\n" +
+		"event: error\ndata: {\"error\":\"failed\"}\n\n" +
+		": keep-alive\n\n"
+	if got := recorder.Body.String(); got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	apiextschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+)
+
+// Variables declares the top-level variables of a CEL environment together with the structural
+// schema describing their values. Variables with a nil schema are declared with a dynamic type.
+type Variables map[string]*apiextschema.Structural
+
+// schemaTypePrefix is prepended to the names of the object types derived from schemas.
+// The prefix cannot appear in a CEL identifier, so type names never shadow variables or fields.
+const schemaTypePrefix = "schema:"
+
+// Check walks data the same way Render does and type-checks every ${...} expression found in
+// string values and map keys against the schemas of the given variables, without evaluating it.
+//
+// In addition to the errors reported by ValidateExpressions, this catches references to fields
+// that are not declared in the schema (e.g. ${parameters.replicaz}) and operations on values of
+// the wrong type (e.g. ${parameters.port + "x"} for an integer port).
+//
+// Schema values are mapped to CEL types as follows:
+//   - string and boolean map to string and bool
+//   - integer maps to int and number maps to double, matching the int64 and float64 values the
+//     parameters are normalized to before rendering
+//   - x-kubernetes-int-or-string maps to dyn
+//   - arrays map to lists of their item type
+//   - objects with additionalProperties map to maps from string to the value type
+//   - objects with properties map to object types whose fields can only be selected, so use
+//     has(parameters.field) instead of 'field' in parameters
+//   - nullable values, objects without properties and objects preserving unknown fields map to dyn
+//     or map(string, dyn)
+//
+// All failing expressions are returned, ordered by path.
+func (e *Engine) Check(data any, variables Variables) []*ExpressionError {
+	env, err := buildCheckEnv(variables)
+	if err != nil {
+		return []*ExpressionError{{Err: fmt.Errorf("failed to build CEL environment: %w", err)}}
+	}
+
+	v := &expressionValidator{compile: func(expression string) error {
+		_, issues := env.Compile(expression)
+		if issues != nil && issues.Err() != nil {
+			return issues.Err()
+		}
+		return nil
+	}}
+	v.walk("", data)
+	return v.errs
+}
+
+// buildCheckEnv builds a CEL environment whose variables are typed from their schemas.
+// The environment is only used for type checking and never evaluates expressions.
+func buildCheckEnv(variables Variables) (*cel.Env, error) {
+	registry, err := types.NewRegistry()
+	if err != nil {
+		return nil, err
+	}
+	provider := &schemaTypeProvider{
+		Registry: registry,
+		fields:   make(map[string]map[string]*types.Type),
+	}
+
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// The type provider must be set before the variables and types are declared
+	envOptions := []cel.EnvOption{cel.CustomTypeProvider(provider)}
+	for _, name := range names {
+		envOptions = append(envOptions, cel.Variable(name, provider.celType(name, variables[name])))
+	}
+	return newEnv(envOptions...)
+}
+
+// schemaTypeProvider resolves the object types derived from structural schemas and delegates all
+// other types to the standard CEL registry.
+type schemaTypeProvider struct {
+	*types.Registry
+
+	// fields holds the field types of each derived object type, keyed by type name
+	fields map[string]map[string]*types.Type
+}
+
+// celType converts the schema of the value at path into a CEL type, registering object types for
+// objects with declared properties.
+func (p *schemaTypeProvider) celType(path string, s *apiextschema.Structural) *types.Type {
+	if s == nil || s.Nullable || s.XIntOrString {
+		return types.DynType
+	}
+
+	switch s.Type {
+	case "string":
+		return types.StringType
+	case "integer":
+		return types.IntType
+	case "number":
+		return types.DoubleType
+	case "boolean":
+		return types.BoolType
+	case "array":
+		if s.Items == nil {
+			return types.NewListType(types.DynType)
+		}
+		return types.NewListType(p.celType(path+"[*]", s.Items))
+	case "object":
+		if s.AdditionalProperties != nil && s.AdditionalProperties.Structural != nil {
+			return types.NewMapType(types.StringType, p.celType(path+"[*]", s.AdditionalProperties.Structural))
+		}
+		if len(s.Properties) == 0 || s.XPreserveUnknownFields || s.XEmbeddedResource {
+			return types.NewMapType(types.StringType, types.DynType)
+		}
+		typeName := schemaTypePrefix + path
+		fields := make(map[string]*types.Type, len(s.Properties))
+		p.fields[typeName] = fields
+		for name, prop := range s.Properties {
+			fields[name] = p.celType(path+"."+name, &prop)
+		}
+		return types.NewObjectType(typeName)
+	default:
+		return types.DynType
+	}
+}
+
+// FindStructType implements types.Provider.
+func (p *schemaTypeProvider) FindStructType(structType string) (*types.Type, bool) {
+	if _, found := p.fields[structType]; found {
+		return types.NewTypeTypeWithParam(types.NewObjectType(structType)), true
+	}
+	return p.Registry.FindStructType(structType)
+}
+
+// FindStructFieldNames implements types.Provider.
+func (p *schemaTypeProvider) FindStructFieldNames(structType string) ([]string, bool) {
+	fields, found := p.fields[structType]
+	if !found {
+		return p.Registry.FindStructFieldNames(structType)
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, true
+}
+
+// FindStructFieldType implements types.Provider.
+func (p *schemaTypeProvider) FindStructFieldType(structType, fieldName string) (*types.FieldType, bool) {
+	fields, found := p.fields[structType]
+	if !found {
+		return p.Registry.FindStructFieldType(structType, fieldName)
+	}
+	fieldType, found := fields[fieldName]
+	if !found {
+		return nil, false
+	}
+	return &types.FieldType{Type: fieldType}, true
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/schema"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	parameters, err := schema.ToStructural(schema.Definition{
+		Types: map[string]any{
+			"Resources": map[string]any{
+				"cpu":    "string | default=100m",
+				"memory": "string | default=128Mi",
+			},
+			"Port": map[string]any{
+				"name": "string",
+				"port": "integer",
+			},
+		},
+		Schemas: []map[string]any{{
+			"replicas":  "integer | default=1",
+			"image":     "string",
+			"debug":     "boolean | default=false",
+			"ratio":     "number | default=0.5",
+			"resources": "Resources",
+			"ports":     "[]Port",
+			"labels":    "map[string]string",
+		}},
+	})
+	if err != nil {
+		t.Fatalf("failed to build schema: %v", err)
+	}
+	variables := Variables{
+		"parameters": parameters,
+		"metadata":   nil,
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		// wantErrs maps the paths of the expressions expected to fail to a part of their error message
+		wantErrs map[string]string
+	}{
+		{
+			name: "typed expressions",
+			template: `
+metadata:
+  name: ${metadata.name}
+  labels: ${oc_merge(parameters.labels, {"app": metadata.name})}
+spec:
+  replicas: ${parameters.replicas + 1}
+  maxReplicas: ${parameters.replicas * 2}
+  minReplicas: ${double(parameters.replicas) * parameters.ratio}
+  scaled: ${parameters.replicas > 2}
+  single: ${parameters.replicas == 1}
+  paused: ${!parameters.debug}
+  image: "${parameters.image}:${has(parameters.labels.version) ? parameters.labels.version : 'latest'}"
+  resources: ${oc_merge(parameters.resources, {"cpu": "200m"})}
+  port: ${parameters.ports[0].port}
+  portNames: ${parameters.ports.map(p, p.name)}
+  scale: ${parameters.ratio * 2.0}
+  memory: ${parameters.resources.?memory.orValue("64Mi")}
+`,
+		},
+		{
+			name: "undeclared field",
+			template: `
+spec:
+  replicas: ${parameters.replicaz}
+`,
+			wantErrs: map[string]string{"spec.replicas": "undefined field 'replicaz'"},
+		},
+		{
+			name: "undeclared nested field",
+			template: `
+spec:
+  cpu: ${parameters.resources.cpus}
+  port: ${parameters.ports[0].number}
+`,
+			wantErrs: map[string]string{
+				"spec.cpu":  "undefined field 'cpus'",
+				"spec.port": "undefined field 'number'",
+			},
+		},
+		{
+			name: "mismatched types",
+			template: `
+spec:
+  port: ${parameters.replicas + "x"}
+  next: ${parameters.replicas + 1.0}
+  team: ${parameters.labels.team - 1}
+`,
+			wantErrs: map[string]string{
+				"spec.port": "no matching overload",
+				"spec.next": "no matching overload",
+				"spec.team": "no matching overload",
+			},
+		},
+		{
+			name: "dynamic variables accept any field",
+			template: `
+name: ${metadata.anything.goes}
+`,
+		},
+		{
+			name: "undeclared variable",
+			template: `
+name: ${workload.name}
+`,
+			wantErrs: map[string]string{"name": "undeclared reference to 'workload'"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var data any
+			if err := yaml.Unmarshal([]byte(tt.template), &data); err != nil {
+				t.Fatalf("failed to parse template: %v", err)
+			}
+
+			gotErrs := map[string]string{}
+			for _, err := range NewEngine().Check(data, variables) {
+				if err.Err == nil {
+					t.Fatalf("expression error at %q has no underlying error", err.Path)
+				}
+				gotErrs[err.Path] = err.Err.Error()
+			}
+
+			wantPaths := make([]string, 0, len(tt.wantErrs))
+			for path := range tt.wantErrs {
+				wantPaths = append(wantPaths, path)
+			}
+			gotPaths := make([]string, 0, len(gotErrs))
+			for path := range gotErrs {
+				gotPaths = append(gotPaths, path)
+			}
+			sort.Strings(wantPaths)
+			sort.Strings(gotPaths)
+			if diff := cmp.Diff(wantPaths, gotPaths); diff != "" {
+				t.Fatalf("Check() paths mismatch (-want +got):\n%s\nerrors: %v", diff, gotErrs)
+			}
+			for path, want := range tt.wantErrs {
+				if !strings.Contains(gotErrs[path], want) {
+					t.Errorf("error at %s = %q, want it to contain %q", path, gotErrs[path], want)
+				}
+			}
+		})
+	}
+}
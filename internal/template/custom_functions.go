@@ -175,9 +175,10 @@ func CustomFunctions() []cel.EnvOption {
 				}),
 			),
 		),
+		// The arguments are dynamically typed so that objects typed from schemas can be merged as well
 		cel.Function("oc_merge",
-			cel.Overload("oc_merge_map_map",
-				[]*cel.Type{cel.MapType(cel.StringType, cel.DynType), cel.MapType(cel.StringType, cel.DynType)},
+			cel.Overload("oc_merge_dyn_dyn",
+				[]*cel.Type{cel.DynType, cel.DynType},
 				cel.MapType(cel.StringType, cel.DynType),
 				cel.BinaryBinding(mergeMapFunction),
 			),
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/google/cel-go/cel"
@@ -327,17 +328,21 @@ func (e *Engine) getOrCreateEnv(inputs map[string]any) (*cel.Env, error) {
 	return env, nil
 }
 
-// buildEnv wires up CEL with the helper surface area expected by our templating story so authors
-// can reuse common snippets like `omit`, `merge`, and `sanitizeK8sResourceName`.
+// buildEnv declares every input as a dynamically typed variable of a new CEL environment.
 func buildEnv(inputs map[string]any) (*cel.Env, error) {
-	envOptions := []cel.EnvOption{
-		cel.OptionalTypes(),
-	}
-
-	// Add variables for all inputs
+	envOptions := make([]cel.EnvOption, 0, len(inputs))
 	for key := range inputs {
 		envOptions = append(envOptions, cel.Variable(key, cel.DynType))
 	}
+	return newEnv(envOptions...)
+}
+
+// newEnv wires up CEL with the helper surface area expected by our templating story so authors
+// can reuse common snippets like `omit`, `merge`, and `sanitizeK8sResourceName`.
+// The given options declare the variables of the environment.
+func newEnv(declarations ...cel.EnvOption) (*cel.Env, error) {
+	envOptions := slices.Clone(declarations)
+	envOptions = append(envOptions, cel.OptionalTypes())
 
 	// Add standard CEL extensions
 	envOptions = append(envOptions,
@@ -594,6 +594,44 @@ dynamicHash: 578fbe87
 	}
 }
 
+// TestRenderIntegerParameters renders expressions on integer typed parameters, which are passed to the
+// engine as int64 values like the normalized parameters of components and traits
+func TestRenderIntegerParameters(t *testing.T) {
+	t.Parallel()
+
+	template := `
+replicas: ${parameters.replicas + 1}
+maxReplicas: ${parameters.replicas * 2}
+scaled: ${parameters.replicas > 2}
+single: ${parameters.replicas == 1}
+exact: ${parameters.replicas == 3}
+label: "replicas-${parameters.replicas}"
+`
+	var tpl any
+	if err := yaml.Unmarshal([]byte(template), &tpl); err != nil {
+		t.Fatalf("failed to unmarshal template: %v", err)
+	}
+
+	rendered, err := NewEngine().Render(tpl, map[string]any{
+		"parameters": map[string]any{"replicas": int64(3)},
+	})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	want := map[string]any{
+		"replicas":    int64(4),
+		"maxReplicas": int64(6),
+		"scaled":      true,
+		"single":      false,
+		"exact":       true,
+		"label":       "replicas-3",
+	}
+	if diff := cmp.Diff(want, rendered); diff != "" {
+		t.Errorf("Render() mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func compareYAML(expected, actual string) error {
 	var wantObj, gotObj any
 	if err := yaml.Unmarshal([]byte(expected), &wantObj); err != nil {
@@ -0,0 +1,104 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ExpressionError describes a CEL expression that failed to compile.
+type ExpressionError struct {
+	// Path locates the string containing the expression within the validated data,
+	// e.g. "spec.template.spec.containers[0].image". Empty when data is a plain string.
+	Path string
+
+	// Expression is the full ${...} expression (or the raw string when it could not be parsed).
+	Expression string
+
+	// Err is the underlying parse or compilation error.
+	Err error
+}
+
+func (e *ExpressionError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("%s: %v", e.Expression, e.Err)
+	}
+	return fmt.Sprintf("%s: %s: %v", e.Path, e.Expression, e.Err)
+}
+
+func (e *ExpressionError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateExpressions walks data the same way Render does and compiles every ${...} expression
+// found in string values and map keys without evaluating it. The given variables are declared
+// with a dynamic type, so this catches syntax errors, references to undeclared variables and
+// calls to unknown functions, but not missing fields.
+//
+// All failing expressions are returned, ordered by path.
+func (e *Engine) ValidateExpressions(data any, variables ...string) []*ExpressionError {
+	declared := make(map[string]any, len(variables))
+	for _, name := range variables {
+		declared[name] = nil
+	}
+
+	env, err := e.getOrCreateEnv(declared)
+	if err != nil {
+		return []*ExpressionError{{Err: fmt.Errorf("failed to build CEL environment: %w", err)}}
+	}
+
+	v := &expressionValidator{compile: func(expression string) error {
+		_, issues := env.Compile(expression)
+		if issues != nil && issues.Err() != nil {
+			return issues.Err()
+		}
+		return nil
+	}}
+	v.walk("", data)
+	return v.errs
+}
+
+type expressionValidator struct {
+	compile func(expression string) error
+	errs    []*ExpressionError
+}
+
+func (v *expressionValidator) walk(path string, data any) {
+	switch typed := data.(type) {
+	case string:
+		v.validateString(path, typed)
+	case map[string]any:
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			v.validateString(childPath, key)
+			v.walk(childPath, typed[key])
+		}
+	case []any:
+		for i, item := range typed {
+			v.walk(fmt.Sprintf("%s[%d]", path, i), item)
+		}
+	}
+}
+
+func (v *expressionValidator) validateString(path, str string) {
+	expressions, err := findCELExpressions(str)
+	if err != nil {
+		v.errs = append(v.errs, &ExpressionError{Path: path, Expression: str, Err: err})
+		return
+	}
+	for _, match := range expressions {
+		if err := v.compile(match.innerExpr); err != nil {
+			v.errs = append(v.errs, &ExpressionError{Path: path, Expression: match.fullExpr, Err: err})
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/yaml"
+)
+
+func TestValidateExpressions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		template  string
+		variables []string
+		// wantPaths lists the paths of the expressions expected to fail, in order
+		wantPaths []string
+	}{
+		{
+			name: "valid expressions in values and keys",
+			template: `
+metadata:
+  name: ${metadata.name}
+  labels:
+    ${"app-" + component.name}: "true"
+spec:
+  replicas: ${parameters.replicas}
+  image: "${workload.containers.main.image}:${has(parameters.tag) ? parameters.tag : 'latest'}"
+`,
+			variables: []string{"metadata", "component", "parameters", "workload"},
+		},
+		{
+			name: "plain strings are ignored",
+			template: `
+kind: Deployment
+note: "costs $5 {per} month"
+`,
+		},
+		{
+			name: "undeclared variable",
+			template: `
+spec:
+  replicas: ${spec.replicas}
+`,
+			variables: []string{"parameters"},
+			wantPaths: []string{"spec.replicas"},
+		},
+		{
+			name: "syntax error and unknown function are both reported",
+			template: `
+spec:
+  containers:
+  - name: ${parameters.name +}
+    image: ${imageOf(parameters)}
+`,
+			variables: []string{"parameters"},
+			wantPaths: []string{"spec.containers[0].image", "spec.containers[0].name"},
+		},
+		{
+			name: "invalid expression in map key",
+			template: `
+data:
+  ${parameters.}: value
+`,
+			variables: []string{"parameters"},
+			wantPaths: []string{"data.${parameters.}"},
+		},
+		{
+			name: "nested expression",
+			template: `
+value: ${"${parameters.x}"}suffix${${parameters.y}}
+`,
+			variables: []string{"parameters"},
+			wantPaths: []string{"value"},
+		},
+		{
+			name: "custom functions are available",
+			template: `
+name: ${oc_generate_name(metadata.name, "cfg")}
+labels: ${oc_merge(metadata.labels, {"a": "b"})}
+`,
+			variables: []string{"metadata"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var data any
+			if err := yaml.Unmarshal([]byte(tt.template), &data); err != nil {
+				t.Fatalf("failed to parse template: %v", err)
+			}
+
+			errs := NewEngine().ValidateExpressions(data, tt.variables...)
+			var gotPaths []string
+			for _, err := range errs {
+				if err.Err == nil {
+					t.Errorf("expression error at %q has no underlying error", err.Path)
+				}
+				gotPaths = append(gotPaths, err.Path)
+			}
+			if diff := cmp.Diff(tt.wantPaths, gotPaths); diff != "" {
+				t.Errorf("ValidateExpressions() paths mismatch (-want +got):\n%s\nerrors: %v", diff, errs)
+			}
+		})
+	}
+}
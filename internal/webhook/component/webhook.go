@@ -6,14 +6,21 @@ package component
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/webhook/validation"
 )
 
 // nolint:unused
@@ -23,7 +30,7 @@ var componentlog = logf.Log.WithName("component-resource")
 // SetupComponentWebhookWithManager registers the webhook for Component in the manager.
 func SetupComponentWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).For(&openchoreodevv1alpha1.Component{}).
-		WithValidator(&Validator{}).
+		WithValidator(&Validator{client: mgr.GetClient()}).
 		WithDefaulter(&Defaulter{}).
 		Complete()
 }
@@ -68,35 +75,32 @@ func (d *Defaulter) Default(_ context.Context, obj runtime.Object) error {
 // NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
 // as this struct is used only for temporary operations and does not need to be deeply copied.
 type Validator struct {
-	// TODO(user): Add more fields as needed for validation
+	// client reads the ComponentType and Trait resources referenced by the component.
+	client client.Client
 }
 
 var _ webhook.CustomValidator = &Validator{}
 
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Component.
-func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	component, ok := obj.(*openchoreodevv1alpha1.Component)
 	if !ok {
 		return nil, fmt.Errorf("expected a Component object but got %T", obj)
 	}
 	componentlog.Info("Validation for Component upon creation", "name", component.GetName())
 
-	// TODO(user): fill in your validation logic upon object creation.
-
-	return nil, nil
+	return nil, v.validateComponent(ctx, component)
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Component.
-func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	component, ok := newObj.(*openchoreodevv1alpha1.Component)
 	if !ok {
 		return nil, fmt.Errorf("expected a Component object for the newObj but got %T", newObj)
 	}
 	componentlog.Info("Validation for Component upon update", "name", component.GetName())
 
-	// TODO(user): fill in your validation logic upon object update.
-
-	return nil, nil
+	return nil, v.validateComponent(ctx, component)
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Component.
@@ -111,3 +115,120 @@ func (v *Validator) ValidateDelete(ctx context.Context, obj runtime.Object) (adm
 
 	return nil, nil
 }
+
+// validateComponent checks the parameters and trait instances of a component against the schemas of the
+// referenced ComponentType and Traits, so that mistakes surface at apply time instead of as conditions
+// after reconciliation. Components that do not use a ComponentType are not checked.
+func (v *Validator) validateComponent(ctx context.Context, component *openchoreodevv1alpha1.Component) error {
+	if component.Spec.ComponentType == "" {
+		return nil
+	}
+
+	specPath := field.NewPath("spec")
+	allErrs, err := v.validateParameters(ctx, component, specPath)
+	if err != nil {
+		return err
+	}
+
+	traitErrs, err := v.validateTraits(ctx, component, specPath.Child("traits"))
+	if err != nil {
+		return err
+	}
+	allErrs = append(allErrs, traitErrs...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(openchoreodevv1alpha1.GroupVersion.WithKind("Component").GroupKind(),
+		component.Name, allErrs)
+}
+
+// validateParameters resolves the ComponentType and validates spec.parameters against its schema.
+// Only errors that prevent the check from running (e.g. API failures) are returned as error.
+func (v *Validator) validateParameters(ctx context.Context, component *openchoreodevv1alpha1.Component,
+	specPath *field.Path) (field.ErrorList, error) {
+	ctPath := specPath.Child("componentType")
+
+	// componentType is in the format {workloadType}/{componentTypeName}
+	workloadType, ctName, found := strings.Cut(component.Spec.ComponentType, "/")
+	if !found || workloadType == "" || ctName == "" {
+		return field.ErrorList{field.Invalid(ctPath, component.Spec.ComponentType,
+			"must be in the format {workloadType}/{name}")}, nil
+	}
+
+	ct := &openchoreodevv1alpha1.ComponentType{}
+	if err := v.client.Get(ctx, types.NamespacedName{Name: ctName, Namespace: component.Namespace}, ct); err != nil {
+		if apierrors.IsNotFound(err) {
+			return field.ErrorList{field.NotFound(ctPath, component.Spec.ComponentType)}, nil
+		}
+		return nil, fmt.Errorf("failed to get ComponentType %q: %w", ctName, err)
+	}
+
+	if ct.Spec.WorkloadType != workloadType {
+		return field.ErrorList{field.Invalid(ctPath, component.Spec.ComponentType,
+			fmt.Sprintf("workload type %q does not match ComponentType workload type %q", workloadType, ct.Spec.WorkloadType))}, nil
+	}
+
+	structural, err := pipelinecontext.BuildStructuralSchema(&pipelinecontext.SchemaInput{
+		Types:              ct.Spec.Schema.Types,
+		ParametersSchema:   ct.Spec.Schema.Parameters,
+		EnvOverridesSchema: ct.Spec.Schema.EnvOverrides,
+	})
+	if err != nil {
+		return field.ErrorList{field.Invalid(ctPath, component.Spec.ComponentType,
+			fmt.Sprintf("ComponentType schema is invalid: %v", err))}, nil
+	}
+
+	return validation.ValidateParameters(component.Spec.Parameters, structural, specPath.Child("parameters"),
+		validation.EnvOverrideFields(ct.Spec.Schema.EnvOverrides)...), nil
+}
+
+// validateTraits checks that trait instance names are unique and that each instance's parameters
+// match the schema of the referenced Trait.
+func (v *Validator) validateTraits(ctx context.Context, component *openchoreodevv1alpha1.Component,
+	fldPath *field.Path) (field.ErrorList, error) {
+	var allErrs field.ErrorList
+	instanceNames := make(map[string]bool, len(component.Spec.Traits))
+	traits := make(map[string]*openchoreodevv1alpha1.Trait)
+
+	for i, ref := range component.Spec.Traits {
+		idxPath := fldPath.Index(i)
+
+		if instanceNames[ref.InstanceName] {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("instanceName"), ref.InstanceName))
+		}
+		instanceNames[ref.InstanceName] = true
+
+		trait, ok := traits[ref.Name]
+		if !ok {
+			trait = &openchoreodevv1alpha1.Trait{}
+			if err := v.client.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: component.Namespace}, trait); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return nil, fmt.Errorf("failed to get Trait %q: %w", ref.Name, err)
+				}
+				trait = nil
+			}
+			traits[ref.Name] = trait
+		}
+		if trait == nil {
+			allErrs = append(allErrs, field.NotFound(idxPath.Child("name"), ref.Name))
+			continue
+		}
+
+		structural, err := pipelinecontext.BuildStructuralSchema(&pipelinecontext.SchemaInput{
+			Types:              trait.Spec.Schema.Types,
+			ParametersSchema:   trait.Spec.Schema.Parameters,
+			EnvOverridesSchema: trait.Spec.Schema.EnvOverrides,
+		})
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), ref.Name,
+				fmt.Sprintf("Trait schema is invalid: %v", err)))
+			continue
+		}
+
+		allErrs = append(allErrs, validation.ValidateParameters(ref.Parameters, structural, idxPath.Child("parameters"),
+			validation.EnvOverrideFields(trait.Spec.Schema.EnvOverrides)...)...)
+	}
+
+	return allErrs, nil
+}
@@ -6,10 +6,33 @@ package component
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 )
 
+const testNamespace = "test-org"
+
+func rawJSON(s string) *runtime.RawExtension {
+	return &runtime.RawExtension{Raw: []byte(s)}
+}
+
+// causeFields returns the field paths reported in an Invalid API error.
+func causeFields(err error) []string {
+	statusErr, ok := err.(*apierrors.StatusError)
+	Expect(ok).To(BeTrue(), "expected a StatusError but got %T", err)
+	Expect(apierrors.IsInvalid(err)).To(BeTrue())
+
+	var fields []string
+	for _, cause := range statusErr.ErrStatus.Details.Causes {
+		fields = append(fields, cause.Field)
+	}
+	return fields
+}
+
 var _ = Describe("Component Webhook", func() {
 	var (
 		obj       *openchoreodevv1alpha1.Component
@@ -18,56 +41,121 @@ var _ = Describe("Component Webhook", func() {
 		defaulter Defaulter
 	)
 
+	componentType := &openchoreodevv1alpha1.ComponentType{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-service", Namespace: testNamespace},
+		Spec: openchoreodevv1alpha1.ComponentTypeSpec{
+			WorkloadType: "deployment",
+			Schema: openchoreodevv1alpha1.ComponentTypeSchema{
+				Parameters: rawJSON(`{
+					"port": "integer | minimum=1 | maximum=65535",
+					"exposed": "boolean | default=false"
+				}`),
+				EnvOverrides: rawJSON(`{
+					"replicas": "integer",
+					"resources": {"cpu": "string | default=100m"}
+				}`),
+			},
+		},
+	}
+
+	storageTrait := &openchoreodevv1alpha1.Trait{
+		ObjectMeta: metav1.ObjectMeta{Name: "storage", Namespace: testNamespace},
+		Spec: openchoreodevv1alpha1.TraitSpec{
+			Schema: openchoreodevv1alpha1.TraitSchema{
+				Parameters:   rawJSON(`{"mountPath": "string"}`),
+				EnvOverrides: rawJSON(`{"size": "string | default=10Gi"}`),
+			},
+		},
+	}
+
 	BeforeEach(func() {
-		obj = &openchoreodevv1alpha1.Component{}
-		oldObj = &openchoreodevv1alpha1.Component{}
-		validator = Validator{}
+		scheme := runtime.NewScheme()
+		Expect(openchoreodevv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		obj = &openchoreodevv1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: testNamespace},
+			Spec: openchoreodevv1alpha1.ComponentSpec{
+				ComponentType: "deployment/web-service",
+				Parameters:    rawJSON(`{"port": 8080}`),
+				Traits: []openchoreodevv1alpha1.ComponentTrait{
+					{Name: "storage", InstanceName: "data", Parameters: rawJSON(`{"mountPath": "/data"}`)},
+				},
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = Validator{
+			client: fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(componentType.DeepCopy(), storageTrait.DeepCopy()).Build(),
+		}
 		Expect(validator).NotTo(BeNil(), "Expected validator to be initialized")
 		defaulter = Defaulter{}
 		Expect(defaulter).NotTo(BeNil(), "Expected defaulter to be initialized")
 		Expect(oldObj).NotTo(BeNil(), "Expected oldObj to be initialized")
 		Expect(obj).NotTo(BeNil(), "Expected obj to be initialized")
-		// TODO (user): Add any setup logic common to all tests
 	})
 
-	AfterEach(func() {
-		// TODO (user): Add any teardown logic common to all tests
-	})
+	Context("When creating or updating Component under Validating Webhook", func() {
+		It("Should admit a component whose parameters and traits match their schemas", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
 
-	Context("When creating Component under Defaulting Webhook", func() {
-		// TODO (user): Add logic for defaulting webhooks
-		// Example:
-		// It("Should apply defaults when a required field is empty", func() {
-		//     By("simulating a scenario where defaults should be applied")
-		//     obj.SomeFieldWithDefault = ""
-		//     By("calling the Default method to apply defaults")
-		//     defaulter.Default(ctx, obj)
-		//     By("checking that the default values are set")
-		//     Expect(obj.SomeFieldWithDefault).To(Equal("default_value"))
-		// })
-	})
+		It("Should admit a component that does not reference a ComponentType", func() {
+			obj.Spec.ComponentType = ""
+			obj.Spec.Parameters = rawJSON(`{"anything": true}`)
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
 
-	Context("When creating or updating Component under Validating Webhook", func() {
-		// TODO (user): Add logic for validating webhooks
-		// Example:
-		// It("Should deny creation if a required field is missing", func() {
-		//     By("simulating an invalid creation scenario")
-		//     obj.SomeRequiredField = ""
-		//     Expect(validator.ValidateCreate(ctx, obj)).Error().To(HaveOccurred())
-		// })
-		//
-		// It("Should admit creation if all required fields are present", func() {
-		//     By("simulating an invalid creation scenario")
-		//     obj.SomeRequiredField = "valid_value"
-		//     Expect(validator.ValidateCreate(ctx, obj)).To(BeNil())
-		// })
-		//
-		// It("Should validate updates correctly", func() {
-		//     By("simulating a valid update scenario")
-		//     oldObj.SomeRequiredField = "updated_value"
-		//     obj.SomeRequiredField = "updated_value"
-		//     Expect(validator.ValidateUpdate(ctx, oldObj, obj)).To(BeNil())
-		// })
-	})
+		It("Should deny a component whose ComponentType does not exist", func() {
+			obj.Spec.ComponentType = "deployment/missing"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(causeFields(err)).To(ConsistOf("spec.componentType"))
+		})
+
+		It("Should deny a component whose workload type does not match the ComponentType", func() {
+			obj.Spec.ComponentType = "statefulset/web-service"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`does not match ComponentType workload type "deployment"`))
+		})
 
+		It("Should deny parameters that violate the ComponentType schema", func() {
+			obj.Spec.Parameters = rawJSON(`{"port": 70000, "exposed": "yes", "debug": true}`)
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(causeFields(err)).To(ConsistOf(
+				"spec.parameters.port",
+				"spec.parameters.exposed",
+				"spec.parameters.debug",
+			))
+		})
+
+		It("Should deny a missing required parameter but not a missing envOverrides value", func() {
+			obj.Spec.Parameters = nil
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(causeFields(err)).To(ConsistOf("spec.parameters.port"))
+		})
+
+		It("Should deny trait instances with duplicate names or unknown traits", func() {
+			obj.Spec.Traits = append(obj.Spec.Traits,
+				openchoreodevv1alpha1.ComponentTrait{Name: "storage", InstanceName: "data", Parameters: rawJSON(`{"mountPath": "/cache"}`)},
+				openchoreodevv1alpha1.ComponentTrait{Name: "autoscaler", InstanceName: "hpa"},
+			)
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(causeFields(err)).To(ConsistOf("spec.traits[1].instanceName", "spec.traits[2].name"))
+		})
+
+		It("Should deny trait parameters that violate the Trait schema", func() {
+			obj.Spec.Traits[0].Parameters = rawJSON(`{"mountPath": 42, "size": "20Gi", "readOnly": true}`)
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(causeFields(err)).To(ConsistOf(
+				"spec.traits[0].parameters.mountPath",
+				"spec.traits[0].parameters.readOnly",
+			))
+		})
+	})
 })
@@ -7,13 +7,18 @@ import (
 	"context"
 	"fmt"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/template"
+	"github.com/openchoreo/openchoreo/internal/webhook/validation"
 )
 
 // nolint:unused
@@ -23,12 +28,10 @@ var componenttypelog = logf.Log.WithName("componenttype-resource")
 // SetupComponentTypeWebhookWithManager registers the webhook for ComponentType in the manager.
 func SetupComponentTypeWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).For(&openchoreodevv1alpha1.ComponentType{}).
-		WithValidator(&Validator{}).
+		WithValidator(&Validator{engine: template.NewEngine()}).
 		Complete()
 }
 
-// TODO(user): EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
-
 // TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
 // NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
 // Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
@@ -40,7 +43,8 @@ func SetupComponentTypeWebhookWithManager(mgr ctrl.Manager) error {
 // NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
 // as this struct is used only for temporary operations and does not need to be deeply copied.
 type Validator struct {
-	// TODO(user): Add more fields as needed for validation
+	// engine compiles the CEL expressions in resource templates.
+	engine *template.Engine
 }
 
 var _ webhook.CustomValidator = &Validator{}
@@ -53,9 +57,7 @@ func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admis
 	}
 	componenttypelog.Info("Validation for ComponentType upon creation", "name", componenttype.GetName())
 
-	// TODO(user): fill in your validation logic upon object creation.
-
-	return nil, nil
+	return nil, v.validateComponentType(componenttype)
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ComponentType.
@@ -66,9 +68,7 @@ func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Obj
 	}
 	componenttypelog.Info("Validation for ComponentType upon update", "name", componenttype.GetName())
 
-	// TODO(user): fill in your validation logic upon object update.
-
-	return nil, nil
+	return nil, v.validateComponentType(componenttype)
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ComponentType.
@@ -83,3 +83,61 @@ func (v *Validator) ValidateDelete(ctx context.Context, obj runtime.Object) (adm
 
 	return nil, nil
 }
+
+// validateComponentType checks that the schema can be converted and that every CEL expression in the
+// resource templates type-checks against the component context, so authoring mistakes are rejected
+// before any component is rendered.
+func (v *Validator) validateComponentType(componentType *openchoreodevv1alpha1.ComponentType) error {
+	specPath := field.NewPath("spec")
+
+	allErrs := validation.ValidateSchema(validation.SchemaSections{
+		Types:        componentType.Spec.Schema.Types,
+		Parameters:   componentType.Spec.Schema.Parameters,
+		EnvOverrides: componentType.Spec.Schema.EnvOverrides,
+	}, specPath.Child("schema"))
+
+	// Expressions are checked against the parameters schema when it can be built; schema errors are
+	// reported above and the parameters are left untyped
+	parameters, err := pipelinecontext.BuildStructuralSchema(&pipelinecontext.SchemaInput{
+		Types:              componentType.Spec.Schema.Types,
+		ParametersSchema:   componentType.Spec.Schema.Parameters,
+		EnvOverridesSchema: componentType.Spec.Schema.EnvOverrides,
+	})
+	if err != nil {
+		parameters = nil
+	}
+	variables := pipelinecontext.ComponentContextSchemas(parameters)
+
+	for i, resource := range componentType.Spec.Resources {
+		allErrs = append(allErrs, v.validateResourceTemplate(resource, variables, specPath.Child("resources").Index(i))...)
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(openchoreodevv1alpha1.GroupVersion.WithKind("ComponentType").GroupKind(),
+		componentType.Name, allErrs)
+}
+
+// validateResourceTemplate type-checks the includeWhen, forEach and template expressions of a resource.
+// includeWhen and forEach are evaluated against the component context, while the template also sees
+// the forEach loop variable.
+func (v *Validator) validateResourceTemplate(resource openchoreodevv1alpha1.ResourceTemplate, variables template.Variables,
+	fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validation.ValidateExpression(v.engine, resource.IncludeWhen, fldPath.Child("includeWhen"), variables)...)
+	allErrs = append(allErrs, validation.ValidateExpression(v.engine, resource.ForEach, fldPath.Child("forEach"), variables)...)
+
+	templateVariables := variables
+	if resource.ForEach != "" {
+		// The renderer binds each item to "item" unless var is set
+		loopVar := resource.Var
+		if loopVar == "" {
+			loopVar = "item"
+		}
+		templateVariables = validation.WithVariables(variables, loopVar)
+	}
+	allErrs = append(allErrs, validation.ValidateTemplate(v.engine, resource.Template, fldPath.Child("template"), templateVariables)...)
+
+	return allErrs
+}
@@ -6,10 +6,16 @@ package componenttype
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/template"
 )
 
+func rawJSON(s string) *runtime.RawExtension {
+	return &runtime.RawExtension{Raw: []byte(s)}
+}
+
 var _ = Describe("ComponentType Webhook", func() {
 	var (
 		obj       *openchoreodevv1alpha1.ComponentType
@@ -18,40 +24,107 @@ var _ = Describe("ComponentType Webhook", func() {
 	)
 
 	BeforeEach(func() {
-		obj = &openchoreodevv1alpha1.ComponentType{}
-		oldObj = &openchoreodevv1alpha1.ComponentType{}
-		validator = Validator{}
+		obj = &openchoreodevv1alpha1.ComponentType{
+			Spec: openchoreodevv1alpha1.ComponentTypeSpec{
+				WorkloadType: "deployment",
+				Schema: openchoreodevv1alpha1.ComponentTypeSchema{
+					Parameters:   rawJSON(`{"replicas": "integer | default=1"}`),
+					EnvOverrides: rawJSON(`{"cpu": "string | default=100m"}`),
+				},
+				Resources: []openchoreodevv1alpha1.ResourceTemplate{
+					{
+						ID: "deployment",
+						Template: rawJSON(`{
+							"apiVersion": "apps/v1",
+							"kind": "Deployment",
+							"metadata": {"name": "${metadata.name}", "namespace": "${metadata.namespace}"},
+							"spec": {"replicas": "${parameters.replicas}"}
+						}`),
+					},
+				},
+			},
+		}
+		obj.Name = "web-app"
+		oldObj = obj.DeepCopy()
+		validator = Validator{engine: template.NewEngine()}
 		Expect(validator).NotTo(BeNil(), "Expected validator to be initialized")
 		Expect(oldObj).NotTo(BeNil(), "Expected oldObj to be initialized")
 		Expect(obj).NotTo(BeNil(), "Expected obj to be initialized")
-		// TODO (user): Add any setup logic common to all tests
-	})
-
-	AfterEach(func() {
-		// TODO (user): Add any teardown logic common to all tests
 	})
 
 	Context("When creating or updating ComponentType under Validating Webhook", func() {
-		// TODO (user): Add logic for validating webhooks
-		// Example:
-		// It("Should deny creation if a required field is missing", func() {
-		//     By("simulating an invalid creation scenario")
-		//     obj.SomeRequiredField = ""
-		//     Expect(validator.ValidateCreate(ctx, obj)).Error().To(HaveOccurred())
-		// })
-		//
-		// It("Should admit creation if all required fields are present", func() {
-		//     By("simulating an invalid creation scenario")
-		//     obj.SomeRequiredField = "valid_value"
-		//     Expect(validator.ValidateCreate(ctx, obj)).To(BeNil())
-		// })
-		//
-		// It("Should validate updates correctly", func() {
-		//     By("simulating a valid update scenario")
-		//     oldObj.SomeRequiredField = "updated_value"
-		//     obj.SomeRequiredField = "updated_value"
-		//     Expect(validator.ValidateUpdate(ctx, oldObj, obj)).To(BeNil())
-		// })
+		It("Should admit a ComponentType with valid schema and expressions", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should admit forEach templates that use the loop variable", func() {
+			obj.Spec.Resources = append(obj.Spec.Resources, openchoreodevv1alpha1.ResourceTemplate{
+				ID:          "config",
+				IncludeWhen: "${has(configurations.configs)}",
+				ForEach:     "${configurations.configs.envs}",
+				Var:         "env",
+				Template: rawJSON(`{
+					"apiVersion": "v1",
+					"kind": "ConfigMap",
+					"metadata": {"name": "${oc_generate_name(metadata.name, env.name)}"}
+				}`),
+			})
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny a template that references an undeclared variable", func() {
+			obj.Spec.Resources[0].Template = rawJSON(`{"spec": {"replicas": "${spec.replicas}"}}`)
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.resources[0].template"))
+			Expect(err.Error()).To(ContainSubstring("spec.replicas"))
+		})
+
+		It("Should deny a template that references a field missing from the parameters schema", func() {
+			obj.Spec.Resources[0].Template = rawJSON(`{"spec": {"replicas": "${parameters.replicaz}"}}`)
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.resources[0].template"))
+			Expect(err.Error()).To(ContainSubstring("undefined field 'replicaz'"))
+		})
+
+		It("Should deny expressions that use a parameter with the wrong type", func() {
+			obj.Spec.Resources[0].IncludeWhen = `${parameters.cpu + 1.0 > 0.0}`
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.resources[0].includeWhen"))
+			Expect(err.Error()).To(ContainSubstring("no matching overload"))
+		})
+
+		It("Should deny invalid includeWhen and forEach expressions", func() {
+			obj.Spec.Resources[0].IncludeWhen = "${parameters.enabled &&}"
+			obj.Spec.Resources[0].ForEach = "${unknownFn(parameters.items)}"
+			obj.Spec.Resources[0].Var = "item"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.resources[0].includeWhen"))
+			Expect(err.Error()).To(ContainSubstring("spec.resources[0].forEach"))
+		})
+
+		It("Should deny the loop variable outside a forEach template", func() {
+			obj.Spec.Resources[0].Template = rawJSON(`{"metadata": {"name": "${item.name}"}}`)
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(HaveOccurred())
+		})
+
+		It("Should deny an invalid shorthand schema", func() {
+			obj.Spec.Schema.Parameters = rawJSON(`{"replicas": "integr | default=1"}`)
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.schema.parameters"))
+		})
+
+		It("Should deny an envOverrides schema that references an unknown type", func() {
+			obj.Spec.Schema.EnvOverrides = rawJSON(`{"resources": "ResourceRequirements"}`)
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.schema.envOverrides"))
+		})
 	})
 
 })
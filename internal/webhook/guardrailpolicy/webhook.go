@@ -0,0 +1,103 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package guardrailpolicy
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/template"
+	"github.com/openchoreo/openchoreo/internal/webhook/validation"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var guardrailpolicylog = logf.Log.WithName("guardrailpolicy-resource")
+
+// SetupGuardrailPolicyWebhookWithManager registers the webhook for GuardrailPolicy in the manager.
+func SetupGuardrailPolicyWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&openchoreodevv1alpha1.GuardrailPolicy{}).
+		WithValidator(&Validator{engine: template.NewEngine()}).
+		Complete()
+}
+
+// NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
+// Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
+// +kubebuilder:webhook:path=/validate-openchoreo-dev-v1alpha1-guardrailpolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=openchoreo.dev,resources=guardrailpolicies,verbs=create;update,versions=v1alpha1,name=vguardrailpolicy-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// Validator struct is responsible for validating the GuardrailPolicy resource
+// when it is created, updated, or deleted.
+//
+// NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
+// as this struct is used only for temporary operations and does not need to be deeply copied.
+type Validator struct {
+	// engine compiles the CEL expressions of the rules.
+	engine *template.Engine
+}
+
+var _ webhook.CustomValidator = &Validator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type GuardrailPolicy.
+func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	policy, ok := obj.(*openchoreodevv1alpha1.GuardrailPolicy)
+	if !ok {
+		return nil, fmt.Errorf("expected a GuardrailPolicy object but got %T", obj)
+	}
+	guardrailpolicylog.Info("Validation for GuardrailPolicy upon creation", "name", policy.GetName())
+
+	return nil, v.validateGuardrailPolicy(policy)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type GuardrailPolicy.
+func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	policy, ok := newObj.(*openchoreodevv1alpha1.GuardrailPolicy)
+	if !ok {
+		return nil, fmt.Errorf("expected a GuardrailPolicy object for the newObj but got %T", newObj)
+	}
+	guardrailpolicylog.Info("Validation for GuardrailPolicy upon update", "name", policy.GetName())
+
+	return nil, v.validateGuardrailPolicy(policy)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type GuardrailPolicy.
+func (v *Validator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateGuardrailPolicy checks that rule names are unique and that every rule type-checks against
+// the component context with the rendered resource bound to "resource", so a broken rule is rejected
+// before it fails the rendering of every component in the organization.
+func (v *Validator) validateGuardrailPolicy(policy *openchoreodevv1alpha1.GuardrailPolicy) error {
+	rulesPath := field.NewPath("spec", "rules")
+	variables := validation.WithVariables(pipelinecontext.ComponentContextSchemas(nil), "resource")
+
+	var allErrs field.ErrorList
+	names := sets.New[string]()
+	for i, rule := range policy.Spec.Rules {
+		rulePath := rulesPath.Index(i)
+		if names.Has(rule.Name) {
+			allErrs = append(allErrs, field.Duplicate(rulePath.Child("name"), rule.Name))
+		}
+		names.Insert(rule.Name)
+
+		allErrs = append(allErrs, validation.ValidateExpression(v.engine, rule.Rule, rulePath.Child("rule"), variables)...)
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(openchoreodevv1alpha1.GroupVersion.WithKind("GuardrailPolicy").GroupKind(),
+		policy.Name, allErrs)
+}
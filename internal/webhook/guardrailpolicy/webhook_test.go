@@ -0,0 +1,70 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package guardrailpolicy
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+var _ = Describe("GuardrailPolicy Webhook", func() {
+	var (
+		obj       *openchoreodevv1alpha1.GuardrailPolicy
+		oldObj    *openchoreodevv1alpha1.GuardrailPolicy
+		validator Validator
+	)
+
+	BeforeEach(func() {
+		obj = &openchoreodevv1alpha1.GuardrailPolicy{
+			Spec: openchoreodevv1alpha1.GuardrailPolicySpec{
+				Rules: []openchoreodevv1alpha1.GuardrailRule{
+					{
+						Name:   "min-replicas-in-production",
+						Target: &openchoreodevv1alpha1.GuardrailTarget{Group: "apps", Kind: "Deployment"},
+						Rule:   `${environment.name != "production" || (has(resource.spec.replicas) && resource.spec.replicas >= 2)}`,
+					},
+					{
+						Name: "labelled",
+						Rule: `${resource.metadata.labels["openchoreo.dev/project"] == metadata.projectName}`,
+					},
+				},
+			},
+		}
+		obj.Name = "baseline"
+		oldObj = obj.DeepCopy()
+		validator = Validator{engine: template.NewEngine()}
+	})
+
+	Context("When creating or updating GuardrailPolicy under Validating Webhook", func() {
+		It("Should admit a GuardrailPolicy with valid rules", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny a rule that does not compile", func() {
+			obj.Spec.Rules[0].Rule = "${resource.spec.replicas >=}"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.rules[0].rule"))
+		})
+
+		It("Should deny references to fields missing from the component context", func() {
+			obj.Spec.Rules[1].Rule = `${resource.metadata.name.startsWith(environment.region)}`
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.rules[1].rule"))
+			Expect(err.Error()).To(ContainSubstring("undefined field 'region'"))
+		})
+
+		It("Should deny duplicate rule names", func() {
+			obj.Spec.Rules[1].Name = obj.Spec.Rules[0].Name
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.rules[1].name"))
+		})
+	})
+})
@@ -6,14 +6,20 @@ package trait
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/internal/template"
+	"github.com/openchoreo/openchoreo/internal/webhook/validation"
 )
 
 // nolint:unused
@@ -23,12 +29,10 @@ var traitlog = logf.Log.WithName("trait-resource")
 // SetupTraitWebhookWithManager registers the webhook for Trait in the manager.
 func SetupTraitWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).For(&openchoreodevv1alpha1.Trait{}).
-		WithValidator(&Validator{}).
+		WithValidator(&Validator{engine: template.NewEngine()}).
 		Complete()
 }
 
-// TODO(user): EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
-
 // TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
 // NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
 // Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
@@ -40,7 +44,8 @@ func SetupTraitWebhookWithManager(mgr ctrl.Manager) error {
 // NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
 // as this struct is used only for temporary operations and does not need to be deeply copied.
 type Validator struct {
-	// TODO(user): Add more fields as needed for validation
+	// engine compiles the CEL expressions in create templates and patches.
+	engine *template.Engine
 }
 
 var _ webhook.CustomValidator = &Validator{}
@@ -53,9 +58,7 @@ func (v *Validator) ValidateCreate(_ context.Context, obj runtime.Object) (admis
 	}
 	traitlog.Info("Validation for Trait upon creation", "name", trait.GetName())
 
-	// TODO(user): fill in your validation logic upon object creation.
-
-	return nil, nil
+	return nil, v.validateTrait(trait)
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Trait.
@@ -66,9 +69,7 @@ func (v *Validator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Obj
 	}
 	traitlog.Info("Validation for Trait upon update", "name", trait.GetName())
 
-	// TODO(user): fill in your validation logic upon object update.
-
-	return nil, nil
+	return nil, v.validateTrait(trait)
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Trait.
@@ -83,3 +84,92 @@ func (v *Validator) ValidateDelete(ctx context.Context, obj runtime.Object) (adm
 
 	return nil, nil
 }
+
+// supportedPatchOperations are the patch operations understood by the trait processor.
+var supportedPatchOperations = []string{"add", "replace", "remove", "mergeShallow"}
+
+// validateTrait checks that the schema can be converted, that every CEL expression in creates and
+// patches type-checks against the trait context, and that patch operations are supported, so a
+// broken trait is rejected before it is attached to any component.
+func (v *Validator) validateTrait(trait *openchoreodevv1alpha1.Trait) error {
+	specPath := field.NewPath("spec")
+
+	allErrs := validation.ValidateSchema(validation.SchemaSections{
+		Types:        trait.Spec.Schema.Types,
+		Parameters:   trait.Spec.Schema.Parameters,
+		EnvOverrides: trait.Spec.Schema.EnvOverrides,
+	}, specPath.Child("schema"))
+
+	// Expressions are checked against the parameters schema when it can be built; schema errors are
+	// reported above and the parameters are left untyped
+	parameters, err := pipelinecontext.BuildStructuralSchema(&pipelinecontext.SchemaInput{
+		Types:              trait.Spec.Schema.Types,
+		ParametersSchema:   trait.Spec.Schema.Parameters,
+		EnvOverridesSchema: trait.Spec.Schema.EnvOverrides,
+	})
+	if err != nil {
+		parameters = nil
+	}
+	variables := pipelinecontext.TraitContextSchemas(parameters)
+
+	for i, create := range trait.Spec.Creates {
+		allErrs = append(allErrs, validation.ValidateTemplate(v.engine, create.Template,
+			specPath.Child("creates").Index(i).Child("template"), variables)...)
+	}
+
+	for i, patch := range trait.Spec.Patches {
+		allErrs = append(allErrs, v.validatePatch(patch, variables, specPath.Child("patches").Index(i))...)
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(openchoreodevv1alpha1.GroupVersion.WithKind("Trait").GroupKind(),
+		trait.Name, allErrs)
+}
+
+// validatePatch validates a single trait patch. forEach is evaluated against the trait context;
+// the where clause additionally sees the candidate "resource", and operations see the forEach
+// loop variable.
+func (v *Validator) validatePatch(patch openchoreodevv1alpha1.TraitPatch, variables template.Variables,
+	fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validation.ValidateExpression(v.engine, patch.ForEach, fldPath.Child("forEach"), variables)...)
+
+	if patch.ForEach != "" {
+		// The processor binds each item to "item" unless var is set
+		loopVar := patch.Var
+		if loopVar == "" {
+			loopVar = "item"
+		}
+		variables = validation.WithVariables(variables, loopVar)
+	}
+
+	allErrs = append(allErrs, validation.ValidateExpression(v.engine, patch.Target.Where,
+		fldPath.Child("target", "where"), validation.WithVariables(variables, "resource"))...)
+
+	for i, op := range patch.Operations {
+		opPath := fldPath.Child("operations").Index(i)
+
+		if !isSupportedPatchOperation(op.Op) {
+			allErrs = append(allErrs, field.NotSupported(opPath.Child("op"), op.Op, supportedPatchOperations))
+		}
+
+		allErrs = append(allErrs, validation.ValidateExpression(v.engine, op.Path, opPath.Child("path"), variables)...)
+		// Operations are matched case-insensitively, as when they are applied
+		if !strings.EqualFold(op.Op, "remove") {
+			allErrs = append(allErrs, validation.ValidateTemplate(v.engine, op.Value, opPath.Child("value"), variables)...)
+		}
+	}
+
+	return allErrs
+}
+
+func isSupportedPatchOperation(op string) bool {
+	for _, supported := range supportedPatchOperations {
+		if strings.EqualFold(op, supported) {
+			return true
+		}
+	}
+	return false
+}
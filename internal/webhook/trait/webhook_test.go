@@ -6,10 +6,16 @@ package trait
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/template"
 )
 
+func rawJSON(s string) *runtime.RawExtension {
+	return &runtime.RawExtension{Raw: []byte(s)}
+}
+
 var _ = Describe("Trait Webhook", func() {
 	var (
 		obj       *openchoreodevv1alpha1.Trait
@@ -18,40 +24,127 @@ var _ = Describe("Trait Webhook", func() {
 	)
 
 	BeforeEach(func() {
-		obj = &openchoreodevv1alpha1.Trait{}
-		oldObj = &openchoreodevv1alpha1.Trait{}
-		validator = Validator{}
+		obj = &openchoreodevv1alpha1.Trait{
+			Spec: openchoreodevv1alpha1.TraitSpec{
+				Schema: openchoreodevv1alpha1.TraitSchema{
+					Parameters: rawJSON(`{
+						"volumeName": "string",
+						"mountPath": "string",
+						"mounts": "array<string> | default=[]"
+					}`),
+					EnvOverrides: rawJSON(`{"size": "string | default=10Gi"}`),
+				},
+				Creates: []openchoreodevv1alpha1.TraitCreate{
+					{
+						Template: rawJSON(`{
+							"apiVersion": "v1",
+							"kind": "PersistentVolumeClaim",
+							"metadata": {"name": "${metadata.name}-${trait.instanceName}"},
+							"spec": {"resources": {"requests": {"storage": "${parameters.size}"}}}
+						}`),
+					},
+				},
+				Patches: []openchoreodevv1alpha1.TraitPatch{
+					{
+						ForEach: "${parameters.mounts}",
+						Var:     "mount",
+						Target: openchoreodevv1alpha1.PatchTarget{
+							Group:   "apps",
+							Version: "v1",
+							Kind:    "Deployment",
+							Where:   "${resource.metadata.name == metadata.name}",
+						},
+						Operations: []openchoreodevv1alpha1.JSONPatchOperation{
+							{
+								Op:    "add",
+								Path:  "/spec/template/spec/containers/0/volumeMounts/-",
+								Value: rawJSON(`{"name": "${parameters.volumeName}", "mountPath": "${mount}"}`),
+							},
+							{
+								Op:   "remove",
+								Path: "/spec/template/spec/containers/0/args",
+							},
+						},
+					},
+				},
+			},
+		}
+		obj.Name = "persistent-volume"
+		oldObj = obj.DeepCopy()
+		validator = Validator{engine: template.NewEngine()}
 		Expect(validator).NotTo(BeNil(), "Expected validator to be initialized")
 		Expect(oldObj).NotTo(BeNil(), "Expected oldObj to be initialized")
 		Expect(obj).NotTo(BeNil(), "Expected obj to be initialized")
-		// TODO (user): Add any setup logic common to all tests
-	})
-
-	AfterEach(func() {
-		// TODO (user): Add any teardown logic common to all tests
 	})
 
 	Context("When creating or updating Trait under Validating Webhook", func() {
-		// TODO (user): Add logic for validating webhooks
-		// Example:
-		// It("Should deny creation if a required field is missing", func() {
-		//     By("simulating an invalid creation scenario")
-		//     obj.SomeRequiredField = ""
-		//     Expect(validator.ValidateCreate(ctx, obj)).Error().To(HaveOccurred())
-		// })
-		//
-		// It("Should admit creation if all required fields are present", func() {
-		//     By("simulating an invalid creation scenario")
-		//     obj.SomeRequiredField = "valid_value"
-		//     Expect(validator.ValidateCreate(ctx, obj)).To(BeNil())
-		// })
-		//
-		// It("Should validate updates correctly", func() {
-		//     By("simulating a valid update scenario")
-		//     oldObj.SomeRequiredField = "updated_value"
-		//     obj.SomeRequiredField = "updated_value"
-		//     Expect(validator.ValidateUpdate(ctx, oldObj, obj)).To(BeNil())
-		// })
+		It("Should admit a Trait with valid schema, creates and patches", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny an unknown patch operation", func() {
+			obj.Spec.Patches[0].Operations[0].Op = "append"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.patches[0].operations[0].op"))
+		})
+
+		It("Should treat operations case-insensitively", func() {
+			// The value of a remove operation is ignored when the patch is applied, whatever the case of the op
+			obj.Spec.Patches[0].Operations[1].Op = "Remove"
+			obj.Spec.Patches[0].Operations[1].Value = rawJSON(`{"name": "${parameters.volume}"}`)
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+
+			obj.Spec.Patches[0].Operations[0].Op = "ADD"
+			obj.Spec.Patches[0].Operations[0].Value = rawJSON(`{"name": "${parameters.volume}"}`)
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.patches[0].operations[0].value"))
+		})
+
+		It("Should deny a broken where clause", func() {
+			obj.Spec.Patches[0].Target.Where = "${resource.metadata.name ==}"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.patches[0].target.where"))
+		})
+
+		It("Should deny operation values that use an undeclared loop variable", func() {
+			obj.Spec.Patches[0].Operations[0].Value = rawJSON(`{"mountPath": "${mnt}"}`)
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.patches[0].operations[0].value"))
+		})
+
+		It("Should deny the loop variable when the patch has no forEach", func() {
+			obj.Spec.Patches[0].ForEach = ""
+			obj.Spec.Patches[0].Var = ""
+			Expect(validator.ValidateCreate(ctx, obj)).Error().To(HaveOccurred())
+		})
+
+		It("Should deny invalid expressions in create templates", func() {
+			obj.Spec.Creates[0].Template = rawJSON(`{"metadata": {"name": "${trait.instanceName + }"}}`)
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.creates[0].template"))
+		})
+
+		It("Should deny references to fields missing from the trait context", func() {
+			obj.Spec.Creates[0].Template = rawJSON(`{"metadata": {"name": "${trait.instance}"}}`)
+			obj.Spec.Patches[0].Operations[0].Value = rawJSON(`{"name": "${parameters.volume}"}`)
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("undefined field 'instance'"))
+			Expect(err.Error()).To(ContainSubstring("undefined field 'volume'"))
+		})
+
+		It("Should deny an invalid shorthand schema", func() {
+			obj.Spec.Schema.Parameters = rawJSON(`{"mountPath": "string | minLength=abc"}`)
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.schema.parameters"))
+		})
 	})
 
 })
@@ -0,0 +1,165 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package validation contains admission checks shared by the ComponentType, Trait and Component webhooks.
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	apiextschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apimachinery/pkg/runtime"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/schema"
+	"github.com/openchoreo/openchoreo/internal/schema/extractor"
+	"github.com/openchoreo/openchoreo/internal/template"
+)
+
+// SchemaSections holds the raw shorthand schema sections of a ComponentType or Trait.
+type SchemaSections struct {
+	Types        *runtime.RawExtension
+	Parameters   *runtime.RawExtension
+	EnvOverrides *runtime.RawExtension
+}
+
+// ValidateSchema checks that the parameters and envOverrides sections can be converted from the
+// shorthand syntax into an OpenAPI schema using the declared custom types.
+func ValidateSchema(sections SchemaSections, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	var types map[string]any
+	if sections.Types != nil && len(sections.Types.Raw) > 0 {
+		if err := yaml.Unmarshal(sections.Types.Raw, &types); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("types"), string(sections.Types.Raw),
+				fmt.Sprintf("failed to parse types: %v", err)))
+			return allErrs
+		}
+	}
+
+	allErrs = append(allErrs, validateSchemaSection(sections.Parameters, types, fldPath.Child("parameters"))...)
+	allErrs = append(allErrs, validateSchemaSection(sections.EnvOverrides, types, fldPath.Child("envOverrides"))...)
+	return allErrs
+}
+
+func validateSchemaSection(raw *runtime.RawExtension, types map[string]any, fldPath *field.Path) field.ErrorList {
+	if raw == nil || len(raw.Raw) == 0 {
+		return nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(raw.Raw, &fields); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, string(raw.Raw), fmt.Sprintf("must be an object: %v", err))}
+	}
+
+	if _, err := extractor.ExtractSchema(fields, types); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, string(raw.Raw), err.Error())}
+	}
+	return nil
+}
+
+// ValidateTemplate type-checks every ${...} expression in a resource template against the schemas of
+// the given variables.
+func ValidateTemplate(engine *template.Engine, raw *runtime.RawExtension, fldPath *field.Path, variables template.Variables) field.ErrorList {
+	if raw == nil || len(raw.Raw) == 0 {
+		return nil
+	}
+
+	var data any
+	if err := json.Unmarshal(raw.Raw, &data); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, string(raw.Raw), fmt.Sprintf("failed to parse template: %v", err))}
+	}
+
+	return toFieldErrors(engine.Check(data, variables), fldPath)
+}
+
+// ValidateExpression type-checks the ${...} expressions contained in a single string field.
+// Empty strings are considered valid.
+func ValidateExpression(engine *template.Engine, expr string, fldPath *field.Path, variables template.Variables) field.ErrorList {
+	if expr == "" {
+		return nil
+	}
+	return toFieldErrors(engine.Check(expr, variables), fldPath)
+}
+
+// WithVariables returns a copy of variables that additionally declares the given names with a
+// dynamic type, such as forEach loop variables.
+func WithVariables(variables template.Variables, names ...string) template.Variables {
+	result := make(template.Variables, len(variables)+len(names))
+	for name, s := range variables {
+		result[name] = s
+	}
+	for _, name := range names {
+		result[name] = nil
+	}
+	return result
+}
+
+func toFieldErrors(errs []*template.ExpressionError, fldPath *field.Path) field.ErrorList {
+	allErrs := make(field.ErrorList, 0, len(errs))
+	for _, err := range errs {
+		detail := err.Err.Error()
+		if err.Path != "" {
+			detail = fmt.Sprintf("at %s: %s", err.Path, detail)
+		}
+		allErrs = append(allErrs, field.Invalid(fldPath, err.Expression, detail))
+	}
+	return allErrs
+}
+
+// ValidateParameters validates the parameter values of a Component or trait instance against the
+// structural schema of its ComponentType or Trait. Schema defaults are applied before validation.
+//
+// Missing values for the given deferredFields are not reported, since these top-level fields come
+// from the envOverrides section and may be supplied per environment by a ReleaseBinding instead.
+func ValidateParameters(raw *runtime.RawExtension, structural *apiextschema.Structural, fldPath *field.Path,
+	deferredFields ...string) field.ErrorList {
+	values := map[string]any{}
+	if raw != nil && len(raw.Raw) > 0 {
+		// Decode integers as int64 the same way the API server does for custom resources
+		if err := utiljson.Unmarshal(raw.Raw, &values); err != nil {
+			return field.ErrorList{field.Invalid(fldPath, string(raw.Raw), fmt.Sprintf("must be an object: %v", err))}
+		}
+	}
+	values = schema.ApplyDefaults(values, structural)
+
+	var allErrs field.ErrorList
+	for _, err := range schema.Validate(values, structural, fldPath) {
+		if err.Type == field.ErrorTypeRequired && isUnder(err.Field, fldPath, deferredFields) {
+			continue
+		}
+		allErrs = append(allErrs, err)
+	}
+	return allErrs
+}
+
+// EnvOverrideFields returns the top-level field names declared in an envOverrides schema section.
+func EnvOverrideFields(raw *runtime.RawExtension) []string {
+	if raw == nil || len(raw.Raw) == 0 {
+		return nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(raw.Raw, &fields); err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	return names
+}
+
+// isUnder reports whether the error path points at (or into) one of the named children of fldPath.
+func isUnder(errPath string, fldPath *field.Path, children []string) bool {
+	for _, child := range children {
+		prefix := fldPath.Child(child).String()
+		if errPath == prefix || strings.HasPrefix(errPath, prefix+".") || strings.HasPrefix(errPath, prefix+"[") {
+			return true
+		}
+	}
+	return false
+}
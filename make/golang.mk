@@ -15,7 +15,8 @@ GO_BUILD_BINARIES := \
 	manager:$(PROJECT_DIR)/cmd/main.go \
 	choreoctl:$(PROJECT_DIR)/cmd/choreoctl/main.go \
 	openchoreo-api:$(PROJECT_DIR)/cmd/openchoreo-api/main.go \
-	observer:$(PROJECT_DIR)/cmd/observer/main.go
+	observer:$(PROJECT_DIR)/cmd/observer/main.go \
+	dataplane-agent:$(PROJECT_DIR)/cmd/dataplane-agent/main.go
 
 GO_BUILD_BINARY_NAMES := $(foreach b,$(GO_BUILD_BINARIES),$(word 1,$(subst :, ,$(b))))
 
@@ -60,7 +60,7 @@ func NewListCmd(impl api.CommandImplementationInterface) *cobra.Command {
 	// Component command
 	componentCmd := (&builder.CommandBuilder{
 		Command: constants.ListComponent,
-		Flags:   []flags.Flag{flags.Organization, flags.Project, flags.Output, flags.Interactive},
+		Flags:   []flags.Flag{flags.Organization, flags.Project, flags.Output, flags.Interactive, flags.Watch},
 		RunE: func(fg *builder.FlagGetter) error {
 			name := ""
 			if len(fg.GetArgs()) > 0 {
@@ -71,6 +71,7 @@ func NewListCmd(impl api.CommandImplementationInterface) *cobra.Command {
 				Project:      fg.GetString(flags.Project),
 				OutputFormat: fg.GetString(flags.Output),
 				Interactive:  fg.GetBool(flags.Interactive),
+				Watch:        fg.GetBool(flags.Watch),
 				Name:         name,
 			})
 		},
@@ -81,7 +82,8 @@ func NewListCmd(impl api.CommandImplementationInterface) *cobra.Command {
 	// Build command
 	buildCmd := (&builder.CommandBuilder{
 		Command: constants.ListBuild,
-		Flags:   []flags.Flag{flags.Organization, flags.Project, flags.Component, flags.Output, flags.Interactive},
+		Flags: []flags.Flag{flags.Organization, flags.Project, flags.Component, flags.Output, flags.Interactive,
+			flags.Watch},
 		RunE: func(fg *builder.FlagGetter) error {
 			name := ""
 			if len(fg.GetArgs()) > 0 {
@@ -93,6 +95,7 @@ func NewListCmd(impl api.CommandImplementationInterface) *cobra.Command {
 				Component:    fg.GetString(flags.Component),
 				OutputFormat: fg.GetString(flags.Output),
 				Interactive:  fg.GetBool(flags.Interactive),
+				Watch:        fg.GetBool(flags.Watch),
 				Name:         name,
 			})
 		},
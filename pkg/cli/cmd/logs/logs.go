@@ -28,6 +28,7 @@ func NewLogsCmd(impl api.CommandImplementationInterface) *cobra.Command {
 			flags.Environment,
 			flags.Deployment,
 			flags.DeploymentTrack,
+			flags.ObserverURL,
 		},
 		RunE: func(fg *builder.FlagGetter) error {
 			return impl.GetLogs(api.LogParams{
@@ -42,6 +43,7 @@ func NewLogsCmd(impl api.CommandImplementationInterface) *cobra.Command {
 				Environment:     fg.GetString(flags.Environment),
 				Deployment:      fg.GetString(flags.Deployment),
 				DeploymentTrack: fg.GetString(flags.DeploymentTrack),
+				ObserverURL:     fg.GetString(flags.ObserverURL),
 			})
 		},
 	}).Build()
@@ -0,0 +1,101 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/pkg/cli/common/builder"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/flags"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// NewPromotionCmd creates the command for requesting and reviewing promotions that require approval
+func NewPromotionCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	promotionCmd := &cobra.Command{
+		Use:     constants.Promotion.Use,
+		Aliases: constants.Promotion.Aliases,
+		Short:   constants.Promotion.Short,
+		Long:    constants.Promotion.Long,
+	}
+
+	requestCmd := (&builder.CommandBuilder{
+		Command: constants.RequestPromotion,
+		Flags: []flags.Flag{
+			flags.Organization,
+			flags.Project,
+			flags.Component,
+			flags.SourceEnvironment,
+			flags.TargetEnvironment,
+			flags.PromotionReason,
+		},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.RequestPromotion(api.RequestPromotionParams{
+				Organization:      fg.GetString(flags.Organization),
+				Project:           fg.GetString(flags.Project),
+				Component:         fg.GetString(flags.Component),
+				SourceEnvironment: fg.GetString(flags.SourceEnvironment),
+				TargetEnvironment: fg.GetString(flags.TargetEnvironment),
+				Reason:            fg.GetString(flags.PromotionReason),
+			})
+		},
+	}).Build()
+	promotionCmd.AddCommand(requestCmd)
+
+	listCmd := (&builder.CommandBuilder{
+		Command: constants.ListPromotionRequests,
+		Flags: []flags.Flag{
+			flags.Organization, flags.Project, flags.Component, flags.Output,
+			flags.Status, flags.TargetEnvironment, flags.Selector, flags.SortBy, flags.SortOrder, flags.Limit,
+		},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.ListPromotionRequests(api.ListPromotionRequestsParams{
+				Organization:      fg.GetString(flags.Organization),
+				Project:           fg.GetString(flags.Project),
+				Component:         fg.GetString(flags.Component),
+				OutputFormat:      fg.GetString(flags.Output),
+				Status:            fg.GetString(flags.Status),
+				TargetEnvironment: fg.GetString(flags.TargetEnvironment),
+				LabelSelector:     fg.GetString(flags.Selector),
+				SortBy:            fg.GetString(flags.SortBy),
+				Order:             fg.GetString(flags.SortOrder),
+				Limit:             fg.GetInt(flags.Limit),
+			})
+		},
+	}).Build()
+	promotionCmd.AddCommand(listCmd)
+
+	approveCmd := (&builder.CommandBuilder{
+		Command: constants.ApprovePromotionRequest,
+		Flags:   []flags.Flag{flags.Organization, flags.Project, flags.Component, flags.ReviewComment},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.ApprovePromotionRequest(reviewParams(fg))
+		},
+	}).Build()
+	approveCmd.Args = cobra.ExactArgs(1)
+	promotionCmd.AddCommand(approveCmd)
+
+	rejectCmd := (&builder.CommandBuilder{
+		Command: constants.RejectPromotionRequest,
+		Flags:   []flags.Flag{flags.Organization, flags.Project, flags.Component, flags.ReviewComment},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.RejectPromotionRequest(reviewParams(fg))
+		},
+	}).Build()
+	rejectCmd.Args = cobra.ExactArgs(1)
+	promotionCmd.AddCommand(rejectCmd)
+
+	return promotionCmd
+}
+
+func reviewParams(fg *builder.FlagGetter) api.ReviewPromotionRequestParams {
+	return api.ReviewPromotionRequestParams{
+		Organization: fg.GetString(flags.Organization),
+		Project:      fg.GetString(flags.Project),
+		Component:    fg.GetString(flags.Component),
+		Name:         fg.GetArgs()[0],
+		Comment:      fg.GetString(flags.ReviewComment),
+	}
+}
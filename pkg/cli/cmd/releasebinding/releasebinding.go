@@ -0,0 +1,57 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/pkg/cli/common/builder"
+	"github.com/openchoreo/openchoreo/pkg/cli/common/constants"
+	"github.com/openchoreo/openchoreo/pkg/cli/flags"
+	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
+)
+
+// NewReleaseBindingCmd creates the command for inspecting the release history of release bindings and rolling them back
+func NewReleaseBindingCmd(impl api.CommandImplementationInterface) *cobra.Command {
+	releaseBindingCmd := &cobra.Command{
+		Use:     constants.ReleaseBinding.Use,
+		Aliases: constants.ReleaseBinding.Aliases,
+		Short:   constants.ReleaseBinding.Short,
+		Long:    constants.ReleaseBinding.Long,
+	}
+
+	historyCmd := (&builder.CommandBuilder{
+		Command: constants.ReleaseBindingHistory,
+		Flags:   []flags.Flag{flags.Organization, flags.Project, flags.Component, flags.Output},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.GetReleaseBindingHistory(api.ReleaseBindingHistoryParams{
+				Organization: fg.GetString(flags.Organization),
+				Project:      fg.GetString(flags.Project),
+				Component:    fg.GetString(flags.Component),
+				Name:         fg.GetArgs()[0],
+				OutputFormat: fg.GetString(flags.Output),
+			})
+		},
+	}).Build()
+	historyCmd.Args = cobra.ExactArgs(1)
+	releaseBindingCmd.AddCommand(historyCmd)
+
+	rollbackCmd := (&builder.CommandBuilder{
+		Command: constants.RollbackReleaseBinding,
+		Flags:   []flags.Flag{flags.Organization, flags.Project, flags.Component, flags.RollbackRelease},
+		RunE: func(fg *builder.FlagGetter) error {
+			return impl.RollbackReleaseBinding(api.RollbackReleaseBindingParams{
+				Organization: fg.GetString(flags.Organization),
+				Project:      fg.GetString(flags.Project),
+				Component:    fg.GetString(flags.Component),
+				Name:         fg.GetArgs()[0],
+				Release:      fg.GetString(flags.RollbackRelease),
+			})
+		},
+	}).Build()
+	rollbackCmd.Args = cobra.ExactArgs(1)
+	releaseBindingCmd.AddCommand(rollbackCmd)
+
+	return releaseBindingCmd
+}
@@ -217,7 +217,10 @@ Examples:
   %[1]s get component -o yaml --organization acme-corp --project online-store
 
   # Output specific component in YAML format
-  %[1]s get component product-catalog -o yaml --organization acme-corp --project online-store`,
+  %[1]s get component product-catalog -o yaml --organization acme-corp --project online-store
+
+  # Watch the components of a project for changes
+  %[1]s get component --watch --organization acme-corp --project online-store`,
 			messages.DefaultCLIName),
 	}
 
@@ -225,11 +228,12 @@ Examples:
 		Use:     "logs",
 		Aliases: []string{"log"},
 		Short:   "Get logs for Choreo resources",
-		Long: `Get logs for Choreo resources such as build and deployment.
+		Long: `Get logs for Choreo resources such as build, deployment and component.
 
 This command allows you to:
 - Stream logs in real-time
 - Get logs from a specific build or deployment
+- Get the runtime logs of a component collected by the observer
 - Follow log output`,
 		Example: `  # Get logs from a specific build
   choreoctl logs --type build --build product-catalog-build-01 --organization acme-corp --project online-store \
@@ -246,6 +250,10 @@ This command allows you to:
   # Stream logs from a specific build
   choreoctl logs --type build --build product-catalog-build-01 --organization acme-corp --project online-store \
    --component product-catalog --follow
+
+  # Follow the runtime logs of a component in an environment through the observer
+  choreoctl logs --type component --organization acme-corp --project online-store --component product-catalog \
+  --environment development --follow
   `,
 	}
 
@@ -290,6 +298,9 @@ This command creates a new build for a component. You can:
 
   # List builds in yaml format
   choreoctl get build -o yaml
+
+  # Watch the builds of a component for changes
+  choreoctl get build --watch --organization acme-corp --project online-store --component product-catalog
 `,
 	}
 	ListDeployableArtifact = Command{
@@ -630,6 +641,102 @@ If no organization is specified, you will be prompted to select one interactivel
 	// ------------------------------------------------------------------------
 
 	// Delete command definitions
+	Render = Command{
+		Use:   "render",
+		Short: "Render the Kubernetes resources of a component locally",
+		Long: `Render the Kubernetes resources a Component produces for an environment without contacting the
+control plane.
+
+The command loads Project, Component, ComponentType, Trait, Workload, Environment, DataPlane,
+ReleaseBinding and SecretReference resources from the given files and runs them through the component rendering pipeline.
+Rendering warnings are written to stderr so the rendered resources can be piped to other tools.`,
+		Example: `  # Render all resources in a directory for the development environment
+  choreoctl render -f ./manifests --environment development
+
+  # Render a specific component as JSON
+  choreoctl render -f ./manifests --component product-catalog --environment production -o json`,
+	}
+
+	Promotion = Command{
+		Use:     "promotion",
+		Aliases: []string{"promotions"},
+		Short:   "Request and review promotions to environments that require approval",
+		Long: `Request and review promotions of a component to environments that require approval.
+
+Promotions to a target environment marked with requiresApproval in the deployment pipeline are not
+applied directly. A promotion request captures the release bound to the source environment and the
+requester, and the release is bound to the target environment once another user approves it.`,
+	}
+
+	RequestPromotion = Command{
+		Use:   "request",
+		Short: "Request the promotion of a component",
+		Example: `  # Request the promotion of the release running in staging to production
+  choreoctl promotion request --organization acme-corp --project online-store --component product-catalog \
+    --source-env staging --target-env production --reason "Release 1.4.0 verified in staging"`,
+	}
+
+	ListPromotionRequests = Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the promotion requests of a component",
+		Example: `  # List the promotion requests of a component
+  choreoctl promotion list --organization acme-corp --project online-store --component product-catalog
+
+  # List the five most recent pending promotion requests to production
+  choreoctl promotion list --component product-catalog --status Pending --target-env production --limit 5
+
+  # Output the promotion requests in YAML format
+  choreoctl promotion list --component product-catalog -o yaml`,
+	}
+
+	ApprovePromotionRequest = Command{
+		Use:   "approve NAME",
+		Short: "Approve a promotion request and promote the requested release",
+		Example: `  # Approve a promotion request
+  choreoctl promotion approve product-catalog-production-x7k2p --component product-catalog --comment "CHG-1234"`,
+	}
+
+	RejectPromotionRequest = Command{
+		Use:   "reject NAME",
+		Short: "Reject a promotion request",
+		Example: `  # Reject a promotion request
+  choreoctl promotion reject product-catalog-production-x7k2p --component product-catalog --comment "Failing smoke tests"`,
+	}
+
+	ReleaseBinding = Command{
+		Use:     "release-binding",
+		Aliases: []string{"release-bindings", "rb"},
+		Short:   "Inspect the release history of release bindings and roll them back",
+		Long: `Inspect the release history of a release binding and roll it back to an earlier component release.
+
+A release binding binds a component release to an environment. Every release bound to it is recorded in
+its history together with the time it was bound and the user who bound it. Rolling back binds an earlier
+release from the history again, which is recorded in the history like any other change.`,
+	}
+
+	ReleaseBindingHistory = Command{
+		Use:   "history NAME",
+		Short: "Show the release history of a release binding",
+		Example: `  # Show the releases bound to the production environment
+  choreoctl release-binding history product-catalog-production --organization acme-corp --project online-store \
+    --component product-catalog
+
+  # Output the history in YAML format
+  choreoctl release-binding history product-catalog-production --component product-catalog -o yaml`,
+	}
+
+	RollbackReleaseBinding = Command{
+		Use:   "rollback NAME",
+		Short: "Roll a release binding back to an earlier release",
+		Example: `  # Roll back to the release bound before the current one
+  choreoctl release-binding rollback product-catalog-production --component product-catalog
+
+  # Roll back to a specific release from the history
+  choreoctl release-binding rollback product-catalog-production --component product-catalog \
+    --release product-catalog-7d9f8c6b5`,
+	}
+
 	Delete = Command{
 		Use:   "delete",
 		Short: "Delete OpenChoreo resources by file names",
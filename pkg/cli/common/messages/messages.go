@@ -42,11 +42,13 @@ const (
 	FlagDisplayDesc            = "Display name for the component (e.g., \"Product Catalog\")"
 	FlagDescriptionDesc        = "Brief description of the organization's purpose"
 	FlagTypeDesc               = "Type of the component [WebApplication|ScheduledTask|Service]"
-	FlagLogTypeDesc            = "Type of the log [deployment, build]"
+	FlagLogTypeDesc            = "Type of the log [deployment, build, component]"
 	FlagBuildDesc              = "Name of the build (e.g., product-catalog-build-01)"
 	FlagCompDesc               = "Name of the component (e.g., product-catalog)"
 	FlagTailDesc               = "Number of lines to show from the end of logs"
 	FlagFollowDesc             = "Follow the logs of the specified resource"
+	FlagWatchDesc              = "Watch for changes after listing, using the OpenChoreo API server"
+	FlagObserverURLDesc        = "URL of the observer API, overriding the observer of the environment's data plane"
 	FlagBuildTypeDesc          = "Type of the build [docker|buildpack]"
 	FlagDockerContext          = "Path to the Docker build context directory"
 	FlagDockerfilePath         = "Path to the Dockerfile"
@@ -66,4 +68,16 @@ const (
 	FlagWaitDesc               = "Wait for resources to be deleted before returning"
 	FlagEnvironmentOrderDesc   = "Comma-separated list of environment names in promotion order (e.g., dev,staging,prod)"
 	FlagDeploymentPipelineDesc = "Name of the deployment pipeline (e.g., dev-prod-pipeline)"
+	RenderFileFlag             = "Path to a file or directory containing the resources to render (e.g., manifests/)"
+	FlagRenderOutputDesc       = "Output format of the rendered resources [yaml|json]"
+	FlagSourceEnvDesc          = "Environment to promote the component from (e.g., staging)"
+	FlagTargetEnvDesc          = "Environment to promote the component to (e.g., production)"
+	FlagPromotionReasonDesc    = "Justification for the promotion, recorded on the promotion request"
+	FlagReviewCommentDesc      = "Review comment, recorded on the promotion request"
+	FlagRollbackReleaseDesc    = "Component release from the binding history to roll back to (defaults to the previous release)"
+	FlagLimitDesc              = "Maximum number of items to list (lists all items when not set)"
+	FlagSelectorDesc           = "Label selector to filter the listed items by (e.g., team=payments,tier!=frontend)"
+	FlagStatusDesc             = "Status to filter the listed items by"
+	FlagSortByDesc             = "Field to sort the listed items by [name|createdAt]"
+	FlagSortOrderDesc          = "Order to sort the listed items in [asc|desc]"
 )
@@ -10,6 +10,9 @@ import (
 	configContext "github.com/openchoreo/openchoreo/pkg/cli/cmd/config"
 	"github.com/openchoreo/openchoreo/pkg/cli/cmd/create"
 	"github.com/openchoreo/openchoreo/pkg/cli/cmd/delete"
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/promotion"
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/releasebinding"
+	"github.com/openchoreo/openchoreo/pkg/cli/cmd/render"
 	"github.com/openchoreo/openchoreo/pkg/cli/cmd/version"
 	"github.com/openchoreo/openchoreo/pkg/cli/common/config"
 	"github.com/openchoreo/openchoreo/pkg/cli/types/api"
@@ -33,6 +36,9 @@ func BuildRootCmd(config *config.CLIConfig, impl api.CommandImplementationInterf
 		// logs.NewLogsCmd(impl),
 		configContext.NewConfigCmd(impl),
 		delete.NewDeleteCmd(impl),
+		promotion.NewPromotionCmd(impl),
+		releasebinding.NewReleaseBindingCmd(impl),
+		render.NewRenderCmd(impl),
 		version.NewVersionCmd(),
 	)
 
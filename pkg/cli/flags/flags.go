@@ -120,6 +120,16 @@ var (
 		Usage: messages.FlagFollowDesc,
 		Type:  "bool",
 	}
+	Watch = Flag{
+		Name:      "watch",
+		Shorthand: "w",
+		Usage:     messages.FlagWatchDesc,
+		Type:      "bool",
+	}
+	ObserverURL = Flag{
+		Name:  "observer-url",
+		Usage: messages.FlagObserverURLDesc,
+	}
 	BuildTypeName = Flag{
 		Name:  "type",
 		Usage: messages.FlagBuildTypeDesc,
@@ -290,6 +300,72 @@ var (
 		Usage:     messages.DeleteFileFlag,
 	}
 
+	RenderFileFlag = Flag{
+		Name:      "file",
+		Shorthand: "f",
+		Usage:     messages.RenderFileFlag,
+	}
+
+	RenderOutput = Flag{
+		Name:      "output",
+		Shorthand: "o",
+		Usage:     messages.FlagRenderOutputDesc,
+	}
+
+	SourceEnvironment = Flag{
+		Name:  "source-env",
+		Usage: messages.FlagSourceEnvDesc,
+	}
+
+	TargetEnvironment = Flag{
+		Name:  "target-env",
+		Usage: messages.FlagTargetEnvDesc,
+	}
+
+	PromotionReason = Flag{
+		Name:  "reason",
+		Usage: messages.FlagPromotionReasonDesc,
+	}
+
+	ReviewComment = Flag{
+		Name:  "comment",
+		Usage: messages.FlagReviewCommentDesc,
+	}
+
+	RollbackRelease = Flag{
+		Name:  "release",
+		Usage: messages.FlagRollbackReleaseDesc,
+	}
+
+	// List flags
+
+	Limit = Flag{
+		Name:  "limit",
+		Usage: messages.FlagLimitDesc,
+		Type:  "int",
+	}
+
+	Selector = Flag{
+		Name:      "selector",
+		Shorthand: "l",
+		Usage:     messages.FlagSelectorDesc,
+	}
+
+	Status = Flag{
+		Name:  "status",
+		Usage: messages.FlagStatusDesc,
+	}
+
+	SortBy = Flag{
+		Name:  "sort-by",
+		Usage: messages.FlagSortByDesc,
+	}
+
+	SortOrder = Flag{
+		Name:  "order",
+		Usage: messages.FlagSortOrderDesc,
+	}
+
 	WorkloadDescriptor = Flag{
 		Name:  "descriptor",
 		Usage: messages.WorkloadDescriptorFlag,
@@ -321,9 +397,12 @@ var (
 // AddFlags adds the specified flags to the given command.
 func AddFlags(cmd *cobra.Command, flags ...Flag) {
 	for _, flag := range flags {
-		if flag.Type == "bool" {
+		switch flag.Type {
+		case "bool":
 			cmd.Flags().BoolP(flag.Name, flag.Shorthand, false, flag.Usage)
-		} else {
+		case "int":
+			cmd.Flags().IntP(flag.Name, flag.Shorthand, 0, flag.Usage)
+		default:
 			// Default to string type
 			cmd.Flags().StringP(flag.Name, flag.Shorthand, "", flag.Usage)
 		}
@@ -24,6 +24,9 @@ type CommandImplementationInterface interface {
 	DeploymentPipelineAPI
 	ConfigurationGroupAPI
 	WorkloadAPI
+	RenderAPI
+	PromotionAPI
+	ReleaseBindingAPI
 }
 
 // OrganizationAPI defines organization-related operations
@@ -126,3 +129,22 @@ type ConfigurationGroupAPI interface {
 type WorkloadAPI interface {
 	CreateWorkload(params CreateWorkloadParams) error
 }
+
+// RenderAPI defines methods for rendering component resources locally
+type RenderAPI interface {
+	Render(params RenderParams) error
+}
+
+// PromotionAPI defines methods for requesting and reviewing promotions that require approval
+type PromotionAPI interface {
+	RequestPromotion(params RequestPromotionParams) error
+	ListPromotionRequests(params ListPromotionRequestsParams) error
+	ApprovePromotionRequest(params ReviewPromotionRequestParams) error
+	RejectPromotionRequest(params ReviewPromotionRequestParams) error
+}
+
+// ReleaseBindingAPI defines methods for inspecting the release history of release bindings and rolling them back
+type ReleaseBindingAPI interface {
+	GetReleaseBindingHistory(params ReleaseBindingHistoryParams) error
+	RollbackReleaseBinding(params RollbackReleaseBindingParams) error
+}
@@ -28,6 +28,7 @@ type GetComponentParams struct {
 	OutputFormat string
 	Name         string
 	Interactive  bool // Add this field
+	Watch        bool
 }
 
 // CreateOrganizationParams defines parameters for creating organizations
@@ -100,6 +101,7 @@ type LogParams struct {
 	Interactive     bool
 	Deployment      string
 	DeploymentTrack string
+	ObserverURL     string
 }
 
 // CreateBuildParams contains parameters for build creation
@@ -130,6 +132,7 @@ type GetBuildParams struct {
 	OutputFormat    string
 	Interactive     bool
 	Name            string
+	Watch           bool
 }
 
 // CreateDeployableArtifactParams defines parameters for creating a deployable artifact
@@ -343,3 +346,62 @@ type CreateWorkloadParams struct {
 	OutputPath       string
 	Interactive      bool
 }
+
+// RenderParams defines parameters for rendering component resources from local files
+type RenderParams struct {
+	FilePath     string
+	Component    string
+	Environment  string
+	OutputFormat string
+}
+
+// RequestPromotionParams defines parameters for requesting the promotion of a component
+type RequestPromotionParams struct {
+	Organization      string
+	Project           string
+	Component         string
+	SourceEnvironment string
+	TargetEnvironment string
+	Reason            string
+}
+
+// ListPromotionRequestsParams defines parameters for listing the promotion requests of a component
+type ListPromotionRequestsParams struct {
+	Organization      string
+	Project           string
+	Component         string
+	OutputFormat      string
+	Status            string
+	TargetEnvironment string
+	LabelSelector     string
+	SortBy            string
+	Order             string
+	Limit             int
+}
+
+// ReviewPromotionRequestParams defines parameters for approving or rejecting a promotion request
+type ReviewPromotionRequestParams struct {
+	Organization string
+	Project      string
+	Component    string
+	Name         string
+	Comment      string
+}
+
+// ReleaseBindingHistoryParams defines parameters for showing the release history of a release binding
+type ReleaseBindingHistoryParams struct {
+	Organization string
+	Project      string
+	Component    string
+	Name         string
+	OutputFormat string
+}
+
+// RollbackReleaseBindingParams defines parameters for rolling a release binding back to an earlier release
+type RollbackReleaseBindingParams struct {
+	Organization string
+	Project      string
+	Component    string
+	Name         string
+	Release      string
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
+)
+
+// mutatingTools are the tools that change resources. Calls to them are recorded in the audit log.
+var mutatingTools = map[string]bool{
+	"create_project":            true,
+	"create_component":          true,
+	"update_component_binding":  true,
+	"create_component_release":  true,
+	"patch_release_binding":     true,
+	"rollback_release_binding":  true,
+	"deploy_release":            true,
+	"promote_component":         true,
+	"request_promotion":         true,
+	"approve_promotion_request": true,
+	"reject_promotion_request":  true,
+	"create_workload":           true,
+	"trigger_build":             true,
+	"create_environment":        true,
+	"create_dataplane":          true,
+	"apply_resource":            true,
+	"delete_resource":           true,
+}
+
+// auditMiddleware records an audit event for every call to a mutating tool, including the denied calls
+func (t *Toolsets) auditMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		callReq, ok := req.(*mcp.CallToolRequest)
+		if !ok || !mutatingTools[callReq.Params.Name] {
+			return next(ctx, method, req)
+		}
+
+		params := callReq.Params
+		var args toolScopeArgs
+		if len(params.Arguments) > 0 {
+			// Invalid arguments are rejected by the tool, and the call is recorded without a resource
+			_ = json.Unmarshal(params.Arguments, &args)
+		}
+		scope := toolResource(params.Name, &args)
+		event := &audit.Event{
+			Source: audit.SourceMCP,
+			Action: params.Name,
+			Resource: audit.Resource{
+				Org:         scope.Org,
+				Project:     scope.Project,
+				Component:   scope.Component,
+				Environment: scope.Environment,
+				Name:        args.Name,
+			},
+			Request: audit.SummarizeJSON(params.Arguments),
+		}
+
+		result, err := next(audit.NewContext(ctx, event), method, req)
+
+		event.Outcome = audit.OutcomeSuccess
+		switch {
+		case err != nil:
+			event.Outcome = audit.OutcomeFailure
+			event.Error = err.Error()
+		case isErrorResult(result):
+			event.Error = resultText(result)
+			event.Outcome = audit.OutcomeFailure
+			if strings.Contains(event.Error, rbac.ErrPermissionDenied.Error()) {
+				event.Outcome = audit.OutcomeDenied
+			}
+		}
+		t.Auditor.Record(ctx, event)
+		return result, err
+	}
+}
+
+// isErrorResult reports whether the result of a tool call is an error
+func isErrorResult(result mcp.Result) bool {
+	callResult, ok := result.(*mcp.CallToolResult)
+	return ok && callResult.IsError
+}
+
+// resultText returns the text content of the result of a tool call
+func resultText(result mcp.Result) string {
+	callResult, ok := result.(*mcp.CallToolResult)
+	if !ok {
+		return ""
+	}
+	var texts []string
+	for _, content := range callResult.Content {
+		if text, ok := content.(*mcp.TextContent); ok {
+			texts = append(texts, text.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
+)
+
+// TestMutatingToolsExist verifies that the audited tools are registered tools, so that a renamed tool
+// does not silently drop out of the audit log
+func TestMutatingToolsExist(t *testing.T) {
+	specs := make(map[string]bool, len(allToolSpecs))
+	for _, spec := range allToolSpecs {
+		specs[spec.name] = true
+	}
+	for name := range mutatingTools {
+		if !specs[name] {
+			t.Errorf("Audited tool %q is not a registered tool", name)
+		}
+	}
+}
+
+func TestAuditMiddleware(t *testing.T) {
+	policy, err := rbac.ParsePolicy([]byte(`
+roles:
+  - name: editor
+    actions: [view, edit]
+bindings:
+  - role: editor
+    groups: [devs]
+    scope:
+      org: ` + testOrgName + `
+`))
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+
+	memory := audit.NewMemorySink(10)
+	mockHandler := NewMockCoreToolsetHandler()
+	toolsets := &Toolsets{
+		ProjectToolset:        mockHandler,
+		InfrastructureToolset: mockHandler,
+		Authorizer:            rbac.NewAuthorizer(policy),
+		Auditor:               audit.New(slog.New(slog.DiscardHandler), memory),
+	}
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-openchoreo-api", Version: "1.0.0"}, nil)
+	toolsets.Register(server)
+	// Stand in for the JWT middleware of the HTTP server
+	server.AddReceivingMiddleware(func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			ctx = jwt.NewContextWithClaims(ctx, gojwt.MapClaims{"sub": "dev", "groups": []interface{}{"devs"}})
+			return next(ctx, method, req)
+		}
+	})
+
+	ctx := context.Background()
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("Failed to connect server: %v", err)
+	}
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("Failed to connect client: %v", err)
+	}
+	defer clientSession.Close()
+
+	calls := []*mcp.CallToolParams{
+		{Name: "create_project", Arguments: map[string]any{"org_name": testOrgName, "name": "new-project"}},
+		{Name: "create_project", Arguments: map[string]any{"org_name": "other-org", "name": "new-project"}},
+		{Name: "get_project", Arguments: map[string]any{"org_name": testOrgName, "project_name": testProjectName}},
+		{Name: "create_dataplane", Arguments: map[string]any{
+			"org_name": testOrgName, "name": "edge", "client_key": "key-data", "observer_password": "observer-pass",
+		}},
+	}
+	for _, params := range calls {
+		if _, err := clientSession.CallTool(ctx, params); err != nil {
+			t.Fatalf("Failed to call tool %s: %v", params.Name, err)
+		}
+	}
+
+	events, err := memory.Query(ctx, &audit.Query{})
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	want := []audit.Event{
+		{
+			Source:   audit.SourceMCP,
+			Subject:  "dev",
+			User:     "dev",
+			Action:   "create_dataplane",
+			Resource: audit.Resource{Org: testOrgName, Name: "edge"},
+			// Credentials are redacted from the summary of the arguments
+			Request: map[string]any{
+				"org_name": testOrgName, "name": "edge", "client_key": "[REDACTED]", "observer_password": "[REDACTED]",
+			},
+			Outcome: audit.OutcomeDenied,
+		},
+		{
+			Source:   audit.SourceMCP,
+			Subject:  "dev",
+			User:     "dev",
+			Action:   "create_project",
+			Resource: audit.Resource{Org: "other-org", Name: "new-project"},
+			Request:  map[string]any{"org_name": "other-org", "name": "new-project"},
+			Outcome:  audit.OutcomeDenied,
+		},
+		{
+			Source:   audit.SourceMCP,
+			Subject:  "dev",
+			User:     "dev",
+			Action:   "create_project",
+			Resource: audit.Resource{Org: testOrgName, Name: "new-project"},
+			Request:  map[string]any{"org_name": testOrgName, "name": "new-project"},
+			Outcome:  audit.OutcomeSuccess,
+		},
+	}
+	if diff := cmp.Diff(want, events, cmpopts.IgnoreFields(audit.Event{}, "ID", "Timestamp", "Error")); diff != "" {
+		t.Errorf("events mismatch (-want +got):\n%s", diff)
+	}
+}
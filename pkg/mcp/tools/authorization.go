@@ -0,0 +1,153 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
+)
+
+// toolActions maps the tools to the action they require on the resource named by their arguments.
+// Tools mapped to an empty action are not scoped to a resource, or are authorized by the toolset handlers
+// because their environment or organization is only known after looking up other resources.
+// Calls to tools missing from this map are denied.
+var toolActions = map[string]rbac.Action{
+	// Organization tools
+	"list_organizations": "",
+	"get_organization":   rbac.ActionView,
+
+	// Project tools
+	"list_projects":  rbac.ActionView,
+	"get_project":    rbac.ActionView,
+	"create_project": rbac.ActionEdit,
+
+	// Component tools
+	"create_component":             rbac.ActionEdit,
+	"list_components":              rbac.ActionView,
+	"get_component":                rbac.ActionView,
+	"get_component_binding":        rbac.ActionView,
+	"update_component_binding":     "",
+	"get_component_workloads":      rbac.ActionView,
+	"list_component_releases":      rbac.ActionView,
+	"create_component_release":     rbac.ActionEdit,
+	"get_component_release":        rbac.ActionView,
+	"get_component_schema":         rbac.ActionView,
+	"get_component_release_schema": rbac.ActionView,
+	"list_release_bindings":        rbac.ActionView,
+	"patch_release_binding":        "",
+	"dry_run_release_binding":      rbac.ActionView,
+	"get_release_binding_history":  rbac.ActionView,
+	"rollback_release_binding":     "",
+	"deploy_release":               "",
+	"promote_component":            "",
+	"request_promotion":            rbac.ActionPromote,
+	"list_promotion_requests":      rbac.ActionView,
+	"approve_promotion_request":    "",
+	"reject_promotion_request":     "",
+	"create_workload":              rbac.ActionEdit,
+
+	// Build tools
+	"list_build_templates":   rbac.ActionView,
+	"trigger_build":          rbac.ActionBuild,
+	"list_builds":            rbac.ActionView,
+	"get_build_observer_url": rbac.ActionView,
+	"list_buildplanes":       rbac.ActionView,
+
+	// Deployment tools
+	"get_deployment_pipeline":    rbac.ActionView,
+	"get_component_observer_url": rbac.ActionView,
+
+	// Infrastructure tools
+	"list_environments":         rbac.ActionView,
+	"get_environment":           rbac.ActionView,
+	"create_environment":        rbac.ActionAdmin,
+	"list_dataplanes":           rbac.ActionView,
+	"get_dataplane":             rbac.ActionView,
+	"create_dataplane":          rbac.ActionAdmin,
+	"list_component_types":      rbac.ActionView,
+	"get_component_type_schema": rbac.ActionView,
+	"list_workflows":            rbac.ActionView,
+	"get_workflow_schema":       rbac.ActionView,
+	"list_traits":               rbac.ActionView,
+	"get_trait_schema":          rbac.ActionView,
+
+	// Schema tools
+	"explain_schema": "",
+
+	// Resource tools
+	"apply_resource":  "",
+	"delete_resource": "",
+}
+
+// toolScopeArgs are the tool arguments identifying the resource a tool acts on
+type toolScopeArgs struct {
+	Name            string `json:"name"`
+	OrgName         string `json:"org_name"`
+	ProjectName     string `json:"project_name"`
+	ComponentName   string `json:"component_name"`
+	Environment     string `json:"environment"`
+	EnvironmentName string `json:"environment_name"`
+	EnvName         string `json:"env_name"`
+}
+
+// authorizationMiddleware rejects tool calls whose user is not allowed to perform the action of the tool
+func (t *Toolsets) authorizationMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		callReq, ok := req.(*mcp.CallToolRequest)
+		if !ok {
+			return next(ctx, method, req)
+		}
+		if err := t.authorizeToolCall(ctx, callReq.Params); err != nil {
+			return &mcp.CallToolResult{
+				IsError: true,
+				Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}},
+			}, nil
+		}
+		return next(ctx, method, req)
+	}
+}
+
+// authorizeToolCall checks the action of a tool against the resource named by the arguments of the call
+func (t *Toolsets) authorizeToolCall(ctx context.Context, params *mcp.CallToolParamsRaw) error {
+	action, ok := toolActions[params.Name]
+	if !ok {
+		return fmt.Errorf("%w: no authorization rule for tool %q", rbac.ErrPermissionDenied, params.Name)
+	}
+	if action == "" {
+		return nil
+	}
+
+	var args toolScopeArgs
+	if len(params.Arguments) > 0 {
+		if err := json.Unmarshal(params.Arguments, &args); err != nil {
+			return fmt.Errorf("invalid arguments: %w", err)
+		}
+	}
+	return t.Authorizer.Authorize(ctx, action, toolResource(params.Name, &args))
+}
+
+// toolResource returns the resource named by the arguments of a tool call
+func toolResource(tool string, args *toolScopeArgs) rbac.Resource {
+	if tool == "get_organization" {
+		return rbac.Resource{Org: args.Name}
+	}
+	resource := rbac.Resource{
+		Org:         args.OrgName,
+		Project:     args.ProjectName,
+		Component:   args.ComponentName,
+		Environment: args.Environment,
+	}
+	if resource.Environment == "" {
+		resource.Environment = args.EnvironmentName
+	}
+	if resource.Environment == "" {
+		resource.Environment = args.EnvName
+	}
+	return resource
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/jwt"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
+)
+
+// TestToolActionsCoverAllTools verifies that every tool has an authorization rule, as calls to tools
+// without one are denied
+func TestToolActionsCoverAllTools(t *testing.T) {
+	for _, spec := range allToolSpecs {
+		if _, ok := toolActions[spec.name]; !ok {
+			t.Errorf("Tool %q has no entry in toolActions", spec.name)
+		}
+	}
+}
+
+func TestAuthorizationMiddleware(t *testing.T) {
+	policy, err := rbac.ParsePolicy([]byte(`
+roles:
+  - name: viewer
+    actions: [view]
+bindings:
+  - role: viewer
+    groups: [devs]
+    scope:
+      org: ` + testOrgName + `
+`))
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+
+	mockHandler := NewMockCoreToolsetHandler()
+	toolsets := &Toolsets{
+		OrganizationToolset: mockHandler,
+		ProjectToolset:      mockHandler,
+		Authorizer:          rbac.NewAuthorizer(policy),
+	}
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-openchoreo-api", Version: "1.0.0"}, nil)
+	toolsets.Register(server)
+	// Stand in for the JWT middleware of the HTTP server
+	server.AddReceivingMiddleware(func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			ctx = jwt.NewContextWithClaims(ctx, gojwt.MapClaims{"sub": "dev", "groups": []interface{}{"devs"}})
+			return next(ctx, method, req)
+		}
+	})
+
+	ctx := context.Background()
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("Failed to connect server: %v", err)
+	}
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("Failed to connect client: %v", err)
+	}
+	defer clientSession.Close()
+
+	tests := []struct {
+		name    string
+		tool    string
+		args    map[string]any
+		allowed bool
+	}{
+		{"view in bound org", "get_project", map[string]any{"org_name": testOrgName, "project_name": testProjectName}, true},
+		{"view in other org", "get_project", map[string]any{"org_name": "other-org", "project_name": testProjectName}, false},
+		{"edit without role", "create_project", map[string]any{"org_name": testOrgName, "name": "new-project"}, false},
+		{"organization named by name", "get_organization", map[string]any{"name": testOrgName}, true},
+		{"tool without resource", "list_organizations", map[string]any{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockHandler.calls = make(map[string][]interface{})
+
+			result, err := clientSession.CallTool(ctx, &mcp.CallToolParams{Name: tt.tool, Arguments: tt.args})
+			if err != nil {
+				t.Fatalf("Failed to call tool: %v", err)
+			}
+			if tt.allowed {
+				if result.IsError || len(mockHandler.calls) != 1 {
+					t.Errorf("Expected the call to be allowed, got result %+v and calls %v", result, mockHandler.calls)
+				}
+				return
+			}
+			if !result.IsError || len(mockHandler.calls) != 0 {
+				t.Fatalf("Expected the call to be denied, got result %+v and calls %v", result, mockHandler.calls)
+			}
+			text, ok := result.Content[0].(*mcp.TextContent)
+			if !ok || !strings.Contains(text.Text, rbac.ErrPermissionDenied.Error()) {
+				t.Errorf("Expected a permission denied message, got %+v", result.Content[0])
+			}
+		})
+	}
+}
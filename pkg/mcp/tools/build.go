@@ -7,6 +7,8 @@ import (
 	"context"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
 func (t *Toolsets) RegisterListBuildTemplates(s *mcp.Server) {
@@ -14,13 +16,14 @@ func (t *Toolsets) RegisterListBuildTemplates(s *mcp.Server) {
 		Name: "list_build_templates",
 		Description: "List available build templates in an organization. Build templates define how source code " +
 			"is transformed into container images (Docker, Buildpacks, Kaniko, etc.).",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createSchema(withListProperties(map[string]any{
 			"org_name": defaultStringProperty(),
-		}, []string{"org_name"}),
+		}), []string{"org_name"}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName string `json:"org_name"`
+		listArgs
 	}) (*mcp.CallToolResult, any, error) {
-		result, err := t.BuildToolset.ListBuildTemplates(ctx, args.OrgName)
+		result, err := t.BuildToolset.ListBuildTemplates(ctx, args.OrgName, args.listOptions(nil))
 		return handleToolResult(result, err)
 	})
 }
@@ -52,17 +55,21 @@ func (t *Toolsets) RegisterListBuilds(s *mcp.Server) {
 		Name: "list_builds",
 		Description: "List all builds for a component showing build history, status (queued, running, " +
 			"succeeded, failed), commit information, and generated image tags.",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createSchema(withListProperties(map[string]any{
 			"org_name":       defaultStringProperty(),
 			"project_name":   defaultStringProperty(),
 			"component_name": defaultStringProperty(),
-		}, []string{"org_name", "project_name", "component_name"}),
+			"status":         stringProperty("Optional: filter by build status (e.g., 'Succeeded', 'Failed')"),
+		}), []string{"org_name", "project_name", "component_name"}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName       string `json:"org_name"`
 		ProjectName   string `json:"project_name"`
 		ComponentName string `json:"component_name"`
+		Status        string `json:"status"`
+		listArgs
 	}) (*mcp.CallToolResult, any, error) {
-		result, err := t.BuildToolset.ListBuilds(ctx, args.OrgName, args.ProjectName, args.ComponentName)
+		opts := args.listOptions(map[string]string{models.FilterStatus: args.Status})
+		result, err := t.BuildToolset.ListBuilds(ctx, args.OrgName, args.ProjectName, args.ComponentName, opts)
 		return handleToolResult(result, err)
 	})
 }
@@ -92,13 +99,14 @@ func (t *Toolsets) RegisterListBuildPlanes(s *mcp.Server) {
 		Name: "list_buildplanes",
 		Description: "List all build planes in an organization. Build planes are dedicated infrastructure where " +
 			"component builds execute (isolated from runtime workloads).",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createSchema(withListProperties(map[string]any{
 			"org_name": defaultStringProperty(),
-		}, []string{"org_name"}),
+		}), []string{"org_name"}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName string `json:"org_name"`
+		listArgs
 	}) (*mcp.CallToolResult, any, error) {
-		result, err := t.BuildToolset.ListBuildPlanes(ctx, args.OrgName)
+		result, err := t.BuildToolset.ListBuildPlanes(ctx, args.OrgName, args.listOptions(nil))
 		return handleToolResult(result, err)
 	})
 }
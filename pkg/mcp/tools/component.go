@@ -16,15 +16,19 @@ func (t *Toolsets) RegisterListComponents(s *mcp.Server) {
 		Name: "list_components",
 		Description: "List all components in a project. Components are deployable units (services, jobs, etc.) " +
 			"with independent build and deployment lifecycles.",
-		InputSchema: createSchema(map[string]any{
-			"org_name":     defaultStringProperty(),
-			"project_name": defaultStringProperty(),
-		}, []string{"org_name", "project_name"}),
+		InputSchema: createSchema(withListProperties(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_type": stringProperty("Optional: filter by component type (e.g., 'deployment/web-app')"),
+		}), []string{"org_name", "project_name"}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
-		OrgName     string `json:"org_name"`
-		ProjectName string `json:"project_name"`
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentType string `json:"component_type"`
+		listArgs
 	}) (*mcp.CallToolResult, any, error) {
-		result, err := t.ComponentToolset.ListComponents(ctx, args.OrgName, args.ProjectName)
+		opts := args.listOptions(map[string]string{models.FilterType: args.ComponentType})
+		result, err := t.ComponentToolset.ListComponents(ctx, args.OrgName, args.ProjectName, opts)
 		return handleToolResult(result, err)
 	})
 }
@@ -137,17 +141,19 @@ func (t *Toolsets) RegisterListComponentReleases(s *mcp.Server) {
 		Name: "list_component_releases",
 		Description: "List all releases for a component. Releases are immutable snapshots of a component at a " +
 			"specific build, ready for deployment to environments.",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createSchema(withListProperties(map[string]any{
 			"org_name":       defaultStringProperty(),
 			"project_name":   defaultStringProperty(),
 			"component_name": defaultStringProperty(),
-		}, []string{"org_name", "project_name", "component_name"}),
+		}), []string{"org_name", "project_name", "component_name"}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName       string `json:"org_name"`
 		ProjectName   string `json:"project_name"`
 		ComponentName string `json:"component_name"`
+		listArgs
 	}) (*mcp.CallToolResult, any, error) {
-		result, err := t.ComponentToolset.ListComponentReleases(ctx, args.OrgName, args.ProjectName, args.ComponentName)
+		result, err := t.ComponentToolset.ListComponentReleases(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.listOptions(nil))
 		return handleToolResult(result, err)
 	})
 }
@@ -203,21 +209,25 @@ func (t *Toolsets) RegisterListReleaseBindings(s *mcp.Server) {
 		Name: "list_release_bindings",
 		Description: "List release bindings for a component. Release bindings associate releases with " +
 			"environments and define deployment configurations. Optionally filter by environment names.",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createSchema(withListProperties(map[string]any{
 			"org_name":       defaultStringProperty(),
 			"project_name":   defaultStringProperty(),
 			"component_name": defaultStringProperty(),
 			"environments": arrayProperty(
 				"Optional: filter by environment names (e.g., ['dev', 'staging'])", "string"),
-		}, []string{"org_name", "project_name", "component_name"}),
+			"status": stringProperty("Optional: filter by status (e.g., 'Ready', 'NotReady', 'Failed')"),
+		}), []string{"org_name", "project_name", "component_name"}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName       string   `json:"org_name"`
 		ProjectName   string   `json:"project_name"`
 		ComponentName string   `json:"component_name"`
 		Environments  []string `json:"environments"`
+		Status        string   `json:"status"`
+		listArgs
 	}) (*mcp.CallToolResult, any, error) {
+		opts := args.listOptions(map[string]string{models.FilterStatus: args.Status})
 		result, err := t.ComponentToolset.ListReleaseBindings(
-			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.Environments)
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.Environments, opts)
 		return handleToolResult(result, err)
 	})
 }
@@ -258,66 +268,110 @@ func (t *Toolsets) RegisterPatchReleaseBinding(s *mcp.Server) {
 		TraitOverrides            map[string]interface{} `json:"trait_overrides"`
 		ConfigurationOverrides    map[string]interface{} `json:"configuration_overrides"`
 	}) (*mcp.CallToolResult, any, error) {
-		// Convert trait overrides to the correct type
-		var traitOverrides map[string]map[string]interface{}
-		if args.TraitOverrides != nil {
-			traitOverrides = make(map[string]map[string]interface{})
-			for k, v := range args.TraitOverrides {
-				if vMap, ok := v.(map[string]interface{}); ok {
-					traitOverrides[k] = vMap
-				}
-			}
-		}
-
-		patchReq := &models.PatchReleaseBindingRequest{
-			ReleaseName:               args.ReleaseName,
-			Environment:               args.Environment,
-			ComponentTypeEnvOverrides: args.ComponentTypeEnvOverrides,
-			TraitOverrides:            traitOverrides,
-		}
-		if args.ConfigurationOverrides != nil {
-			// Convert map to WorkloadOverrides struct
-			workloadOverrides := &models.WorkloadOverrides{
-				Containers: make(map[string]models.ContainerOverride),
-			}
-			// Default container name if not specified
-			containerName := "default"
-			if name, ok := args.ConfigurationOverrides["container_name"].(string); ok && name != "" {
-				containerName = name
-			}
-			containerOverride := models.ContainerOverride{}
-			if envVars, ok := args.ConfigurationOverrides["env"].([]interface{}); ok {
-				for _, ev := range envVars {
-					if evMap, ok := ev.(map[string]interface{}); ok {
-						containerOverride.Env = append(containerOverride.Env, models.EnvVar{
-							Key:   evMap["key"].(string),
-							Value: evMap["value"].(string),
-						})
-					}
-				}
-			}
-			if files, ok := args.ConfigurationOverrides["files"].([]interface{}); ok {
-				for _, f := range files {
-					if fMap, ok := f.(map[string]interface{}); ok {
-						containerOverride.Files = append(containerOverride.Files, models.FileVar{
-							Key:       fMap["key"].(string),
-							MountPath: fMap["mount_path"].(string),
-							Value:     fMap["value"].(string),
-						})
-					}
-				}
-			}
-			if len(containerOverride.Env) > 0 || len(containerOverride.Files) > 0 {
-				workloadOverrides.Containers[containerName] = containerOverride
-				patchReq.WorkloadOverrides = workloadOverrides
-			}
-		}
+		patchReq := buildPatchReleaseBindingRequest(args.ReleaseName, args.Environment,
+			args.ComponentTypeEnvOverrides, args.TraitOverrides, args.ConfigurationOverrides)
 		result, err := t.ComponentToolset.PatchReleaseBinding(
 			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.BindingName, patchReq)
 		return handleToolResult(result, err)
 	})
 }
 
+func (t *Toolsets) RegisterDryRunReleaseBinding(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "dry_run_release_binding",
+		Description: "Preview a release binding patch without applying it. Renders the binding with the proposed " +
+			"overrides and returns a per-resource diff (added, modified, removed, unchanged) against the resources " +
+			"currently deployed to the environment. Accepts the same arguments as patch_release_binding.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"binding_name":   defaultStringProperty(),
+			"release_name":   stringProperty("Optional: preview binding a different release"),
+			"environment":    stringProperty("Optional: target environment (required if the binding does not exist yet)"),
+			"component_type_env_overrides": map[string]any{
+				"type":        "object",
+				"description": "Optional: environment-specific overrides for component type parameters",
+			},
+			"trait_overrides": map[string]any{
+				"type":        "object",
+				"description": "Optional: environment-specific trait configuration overrides",
+			},
+			"configuration_overrides": map[string]any{
+				"type":        "object",
+				"description": "Optional: workload configuration overrides (env vars, files, etc.)",
+			},
+		}, []string{"org_name", "project_name", "component_name", "binding_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName                   string                 `json:"org_name"`
+		ProjectName               string                 `json:"project_name"`
+		ComponentName             string                 `json:"component_name"`
+		BindingName               string                 `json:"binding_name"`
+		ReleaseName               string                 `json:"release_name"`
+		Environment               string                 `json:"environment"`
+		ComponentTypeEnvOverrides map[string]interface{} `json:"component_type_env_overrides"`
+		TraitOverrides            map[string]interface{} `json:"trait_overrides"`
+		ConfigurationOverrides    map[string]interface{} `json:"configuration_overrides"`
+	}) (*mcp.CallToolResult, any, error) {
+		patchReq := buildPatchReleaseBindingRequest(args.ReleaseName, args.Environment,
+			args.ComponentTypeEnvOverrides, args.TraitOverrides, args.ConfigurationOverrides)
+		result, err := t.ComponentToolset.DryRunReleaseBinding(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.BindingName, patchReq)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterGetReleaseBindingHistory(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "get_release_binding_history",
+		Description: "Get the release history of a release binding, most recent first. Shows which component " +
+			"release was bound to the environment, when, and by whom. The entry marked current is the release " +
+			"currently bound; the other entries are the releases the binding can be rolled back to.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"binding_name":   defaultStringProperty(),
+		}, []string{"org_name", "project_name", "component_name", "binding_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		BindingName   string `json:"binding_name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.ComponentToolset.GetReleaseBindingHistory(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.BindingName)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterRollbackReleaseBinding(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "rollback_release_binding",
+		Description: "Roll a release binding back to an earlier component release from its release history. " +
+			"Without a release name the binding is rolled back to the release bound before the current one. " +
+			"The rollback is recorded in the history with the authenticated user.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"binding_name":   defaultStringProperty(),
+			"release_name":   stringProperty("Optional: release from the history to roll back to"),
+		}, []string{"org_name", "project_name", "component_name", "binding_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		BindingName   string `json:"binding_name"`
+		ReleaseName   string `json:"release_name"`
+	}) (*mcp.CallToolResult, any, error) {
+		rollbackReq := &models.RollbackReleaseBindingRequest{ReleaseName: args.ReleaseName}
+		result, err := t.ComponentToolset.RollbackReleaseBinding(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.BindingName, rollbackReq)
+		return handleToolResult(result, err)
+	})
+}
+
 func (t *Toolsets) RegisterDeployRelease(s *mcp.Server) {
 	mcp.AddTool(s, &mcp.Tool{
 		Name: "deploy_release",
@@ -372,6 +426,121 @@ func (t *Toolsets) RegisterPromoteComponent(s *mcp.Server) {
 	})
 }
 
+func (t *Toolsets) RegisterRequestPromotion(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "request_promotion",
+		Description: "Request the promotion of a component to an environment that requires approval. Captures " +
+			"the release currently bound to the source environment and records the requester for auditing. " +
+			"The release is bound to the target environment once the request is approved.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"source_env":     stringProperty("Source environment name (e.g., 'staging')"),
+			"target_env":     stringProperty("Target environment name (e.g., 'production')"),
+			"reason":         stringProperty("Optional: justification for the promotion"),
+		}, []string{"org_name", "project_name", "component_name", "source_env", "target_env"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		SourceEnv     string `json:"source_env"`
+		TargetEnv     string `json:"target_env"`
+		Reason        string `json:"reason"`
+	}) (*mcp.CallToolResult, any, error) {
+		promotionReq := &models.CreatePromotionRequestRequest{
+			SourceEnvironment: args.SourceEnv,
+			TargetEnvironment: args.TargetEnv,
+			Reason:            args.Reason,
+		}
+		result, err := t.ComponentToolset.RequestPromotion(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, promotionReq)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterListPromotionRequests(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "list_promotion_requests",
+		Description: "List the promotion requests of a component, newest first. Shows the requested release, " +
+			"the requester, the reviewer and whether the request is Pending, Approved, Rejected or Promoted.",
+		InputSchema: createSchema(withListProperties(map[string]any{
+			"org_name":           defaultStringProperty(),
+			"project_name":       defaultStringProperty(),
+			"component_name":     defaultStringProperty(),
+			"status":             stringProperty("Optional: filter by status: 'Pending', 'Approved', 'Rejected' or 'Promoted'"),
+			"target_environment": stringProperty("Optional: filter by the environment the release is promoted to"),
+		}), []string{"org_name", "project_name", "component_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName           string `json:"org_name"`
+		ProjectName       string `json:"project_name"`
+		ComponentName     string `json:"component_name"`
+		Status            string `json:"status"`
+		TargetEnvironment string `json:"target_environment"`
+		listArgs
+	}) (*mcp.CallToolResult, any, error) {
+		opts := args.listOptions(map[string]string{
+			models.FilterStatus:      args.Status,
+			models.FilterEnvironment: args.TargetEnvironment,
+		})
+		result, err := t.ComponentToolset.ListPromotionRequests(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, opts)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterApprovePromotionRequest(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "approve_promotion_request",
+		Description: "Approve a pending promotion request and bind the requested release to the target " +
+			"environment. The approver is recorded from the authenticated user and must differ from the requester.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"request_name":   stringProperty("Name of the promotion request"),
+			"comment":        stringProperty("Optional: review comment"),
+		}, []string{"org_name", "project_name", "component_name", "request_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		RequestName   string `json:"request_name"`
+		Comment       string `json:"comment"`
+	}) (*mcp.CallToolResult, any, error) {
+		reviewReq := &models.ReviewPromotionRequestRequest{Comment: args.Comment}
+		result, err := t.ComponentToolset.ApprovePromotionRequest(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.RequestName, reviewReq)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterRejectPromotionRequest(s *mcp.Server) {
+	mcp.AddTool(s, &mcp.Tool{
+		Name: "reject_promotion_request",
+		Description: "Reject a pending promotion request. The reviewer is recorded from the authenticated user " +
+			"and the target environment is left unchanged.",
+		InputSchema: createSchema(map[string]any{
+			"org_name":       defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"component_name": defaultStringProperty(),
+			"request_name":   stringProperty("Name of the promotion request"),
+			"comment":        stringProperty("Optional: review comment"),
+		}, []string{"org_name", "project_name", "component_name", "request_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		OrgName       string `json:"org_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		RequestName   string `json:"request_name"`
+		Comment       string `json:"comment"`
+	}) (*mcp.CallToolResult, any, error) {
+		reviewReq := &models.ReviewPromotionRequestRequest{Comment: args.Comment}
+		result, err := t.ComponentToolset.RejectPromotionRequest(
+			ctx, args.OrgName, args.ProjectName, args.ComponentName, args.RequestName, reviewReq)
+		return handleToolResult(result, err)
+	})
+}
+
 func (t *Toolsets) RegisterCreateWorkload(s *mcp.Server) {
 	mcp.AddTool(s, &mcp.Tool{
 		Name: "create_workload",
@@ -468,3 +637,65 @@ func (t *Toolsets) RegisterGetComponentReleaseSchema(s *mcp.Server) {
 		return handleToolResult(result, err)
 	})
 }
+
+// buildPatchReleaseBindingRequest converts the loosely typed MCP tool arguments into a PatchReleaseBindingRequest
+func buildPatchReleaseBindingRequest(
+	releaseName, environment string,
+	componentTypeEnvOverrides, traitOverridesArg, configurationOverrides map[string]interface{},
+) *models.PatchReleaseBindingRequest {
+	// Convert trait overrides to the correct type
+	var traitOverrides map[string]map[string]interface{}
+	if traitOverridesArg != nil {
+		traitOverrides = make(map[string]map[string]interface{})
+		for k, v := range traitOverridesArg {
+			if vMap, ok := v.(map[string]interface{}); ok {
+				traitOverrides[k] = vMap
+			}
+		}
+	}
+
+	patchReq := &models.PatchReleaseBindingRequest{
+		ReleaseName:               releaseName,
+		Environment:               environment,
+		ComponentTypeEnvOverrides: componentTypeEnvOverrides,
+		TraitOverrides:            traitOverrides,
+	}
+	if configurationOverrides != nil {
+		// Convert map to WorkloadOverrides struct
+		workloadOverrides := &models.WorkloadOverrides{
+			Containers: make(map[string]models.ContainerOverride),
+		}
+		// Default container name if not specified
+		containerName := "default"
+		if name, ok := configurationOverrides["container_name"].(string); ok && name != "" {
+			containerName = name
+		}
+		containerOverride := models.ContainerOverride{}
+		if envVars, ok := configurationOverrides["env"].([]interface{}); ok {
+			for _, ev := range envVars {
+				if evMap, ok := ev.(map[string]interface{}); ok {
+					containerOverride.Env = append(containerOverride.Env, models.EnvVar{
+						Key:   evMap["key"].(string),
+						Value: evMap["value"].(string),
+					})
+				}
+			}
+		}
+		if files, ok := configurationOverrides["files"].([]interface{}); ok {
+			for _, f := range files {
+				if fMap, ok := f.(map[string]interface{}); ok {
+					containerOverride.Files = append(containerOverride.Files, models.FileVar{
+						Key:       fMap["key"].(string),
+						MountPath: fMap["mount_path"].(string),
+						Value:     fMap["value"].(string),
+					})
+				}
+			}
+		}
+		if len(containerOverride.Env) > 0 || len(containerOverride.Files) > 0 {
+			workloadOverrides.Containers[containerName] = containerOverride
+			patchReq.WorkloadOverrides = workloadOverrides
+		}
+	}
+	return patchReq
+}
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
 const testReleaseName = "release-1"
@@ -30,9 +32,14 @@ func componentBasicSpecs() []toolTestSpec {
 			descriptionKeywords: []string{"list", "component"},
 			descriptionMinLen:   10,
 			requiredParams:      []string{"org_name", "project_name"},
+			optionalParams:      []string{"component_type", "limit", "cursor", "label_selector", "sort_by", "order"},
 			testArgs: map[string]any{
-				"org_name":     testOrgName,
-				"project_name": testProjectName,
+				"org_name":       testOrgName,
+				"project_name":   testProjectName,
+				"component_type": "deployment/web-app",
+				"limit":          20,
+				"sort_by":        "createdAt",
+				"order":          "DESC",
 			},
 			expectedMethod: "ListComponents",
 			validateCall: func(t *testing.T, args []interface{}) {
@@ -42,6 +49,15 @@ func componentBasicSpecs() []toolTestSpec {
 				if args[1] != testProjectName {
 					t.Errorf("Expected project name %q, got %v", testProjectName, args[1])
 				}
+				want := &models.ListOptions{
+					Limit:   20,
+					Filters: map[string]string{models.FilterType: "deployment/web-app"},
+					SortBy:  models.SortByCreatedAt,
+					Order:   models.SortOrderDesc,
+				}
+				if diff := cmp.Diff(want, args[2]); diff != "" {
+					t.Errorf("list options mismatch (-want +got):\n%s", diff)
+				}
 			},
 		},
 		{
@@ -285,6 +301,85 @@ func componentBindingSpecs() []toolTestSpec {
 				}
 			},
 		},
+		{
+			name:                "dry_run_release_binding",
+			toolset:             "component",
+			descriptionKeywords: []string{"release", "binding", "diff"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"org_name", "project_name", "component_name", "binding_name"},
+			optionalParams: []string{
+				"release_name", "environment", "component_type_env_overrides",
+				"trait_overrides", "configuration_overrides",
+			},
+			testArgs: map[string]any{
+				"org_name":       testOrgName,
+				"project_name":   testProjectName,
+				"component_name": testComponentName,
+				"binding_name":   "binding-1",
+				"component_type_env_overrides": map[string]any{
+					"replicas": 3,
+				},
+			},
+			expectedMethod: "DryRunReleaseBinding",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testOrgName || args[1] != testProjectName || args[2] != testComponentName || args[3] != "binding-1" {
+					t.Errorf("Expected (%s, %s, %s, binding-1), got (%v, %v, %v, %v)",
+						testOrgName, testProjectName, testComponentName, args[0], args[1], args[2], args[3])
+				}
+				req, ok := args[4].(*models.PatchReleaseBindingRequest)
+				if !ok {
+					t.Fatalf("Expected *models.PatchReleaseBindingRequest, got %T", args[4])
+				}
+				if req.ComponentTypeEnvOverrides["replicas"] == nil {
+					t.Errorf("Expected componentTypeEnvOverrides.replicas to be set, got %v", req.ComponentTypeEnvOverrides)
+				}
+			},
+		},
+		{
+			name:                "get_release_binding_history",
+			toolset:             "component",
+			descriptionKeywords: []string{"release", "history"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"org_name", "project_name", "component_name", "binding_name"},
+			testArgs: map[string]any{
+				"org_name":       testOrgName,
+				"project_name":   testProjectName,
+				"component_name": testComponentName,
+				"binding_name":   "binding-1",
+			},
+			expectedMethod: "GetReleaseBindingHistory",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testOrgName || args[1] != testProjectName || args[2] != testComponentName || args[3] != "binding-1" {
+					t.Errorf("Expected (%s, %s, %s, binding-1), got (%v, %v, %v, %v)",
+						testOrgName, testProjectName, testComponentName, args[0], args[1], args[2], args[3])
+				}
+			},
+		},
+		{
+			name:                "rollback_release_binding",
+			toolset:             "component",
+			descriptionKeywords: []string{"roll", "release", "history"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"org_name", "project_name", "component_name", "binding_name"},
+			optionalParams:      []string{"release_name"},
+			testArgs: map[string]any{
+				"org_name":       testOrgName,
+				"project_name":   testProjectName,
+				"component_name": testComponentName,
+				"binding_name":   "binding-1",
+				"release_name":   "component-1-v1",
+			},
+			expectedMethod: "RollbackReleaseBinding",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[3] != "binding-1" {
+					t.Errorf("Expected binding name binding-1, got %v", args[3])
+				}
+				req, ok := args[4].(*models.RollbackReleaseBindingRequest)
+				if !ok || req.ReleaseName != "component-1-v1" {
+					t.Errorf("Unexpected rollback request %+v", args[4])
+				}
+			},
+		},
 		{
 			name:                "deploy_release",
 			toolset:             "component",
@@ -326,6 +421,111 @@ func componentBindingSpecs() []toolTestSpec {
 				}
 			},
 		},
+		{
+			name:                "request_promotion",
+			toolset:             "component",
+			descriptionKeywords: []string{"promotion", "approv"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"org_name", "project_name", "component_name", "source_env", "target_env"},
+			optionalParams:      []string{"reason"},
+			testArgs: map[string]any{
+				"org_name":       testOrgName,
+				"project_name":   testProjectName,
+				"component_name": testComponentName,
+				"source_env":     "staging",
+				"target_env":     "production",
+				"reason":         "Release verified in staging",
+			},
+			expectedMethod: "RequestPromotion",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testOrgName || args[1] != testProjectName || args[2] != testComponentName {
+					t.Errorf("Expected (%s, %s, %s), got (%v, %v, %v)",
+						testOrgName, testProjectName, testComponentName, args[0], args[1], args[2])
+				}
+				req, ok := args[3].(*models.CreatePromotionRequestRequest)
+				if !ok {
+					t.Fatalf("Expected *models.CreatePromotionRequestRequest, got %T", args[3])
+				}
+				if req.SourceEnvironment != "staging" || req.TargetEnvironment != "production" || req.Reason == "" {
+					t.Errorf("Unexpected promotion request %+v", req)
+				}
+			},
+		},
+		{
+			name:                "list_promotion_requests",
+			toolset:             "component",
+			descriptionKeywords: []string{"list", "promotion"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"org_name", "project_name", "component_name"},
+			optionalParams:      []string{"status", "target_environment"},
+			testArgs: map[string]any{
+				"org_name":           testOrgName,
+				"project_name":       testProjectName,
+				"component_name":     testComponentName,
+				"status":             "Pending",
+				"target_environment": "",
+			},
+			expectedMethod: "ListPromotionRequests",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testOrgName || args[1] != testProjectName || args[2] != testComponentName {
+					t.Errorf("Expected (%s, %s, %s), got (%v, %v, %v)",
+						testOrgName, testProjectName, testComponentName, args[0], args[1], args[2])
+				}
+				opts, ok := args[3].(*models.ListOptions)
+				if !ok {
+					t.Fatalf("Expected *models.ListOptions, got %T", args[3])
+				}
+				want := map[string]string{models.FilterStatus: "Pending"}
+				if diff := cmp.Diff(want, opts.Filters); diff != "" {
+					t.Errorf("filters mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name:                "approve_promotion_request",
+			toolset:             "component",
+			descriptionKeywords: []string{"approve", "promotion"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"org_name", "project_name", "component_name", "request_name"},
+			optionalParams:      []string{"comment"},
+			testArgs: map[string]any{
+				"org_name":       testOrgName,
+				"project_name":   testProjectName,
+				"component_name": testComponentName,
+				"request_name":   "component-1-production-x7k2p",
+				"comment":        "Change ticket CHG-1234",
+			},
+			expectedMethod: "ApprovePromotionRequest",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[3] != "component-1-production-x7k2p" {
+					t.Errorf("Expected request name component-1-production-x7k2p, got %v", args[3])
+				}
+				req, ok := args[4].(*models.ReviewPromotionRequestRequest)
+				if !ok || req.Comment != "Change ticket CHG-1234" {
+					t.Errorf("Unexpected review request %+v", args[4])
+				}
+			},
+		},
+		{
+			name:                "reject_promotion_request",
+			toolset:             "component",
+			descriptionKeywords: []string{"reject", "promotion"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"org_name", "project_name", "component_name", "request_name"},
+			optionalParams:      []string{"comment"},
+			testArgs: map[string]any{
+				"org_name":       testOrgName,
+				"project_name":   testProjectName,
+				"component_name": testComponentName,
+				"request_name":   "component-1-production-x7k2p",
+			},
+			expectedMethod: "RejectPromotionRequest",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[3] != "component-1-production-x7k2p" {
+					t.Errorf("Expected request name component-1-production-x7k2p, got %v", args[3])
+				}
+			},
+		},
 		{
 			name:                "create_workload",
 			toolset:             "component",
@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 )
 
 // Helper functions to create JSON Schema definitions
@@ -17,6 +19,13 @@ func stringProperty(description string) map[string]any {
 	}
 }
 
+func integerProperty(description string) map[string]any {
+	return map[string]any{
+		"type":        "integer",
+		"description": description,
+	}
+}
+
 func defaultStringProperty() map[string]any {
 	return map[string]any{
 		"type": "string",
@@ -58,3 +67,36 @@ func createSchema(properties map[string]any, required []string) map[string]any {
 	}
 	return schema
 }
+
+// listArgs holds the pagination, filtering and sorting arguments shared by all list tools
+type listArgs struct {
+	Limit         int    `json:"limit"`
+	Cursor        string `json:"cursor"`
+	LabelSelector string `json:"label_selector"`
+	SortBy        string `json:"sort_by"`
+	Order         string `json:"order"`
+}
+
+// listOptions converts the list arguments and the resource specific filters of a list tool to list options
+func (a *listArgs) listOptions(filters map[string]string) *models.ListOptions {
+	opts := &models.ListOptions{
+		Limit:         a.Limit,
+		Cursor:        a.Cursor,
+		LabelSelector: a.LabelSelector,
+		Filters:       filters,
+		SortBy:        a.SortBy,
+		Order:         a.Order,
+	}
+	opts.Sanitize()
+	return opts
+}
+
+// withListProperties adds the properties of the list arguments to the input schema properties of a list tool
+func withListProperties(properties map[string]any) map[string]any {
+	properties["limit"] = integerProperty("Optional: maximum number of items to return. All items are returned if omitted")
+	properties["cursor"] = stringProperty("Optional: the nextCursor of the previous page, to list the next page")
+	properties["label_selector"] = stringProperty("Optional: filter by labels (e.g., 'team=payments,tier!=frontend')")
+	properties["sort_by"] = stringProperty("Optional: 'name' (default) or 'createdAt'")
+	properties["order"] = stringProperty("Optional: 'asc' (default) or 'desc'")
+	return properties
+}
@@ -16,13 +16,14 @@ func (t *Toolsets) RegisterListEnvironments(s *mcp.Server) {
 		Name: "list_environments",
 		Description: "List all environments in an organization. Environments are deployment targets representing " +
 			"pipeline stages (dev, staging, production) or isolated tenants.",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createSchema(withListProperties(map[string]any{
 			"org_name": defaultStringProperty(),
-		}, []string{"org_name"}),
+		}), []string{"org_name"}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName string `json:"org_name"`
+		listArgs
 	}) (*mcp.CallToolResult, any, error) {
-		result, err := t.InfrastructureToolset.ListEnvironments(ctx, args.OrgName)
+		result, err := t.InfrastructureToolset.ListEnvironments(ctx, args.OrgName, args.listOptions(nil))
 		return handleToolResult(result, err)
 	})
 }
@@ -89,13 +90,14 @@ func (t *Toolsets) RegisterListDataPlanes(s *mcp.Server) {
 		Name: "list_dataplanes",
 		Description: "List all data planes in an organization. Data planes are Kubernetes clusters or cluster " +
 			"regions where component workloads actually execute.",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createSchema(withListProperties(map[string]any{
 			"org_name": defaultStringProperty(),
-		}, []string{"org_name"}),
+		}), []string{"org_name"}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName string `json:"org_name"`
+		listArgs
 	}) (*mcp.CallToolResult, any, error) {
-		result, err := t.InfrastructureToolset.ListDataPlanes(ctx, args.OrgName)
+		result, err := t.InfrastructureToolset.ListDataPlanes(ctx, args.OrgName, args.listOptions(nil))
 		return handleToolResult(result, err)
 	})
 }
@@ -184,13 +186,14 @@ func (t *Toolsets) RegisterListComponentTypes(s *mcp.Server) {
 		Name: "list_component_types",
 		Description: "List all available component types in an organization. Component types define the " +
 			"structure and capabilities of components (e.g., WebApplication, Service, ScheduledTask).",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createSchema(withListProperties(map[string]any{
 			"org_name": defaultStringProperty(),
-		}, []string{"org_name"}),
+		}), []string{"org_name"}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName string `json:"org_name"`
+		listArgs
 	}) (*mcp.CallToolResult, any, error) {
-		result, err := t.InfrastructureToolset.ListComponentTypes(ctx, args.OrgName)
+		result, err := t.InfrastructureToolset.ListComponentTypes(ctx, args.OrgName, args.listOptions(nil))
 		return handleToolResult(result, err)
 	})
 }
@@ -218,13 +221,14 @@ func (t *Toolsets) RegisterListWorkflows(s *mcp.Server) {
 		Name: "list_workflows",
 		Description: "List all available workflows in an organization. Workflows define build and deployment " +
 			"processes for components.",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createSchema(withListProperties(map[string]any{
 			"org_name": defaultStringProperty(),
-		}, []string{"org_name"}),
+		}), []string{"org_name"}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName string `json:"org_name"`
+		listArgs
 	}) (*mcp.CallToolResult, any, error) {
-		result, err := t.InfrastructureToolset.ListWorkflows(ctx, args.OrgName)
+		result, err := t.InfrastructureToolset.ListWorkflows(ctx, args.OrgName, args.listOptions(nil))
 		return handleToolResult(result, err)
 	})
 }
@@ -252,13 +256,14 @@ func (t *Toolsets) RegisterListTraits(s *mcp.Server) {
 		Name: "list_traits",
 		Description: "List all available traits in an organization. Traits add capabilities to components " +
 			"(e.g., autoscaling, ingress, service mesh).",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createSchema(withListProperties(map[string]any{
 			"org_name": defaultStringProperty(),
-		}, []string{"org_name"}),
+		}), []string{"org_name"}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName string `json:"org_name"`
+		listArgs
 	}) (*mcp.CallToolResult, any, error) {
-		result, err := t.InfrastructureToolset.ListTraits(ctx, args.OrgName)
+		result, err := t.InfrastructureToolset.ListTraits(ctx, args.OrgName, args.listOptions(nil))
 		return handleToolResult(result, err)
 	})
 }
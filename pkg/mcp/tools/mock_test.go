@@ -32,13 +32,15 @@ func (m *MockCoreToolsetHandler) GetOrganization(ctx context.Context, name strin
 	return `{"name":"test-org"}`, nil
 }
 
-func (m *MockCoreToolsetHandler) ListOrganizations(ctx context.Context) (any, error) {
-	m.recordCall("ListOrganizations")
+func (m *MockCoreToolsetHandler) ListOrganizations(ctx context.Context, opts *models.ListOptions) (any, error) {
+	m.recordCall("ListOrganizations", opts)
 	return `[{"name":"test-org"}]`, nil
 }
 
-func (m *MockCoreToolsetHandler) ListProjects(ctx context.Context, orgName string) (any, error) {
-	m.recordCall("ListProjects", orgName)
+func (m *MockCoreToolsetHandler) ListProjects(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (any, error) {
+	m.recordCall("ListProjects", orgName, opts)
 	return `[{"name":"project1"}]`, nil
 }
 
@@ -61,8 +63,10 @@ func (m *MockCoreToolsetHandler) CreateComponent(
 	return `{"name":"new-component"}`, nil
 }
 
-func (m *MockCoreToolsetHandler) ListComponents(ctx context.Context, orgName, projectName string) (any, error) {
-	m.recordCall("ListComponents", orgName, projectName)
+func (m *MockCoreToolsetHandler) ListComponents(
+	ctx context.Context, orgName, projectName string, opts *models.ListOptions,
+) (any, error) {
+	m.recordCall("ListComponents", orgName, projectName, opts)
 	return `[{"name":"component1"}]`, nil
 }
 
@@ -109,8 +113,10 @@ func (m *MockCoreToolsetHandler) GetComponentWorkloads(
 	return `[{"name":"workload1"}]`, nil
 }
 
-func (m *MockCoreToolsetHandler) ListEnvironments(ctx context.Context, orgName string) (any, error) {
-	m.recordCall("ListEnvironments", orgName)
+func (m *MockCoreToolsetHandler) ListEnvironments(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (any, error) {
+	m.recordCall("ListEnvironments", orgName, opts)
 	return `[{"name":"dev"}]`, nil
 }
 
@@ -126,8 +132,10 @@ func (m *MockCoreToolsetHandler) CreateEnvironment(
 	return `{"name":"new-env"}`, nil
 }
 
-func (m *MockCoreToolsetHandler) ListDataPlanes(ctx context.Context, orgName string) (any, error) {
-	m.recordCall("ListDataPlanes", orgName)
+func (m *MockCoreToolsetHandler) ListDataPlanes(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (any, error) {
+	m.recordCall("ListDataPlanes", orgName, opts)
 	return `[{"name":"dp1"}]`, nil
 }
 
@@ -143,8 +151,10 @@ func (m *MockCoreToolsetHandler) CreateDataPlane(
 	return `{"name":"new-dp"}`, nil
 }
 
-func (m *MockCoreToolsetHandler) ListBuildTemplates(ctx context.Context, orgName string) (any, error) {
-	m.recordCall("ListBuildTemplates", orgName)
+func (m *MockCoreToolsetHandler) ListBuildTemplates(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (any, error) {
+	m.recordCall("ListBuildTemplates", orgName, opts)
 	return `[{"name":"template1"}]`, nil
 }
 
@@ -156,14 +166,16 @@ func (m *MockCoreToolsetHandler) TriggerBuild(
 }
 
 func (m *MockCoreToolsetHandler) ListBuilds(
-	ctx context.Context, orgName, projectName, componentName string,
+	ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions,
 ) (any, error) {
-	m.recordCall("ListBuilds", orgName, projectName, componentName)
+	m.recordCall("ListBuilds", orgName, projectName, componentName, opts)
 	return `[{"id":"build-123"}]`, nil
 }
 
-func (m *MockCoreToolsetHandler) ListBuildPlanes(ctx context.Context, orgName string) (any, error) {
-	m.recordCall("ListBuildPlanes", orgName)
+func (m *MockCoreToolsetHandler) ListBuildPlanes(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (any, error) {
+	m.recordCall("ListBuildPlanes", orgName, opts)
 	return `[{"name":"bp1"}]`, nil
 }
 
@@ -180,9 +192,9 @@ func (m *MockCoreToolsetHandler) ExplainSchema(ctx context.Context, kind, path s
 }
 
 func (m *MockCoreToolsetHandler) ListComponentReleases(
-	ctx context.Context, orgName, projectName, componentName string,
+	ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions,
 ) (any, error) {
-	m.recordCall("ListComponentReleases", orgName, projectName, componentName)
+	m.recordCall("ListComponentReleases", orgName, projectName, componentName, opts)
 	return `[{"name":"release-1"}]`, nil
 }
 
@@ -202,8 +214,9 @@ func (m *MockCoreToolsetHandler) GetComponentRelease(
 
 func (m *MockCoreToolsetHandler) ListReleaseBindings(
 	ctx context.Context, orgName, projectName, componentName string, environments []string,
+	opts *models.ListOptions,
 ) (any, error) {
-	m.recordCall("ListReleaseBindings", orgName, projectName, componentName, environments)
+	m.recordCall("ListReleaseBindings", orgName, projectName, componentName, environments, opts)
 	return `[{"environment":"dev"}]`, nil
 }
 
@@ -215,6 +228,29 @@ func (m *MockCoreToolsetHandler) PatchReleaseBinding(
 	return `{"status":"updated"}`, nil
 }
 
+func (m *MockCoreToolsetHandler) DryRunReleaseBinding(
+	ctx context.Context, orgName, projectName, componentName, bindingName string,
+	req *models.PatchReleaseBindingRequest,
+) (any, error) {
+	m.recordCall("DryRunReleaseBinding", orgName, projectName, componentName, bindingName, req)
+	return `{"summary":{"modified":1}}`, nil
+}
+
+func (m *MockCoreToolsetHandler) GetReleaseBindingHistory(
+	ctx context.Context, orgName, projectName, componentName, bindingName string,
+) (any, error) {
+	m.recordCall("GetReleaseBindingHistory", orgName, projectName, componentName, bindingName)
+	return `{"history":[{"releaseName":"component-1-v2","current":true},{"releaseName":"component-1-v1"}]}`, nil
+}
+
+func (m *MockCoreToolsetHandler) RollbackReleaseBinding(
+	ctx context.Context, orgName, projectName, componentName, bindingName string,
+	req *models.RollbackReleaseBindingRequest,
+) (any, error) {
+	m.recordCall("RollbackReleaseBinding", orgName, projectName, componentName, bindingName, req)
+	return `{"releaseName":"component-1-v1"}`, nil
+}
+
 func (m *MockCoreToolsetHandler) DeployRelease(
 	ctx context.Context, orgName, projectName, componentName string, req *models.DeployReleaseRequest,
 ) (any, error) {
@@ -229,6 +265,36 @@ func (m *MockCoreToolsetHandler) PromoteComponent(
 	return `{"environment":"staging"}`, nil
 }
 
+func (m *MockCoreToolsetHandler) RequestPromotion(
+	ctx context.Context, orgName, projectName, componentName string, req *models.CreatePromotionRequestRequest,
+) (any, error) {
+	m.recordCall("RequestPromotion", orgName, projectName, componentName, req)
+	return `{"name":"component-1-production-x7k2p","phase":"Pending"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) ListPromotionRequests(
+	ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions,
+) (any, error) {
+	m.recordCall("ListPromotionRequests", orgName, projectName, componentName, opts)
+	return `[{"name":"component-1-production-x7k2p","phase":"Pending"}]`, nil
+}
+
+func (m *MockCoreToolsetHandler) ApprovePromotionRequest(
+	ctx context.Context, orgName, projectName, componentName, requestName string,
+	req *models.ReviewPromotionRequestRequest,
+) (any, error) {
+	m.recordCall("ApprovePromotionRequest", orgName, projectName, componentName, requestName, req)
+	return `{"name":"component-1-production-x7k2p","phase":"Promoted"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) RejectPromotionRequest(
+	ctx context.Context, orgName, projectName, componentName, requestName string,
+	req *models.ReviewPromotionRequestRequest,
+) (any, error) {
+	m.recordCall("RejectPromotionRequest", orgName, projectName, componentName, requestName, req)
+	return `{"name":"component-1-production-x7k2p","phase":"Rejected"}`, nil
+}
+
 func (m *MockCoreToolsetHandler) CreateWorkload(
 	ctx context.Context, orgName, projectName, componentName string, workloadSpec interface{},
 ) (any, error) {
@@ -250,8 +316,10 @@ func (m *MockCoreToolsetHandler) GetComponentReleaseSchema(
 	return emptyObjectSchema, nil
 }
 
-func (m *MockCoreToolsetHandler) ListComponentTypes(ctx context.Context, orgName string) (any, error) {
-	m.recordCall("ListComponentTypes", orgName)
+func (m *MockCoreToolsetHandler) ListComponentTypes(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (any, error) {
+	m.recordCall("ListComponentTypes", orgName, opts)
 	return `[{"name":"WebApplication"}]`, nil
 }
 
@@ -260,8 +328,10 @@ func (m *MockCoreToolsetHandler) GetComponentTypeSchema(ctx context.Context, org
 	return emptyObjectSchema, nil
 }
 
-func (m *MockCoreToolsetHandler) ListWorkflows(ctx context.Context, orgName string) (any, error) {
-	m.recordCall("ListWorkflows", orgName)
+func (m *MockCoreToolsetHandler) ListWorkflows(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (any, error) {
+	m.recordCall("ListWorkflows", orgName, opts)
 	return `[{"name":"workflow-1"}]`, nil
 }
 
@@ -270,8 +340,10 @@ func (m *MockCoreToolsetHandler) GetWorkflowSchema(ctx context.Context, orgName,
 	return emptyObjectSchema, nil
 }
 
-func (m *MockCoreToolsetHandler) ListTraits(ctx context.Context, orgName string) (any, error) {
-	m.recordCall("ListTraits", orgName)
+func (m *MockCoreToolsetHandler) ListTraits(
+	ctx context.Context, orgName string, opts *models.ListOptions,
+) (any, error) {
+	m.recordCall("ListTraits", orgName, opts)
 	return `[{"name":"autoscaling"}]`, nil
 }
 
@@ -31,10 +31,11 @@ func (t *Toolsets) RegisterListOrganizations(s *mcp.Server) {
 		Name: "list_organizations",
 		Description: "List all accessible organizations. Organizations are the top-level " +
 			"tenant boundary containing projects, environments, and infrastructure.",
-		InputSchema: createSchema(map[string]any{}, []string{}),
+		InputSchema: createSchema(withListProperties(map[string]any{}), []string{}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		listArgs
 	}) (*mcp.CallToolResult, any, error) {
-		result, err := t.OrganizationToolset.ListOrganizations(ctx)
+		result, err := t.OrganizationToolset.ListOrganizations(ctx, args.listOptions(nil))
 		return handleToolResult(result, err)
 	})
 }
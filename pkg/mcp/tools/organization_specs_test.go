@@ -3,7 +3,11 @@
 
 package tools
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+)
 
 // organizationToolSpecs returns test specs for organization toolset
 func organizationToolSpecs() []toolTestSpec {
@@ -14,13 +18,20 @@ func organizationToolSpecs() []toolTestSpec {
 			descriptionKeywords: []string{"organization"},
 			descriptionMinLen:   10,
 			requiredParams:      []string{},
-			optionalParams:      []string{},
-			testArgs:            map[string]any{},
+			optionalParams:      []string{"limit", "cursor"},
+			testArgs:            map[string]any{"limit": 10, "cursor": " next-page "},
 			expectedMethod:      "ListOrganizations",
 			validateCall: func(t *testing.T, args []interface{}) {
-				// ListOrganizations takes no arguments
-				if len(args) != 0 {
-					t.Errorf("Expected no arguments for ListOrganizations, got %d", len(args))
+				// ListOrganizations only takes the list options
+				if len(args) != 1 {
+					t.Fatalf("Expected only the list options for ListOrganizations, got %d arguments", len(args))
+				}
+				opts, ok := args[0].(*models.ListOptions)
+				if !ok {
+					t.Fatalf("Expected *models.ListOptions, got %T", args[0])
+				}
+				if opts.Limit != 10 || opts.Cursor != "next-page" {
+					t.Errorf("Expected limit 10 and cursor %q, got %+v", "next-page", opts)
 				}
 			},
 		},
@@ -16,13 +16,14 @@ func (t *Toolsets) RegisterListProjects(s *mcp.Server) {
 		Name: "list_projects",
 		Description: "List all projects in an organization. Projects are logical groupings of related " +
 			"components that share deployment pipelines.",
-		InputSchema: createSchema(map[string]any{
+		InputSchema: createSchema(withListProperties(map[string]any{
 			"org_name": stringProperty("Use get_organization to discover valid names"),
-		}, []string{"org_name"}),
+		}), []string{"org_name"}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		OrgName string `json:"org_name"`
+		listArgs
 	}) (*mcp.CallToolResult, any, error) {
-		result, err := t.ProjectToolset.ListProjects(ctx, args.OrgName)
+		result, err := t.ProjectToolset.ListProjects(ctx, args.OrgName, args.listOptions(nil))
 		return handleToolResult(result, err)
 	})
 }
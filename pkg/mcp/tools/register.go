@@ -40,8 +40,15 @@ func (t *Toolsets) componentToolRegistrations() []RegisterFunc {
 		t.RegisterGetComponentReleaseSchema,
 		t.RegisterListReleaseBindings,
 		t.RegisterPatchReleaseBinding,
+		t.RegisterDryRunReleaseBinding,
+		t.RegisterGetReleaseBindingHistory,
+		t.RegisterRollbackReleaseBinding,
 		t.RegisterDeployRelease,
 		t.RegisterPromoteComponent,
+		t.RegisterRequestPromotion,
+		t.RegisterListPromotionRequests,
+		t.RegisterApprovePromotionRequest,
+		t.RegisterRejectPromotionRequest,
 		t.RegisterCreateWorkload,
 	}
 }
@@ -99,6 +106,16 @@ func (t *Toolsets) resourceToolRegistrations() []RegisterFunc {
 }
 
 func (t *Toolsets) Register(s *mcp.Server) {
+	// Authorize tool calls if an authorization policy is configured
+	if t.Authorizer != nil {
+		s.AddReceivingMiddleware(t.authorizationMiddleware)
+	}
+
+	// Audit calls to mutating tools. Added after the authorization middleware to wrap it and record denied calls.
+	if t.Auditor != nil {
+		s.AddReceivingMiddleware(t.auditMiddleware)
+	}
+
 	// Register organization tools if OrganizationToolset is enabled
 	if t.OrganizationToolset != nil {
 		for _, registerFunc := range t.organizationToolRegistrations() {
@@ -9,6 +9,8 @@ import (
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth/rbac"
 )
 
 // ToolsetType represents a type of toolset that can be enabled
@@ -34,18 +36,24 @@ type Toolsets struct {
 	InfrastructureToolset InfrastructureToolsetHandler
 	SchemaToolset         SchemaToolsetHandler
 	ResourceToolset       ResourceToolsetHandler
+
+	// Authorizer authorizes the tool calls. Tool calls are not authorized when it is nil.
+	Authorizer *rbac.Authorizer
+
+	// Auditor records the calls to the tools changing resources. Tool calls are not audited when it is nil.
+	Auditor *audit.Auditor
 }
 
 // OrganizationToolsetHandler handles organization operations
 type OrganizationToolsetHandler interface {
 	GetOrganization(ctx context.Context, name string) (any, error)
-	ListOrganizations(ctx context.Context) (any, error)
+	ListOrganizations(ctx context.Context, opts *models.ListOptions) (any, error)
 }
 
 // ProjectToolsetHandler handles organization and project operations
 type ProjectToolsetHandler interface {
 	// Project operations
-	ListProjects(ctx context.Context, orgName string) (any, error)
+	ListProjects(ctx context.Context, orgName string, opts *models.ListOptions) (any, error)
 	GetProject(ctx context.Context, orgName, projectName string) (any, error)
 	CreateProject(ctx context.Context, orgName string, req *models.CreateProjectRequest) (any, error)
 }
@@ -53,7 +61,7 @@ type ProjectToolsetHandler interface {
 // ComponentToolsetHandler handles component operations
 type ComponentToolsetHandler interface {
 	CreateComponent(ctx context.Context, orgName, projectName string, req *models.CreateComponentRequest) (any, error)
-	ListComponents(ctx context.Context, orgName, projectName string) (any, error)
+	ListComponents(ctx context.Context, orgName, projectName string, opts *models.ListOptions) (any, error)
 	GetComponent(
 		ctx context.Context, orgName, projectName, componentName string, additionalResources []string,
 	) (any, error)
@@ -64,17 +72,29 @@ type ComponentToolsetHandler interface {
 	) (any, error)
 	GetComponentWorkloads(ctx context.Context, orgName, projectName, componentName string) (any, error)
 	// Component release operations
-	ListComponentReleases(ctx context.Context, orgName, projectName, componentName string) (any, error)
+	ListComponentReleases(
+		ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions,
+	) (any, error)
 	CreateComponentRelease(ctx context.Context, orgName, projectName, componentName, releaseName string) (any, error)
 	GetComponentRelease(ctx context.Context, orgName, projectName, componentName, releaseName string) (any, error)
 	// Release binding operations
 	ListReleaseBindings(
 		ctx context.Context, orgName, projectName, componentName string, environments []string,
+		opts *models.ListOptions,
 	) (any, error)
 	PatchReleaseBinding(
 		ctx context.Context, orgName, projectName, componentName, bindingName string,
 		req *models.PatchReleaseBindingRequest,
 	) (any, error)
+	DryRunReleaseBinding(
+		ctx context.Context, orgName, projectName, componentName, bindingName string,
+		req *models.PatchReleaseBindingRequest,
+	) (any, error)
+	GetReleaseBindingHistory(ctx context.Context, orgName, projectName, componentName, bindingName string) (any, error)
+	RollbackReleaseBinding(
+		ctx context.Context, orgName, projectName, componentName, bindingName string,
+		req *models.RollbackReleaseBindingRequest,
+	) (any, error)
 	// Deployment operations
 	DeployRelease(
 		ctx context.Context, orgName, projectName, componentName string, req *models.DeployReleaseRequest,
@@ -82,6 +102,21 @@ type ComponentToolsetHandler interface {
 	PromoteComponent(
 		ctx context.Context, orgName, projectName, componentName string, req *models.PromoteComponentRequest,
 	) (any, error)
+	// Promotion request operations
+	RequestPromotion(
+		ctx context.Context, orgName, projectName, componentName string, req *models.CreatePromotionRequestRequest,
+	) (any, error)
+	ListPromotionRequests(
+		ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions,
+	) (any, error)
+	ApprovePromotionRequest(
+		ctx context.Context, orgName, projectName, componentName, requestName string,
+		req *models.ReviewPromotionRequestRequest,
+	) (any, error)
+	RejectPromotionRequest(
+		ctx context.Context, orgName, projectName, componentName, requestName string,
+		req *models.ReviewPromotionRequestRequest,
+	) (any, error)
 	// Workload operations
 	CreateWorkload(ctx context.Context, orgName, projectName, componentName string, workloadSpec interface{}) (any, error)
 	// Schema operations
@@ -91,11 +126,13 @@ type ComponentToolsetHandler interface {
 
 // BuildToolsetHandler handles build operations
 type BuildToolsetHandler interface {
-	ListBuildTemplates(ctx context.Context, orgName string) (any, error)
+	ListBuildTemplates(ctx context.Context, orgName string, opts *models.ListOptions) (any, error)
 	TriggerBuild(ctx context.Context, orgName, projectName, componentName, commit string) (any, error)
-	ListBuilds(ctx context.Context, orgName, projectName, componentName string) (any, error)
+	ListBuilds(
+		ctx context.Context, orgName, projectName, componentName string, opts *models.ListOptions,
+	) (any, error)
 	GetBuildObserverURL(ctx context.Context, orgName, projectName, componentName string) (any, error)
-	ListBuildPlanes(ctx context.Context, orgName string) (any, error)
+	ListBuildPlanes(ctx context.Context, orgName string, opts *models.ListOptions) (any, error)
 }
 
 // DeploymentToolsetHandler handles deployment operations
@@ -109,25 +146,25 @@ type DeploymentToolsetHandler interface {
 // InfrastructureToolsetHandler handles infrastructure operations
 type InfrastructureToolsetHandler interface {
 	// Environment operations
-	ListEnvironments(ctx context.Context, orgName string) (any, error)
+	ListEnvironments(ctx context.Context, orgName string, opts *models.ListOptions) (any, error)
 	GetEnvironment(ctx context.Context, orgName, envName string) (any, error)
 	CreateEnvironment(ctx context.Context, orgName string, req *models.CreateEnvironmentRequest) (any, error)
 
 	// DataPlane operations
-	ListDataPlanes(ctx context.Context, orgName string) (any, error)
+	ListDataPlanes(ctx context.Context, orgName string, opts *models.ListOptions) (any, error)
 	GetDataPlane(ctx context.Context, orgName, dpName string) (any, error)
 	CreateDataPlane(ctx context.Context, orgName string, req *models.CreateDataPlaneRequest) (any, error)
 
 	// ComponentType operations
-	ListComponentTypes(ctx context.Context, orgName string) (any, error)
+	ListComponentTypes(ctx context.Context, orgName string, opts *models.ListOptions) (any, error)
 	GetComponentTypeSchema(ctx context.Context, orgName, ctName string) (any, error)
 
 	// Workflow operations
-	ListWorkflows(ctx context.Context, orgName string) (any, error)
+	ListWorkflows(ctx context.Context, orgName string, opts *models.ListOptions) (any, error)
 	GetWorkflowSchema(ctx context.Context, orgName, workflowName string) (any, error)
 
 	// Trait operations
-	ListTraits(ctx context.Context, orgName string) (any, error)
+	ListTraits(ctx context.Context, orgName string, opts *models.ListOptions) (any, error)
 	GetTraitSchema(ctx context.Context, orgName, traitName string) (any, error)
 }
 